@@ -0,0 +1,159 @@
+// Command compose composes a federated graph from local subgraph schemas and writes the execution config
+// for the router. It doesn't require the control plane, which allows running the router in air-gapped environments.
+//
+// The input file lists the subgraphs:
+//
+//	version: 1
+//	subgraphs:
+//	  - name: employees
+//	    routing_url: http://localhost:4001/graphql
+//	    schema:
+//	      file: ./employees.graphql
+//	  - name: products
+//	    routing_url: http://localhost:4002/graphql
+//	    introspection:
+//	      url: http://localhost:4002/graphql
+//	    subscription:
+//	      url: ws://localhost:4002/graphql
+//	      protocol: ws
+//
+// The only supported version of the input file is 1. Schema files are resolved relative to the input file.
+// Subgraphs without a schema file are introspected through the introspection URL or, if not set, through the
+// routing URL.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/wundergraph/cosmo/composition-go"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	inputFilePath  = flag.String("input", "graph.yaml", "input file location with the subgraphs to compose")
+	outputFilePath = flag.String("out", "", "output execution config file location. Writes to stdout if empty")
+)
+
+// inputConfigVersion is the only supported version of the input file format
+const inputConfigVersion = 1
+
+type inputConfig struct {
+	Version   int              `yaml:"version"`
+	Subgraphs []subgraphConfig `yaml:"subgraphs"`
+}
+
+type subgraphConfig struct {
+	Name       string `yaml:"name"`
+	RoutingURL string `yaml:"routing_url"`
+	Schema     struct {
+		File string `yaml:"file"`
+	} `yaml:"schema"`
+	Introspection struct {
+		URL string `yaml:"url"`
+	} `yaml:"introspection"`
+	Subscription struct {
+		URL      string `yaml:"url"`
+		Protocol string `yaml:"protocol"`
+	} `yaml:"subscription"`
+}
+
+func main() {
+	flag.Parse()
+
+	subgraphs, err := loadSubgraphs(*inputFilePath)
+	if err != nil {
+		log.Fatalf("failed to load subgraphs: %v", err)
+	}
+
+	routerConfig, err := composition.BuildRouterConfigurationWithWarnings(subgraphs...)
+	if err != nil {
+		log.Fatalf("failed to compose subgraphs: %v", err)
+	}
+
+	for _, warning := range routerConfig.Warnings {
+		log.Printf("warning: %s", warning.Message)
+	}
+
+	if *outputFilePath == "" {
+		fmt.Println(routerConfig.Config)
+		return
+	}
+
+	if err := os.WriteFile(*outputFilePath, []byte(routerConfig.Config), 0644); err != nil {
+		log.Fatalf("failed to write execution config: %v", err)
+	}
+
+	log.Printf("Wrote execution config of %d subgraphs to %s", len(subgraphs), *outputFilePath)
+}
+
+// loadSubgraphs reads the input file and resolves the schema of every subgraph
+func loadSubgraphs(path string) ([]*composition.Subgraph, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg inputConfig
+	if err := yaml.Unmarshal(content, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse input file: %w", err)
+	}
+
+	if cfg.Version != inputConfigVersion {
+		return nil, fmt.Errorf("unsupported input file version %d, expected version %d", cfg.Version, inputConfigVersion)
+	}
+
+	if len(cfg.Subgraphs) == 0 {
+		return nil, errors.New("input file doesn't contain any subgraphs")
+	}
+
+	baseDir := filepath.Dir(path)
+	subgraphs := make([]*composition.Subgraph, 0, len(cfg.Subgraphs))
+
+	for i, sg := range cfg.Subgraphs {
+		if sg.Name == "" {
+			return nil, fmt.Errorf("subgraph #%d has no name", i)
+		}
+		if sg.RoutingURL == "" {
+			return nil, fmt.Errorf("subgraph %s has no routing_url", sg.Name)
+		}
+		if sg.Schema.File != "" && sg.Introspection.URL != "" {
+			return nil, fmt.Errorf("subgraph %s must set either schema.file or introspection.url, not both", sg.Name)
+		}
+
+		subgraph := &composition.Subgraph{
+			Name:                 sg.Name,
+			URL:                  sg.RoutingURL,
+			SubscriptionURL:      sg.Subscription.URL,
+			SubscriptionProtocol: sg.Subscription.Protocol,
+		}
+
+		switch {
+		case sg.Schema.File != "":
+			schemaPath := sg.Schema.File
+			if !filepath.IsAbs(schemaPath) {
+				schemaPath = filepath.Join(baseDir, schemaPath)
+			}
+			schema, err := os.ReadFile(schemaPath)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read schema of subgraph %s: %w", sg.Name, err)
+			}
+			subgraph.Schema = string(schema)
+		case sg.Introspection.URL != "":
+			schema, err := composition.IntrospectSubgraph(sg.Introspection.URL)
+			if err != nil {
+				return nil, fmt.Errorf("error introspecting subgraph %s: %w", sg.Name, err)
+			}
+			subgraph.Schema = schema
+		}
+
+		// Subgraphs without a schema are introspected through the routing URL during composition
+		subgraphs = append(subgraphs, subgraph)
+	}
+
+	return subgraphs, nil
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wundergraph/cosmo/composition-go"
+)
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestLoadSubgraphs(t *testing.T) {
+	t.Run("schema files and introspection", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"data":{"_service":{"sdl":"type Query { products: [String] }"}}}`))
+		}))
+		defer server.Close()
+
+		dir := t.TempDir()
+		writeFile(t, dir, "employees.graphql", `type Query { employees: [String] }`)
+		input := writeFile(t, dir, "graph.yaml", `
+version: 1
+subgraphs:
+  - name: employees
+    routing_url: http://localhost:4001/graphql
+    schema:
+      file: ./employees.graphql
+  - name: products
+    routing_url: http://localhost:4002/graphql
+    introspection:
+      url: `+server.URL+`
+    subscription:
+      url: ws://localhost:4002/graphql
+      protocol: ws
+`)
+
+		subgraphs, err := loadSubgraphs(input)
+		require.NoError(t, err)
+		require.Len(t, subgraphs, 2)
+
+		require.Equal(t, "employees", subgraphs[0].Name)
+		require.Equal(t, `type Query { employees: [String] }`, subgraphs[0].Schema)
+		require.Equal(t, "http://localhost:4002/graphql", subgraphs[1].URL)
+		require.Equal(t, `type Query { products: [String] }`, subgraphs[1].Schema)
+		require.Equal(t, "ws", subgraphs[1].SubscriptionProtocol)
+
+		routerConfig, err := composition.BuildRouterConfiguration(subgraphs...)
+		require.NoError(t, err)
+		require.True(t, json.Valid([]byte(routerConfig)))
+	})
+
+	t.Run("invalid subgraphs", func(t *testing.T) {
+		dir := t.TempDir()
+
+		_, err := loadSubgraphs(writeFile(t, dir, "empty.yaml", `version: 1`))
+		require.ErrorContains(t, err, "doesn't contain any subgraphs")
+
+		_, err = loadSubgraphs(writeFile(t, dir, "no-version.yaml", `subgraphs: [{name: a, routing_url: "http://a"}]`))
+		require.ErrorContains(t, err, "unsupported input file version 0")
+
+		_, err = loadSubgraphs(writeFile(t, dir, "unknown-version.yaml", "version: 2\nsubgraphs: [{name: a, routing_url: \"http://a\"}]"))
+		require.ErrorContains(t, err, "unsupported input file version 2")
+
+		_, err = loadSubgraphs(writeFile(t, dir, "no-url.yaml", "version: 1\nsubgraphs: [{name: a}]"))
+		require.ErrorContains(t, err, "has no routing_url")
+
+		_, err = loadSubgraphs(writeFile(t, dir, "missing.yaml", "version: 1\nsubgraphs: [{name: a, routing_url: \"http://a\", schema: {file: missing.graphql}}]"))
+		require.ErrorContains(t, err, "failed to read schema of subgraph a")
+	})
+}
@@ -0,0 +1,271 @@
+package composition
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultIntrospectionTimeout = 30 * time.Second
+
+// Option configures a Composer
+type Option func(c *Composer)
+
+// WithPoolSize sets the maximum number of VMs used by the Composer. It also limits the number
+// of compositions running concurrently. Defaults to GOMAXPROCS.
+func WithPoolSize(size int) Option {
+	return func(c *Composer) {
+		if size > 0 {
+			c.poolSize = size
+		}
+	}
+}
+
+// WithHTTPClient sets the client used to introspect subgraphs without a schema.
+// Defaults to a client with a timeout of 30 seconds.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Composer) {
+		if client != nil {
+			c.httpClient = client
+		}
+	}
+}
+
+// WithIntrospectionHeaders sets the headers sent when introspecting the subgraph with the given name.
+// Can be used multiple times for different subgraphs.
+func WithIntrospectionHeaders(subgraphName string, header http.Header) Option {
+	return func(c *Composer) {
+		c.introspectionHeaders[subgraphName] = header.Clone()
+	}
+}
+
+// Composer federates subgraphs using a bounded pool of VMs. A Composer is safe for concurrent use
+// and should be reused, since creating a VM is expensive. The package level functions use a
+// shared Composer with the default options.
+type Composer struct {
+	poolSize             int
+	httpClient           *http.Client
+	introspectionHeaders map[string]http.Header
+
+	// tokens limits the number of VMs in use, idle holds the VMs that can be reused
+	tokens chan struct{}
+	idle   chan *vm
+
+	// mu guards closed, so that no VM is returned to idle after Close disposed the idle VMs
+	mu     sync.Mutex
+	closed bool
+}
+
+// NewComposer returns a Composer configured with the given options.
+func NewComposer(opts ...Option) *Composer {
+	c := &Composer{
+		poolSize:             runtime.GOMAXPROCS(0),
+		httpClient:           &http.Client{Timeout: defaultIntrospectionTimeout},
+		introspectionHeaders: make(map[string]http.Header),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.tokens = make(chan struct{}, c.poolSize)
+	c.idle = make(chan *vm, c.poolSize)
+	return c
+}
+
+// Federate produces a federated graph from the subgraphs. Subgraphs without a schema are introspected
+// first. If the composition fails, the returned error is of type CompositionErrors. Cancelling the
+// context aborts the introspection and the composition.
+func (c *Composer) Federate(ctx context.Context, subgraphs ...*Subgraph) (*FederatedGraph, error) {
+	updatedSubgraphs, err := c.updateSchemas(ctx, subgraphs)
+	if err != nil {
+		return nil, err
+	}
+	var result *federationResult
+	err = c.withVM(ctx, func(vm *vm) error {
+		var err error
+		result, err = vm.FederateSubgraphs(updatedSubgraphs)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := compositionErrors(result.Errors); err != nil {
+		return nil, err
+	}
+	return &FederatedGraph{
+		FieldConfigurations: result.FieldConfigurations,
+		SDL:                 result.SDL,
+		Warnings:            compositionWarnings(result.Warnings),
+	}, nil
+}
+
+// BuildRouterConfiguration produces the router execution config from the subgraphs. Subgraphs without
+// a schema are introspected first. If the composition fails, the returned error is of type
+// CompositionErrors. Cancelling the context aborts the introspection and the composition.
+func (c *Composer) BuildRouterConfiguration(ctx context.Context, subgraphs ...*Subgraph) (*RouterConfiguration, error) {
+	updatedSubgraphs, err := c.updateSchemas(ctx, subgraphs)
+	if err != nil {
+		return nil, err
+	}
+	var result *routerConfigurationResult
+	err = c.withVM(ctx, func(vm *vm) error {
+		var err error
+		result, err = vm.BuildRouterConfiguration(updatedSubgraphs)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := compositionErrors(result.Errors); err != nil {
+		return nil, err
+	}
+	return &RouterConfiguration{
+		Config:   result.Config,
+		Warnings: compositionWarnings(result.Warnings),
+	}, nil
+}
+
+// IntrospectSubgraph retrieves the SDL of the subgraph using the _service query. The request
+// is sent to the URL of the subgraph with the introspection headers configured for its name.
+func (c *Composer) IntrospectSubgraph(ctx context.Context, subgraph *Subgraph) (string, error) {
+	return c.introspectSubgraph(ctx, subgraph.URL, c.introspectionHeaders[subgraph.Name])
+}
+
+// Close disposes the idle VMs. VMs in use are disposed when they are released.
+// The Composer must not be used after calling Close.
+func (c *Composer) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.closed = true
+	for {
+		select {
+		case vm := <-c.idle:
+			vm.Dispose()
+		default:
+			return
+		}
+	}
+}
+
+// withVM runs fn with a VM from the pool. If the context is cancelled while fn is running,
+// the VM is interrupted and disposed instead of being returned to the pool.
+func (c *Composer) withVM(ctx context.Context, fn func(vm *vm) error) error {
+	select {
+	case c.tokens <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-c.tokens }()
+
+	var vm *vm
+	select {
+	case vm = <-c.idle:
+	default:
+		var err error
+		vm, err = newVM()
+		if err != nil {
+			return err
+		}
+	}
+
+	// The watcher reports whether it interrupted the VM once it stopped, so that an interrupted VM
+	// is never returned to the pool, even if the context is cancelled right after fn returned
+	done := make(chan struct{})
+	stopped := make(chan bool, 1)
+	go func() {
+		select {
+		case <-ctx.Done():
+			vm.Interrupt()
+			stopped <- true
+		case <-done:
+			stopped <- false
+		}
+	}()
+
+	err := fn(vm)
+	close(done)
+
+	if <-stopped {
+		vm.Dispose()
+		return ctx.Err()
+	}
+
+	c.release(vm)
+	return err
+}
+
+// release returns the VM to the pool, or disposes it if the pool is full or the Composer is closed
+func (c *Composer) release(vm *vm) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		vm.Dispose()
+		return
+	}
+	select {
+	case c.idle <- vm:
+	default:
+		vm.Dispose()
+	}
+}
+
+func (c *Composer) updateSchemas(ctx context.Context, subgraphs []*Subgraph) ([]*Subgraph, error) {
+	updatedSubgraphs := make([]*Subgraph, 0, len(subgraphs))
+	for _, subgraph := range subgraphs {
+		if subgraph.Schema != "" {
+			updatedSubgraphs = append(updatedSubgraphs, subgraph)
+			continue
+		}
+		sdl, err := c.IntrospectSubgraph(ctx, subgraph)
+		if err != nil {
+			return nil, fmt.Errorf("error introspecting subgraph %s: %w", subgraph.Name, err)
+		}
+		cpy := *subgraph
+		cpy.Schema = sdl
+		updatedSubgraphs = append(updatedSubgraphs, &cpy)
+	}
+	return updatedSubgraphs, nil
+}
+
+func (c *Composer) introspectSubgraph(ctx context.Context, URL string, header http.Header) (string, error) {
+	if URL == "" {
+		return "", errors.New("no URL provided")
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", URL, strings.NewReader(sdlQuery))
+	if err != nil {
+		return "", fmt.Errorf("could not create request: %w", err)
+	}
+	for name, values := range header {
+		for _, value := range values {
+			req.Header.Add(name, value)
+		}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("could not retrieve schema: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("could not retrieve schema: unexpected status code %d", resp.StatusCode)
+	}
+	var response sdlResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return "", fmt.Errorf("could not decode SDL response: %w", err)
+	}
+	if len(response.Errors) > 0 {
+		var errorMessages []string
+		for _, err := range response.Errors {
+			errorMessages = append(errorMessages, err.Message)
+		}
+		return "", fmt.Errorf("SDL query returned errors: %s", strings.Join(errorMessages, ", "))
+	}
+	return response.Data.Service.SDL, nil
+}
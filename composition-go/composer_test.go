@@ -0,0 +1,116 @@
+package composition
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComposerConcurrentUse(t *testing.T) {
+	composer := NewComposer(WithPoolSize(2))
+	defer composer.Close()
+
+	var wg sync.WaitGroup
+	errs := make([]error, 6)
+	for ii := range errs {
+		wg.Add(1)
+		go func(ii int) {
+			defer wg.Done()
+			_, errs[ii] = composer.Federate(context.Background(), subgraphs...)
+		}(ii)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	require.LessOrEqual(t, len(composer.idle), 2)
+}
+
+func TestComposerIntrospection(t *testing.T) {
+	t.Run("introspection headers", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":{"_service":{"sdl":"type Query { employees: [String] }"}}}`))
+		}))
+		defer server.Close()
+
+		composer := NewComposer(WithIntrospectionHeaders("employees", http.Header{"Authorization": []string{"Bearer token"}}))
+		defer composer.Close()
+
+		federated, err := composer.Federate(context.Background(), &Subgraph{Name: "employees", URL: server.URL})
+		require.NoError(t, err)
+		require.Contains(t, federated.SDL, "employees: [String]")
+
+		_, err = composer.Federate(context.Background(), &Subgraph{Name: "other", URL: server.URL})
+		require.ErrorContains(t, err, "error introspecting subgraph other: could not retrieve schema: unexpected status code 401")
+	})
+
+	t.Run("client timeout", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+		}))
+		defer server.Close()
+
+		composer := NewComposer(WithHTTPClient(&http.Client{Timeout: 50 * time.Millisecond}))
+		defer composer.Close()
+
+		_, err := composer.BuildRouterConfiguration(context.Background(), &Subgraph{Name: "employees", URL: server.URL})
+		require.ErrorContains(t, err, "Client.Timeout exceeded")
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+		}))
+		defer server.Close()
+
+		composer := NewComposer()
+		defer composer.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		_, err := composer.Federate(ctx, &Subgraph{Name: "employees", URL: server.URL})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestComposerCancelledWhileWaitingForVM(t *testing.T) {
+	composer := NewComposer(WithPoolSize(1))
+	defer composer.Close()
+
+	// Occupy the only slot of the pool
+	composer.tokens <- struct{}{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := composer.BuildRouterConfiguration(ctx, subgraphs...)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestComposerCloseDisposesVMInUse(t *testing.T) {
+	composer := NewComposer(WithPoolSize(1))
+
+	err := composer.withVM(context.Background(), func(vm *vm) error {
+		composer.Close()
+		return nil
+	})
+	require.NoError(t, err)
+	require.Empty(t, composer.idle)
+}
@@ -4,12 +4,8 @@
 package composition
 
 import (
+	"context"
 	_ "embed"
-	"encoding/json"
-	"fmt"
-	"net/http"
-	"strings"
-	"sync"
 )
 
 // Subgraph represents a graph to be federated. URL is optional.
@@ -36,6 +32,31 @@ type FieldConfiguration struct {
 type FederatedGraph struct {
 	FieldConfigurations []*FieldConfiguration `goja:"fieldConfigurations"`
 	SDL                 string                `goja:"sdl"`
+	// Warnings are issues that didn't prevent the composition
+	Warnings []*CompositionWarning `goja:"-"`
+}
+
+// RouterConfiguration is the result of a successful composition for the router
+type RouterConfiguration struct {
+	// Config is the router execution config as JSON
+	Config string
+	// Warnings are issues that didn't prevent the composition
+	Warnings []*CompositionWarning
+}
+
+// federationResult is the result of the federateSubgraphs shim function
+type federationResult struct {
+	FieldConfigurations []*FieldConfiguration `goja:"fieldConfigurations"`
+	SDL                 string                `goja:"sdl"`
+	Errors              []*compositionMessage `goja:"errors"`
+	Warnings            []*compositionMessage `goja:"warnings"`
+}
+
+// routerConfigurationResult is the result of the buildRouterConfiguration shim function
+type routerConfigurationResult struct {
+	Config   string                `goja:"config"`
+	Errors   []*compositionMessage `goja:"errors"`
+	Warnings []*compositionMessage `goja:"warnings"`
 }
 
 type sdlResponse struct {
@@ -62,94 +83,36 @@ const (
 //go:embed index.global.js
 var indexJs string
 
-var (
-	pool sync.Pool
-)
-
-func introspectSubgraph(URL string) (string, error) {
-	if URL == "" {
-		return "", fmt.Errorf("no URL provided")
-	}
-	req, err := http.NewRequest("POST", URL, strings.NewReader(sdlQuery))
-	if err != nil {
-		return "", fmt.Errorf("could not create request: %w", err)
-	}
-	req.Header.Add("Content-Type", "application/json")
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return "", fmt.Errorf("could not retrieve schema: %w", err)
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("could not retrieve schema: unexpected status code %d", resp.StatusCode)
-	}
-	var response sdlResponse
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return "", fmt.Errorf("could not decode SDL response: %w", err)
-	}
-	if len(response.Errors) > 0 {
-		var errorMessages []string
-		for _, err := range response.Errors {
-			errorMessages = append(errorMessages, err.Message)
-		}
-		return "", fmt.Errorf("SDL query returned errors: %s", strings.Join(errorMessages, ", "))
-	}
-	return response.Data.Service.SDL, nil
-}
+// defaultComposer is used by the package level functions
+var defaultComposer = NewComposer()
 
-func updateSchemas(subgraphs []*Subgraph) ([]*Subgraph, error) {
-	updatedSubgraphs := make([]*Subgraph, 0, len(subgraphs))
-	for _, subgraph := range subgraphs {
-		if subgraph.Schema != "" {
-			updatedSubgraphs = append(updatedSubgraphs, subgraph)
-			continue
-		}
-		sdl, err := introspectSubgraph(subgraph.URL)
-		if err != nil {
-			return nil, fmt.Errorf("error introspecting subgraph %s: %w", subgraph.Name, err)
-		}
-		cpy := *subgraph
-		cpy.Schema = sdl
-		updatedSubgraphs = append(updatedSubgraphs, &cpy)
-	}
-	return updatedSubgraphs, nil
+// IntrospectSubgraph retrieves the SDL of a subgraph from its URL using the _service query.
+func IntrospectSubgraph(URL string) (string, error) {
+	return defaultComposer.introspectSubgraph(context.Background(), URL, nil)
 }
 
 // Federate produces a federated graphs from the schemas and names
-// of each of the subgraphs.
+// of each of the subgraphs. If the composition fails, the returned
+// error is of type CompositionErrors.
 func Federate(subgraphs ...*Subgraph) (*FederatedGraph, error) {
-	updatedSubgraphs, err := updateSchemas(subgraphs)
-	if err != nil {
-		return nil, err
-	}
-	vm, _ := pool.Get().(*vm)
-	if vm == nil {
-		var err error
-		vm, err = newVM()
-		if err != nil {
-			return nil, err
-		}
-	}
-	defer pool.Put(vm)
-	return vm.FederateSubgraphs(updatedSubgraphs)
+	return defaultComposer.Federate(context.Background(), subgraphs...)
 }
 
 // BuildRouterConfiguration produces a federated router configuration
 // as a string that can be saved to a file and used to configure the
-// router data sources.
+// router data sources. If the composition fails, the returned error
+// is of type CompositionErrors. Use BuildRouterConfigurationWithWarnings
+// to receive the composition warnings.
 func BuildRouterConfiguration(subgraphs ...*Subgraph) (string, error) {
-	updatedSubgraphs, err := updateSchemas(subgraphs)
+	routerConfig, err := BuildRouterConfigurationWithWarnings(subgraphs...)
 	if err != nil {
 		return "", err
 	}
-	vm, _ := pool.Get().(*vm)
-	if vm == nil {
-		var err error
-		vm, err = newVM()
-		if err != nil {
-			return "", err
-		}
-	}
-	defer pool.Put(vm)
-	return vm.BuildRouterConfiguration(updatedSubgraphs)
+	return routerConfig.Config, nil
+}
+
+// BuildRouterConfigurationWithWarnings is like BuildRouterConfiguration,
+// but also returns the warnings of the composition.
+func BuildRouterConfigurationWithWarnings(subgraphs ...*Subgraph) (*RouterConfiguration, error) {
+	return defaultComposer.BuildRouterConfiguration(context.Background(), subgraphs...)
 }
@@ -177,3 +177,109 @@ func BenchmarkFederateDirectory(b *testing.B) {
 		}
 	}
 }
+
+func TestFederateSubgraphsWarnings(t *testing.T) {
+	federated, err := Federate(&Subgraph{
+		Name:   "A",
+		Schema: `type Query { hello: String @override(from: "missing") }`,
+	})
+	require.NoError(t, err)
+	require.Len(t, federated.Warnings, 1)
+	assert.Equal(t, WarningCodeComposition, federated.Warnings[0].Code)
+	assert.Equal(t, "A", federated.Warnings[0].SubgraphName)
+	assert.Equal(t, "Query.hello", federated.Warnings[0].Coordinate)
+	assert.Contains(t, federated.Warnings[0].Message, `a subgraph by the name of "missing" does not exist`)
+}
+
+func TestBuildRouterConfigurationWarnings(t *testing.T) {
+	routerConfig, err := BuildRouterConfigurationWithWarnings(&Subgraph{
+		Name:   "A",
+		URL:    "http://localhost:4001/graphql",
+		Schema: `type Query { hello: String @override(from: "missing") }`,
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, routerConfig.Config)
+	require.Len(t, routerConfig.Warnings, 1)
+	assert.Equal(t, WarningCodeComposition, routerConfig.Warnings[0].Code)
+	assert.Equal(t, "A", routerConfig.Warnings[0].SubgraphName)
+	assert.Equal(t, "Query.hello", routerConfig.Warnings[0].Coordinate)
+}
+
+func TestFederateSubgraphsErrors(t *testing.T) {
+	t.Run("invalid schema", func(t *testing.T) {
+		_, err := Federate(&Subgraph{
+			Name:   "A",
+			Schema: "type Query {\n  hello: String\n  world(: Int\n}",
+		})
+		var compositionErrs CompositionErrors
+		require.ErrorAs(t, err, &compositionErrs)
+		require.Len(t, compositionErrs, 1)
+		assert.Equal(t, ErrorCodeInvalidSubgraphSchema, compositionErrs[0].Code)
+		assert.Equal(t, "A", compositionErrs[0].SubgraphName)
+		assert.Equal(t, &SourceLocation{Line: 3, Column: 9}, compositionErrs[0].Location)
+	})
+
+	t.Run("invalid subgraph", func(t *testing.T) {
+		_, err := Federate(&Subgraph{
+			Name:   "A",
+			Schema: `type Query { hello: String }`,
+		}, &Subgraph{
+			Name:   "B",
+			Schema: `type Query { world: Unknown }`,
+		})
+		var compositionErrs CompositionErrors
+		require.ErrorAs(t, err, &compositionErrs)
+		require.NotEmpty(t, compositionErrs)
+		for _, compositionErr := range compositionErrs {
+			assert.Equal(t, ErrorCodeSubgraphValidation, compositionErr.Code)
+			assert.Equal(t, "B", compositionErr.SubgraphName)
+			assert.Equal(t, "Unknown", compositionErr.Coordinate)
+		}
+	})
+
+	t.Run("invalid subgraph with location", func(t *testing.T) {
+		_, err := Federate(&Subgraph{
+			Name:   "A",
+			Schema: "type Query {\n  a: I\n}\ninterface I { x: String }\ntype T implements I {\n  y: String\n}",
+		})
+		var compositionErr *CompositionError
+		require.ErrorAs(t, err, &compositionErr)
+		assert.Equal(t, ErrorCodeSubgraphValidation, compositionErr.Code)
+		assert.Equal(t, "A", compositionErr.SubgraphName)
+		assert.Equal(t, "T", compositionErr.Coordinate)
+		assert.Equal(t, &SourceLocation{Line: 5, Column: 1}, compositionErr.Location)
+	})
+
+	t.Run("composition error", func(t *testing.T) {
+		_, err := BuildRouterConfiguration(&Subgraph{
+			Name:   "A",
+			Schema: `type Query { hello: String }`,
+		}, &Subgraph{
+			Name:   "B",
+			Schema: `type Query { hello: Int }`,
+		})
+		var compositionErr *CompositionError
+		require.ErrorAs(t, err, &compositionErr)
+		assert.Equal(t, ErrorCodeComposition, compositionErr.Code)
+		assert.Empty(t, compositionErr.SubgraphName)
+		assert.Equal(t, "Query.hello", compositionErr.Coordinate)
+		assert.Nil(t, compositionErr.Location)
+		assert.Contains(t, err.Error(), "could not federate schema: ")
+	})
+
+	t.Run("composition error of a single subgraph", func(t *testing.T) {
+		_, err := Federate(&Subgraph{
+			Name:   "A",
+			Schema: "type Query {\n  user: User\n}\ntype User @key(fields: \"id\") {\n  id: ID!\n}",
+		}, &Subgraph{
+			Name:   "B",
+			Schema: "type Query {\n  b: String\n}\ntype User @key(fields: \"id\", resolvable: false) {\n  id: ID!\n  name: String\n}",
+		})
+		var compositionErr *CompositionError
+		require.ErrorAs(t, err, &compositionErr)
+		assert.Equal(t, ErrorCodeComposition, compositionErr.Code)
+		assert.Equal(t, "B", compositionErr.SubgraphName)
+		assert.Equal(t, "User.name", compositionErr.Coordinate)
+		assert.Equal(t, &SourceLocation{Line: 6, Column: 3}, compositionErr.Location)
+	})
+}
@@ -0,0 +1,131 @@
+package composition
+
+import "strings"
+
+const (
+	// ErrorCodeInvalidSubgraphSchema is returned when the SDL of a subgraph can't be parsed
+	ErrorCodeInvalidSubgraphSchema = "INVALID_SUBGRAPH_SCHEMA"
+	// ErrorCodeSubgraphValidation is returned when the schema of a subgraph is invalid on its own
+	ErrorCodeSubgraphValidation = "SUBGRAPH_VALIDATION_ERROR"
+	// ErrorCodeComposition is returned when the valid subgraphs can't be federated
+	ErrorCodeComposition = "COMPOSITION_ERROR"
+	// WarningCodeComposition is used for warnings of a successful or failed composition
+	WarningCodeComposition = "COMPOSITION_WARNING"
+)
+
+// SourceLocation is a position in the SDL of a subgraph. Line and column start at 1.
+type SourceLocation struct {
+	Line   int
+	Column int
+}
+
+// CompositionError is an error that prevented the subgraphs from being federated.
+type CompositionError struct {
+	// Code identifies the kind of error
+	Code string
+	// Message is the message of the composition library
+	Message string
+	// SubgraphName is the name of the subgraph that caused the error. Empty for errors that refer to
+	// several subgraphs
+	SubgraphName string
+	// Coordinate is the schema coordinate the error refers to, e.g. Query.employees, if the composition
+	// library reports it
+	Coordinate string
+	// Location is the position in the subgraph SDL, if the subgraph and the coordinate are known
+	Location *SourceLocation
+}
+
+func (e *CompositionError) Error() string {
+	return e.Message
+}
+
+// CompositionWarning is an issue that doesn't prevent the subgraphs from being federated.
+type CompositionWarning struct {
+	Code         string
+	Message      string
+	SubgraphName string
+	Coordinate   string
+	Location     *SourceLocation
+}
+
+func (w *CompositionWarning) String() string {
+	return w.Message
+}
+
+// CompositionErrors is returned by Federate and BuildRouterConfiguration when the composition fails.
+// Use errors.As to access the individual errors.
+type CompositionErrors []*CompositionError
+
+func (e CompositionErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Message)
+	}
+	return "could not federate schema: " + strings.Join(messages, ", ")
+}
+
+// Unwrap allows errors.As to match a single CompositionError
+func (e CompositionErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// compositionMessage is an error or warning as returned by the JS shim
+type compositionMessage struct {
+	Code       string `goja:"code"`
+	Message    string `goja:"message"`
+	Subgraph   string `goja:"subgraph"`
+	Coordinate string `goja:"coordinate"`
+	Line       int    `goja:"line"`
+	Column     int    `goja:"column"`
+}
+
+// location returns the position of the message in the subgraph SDL, if known
+func (m *compositionMessage) location() *SourceLocation {
+	if m.Line <= 0 {
+		return nil
+	}
+	return &SourceLocation{Line: m.Line, Column: m.Column}
+}
+
+func (m *compositionMessage) toError() *CompositionError {
+	return &CompositionError{
+		Code:         m.Code,
+		Message:      m.Message,
+		SubgraphName: m.Subgraph,
+		Coordinate:   m.Coordinate,
+		Location:     m.location(),
+	}
+}
+
+func (m *compositionMessage) toWarning() *CompositionWarning {
+	return &CompositionWarning{
+		Code:         m.Code,
+		Message:      m.Message,
+		SubgraphName: m.Subgraph,
+		Coordinate:   m.Coordinate,
+		Location:     m.location(),
+	}
+}
+
+func compositionErrors(messages []*compositionMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	errs := make(CompositionErrors, 0, len(messages))
+	for _, m := range messages {
+		errs = append(errs, m.toError())
+	}
+	return errs
+}
+
+func compositionWarnings(messages []*compositionMessage) []*CompositionWarning {
+	warnings := make([]*CompositionWarning, 0, len(messages))
+	for _, m := range messages {
+		warnings = append(warnings, m.toWarning())
+	}
+	return warnings
+}
@@ -367,27 +367,27 @@ ${t.join(`
 `+t:t)+i.replace(/\n/g,`
 `+t)+`
 `}});var MV=M(yA=>{"use strict";m();T();h();Object.defineProperty(yA,"__esModule",{value:!0});yA.concatAST=Jte;var Yte=en();function Jte(e){let t=[];for(let n of e)t.push(...n.definitions);return{kind:Yte.Kind.DOCUMENT,definitions:t}}});var jV=M(IA=>{"use strict";m();T();h();Object.defineProperty(IA,"__esModule",{value:!0});IA.separateOperations=Hte;var sI=en(),zte=Pl();function Hte(e){let t=[],n=Object.create(null);for(let i of e.definitions)switch(i.kind){case sI.Kind.OPERATION_DEFINITION:t.push(i);break;case sI.Kind.FRAGMENT_DEFINITION:n[i.name.value]=BV(i.selectionSet);break;default:}let r=Object.create(null);for(let i of t){let s=new Set;for(let u of BV(i.selectionSet))VV(s,n,u);let o=i.name?i.name.value:"";r[o]={kind:sI.Kind.DOCUMENT,definitions:e.definitions.filter(u=>u===i||u.kind===sI.Kind.FRAGMENT_DEFINITION&&s.has(u.name.value))}}return r}function VV(e,t,n){if(!e.has(n)){e.add(n);let r=t[n];if(r!==void 0)for(let i of r)VV(e,t,i)}}function BV(e){let t=[];return(0,zte.visit)(e,{FragmentSpread(n){t.push(n.name.value)}}),t}});var KV=M(_A=>{"use strict";m();T();h();Object.defineProperty(_A,"__esModule",{value:!0});_A.stripIgnoredCharacters=Xte;var Wte=gh(),qV=By(),$V=jy(),gA=Hh();function Xte(e){let t=(0,$V.isSource)(e)?e:new $V.Source(e),n=t.body,r=new qV.Lexer(t),i="",s=!1;for(;r.advance().kind!==gA.TokenKind.EOF;){let o=r.token,u=o.kind,l=!(0,qV.isPunctuatorTokenKind)(o.kind);s&&(l||o.kind===gA.TokenKind.SPREAD)&&(i+=" ");let d=n.slice(o.start,o.end);u===gA.TokenKind.BLOCK_STRING?i+=(0,Wte.printBlockString)(o.value,{minimize:!0}):i+=d,s=l}return i}});var QV=M(oI=>{"use strict";m();T();h();Object.defineProperty(oI,"__esModule",{value:!0});oI.assertValidName=nne;oI.isValidNameError=GV;var Zte=xi(),ene=at(),tne=_h();function nne(e){let t=GV(e);if(t)throw t;return e}function GV(e){if(typeof e=="string"||(0,Zte.devAssert)(!1,"Expected name to be a string."),e.startsWith("__"))return new ene.GraphQLError(`Name "${e}" must not begin with "__", which is reserved by GraphQL introspection.`);try{(0,tne.assertName)(e)}catch(t){return t}}});var ej=M(ca=>{"use strict";m();T();h();Object.defineProperty(ca,"__esModule",{value:!0});ca.DangerousChangeType=ca.BreakingChangeType=void 0;ca.findBreakingChanges=une;ca.findDangerousChanges=cne;var rne=_n(),XV=yi(),YV=wc(),ine=vs(),fn=tn(),sne=ra(),one=Uh(),ane=Nb(),mr;ca.BreakingChangeType=mr;(function(e){e.TYPE_REMOVED="TYPE_REMOVED",e.TYPE_CHANGED_KIND="TYPE_CHANGED_KIND",e.TYPE_REMOVED_FROM_UNION="TYPE_REMOVED_FROM_UNION",e.VALUE_REMOVED_FROM_ENUM="VALUE_REMOVED_FROM_ENUM",e.REQUIRED_INPUT_FIELD_ADDED="REQUIRED_INPUT_FIELD_ADDED",e.IMPLEMENTED_INTERFACE_REMOVED="IMPLEMENTED_INTERFACE_REMOVED",e.FIELD_REMOVED="FIELD_REMOVED",e.FIELD_CHANGED_KIND="FIELD_CHANGED_KIND",e.REQUIRED_ARG_ADDED="REQUIRED_ARG_ADDED",e.ARG_REMOVED="ARG_REMOVED",e.ARG_CHANGED_KIND="ARG_CHANGED_KIND",e.DIRECTIVE_REMOVED="DIRECTIVE_REMOVED",e.DIRECTIVE_ARG_REMOVED="DIRECTIVE_ARG_REMOVED",e.REQUIRED_DIRECTIVE_ARG_ADDED="REQUIRED_DIRECTIVE_ARG_ADDED",e.DIRECTIVE_REPEATABLE_REMOVED="DIRECTIVE_REPEATABLE_REMOVED",e.DIRECTIVE_LOCATION_REMOVED="DIRECTIVE_LOCATION_REMOVED"})(mr||(ca.BreakingChangeType=mr={}));var Ro;ca.DangerousChangeType=Ro;(function(e){e.VALUE_ADDED_TO_ENUM="VALUE_ADDED_TO_ENUM",e.TYPE_ADDED_TO_UNION="TYPE_ADDED_TO_UNION",e.OPTIONAL_INPUT_FIELD_ADDED="OPTIONAL_INPUT_FIELD_ADDED",e.OPTIONAL_ARG_ADDED="OPTIONAL_ARG_ADDED",e.IMPLEMENTED_INTERFACE_ADDED="IMPLEMENTED_INTERFACE_ADDED",e.ARG_DEFAULT_VALUE_CHANGE="ARG_DEFAULT_VALUE_CHANGE"})(Ro||(ca.DangerousChangeType=Ro={}));function une(e,t){return ZV(e,t).filter(n=>n.type in mr)}function cne(e,t){return ZV(e,t).filter(n=>n.type in Ro)}function ZV(e,t){return[...dne(e,t),...lne(e,t)]}function lne(e,t){let n=[],r=eu(e.getDirectives(),t.getDirectives());for(let i of r.removed)n.push({type:mr.DIRECTIVE_REMOVED,description:`${i.name} was removed.`});for(let[i,s]of r.persisted){let o=eu(i.args,s.args);for(let u of o.added)(0,fn.isRequiredArgument)(u)&&n.push({type:mr.REQUIRED_DIRECTIVE_ARG_ADDED,description:`A required arg ${u.name} on directive ${i.name} was added.`});for(let u of o.removed)n.push({type:mr.DIRECTIVE_ARG_REMOVED,description:`${u.name} was removed from ${i.name}.`});i.isRepeatable&&!s.isRepeatable&&n.push({type:mr.DIRECTIVE_REPEATABLE_REMOVED,description:`Repeatable flag was removed from ${i.name}.`});for(let u of i.locations)s.locations.includes(u)||n.push({type:mr.DIRECTIVE_LOCATION_REMOVED,description:`${u} was removed from ${i.name}.`})}return n}function dne(e,t){let n=[],r=eu(Object.values(e.getTypeMap()),Object.values(t.getTypeMap()));for(let i of r.removed)n.push({type:mr.TYPE_REMOVED,description:(0,sne.isSpecifiedScalarType)(i)?`Standard scalar ${i.name} was removed because it is not referenced anymore.`:`${i.name} was removed.`});for(let[i,s]of r.persisted)(0,fn.isEnumType)(i)&&(0,fn.isEnumType)(s)?n.push(...mne(i,s)):(0,fn.isUnionType)(i)&&(0,fn.isUnionType)(s)?n.push(...fne(i,s)):(0,fn.isInputObjectType)(i)&&(0,fn.isInputObjectType)(s)?n.push(...pne(i,s)):(0,fn.isObjectType)(i)&&(0,fn.isObjectType)(s)?n.push(...zV(i,s),...JV(i,s)):(0,fn.isInterfaceType)(i)&&(0,fn.isInterfaceType)(s)?n.push(...zV(i,s),...JV(i,s)):i.constructor!==s.constructor&&n.push({type:mr.TYPE_CHANGED_KIND,description:`${i.name} changed from ${HV(i)} to ${HV(s)}.`});return n}function pne(e,t){let n=[],r=eu(Object.values(e.getFields()),Object.values(t.getFields()));for(let i of r.added)(0,fn.isRequiredInputField)(i)?n.push({type:mr.REQUIRED_INPUT_FIELD_ADDED,description:`A required field ${i.name} on input type ${e.name} was added.`}):n.push({type:Ro.OPTIONAL_INPUT_FIELD_ADDED,description:`An optional field ${i.name} on input type ${e.name} was added.`});for(let i of r.removed)n.push({type:mr.FIELD_REMOVED,description:`${e.name}.${i.name} was removed.`});for(let[i,s]of r.persisted)lT(i.type,s.type)||n.push({type:mr.FIELD_CHANGED_KIND,description:`${e.name}.${i.name} changed type from ${String(i.type)} to ${String(s.type)}.`});return n}function fne(e,t){let n=[],r=eu(e.getTypes(),t.getTypes());for(let i of r.added)n.push({type:Ro.TYPE_ADDED_TO_UNION,description:`${i.name} was added to union type ${e.name}.`});for(let i of r.removed)n.push({type:mr.TYPE_REMOVED_FROM_UNION,description:`${i.name} was removed from union type ${e.name}.`});return n}function mne(e,t){let n=[],r=eu(e.getValues(),t.getValues());for(let i of r.added)n.push({type:Ro.VALUE_ADDED_TO_ENUM,description:`${i.name} was added to enum type ${e.name}.`});for(let i of r.removed)n.push({type:mr.VALUE_REMOVED_FROM_ENUM,description:`${i.name} was removed from enum type ${e.name}.`});return n}function JV(e,t){let n=[],r=eu(e.getInterfaces(),t.getInterfaces());for(let i of r.added)n.push({type:Ro.IMPLEMENTED_INTERFACE_ADDED,description:`${i.name} added to interfaces implemented by ${e.name}.`});for(let i of r.removed)n.push({type:mr.IMPLEMENTED_INTERFACE_REMOVED,description:`${e.name} no longer implements interface ${i.name}.`});return n}function zV(e,t){let n=[],r=eu(Object.values(e.getFields()),Object.values(t.getFields()));for(let i of r.removed)n.push({type:mr.FIELD_REMOVED,description:`${e.name}.${i.name} was removed.`});for(let[i,s]of r.persisted)n.push(...hne(e,i,s)),cT(i.type,s.type)||n.push({type:mr.FIELD_CHANGED_KIND,description:`${e.name}.${i.name} changed type from ${String(i.type)} to ${String(s.type)}.`});return n}function hne(e,t,n){let r=[],i=eu(t.args,n.args);for(let s of i.removed)r.push({type:mr.ARG_REMOVED,description:`${e.name}.${t.name} arg ${s.name} was removed.`});for(let[s,o]of i.persisted)if(!lT(s.type,o.type))r.push({type:mr.ARG_CHANGED_KIND,description:`${e.name}.${t.name} arg ${s.name} has changed type from ${String(s.type)} to ${String(o.type)}.`});else if(s.defaultValue!==void 0)if(o.defaultValue===void 0)r.push({type:Ro.ARG_DEFAULT_VALUE_CHANGE,description:`${e.name}.${t.name} arg ${s.name} defaultValue was removed.`});else{let l=WV(s.defaultValue,s.type),d=WV(o.defaultValue,o.type);l!==d&&r.push({type:Ro.ARG_DEFAULT_VALUE_CHANGE,description:`${e.name}.${t.name} arg ${s.name} has changed defaultValue from ${l} to ${d}.`})}for(let s of i.added)(0,fn.isRequiredArgument)(s)?r.push({type:mr.REQUIRED_ARG_ADDED,description:`A required arg ${s.name} on ${e.name}.${t.name} was added.`}):r.push({type:Ro.OPTIONAL_ARG_ADDED,description:`An optional arg ${s.name} on ${e.name}.${t.name} was added.`});return r}function cT(e,t){return(0,fn.isListType)(e)?(0,fn.isListType)(t)&&cT(e.ofType,t.ofType)||(0,fn.isNonNullType)(t)&&cT(e,t.ofType):(0,fn.isNonNullType)(e)?(0,fn.isNonNullType)(t)&&cT(e.ofType,t.ofType):(0,fn.isNamedType)(t)&&e.name===t.name||(0,fn.isNonNullType)(t)&&cT(e,t.ofType)}function lT(e,t){return(0,fn.isListType)(e)?(0,fn.isListType)(t)&&lT(e.ofType,t.ofType):(0,fn.isNonNullType)(e)?(0,fn.isNonNullType)(t)&&lT(e.ofType,t.ofType)||!(0,fn.isNonNullType)(t)&&lT(e.ofType,t):(0,fn.isNamedType)(t)&&e.name===t.name}function HV(e){if((0,fn.isScalarType)(e))return"a Scalar type";if((0,fn.isObjectType)(e))return"an Object type";if((0,fn.isInterfaceType)(e))return"an Interface type";if((0,fn.isUnionType)(e))return"a Union type";if((0,fn.isEnumType)(e))return"an Enum type";if((0,fn.isInputObjectType)(e))return"an Input type";(0,XV.invariant)(!1,"Unexpected type: "+(0,rne.inspect)(e))}function WV(e,t){let n=(0,one.astFromValue)(e,t);return n!=null||(0,XV.invariant)(!1),(0,ine.print)((0,ane.sortValueNode)(n))}function eu(e,t){let n=[],r=[],i=[],s=(0,YV.keyMap)(e,({name:u})=>u),o=(0,YV.keyMap)(t,({name:u})=>u);for(let u of e){let l=o[u.name];l===void 0?r.push(u):i.push([u,l])}for(let u of t)s[u.name]===void 0&&n.push(u);return{added:n,persisted:i,removed:r}}});var ij=M(cn=>{"use strict";m();T();h();Object.defineProperty(cn,"__esModule",{value:!0});Object.defineProperty(cn,"BreakingChangeType",{enumerable:!0,get:function(){return aI.BreakingChangeType}});Object.defineProperty(cn,"DangerousChangeType",{enumerable:!0,get:function(){return aI.DangerousChangeType}});Object.defineProperty(cn,"TypeInfo",{enumerable:!0,get:function(){return nj.TypeInfo}});Object.defineProperty(cn,"assertValidName",{enumerable:!0,get:function(){return rj.assertValidName}});Object.defineProperty(cn,"astFromValue",{enumerable:!0,get:function(){return Dne.astFromValue}});Object.defineProperty(cn,"buildASTSchema",{enumerable:!0,get:function(){return tj.buildASTSchema}});Object.defineProperty(cn,"buildClientSchema",{enumerable:!0,get:function(){return Ine.buildClientSchema}});Object.defineProperty(cn,"buildSchema",{enumerable:!0,get:function(){return tj.buildSchema}});Object.defineProperty(cn,"coerceInputValue",{enumerable:!0,get:function(){return bne.coerceInputValue}});Object.defineProperty(cn,"concatAST",{enumerable:!0,get:function(){return Ane.concatAST}});Object.defineProperty(cn,"doTypesOverlap",{enumerable:!0,get:function(){return OA.doTypesOverlap}});Object.defineProperty(cn,"extendSchema",{enumerable:!0,get:function(){return gne.extendSchema}});Object.defineProperty(cn,"findBreakingChanges",{enumerable:!0,get:function(){return aI.findBreakingChanges}});Object.defineProperty(cn,"findDangerousChanges",{enumerable:!0,get:function(){return aI.findDangerousChanges}});Object.defineProperty(cn,"getIntrospectionQuery",{enumerable:!0,get:function(){return Tne.getIntrospectionQuery}});Object.defineProperty(cn,"getOperationAST",{enumerable:!0,get:function(){return Nne.getOperationAST}});Object.defineProperty(cn,"getOperationRootType",{enumerable:!0,get:function(){return Ene.getOperationRootType}});Object.defineProperty(cn,"introspectionFromSchema",{enumerable:!0,get:function(){return yne.introspectionFromSchema}});Object.defineProperty(cn,"isEqualType",{enumerable:!0,get:function(){return OA.isEqualType}});Object.defineProperty(cn,"isTypeSubTypeOf",{enumerable:!0,get:function(){return OA.isTypeSubTypeOf}});Object.defineProperty(cn,"isValidNameError",{enumerable:!0,get:function(){return rj.isValidNameError}});Object.defineProperty(cn,"lexicographicSortSchema",{enumerable:!0,get:function(){return _ne.lexicographicSortSchema}});Object.defineProperty(cn,"printIntrospectionSchema",{enumerable:!0,get:function(){return vA.printIntrospectionSchema}});Object.defineProperty(cn,"printSchema",{enumerable:!0,get:function(){return vA.printSchema}});Object.defineProperty(cn,"printType",{enumerable:!0,get:function(){return vA.printType}});Object.defineProperty(cn,"separateOperations",{enumerable:!0,get:function(){return Rne.separateOperations}});Object.defineProperty(cn,"stripIgnoredCharacters",{enumerable:!0,get:function(){return Fne.stripIgnoredCharacters}});Object.defineProperty(cn,"typeFromAST",{enumerable:!0,get:function(){return vne.typeFromAST}});Object.defineProperty(cn,"valueFromAST",{enumerable:!0,get:function(){return One.valueFromAST}});Object.defineProperty(cn,"valueFromASTUntyped",{enumerable:!0,get:function(){return Sne.valueFromASTUntyped}});Object.defineProperty(cn,"visitWithTypeInfo",{enumerable:!0,get:function(){return nj.visitWithTypeInfo}});var Tne=sA(),Nne=fV(),Ene=mV(),yne=hV(),Ine=NV(),tj=bV(),gne=dA(),_ne=FV(),vA=xV(),vne=ia(),One=Gh(),Sne=pD(),Dne=Uh(),nj=Iy(),bne=xb(),Ane=MV(),Rne=jV(),Fne=KV(),OA=bh(),rj=QV(),aI=ej()});var SA=M($=>{"use strict";m();T();h();Object.defineProperty($,"__esModule",{value:!0});Object.defineProperty($,"BREAK",{enumerable:!0,get:function(){return Nn.BREAK}});Object.defineProperty($,"BreakingChangeType",{enumerable:!0,get:function(){return En.BreakingChangeType}});Object.defineProperty($,"DEFAULT_DEPRECATION_REASON",{enumerable:!0,get:function(){return Ae.DEFAULT_DEPRECATION_REASON}});Object.defineProperty($,"DangerousChangeType",{enumerable:!0,get:function(){return En.DangerousChangeType}});Object.defineProperty($,"DirectiveLocation",{enumerable:!0,get:function(){return Nn.DirectiveLocation}});Object.defineProperty($,"ExecutableDefinitionsRule",{enumerable:!0,get:function(){return Ct.ExecutableDefinitionsRule}});Object.defineProperty($,"FieldsOnCorrectTypeRule",{enumerable:!0,get:function(){return Ct.FieldsOnCorrectTypeRule}});Object.defineProperty($,"FragmentsOnCompositeTypesRule",{enumerable:!0,get:function(){return Ct.FragmentsOnCompositeTypesRule}});Object.defineProperty($,"GRAPHQL_MAX_INT",{enumerable:!0,get:function(){return Ae.GRAPHQL_MAX_INT}});Object.defineProperty($,"GRAPHQL_MIN_INT",{enumerable:!0,get:function(){return Ae.GRAPHQL_MIN_INT}});Object.defineProperty($,"GraphQLBoolean",{enumerable:!0,get:function(){return Ae.GraphQLBoolean}});Object.defineProperty($,"GraphQLDeprecatedDirective",{enumerable:!0,get:function(){return Ae.GraphQLDeprecatedDirective}});Object.defineProperty($,"GraphQLDirective",{enumerable:!0,get:function(){return Ae.GraphQLDirective}});Object.defineProperty($,"GraphQLEnumType",{enumerable:!0,get:function(){return Ae.GraphQLEnumType}});Object.defineProperty($,"GraphQLError",{enumerable:!0,get:function(){return dT.GraphQLError}});Object.defineProperty($,"GraphQLFloat",{enumerable:!0,get:function(){return Ae.GraphQLFloat}});Object.defineProperty($,"GraphQLID",{enumerable:!0,get:function(){return Ae.GraphQLID}});Object.defineProperty($,"GraphQLIncludeDirective",{enumerable:!0,get:function(){return Ae.GraphQLIncludeDirective}});Object.defineProperty($,"GraphQLInputObjectType",{enumerable:!0,get:function(){return Ae.GraphQLInputObjectType}});Object.defineProperty($,"GraphQLInt",{enumerable:!0,get:function(){return Ae.GraphQLInt}});Object.defineProperty($,"GraphQLInterfaceType",{enumerable:!0,get:function(){return Ae.GraphQLInterfaceType}});Object.defineProperty($,"GraphQLList",{enumerable:!0,get:function(){return Ae.GraphQLList}});Object.defineProperty($,"GraphQLNonNull",{enumerable:!0,get:function(){return Ae.GraphQLNonNull}});Object.defineProperty($,"GraphQLObjectType",{enumerable:!0,get:function(){return Ae.GraphQLObjectType}});Object.defineProperty($,"GraphQLOneOfDirective",{enumerable:!0,get:function(){return Ae.GraphQLOneOfDirective}});Object.defineProperty($,"GraphQLScalarType",{enumerable:!0,get:function(){return Ae.GraphQLScalarType}});Object.defineProperty($,"GraphQLSchema",{enumerable:!0,get:function(){return Ae.GraphQLSchema}});Object.defineProperty($,"GraphQLSkipDirective",{enumerable:!0,get:function(){return Ae.GraphQLSkipDirective}});Object.defineProperty($,"GraphQLSpecifiedByDirective",{enumerable:!0,get:function(){return Ae.GraphQLSpecifiedByDirective}});Object.defineProperty($,"GraphQLString",{enumerable:!0,get:function(){return Ae.GraphQLString}});Object.defineProperty($,"GraphQLUnionType",{enumerable:!0,get:function(){return Ae.GraphQLUnionType}});Object.defineProperty($,"Kind",{enumerable:!0,get:function(){return Nn.Kind}});Object.defineProperty($,"KnownArgumentNamesRule",{enumerable:!0,get:function(){return Ct.KnownArgumentNamesRule}});Object.defineProperty($,"KnownDirectivesRule",{enumerable:!0,get:function(){return Ct.KnownDirectivesRule}});Object.defineProperty($,"KnownFragmentNamesRule",{enumerable:!0,get:function(){return Ct.KnownFragmentNamesRule}});Object.defineProperty($,"KnownTypeNamesRule",{enumerable:!0,get:function(){return Ct.KnownTypeNamesRule}});Object.defineProperty($,"Lexer",{enumerable:!0,get:function(){return Nn.Lexer}});Object.defineProperty($,"Location",{enumerable:!0,get:function(){return Nn.Location}});Object.defineProperty($,"LoneAnonymousOperationRule",{enumerable:!0,get:function(){return Ct.LoneAnonymousOperationRule}});Object.defineProperty($,"LoneSchemaDefinitionRule",{enumerable:!0,get:function(){return Ct.LoneSchemaDefinitionRule}});Object.defineProperty($,"MaxIntrospectionDepthRule",{enumerable:!0,get:function(){return Ct.MaxIntrospectionDepthRule}});Object.defineProperty($,"NoDeprecatedCustomRule",{enumerable:!0,get:function(){return Ct.NoDeprecatedCustomRule}});Object.defineProperty($,"NoFragmentCyclesRule",{enumerable:!0,get:function(){return Ct.NoFragmentCyclesRule}});Object.defineProperty($,"NoSchemaIntrospectionCustomRule",{enumerable:!0,get:function(){return Ct.NoSchemaIntrospectionCustomRule}});Object.defineProperty($,"NoUndefinedVariablesRule",{enumerable:!0,get:function(){return Ct.NoUndefinedVariablesRule}});Object.defineProperty($,"NoUnusedFragmentsRule",{enumerable:!0,get:function(){return Ct.NoUnusedFragmentsRule}});Object.defineProperty($,"NoUnusedVariablesRule",{enumerable:!0,get:function(){return Ct.NoUnusedVariablesRule}});Object.defineProperty($,"OperationTypeNode",{enumerable:!0,get:function(){return Nn.OperationTypeNode}});Object.defineProperty($,"OverlappingFieldsCanBeMergedRule",{enumerable:!0,get:function(){return Ct.OverlappingFieldsCanBeMergedRule}});Object.defineProperty($,"PossibleFragmentSpreadsRule",{enumerable:!0,get:function(){return Ct.PossibleFragmentSpreadsRule}});Object.defineProperty($,"PossibleTypeExtensionsRule",{enumerable:!0,get:function(){return Ct.PossibleTypeExtensionsRule}});Object.defineProperty($,"ProvidedRequiredArgumentsRule",{enumerable:!0,get:function(){return Ct.ProvidedRequiredArgumentsRule}});Object.defineProperty($,"ScalarLeafsRule",{enumerable:!0,get:function(){return Ct.ScalarLeafsRule}});Object.defineProperty($,"SchemaMetaFieldDef",{enumerable:!0,get:function(){return Ae.SchemaMetaFieldDef}});Object.defineProperty($,"SingleFieldSubscriptionsRule",{enumerable:!0,get:function(){return Ct.SingleFieldSubscriptionsRule}});Object.defineProperty($,"Source",{enumerable:!0,get:function(){return Nn.Source}});Object.defineProperty($,"Token",{enumerable:!0,get:function(){return Nn.Token}});Object.defineProperty($,"TokenKind",{enumerable:!0,get:function(){return Nn.TokenKind}});Object.defineProperty($,"TypeInfo",{enumerable:!0,get:function(){return En.TypeInfo}});Object.defineProperty($,"TypeKind",{enumerable:!0,get:function(){return Ae.TypeKind}});Object.defineProperty($,"TypeMetaFieldDef",{enumerable:!0,get:function(){return Ae.TypeMetaFieldDef}});Object.defineProperty($,"TypeNameMetaFieldDef",{enumerable:!0,get:function(){return Ae.TypeNameMetaFieldDef}});Object.defineProperty($,"UniqueArgumentDefinitionNamesRule",{enumerable:!0,get:function(){return Ct.UniqueArgumentDefinitionNamesRule}});Object.defineProperty($,"UniqueArgumentNamesRule",{enumerable:!0,get:function(){return Ct.UniqueArgumentNamesRule}});Object.defineProperty($,"UniqueDirectiveNamesRule",{enumerable:!0,get:function(){return Ct.UniqueDirectiveNamesRule}});Object.defineProperty($,"UniqueDirectivesPerLocationRule",{enumerable:!0,get:function(){return Ct.UniqueDirectivesPerLocationRule}});Object.defineProperty($,"UniqueEnumValueNamesRule",{enumerable:!0,get:function(){return Ct.UniqueEnumValueNamesRule}});Object.defineProperty($,"UniqueFieldDefinitionNamesRule",{enumerable:!0,get:function(){return Ct.UniqueFieldDefinitionNamesRule}});Object.defineProperty($,"UniqueFragmentNamesRule",{enumerable:!0,get:function(){return Ct.UniqueFragmentNamesRule}});Object.defineProperty($,"UniqueInputFieldNamesRule",{enumerable:!0,get:function(){return Ct.UniqueInputFieldNamesRule}});Object.defineProperty($,"UniqueOperationNamesRule",{enumerable:!0,get:function(){return Ct.UniqueOperationNamesRule}});Object.defineProperty($,"UniqueOperationTypesRule",{enumerable:!0,get:function(){return Ct.UniqueOperationTypesRule}});Object.defineProperty($,"UniqueTypeNamesRule",{enumerable:!0,get:function(){return Ct.UniqueTypeNamesRule}});Object.defineProperty($,"UniqueVariableNamesRule",{enumerable:!0,get:function(){return Ct.UniqueVariableNamesRule}});Object.defineProperty($,"ValidationContext",{enumerable:!0,get:function(){return Ct.ValidationContext}});Object.defineProperty($,"ValuesOfCorrectTypeRule",{enumerable:!0,get:function(){return Ct.ValuesOfCorrectTypeRule}});Object.defineProperty($,"VariablesAreInputTypesRule",{enumerable:!0,get:function(){return Ct.VariablesAreInputTypesRule}});Object.defineProperty($,"VariablesInAllowedPositionRule",{enumerable:!0,get:function(){return Ct.VariablesInAllowedPositionRule}});Object.defineProperty($,"__Directive",{enumerable:!0,get:function(){return Ae.__Directive}});Object.defineProperty($,"__DirectiveLocation",{enumerable:!0,get:function(){return Ae.__DirectiveLocation}});Object.defineProperty($,"__EnumValue",{enumerable:!0,get:function(){return Ae.__EnumValue}});Object.defineProperty($,"__Field",{enumerable:!0,get:function(){return Ae.__Field}});Object.defineProperty($,"__InputValue",{enumerable:!0,get:function(){return Ae.__InputValue}});Object.defineProperty($,"__Schema",{enumerable:!0,get:function(){return Ae.__Schema}});Object.defineProperty($,"__Type",{enumerable:!0,get:function(){return Ae.__Type}});Object.defineProperty($,"__TypeKind",{enumerable:!0,get:function(){return Ae.__TypeKind}});Object.defineProperty($,"assertAbstractType",{enumerable:!0,get:function(){return Ae.assertAbstractType}});Object.defineProperty($,"assertCompositeType",{enumerable:!0,get:function(){return Ae.assertCompositeType}});Object.defineProperty($,"assertDirective",{enumerable:!0,get:function(){return Ae.assertDirective}});Object.defineProperty($,"assertEnumType",{enumerable:!0,get:function(){return Ae.assertEnumType}});Object.defineProperty($,"assertEnumValueName",{enumerable:!0,get:function(){return Ae.assertEnumValueName}});Object.defineProperty($,"assertInputObjectType",{enumerable:!0,get:function(){return Ae.assertInputObjectType}});Object.defineProperty($,"assertInputType",{enumerable:!0,get:function(){return Ae.assertInputType}});Object.defineProperty($,"assertInterfaceType",{enumerable:!0,get:function(){return Ae.assertInterfaceType}});Object.defineProperty($,"assertLeafType",{enumerable:!0,get:function(){return Ae.assertLeafType}});Object.defineProperty($,"assertListType",{enumerable:!0,get:function(){return Ae.assertListType}});Object.defineProperty($,"assertName",{enumerable:!0,get:function(){return Ae.assertName}});Object.defineProperty($,"assertNamedType",{enumerable:!0,get:function(){return Ae.assertNamedType}});Object.defineProperty($,"assertNonNullType",{enumerable:!0,get:function(){return Ae.assertNonNullType}});Object.defineProperty($,"assertNullableType",{enumerable:!0,get:function(){return Ae.assertNullableType}});Object.defineProperty($,"assertObjectType",{enumerable:!0,get:function(){return Ae.assertObjectType}});Object.defineProperty($,"assertOutputType",{enumerable:!0,get:function(){return Ae.assertOutputType}});Object.defineProperty($,"assertScalarType",{enumerable:!0,get:function(){return Ae.assertScalarType}});Object.defineProperty($,"assertSchema",{enumerable:!0,get:function(){return Ae.assertSchema}});Object.defineProperty($,"assertType",{enumerable:!0,get:function(){return Ae.assertType}});Object.defineProperty($,"assertUnionType",{enumerable:!0,get:function(){return Ae.assertUnionType}});Object.defineProperty($,"assertValidName",{enumerable:!0,get:function(){return En.assertValidName}});Object.defineProperty($,"assertValidSchema",{enumerable:!0,get:function(){return Ae.assertValidSchema}});Object.defineProperty($,"assertWrappingType",{enumerable:!0,get:function(){return Ae.assertWrappingType}});Object.defineProperty($,"astFromValue",{enumerable:!0,get:function(){return En.astFromValue}});Object.defineProperty($,"buildASTSchema",{enumerable:!0,get:function(){return En.buildASTSchema}});Object.defineProperty($,"buildClientSchema",{enumerable:!0,get:function(){return En.buildClientSchema}});Object.defineProperty($,"buildSchema",{enumerable:!0,get:function(){return En.buildSchema}});Object.defineProperty($,"coerceInputValue",{enumerable:!0,get:function(){return En.coerceInputValue}});Object.defineProperty($,"concatAST",{enumerable:!0,get:function(){return En.concatAST}});Object.defineProperty($,"createSourceEventStream",{enumerable:!0,get:function(){return la.createSourceEventStream}});Object.defineProperty($,"defaultFieldResolver",{enumerable:!0,get:function(){return la.defaultFieldResolver}});Object.defineProperty($,"defaultTypeResolver",{enumerable:!0,get:function(){return la.defaultTypeResolver}});Object.defineProperty($,"doTypesOverlap",{enumerable:!0,get:function(){return En.doTypesOverlap}});Object.defineProperty($,"execute",{enumerable:!0,get:function(){return la.execute}});Object.defineProperty($,"executeSync",{enumerable:!0,get:function(){return la.executeSync}});Object.defineProperty($,"extendSchema",{enumerable:!0,get:function(){return En.extendSchema}});Object.defineProperty($,"findBreakingChanges",{enumerable:!0,get:function(){return En.findBreakingChanges}});Object.defineProperty($,"findDangerousChanges",{enumerable:!0,get:function(){return En.findDangerousChanges}});Object.defineProperty($,"formatError",{enumerable:!0,get:function(){return dT.formatError}});Object.defineProperty($,"getArgumentValues",{enumerable:!0,get:function(){return la.getArgumentValues}});Object.defineProperty($,"getDirectiveValues",{enumerable:!0,get:function(){return la.getDirectiveValues}});Object.defineProperty($,"getEnterLeaveForKind",{enumerable:!0,get:function(){return Nn.getEnterLeaveForKind}});Object.defineProperty($,"getIntrospectionQuery",{enumerable:!0,get:function(){return En.getIntrospectionQuery}});Object.defineProperty($,"getLocation",{enumerable:!0,get:function(){return Nn.getLocation}});Object.defineProperty($,"getNamedType",{enumerable:!0,get:function(){return Ae.getNamedType}});Object.defineProperty($,"getNullableType",{enumerable:!0,get:function(){return Ae.getNullableType}});Object.defineProperty($,"getOperationAST",{enumerable:!0,get:function(){return En.getOperationAST}});Object.defineProperty($,"getOperationRootType",{enumerable:!0,get:function(){return En.getOperationRootType}});Object.defineProperty($,"getVariableValues",{enumerable:!0,get:function(){return la.getVariableValues}});Object.defineProperty($,"getVisitFn",{enumerable:!0,get:function(){return Nn.getVisitFn}});Object.defineProperty($,"graphql",{enumerable:!0,get:function(){return oj.graphql}});Object.defineProperty($,"graphqlSync",{enumerable:!0,get:function(){return oj.graphqlSync}});Object.defineProperty($,"introspectionFromSchema",{enumerable:!0,get:function(){return En.introspectionFromSchema}});Object.defineProperty($,"introspectionTypes",{enumerable:!0,get:function(){return Ae.introspectionTypes}});Object.defineProperty($,"isAbstractType",{enumerable:!0,get:function(){return Ae.isAbstractType}});Object.defineProperty($,"isCompositeType",{enumerable:!0,get:function(){return Ae.isCompositeType}});Object.defineProperty($,"isConstValueNode",{enumerable:!0,get:function(){return Nn.isConstValueNode}});Object.defineProperty($,"isDefinitionNode",{enumerable:!0,get:function(){return Nn.isDefinitionNode}});Object.defineProperty($,"isDirective",{enumerable:!0,get:function(){return Ae.isDirective}});Object.defineProperty($,"isEnumType",{enumerable:!0,get:function(){return Ae.isEnumType}});Object.defineProperty($,"isEqualType",{enumerable:!0,get:function(){return En.isEqualType}});Object.defineProperty($,"isExecutableDefinitionNode",{enumerable:!0,get:function(){return Nn.isExecutableDefinitionNode}});Object.defineProperty($,"isInputObjectType",{enumerable:!0,get:function(){return Ae.isInputObjectType}});Object.defineProperty($,"isInputType",{enumerable:!0,get:function(){return Ae.isInputType}});Object.defineProperty($,"isInterfaceType",{enumerable:!0,get:function(){return Ae.isInterfaceType}});Object.defineProperty($,"isIntrospectionType",{enumerable:!0,get:function(){return Ae.isIntrospectionType}});Object.defineProperty($,"isLeafType",{enumerable:!0,get:function(){return Ae.isLeafType}});Object.defineProperty($,"isListType",{enumerable:!0,get:function(){return Ae.isListType}});Object.defineProperty($,"isNamedType",{enumerable:!0,get:function(){return Ae.isNamedType}});Object.defineProperty($,"isNonNullType",{enumerable:!0,get:function(){return Ae.isNonNullType}});Object.defineProperty($,"isNullableType",{enumerable:!0,get:function(){return Ae.isNullableType}});Object.defineProperty($,"isObjectType",{enumerable:!0,get:function(){return Ae.isObjectType}});Object.defineProperty($,"isOutputType",{enumerable:!0,get:function(){return Ae.isOutputType}});Object.defineProperty($,"isRequiredArgument",{enumerable:!0,get:function(){return Ae.isRequiredArgument}});Object.defineProperty($,"isRequiredInputField",{enumerable:!0,get:function(){return Ae.isRequiredInputField}});Object.defineProperty($,"isScalarType",{enumerable:!0,get:function(){return Ae.isScalarType}});Object.defineProperty($,"isSchema",{enumerable:!0,get:function(){return Ae.isSchema}});Object.defineProperty($,"isSelectionNode",{enumerable:!0,get:function(){return Nn.isSelectionNode}});Object.defineProperty($,"isSpecifiedDirective",{enumerable:!0,get:function(){return Ae.isSpecifiedDirective}});Object.defineProperty($,"isSpecifiedScalarType",{enumerable:!0,get:function(){return Ae.isSpecifiedScalarType}});Object.defineProperty($,"isType",{enumerable:!0,get:function(){return Ae.isType}});Object.defineProperty($,"isTypeDefinitionNode",{enumerable:!0,get:function(){return Nn.isTypeDefinitionNode}});Object.defineProperty($,"isTypeExtensionNode",{enumerable:!0,get:function(){return Nn.isTypeExtensionNode}});Object.defineProperty($,"isTypeNode",{enumerable:!0,get:function(){return Nn.isTypeNode}});Object.defineProperty($,"isTypeSubTypeOf",{enumerable:!0,get:function(){return En.isTypeSubTypeOf}});Object.defineProperty($,"isTypeSystemDefinitionNode",{enumerable:!0,get:function(){return Nn.isTypeSystemDefinitionNode}});Object.defineProperty($,"isTypeSystemExtensionNode",{enumerable:!0,get:function(){return Nn.isTypeSystemExtensionNode}});Object.defineProperty($,"isUnionType",{enumerable:!0,get:function(){return Ae.isUnionType}});Object.defineProperty($,"isValidNameError",{enumerable:!0,get:function(){return En.isValidNameError}});Object.defineProperty($,"isValueNode",{enumerable:!0,get:function(){return Nn.isValueNode}});Object.defineProperty($,"isWrappingType",{enumerable:!0,get:function(){return Ae.isWrappingType}});Object.defineProperty($,"lexicographicSortSchema",{enumerable:!0,get:function(){return En.lexicographicSortSchema}});Object.defineProperty($,"locatedError",{enumerable:!0,get:function(){return dT.locatedError}});Object.defineProperty($,"parse",{enumerable:!0,get:function(){return Nn.parse}});Object.defineProperty($,"parseConstValue",{enumerable:!0,get:function(){return Nn.parseConstValue}});Object.defineProperty($,"parseType",{enumerable:!0,get:function(){return Nn.parseType}});Object.defineProperty($,"parseValue",{enumerable:!0,get:function(){return Nn.parseValue}});Object.defineProperty($,"print",{enumerable:!0,get:function(){return Nn.print}});Object.defineProperty($,"printError",{enumerable:!0,get:function(){return dT.printError}});Object.defineProperty($,"printIntrospectionSchema",{enumerable:!0,get:function(){return En.printIntrospectionSchema}});Object.defineProperty($,"printLocation",{enumerable:!0,get:function(){return Nn.printLocation}});Object.defineProperty($,"printSchema",{enumerable:!0,get:function(){return En.printSchema}});Object.defineProperty($,"printSourceLocation",{enumerable:!0,get:function(){return Nn.printSourceLocation}});Object.defineProperty($,"printType",{enumerable:!0,get:function(){return En.printType}});Object.defineProperty($,"recommendedRules",{enumerable:!0,get:function(){return Ct.recommendedRules}});Object.defineProperty($,"resolveObjMapThunk",{enumerable:!0,get:function(){return Ae.resolveObjMapThunk}});Object.defineProperty($,"resolveReadonlyArrayThunk",{enumerable:!0,get:function(){return Ae.resolveReadonlyArrayThunk}});Object.defineProperty($,"responsePathAsArray",{enumerable:!0,get:function(){return la.responsePathAsArray}});Object.defineProperty($,"separateOperations",{enumerable:!0,get:function(){return En.separateOperations}});Object.defineProperty($,"specifiedDirectives",{enumerable:!0,get:function(){return Ae.specifiedDirectives}});Object.defineProperty($,"specifiedRules",{enumerable:!0,get:function(){return Ct.specifiedRules}});Object.defineProperty($,"specifiedScalarTypes",{enumerable:!0,get:function(){return Ae.specifiedScalarTypes}});Object.defineProperty($,"stripIgnoredCharacters",{enumerable:!0,get:function(){return En.stripIgnoredCharacters}});Object.defineProperty($,"subscribe",{enumerable:!0,get:function(){return la.subscribe}});Object.defineProperty($,"syntaxError",{enumerable:!0,get:function(){return dT.syntaxError}});Object.defineProperty($,"typeFromAST",{enumerable:!0,get:function(){return En.typeFromAST}});Object.defineProperty($,"validate",{enumerable:!0,get:function(){return Ct.validate}});Object.defineProperty($,"validateSchema",{enumerable:!0,get:function(){return Ae.validateSchema}});Object.defineProperty($,"valueFromAST",{enumerable:!0,get:function(){return En.valueFromAST}});Object.defineProperty($,"valueFromASTUntyped",{enumerable:!0,get:function(){return En.valueFromASTUntyped}});Object.defineProperty($,"version",{enumerable:!0,get:function(){return sj.version}});Object.defineProperty($,"versionInfo",{enumerable:!0,get:function(){return sj.versionInfo}});Object.defineProperty($,"visit",{enumerable:!0,get:function(){return Nn.visit}});Object.defineProperty($,"visitInParallel",{enumerable:!0,get:function(){return Nn.visitInParallel}});Object.defineProperty($,"visitWithTypeInfo",{enumerable:!0,get:function(){return En.visitWithTypeInfo}});var sj=_B(),oj=JB(),Ae=WB(),Nn=ZB(),la=aV(),Ct=dV(),dT=pV(),En=ij()});var rs=M(ns=>{"use strict";m();T();h();Object.defineProperty(ns,"__esModule",{value:!0});ns.areSetsEqual=Pne;ns.getAllMutualEntries=Lne;ns.getOrThrowError=Cne;ns.getAllSetDisparities=Une;ns.getEntriesNotInHashSet=kne;ns.numberToOrdinal=xne;ns.addIterableValuesToSet=Mne;ns.kindToTypeString=Bne;ns.getValueOrDefault=Vne;ns.add=jne;ns.generateSimpleDirective=qne;ns.generateRequiresScopesDirective=$ne;var Ln=SA(),_i=zr(),wne=Fo(),DA=Xi();function Pne(e,t){if(e.size!==t.size)return!1;for(let n of e)if(!t.has(n))return!1;return!0}function Lne(e,t){let n=new Set;for(let r of e)t.has(r)&&n.add(r);return n}function Cne(e,t,n){let r=e.get(t);if(r===void 0)throw(0,wne.invalidKeyFatalError)(t,n);return r}function Une(e,t){let n=new Set(t),r=[];for(let i of e)n.delete(i)||r.push(i);for(let i of n)r.push(i);return r}function kne(e,t){let n=[];for(let r of e)t.has(r)||n.push(r);return n}function xne(e){let t=e.toString();switch(t[t.length-1]){case"1":return`${t}st`;case"2":return`${t}nd`;case"3":return`${t}rd`;default:return`${t}th`}}function Mne(e,t){for(let n of e)t.add(n)}function Bne(e){switch(e){case Ln.Kind.BOOLEAN:return _i.BOOLEAN_SCALAR;case Ln.Kind.ENUM:case Ln.Kind.ENUM_TYPE_DEFINITION:return _i.ENUM;case Ln.Kind.ENUM_TYPE_EXTENSION:return"Enum extension";case Ln.Kind.ENUM_VALUE_DEFINITION:return _i.ENUM_VALUE;case Ln.Kind.FIELD_DEFINITION:return _i.FIELD;case Ln.Kind.FLOAT:return _i.FLOAT_SCALAR;case Ln.Kind.INPUT_OBJECT_TYPE_DEFINITION:return _i.INPUT_OBJECT;case Ln.Kind.INPUT_OBJECT_TYPE_EXTENSION:return"Input Object extension";case Ln.Kind.INPUT_VALUE_DEFINITION:return _i.INPUT_VALUE;case Ln.Kind.INT:return _i.INT_SCALAR;case Ln.Kind.INTERFACE_TYPE_DEFINITION:return _i.INTERFACE;case Ln.Kind.INTERFACE_TYPE_EXTENSION:return"Interface extension";case Ln.Kind.NULL:return _i.NULL;case Ln.Kind.OBJECT:case Ln.Kind.OBJECT_TYPE_DEFINITION:return _i.OBJECT;case Ln.Kind.OBJECT_TYPE_EXTENSION:return"Object extension";case Ln.Kind.STRING:return _i.STRING_SCALAR;case Ln.Kind.SCALAR_TYPE_DEFINITION:return _i.SCALAR;case Ln.Kind.SCALAR_TYPE_EXTENSION:return"Scalar extension";case Ln.Kind.UNION_TYPE_DEFINITION:return _i.UNION;case Ln.Kind.UNION_TYPE_EXTENSION:return"Union extension";default:return e}}function Vne(e,t,n){let r=e.get(t);if(r)return r;let i=n();return e.set(t,i),i}function jne(e,t){return e.has(t)?!1:(e.add(t),!0)}function qne(e){return{kind:Ln.Kind.DIRECTIVE,name:(0,DA.stringToNameNode)(e)}}function $ne(e){let t=[];for(let n of e){let r=[];for(let i of n)r.push({kind:Ln.Kind.STRING,value:i});t.push({kind:Ln.Kind.LIST,values:r})}return{kind:Ln.Kind.DIRECTIVE,name:(0,DA.stringToNameNode)(_i.REQUIRES_SCOPES),arguments:[{kind:Ln.Kind.ARGUMENT,name:(0,DA.stringToNameNode)(_i.SCOPES),value:{kind:Ln.Kind.LIST,values:t}}]}}});var Fo=M(ee=>{"use strict";m();T();h();Object.defineProperty(ee,"__esModule",{value:!0});ee.invalidEventProviderIdErrorMessage=ee.invalidNatsStreamConfigurationDefinitionErrorMessage=ee.invalidEdfsPublishResultObjectErrorMessage=ee.invalidNatsStreamInputErrorMessage=ee.inlineFragmentInFieldSetErrorMessage=ee.inaccessibleQueryRootTypeError=ee.federationFactoryInitializationFatalError=ee.subgraphValidationFailureError=ee.minimumSubgraphRequirementError=void 0;ee.multipleNamedTypeDefinitionError=Kne;ee.incompatibleArgumentTypesError=Gne;ee.incompatibleInputValueDefaultValueTypeError=Qne;ee.incompatibleChildTypesError=Yne;ee.incompatibleInputValueDefaultValuesError=Jne;ee.incompatibleSharedEnumError=zne;ee.invalidSubgraphNamesError=Hne;ee.duplicateDirectiveDefinitionError=Wne;ee.duplicateEnumValueDefinitionError=Xne;ee.duplicateFieldDefinitionError=Zne;ee.duplicateInputFieldDefinitionError=ere;ee.duplicateImplementedInterfaceError=tre;ee.duplicateUnionMemberDefinitionError=nre;ee.duplicateTypeDefinitionError=rre;ee.duplicateOperationTypeDefinitionError=ire;ee.noBaseDefinitionForExtensionError=sre;ee.noBaseScalarDefinitionError=ore;ee.noDefinedUnionMembersError=are;ee.noDefinedEnumValuesError=ure;ee.operationDefinitionError=cre;ee.invalidFieldShareabilityError=lre;ee.undefinedDirectiveError=dre;ee.undefinedTypeError=pre;ee.invalidRepeatedDirectiveErrorMessage=fre;ee.invalidDirectiveError=mre;ee.invalidRepeatedFederatedDirectiveErrorMessage=hre;ee.invalidDirectiveLocationErrorMessage=Tre;ee.undefinedRequiredArgumentsErrorMessage=Nre;ee.unexpectedDirectiveArgumentErrorMessage=Ere;ee.duplicateDirectiveArgumentDefinitionsErrorMessage=yre;ee.invalidArgumentValueErrorMessage=Ire;ee.invalidDirectiveArgumentTypeErrorMessage=gre;ee.invalidKeyDirectivesError=_re;ee.maximumTypeNestingExceededError=vre;ee.unexpectedKindFatalError=Ore;ee.incompatibleParentKindFatalError=Sre;ee.unexpectedEdgeFatalError=Dre;ee.incompatibleParentKindMergeError=bre;ee.fieldTypeMergeFatalError=Are;ee.unexpectedDirectiveLocationError=Rre;ee.unexpectedTypeNodeKindFatalError=Fre;ee.invalidKeyFatalError=wre;ee.invalidConfigurationResultFatalError=Pre;ee.unexpectedParentKindForChildError=Lre;ee.subgraphValidationError=Cre;ee.invalidSubgraphNameErrorMessage=Ure;ee.invalidOperationTypeDefinitionError=kre;ee.invalidRootTypeDefinitionError=xre;ee.subgraphInvalidSyntaxError=Mre;ee.invalidInterfaceImplementationError=Bre;ee.invalidRequiredInputValueError=Vre;ee.duplicateArgumentsError=jre;ee.invalidArgumentsError=qre;ee.noQueryRootTypeError=$re;ee.expectedEntityError=Kre;ee.abstractTypeInKeyFieldSetErrorMessage=Gre;ee.unknownTypeInFieldSetErrorMessage=Qre;ee.invalidSelectionSetErrorMessage=Yre;ee.invalidSelectionSetDefinitionErrorMessage=Jre;ee.undefinedFieldInFieldSetErrorMessage=zre;ee.unparsableFieldSetErrorMessage=Hre;ee.unparsableFieldSetSelectionErrorMessage=Wre;ee.undefinedObjectLikeParentError=Xre;ee.unexpectedArgumentErrorMessage=Zre;ee.argumentsInKeyFieldSetErrorMessage=eie;ee.invalidProvidesOrRequiresDirectivesError=tie;ee.duplicateFieldInFieldSetErrorMessage=nie;ee.invalidConfigurationDataErrorMessage=rie;ee.incompatibleTypeWithProvidesErrorMessage=iie;ee.invalidInlineFragmentTypeErrorMessage=sie;ee.inlineFragmentWithoutTypeConditionErrorMessage=oie;ee.unknownInlineFragmentTypeConditionErrorMessage=aie;ee.invalidInlineFragmentTypeConditionTypeErrorMessage=uie;ee.invalidInlineFragmentTypeConditionErrorMessage=cie;ee.invalidSelectionOnUnionErrorMessage=lie;ee.duplicateOverriddenFieldErrorMessage=die;ee.duplicateOverriddenFieldsError=pie;ee.noFieldDefinitionsError=fie;ee.noInputValueDefinitionsError=mie;ee.allChildDefinitionsAreInaccessibleError=hie;ee.equivalentSourceAndTargetOverrideErrorMessage=Tie;ee.undefinedEntityInterfaceImplementationsError=Nie;ee.orScopesLimitError=Eie;ee.invalidEventDrivenGraphError=yie;ee.invalidRootTypeFieldEventsDirectivesErrorMessage=Iie;ee.invalidEventDrivenMutationResponseTypeErrorMessage=gie;ee.invalidRootTypeFieldResponseTypesEventDrivenErrorMessage=_ie;ee.invalidNatsStreamInputFieldsErrorMessage=vie;ee.invalidKeyFieldSetsEventDrivenErrorMessage=Oie;ee.nonExternalKeyFieldNamesEventDrivenErrorMessage=Sie;ee.nonKeyFieldNamesEventDrivenErrorMessage=Die;ee.nonEntityObjectExtensionsEventDrivenErrorMessage=bie;ee.nonKeyComposingObjectTypeNamesEventDrivenErrorMessage=Aie;ee.invalidEdfsDirectiveName=Rie;ee.invalidImplementedTypeError=Fie;ee.selfImplementationError=wie;ee.invalidEventSubjectErrorMessage=Pie;ee.invalidEventSubjectsErrorMessage=Lie;ee.invalidEventSubjectsItemErrorMessage=Cie;ee.invalidEventSubjectsArgumentErrorMessage=Uie;ee.undefinedEventSubjectsArgumentErrorMessage=kie;ee.invalidEventDirectiveError=xie;ee.invalidReferencesOfInaccessibleTypeError=Mie;ee.inaccessibleRequiredArgumentError=Bie;ee.invalidUnionMemberTypeError=Vie;ee.invalidRootTypeError=jie;ee.invalidSubscriptionFilterLocationError=qie;ee.invalidSubscriptionFilterDirectiveError=$ie;ee.subscriptionFilterNamedTypeErrorMessage=Kie;ee.subscriptionFilterConditionDepthExceededErrorMessage=Gie;ee.subscriptionFilterConditionInvalidInputFieldNumberErrorMessage=Qie;ee.subscriptionFilterConditionInvalidInputFieldErrorMessage=Yie;ee.subscriptionFilterConditionInvalidInputFieldTypeErrorMessage=Jie;ee.subscriptionFilterArrayConditionInvalidItemTypeErrorMessage=zie;ee.subscriptionFilterArrayConditionInvalidLengthErrorMessage=Hie;ee.invalidInputFieldTypeErrorMessage=Wie;ee.subscriptionFieldConditionInvalidInputFieldErrorMessage=Xie;ee.subscriptionFieldConditionInvalidValuesArrayErrorMessage=Zie;ee.subscriptionFieldConditionEmptyValuesArrayErrorMessage=ese;ee.unknownFieldSubgraphNameError=tse;ee.invalidSubscriptionFieldConditionFieldPathErrorMessage=nse;ee.invalidSubscriptionFieldConditionFieldPathParentErrorMessage=rse;ee.undefinedSubscriptionFieldConditionFieldPathFieldErrorMessage=ise;ee.invalidSubscriptionFieldConditionFieldPathFieldErrorMessage=sse;ee.inaccessibleSubscriptionFieldConditionFieldPathFieldErrorMessage=ose;ee.nonLeafSubscriptionFieldConditionFieldPathFinalFieldErrorMessage=ase;ee.unresolvablePathError=use;ee.allExternalFieldInstancesError=cse;ee.externalInterfaceFieldsError=lse;ee.nonExternalConditionalFieldError=dse;ee.incompatibleFederatedFieldNamedTypeError=pse;ee.unknownNamedTypeErrorMessage=dj;ee.unknownNamedTypeError=fse;ee.unknownFieldDataError=mse;ee.unexpectedNonCompositeOutputTypeError=hse;ee.invalidExternalDirectiveError=Tse;ee.configureDescriptionNoDescriptionError=Nse;ee.configureDescriptionPropagationError=Ese;ee.duplicateDirectiveDefinitionArgumentErrorMessage=yse;ee.duplicateDirectiveDefinitionLocationErrorMessage=Ise;ee.invalidDirectiveDefinitionLocationErrorMessage=gse;ee.invalidDirectiveDefinitionError=_se;var ft=zr(),aj=kp(),pT=rs();ee.minimumSubgraphRequirementError=new Error("At least one subgraph is required for federation.");function Kne(e,t,n){return new Error(`The named type "${e}" is defined as both types "${t}" and "${n}".
-However, there must be only one type named "${e}".`)}function Gne(e,t,n,r){return new Error(`Incompatible types when merging two instances of argument "${e}" on path "${t}":
- Expected type "${n}" but received "${r}"`)}function Qne(e,t,n,r){return new Error(`The ${e} of type "${n}" defined on path "${t}" is incompatible with the default value of "${r}".`)}function Yne(e,t,n){return new Error(`Incompatible types when merging two instances of "${e}":
- Expected type "${t}" but received "${n}"`)}function Jne(e,t,n,r,i){return new Error(`Expected the ${e} defined on path "${t}" to define the default value "${r}".
+However, there must be only one type named "${e}".`)}function Gne(e,t,n,r){return cmE(`Incompatible types when merging two instances of argument "${e}" on path "${t}":
+ Expected type "${n}" but received "${r}"`,{coordinate:t})}function Qne(e,t,n,r){return new Error(`The ${e} of type "${n}" defined on path "${t}" is incompatible with the default value of "${r}".`)}function cmE(e,t){return Object.assign(new Error(e),t)}function cmC(e,t){let n=[...t];return n.length===1?`${e}.${n[0]}`:e}function Yne(e,t,n){return cmE(`Incompatible types when merging two instances of "${e}":
+ Expected type "${t}" but received "${n}"`,{coordinate:e})}function Jne(e,t,n,r,i){return new Error(`Expected the ${e} defined on path "${t}" to define the default value "${r}".
 "However, the default value "${i}" is defined in the following subgraph`+(n.length>1?"s":"")+`:
  "`+n.join(ft.QUOTATION_JOIN)+`"
-If an instance defines a default value, that default value must be consistently defined across all subgraphs.`)}function zne(e){return new Error(`Enum "${e}" was used as both an input and output but was inconsistently defined across inclusive subgraphs.`)}function Hne(e,t){let n="Subgraphs to be federated must each have a unique, non-empty name.";e.length>0&&(n+=`
+If an instance defines a default value, that default value must be consistently defined across all subgraphs.`)}function zne(e){return cmE(`Enum "${e}" was used as both an input and output but was inconsistently defined across inclusive subgraphs.`,{coordinate:e})}function Hne(e,t){let n="Subgraphs to be federated must each have a unique, non-empty name.";e.length>0&&(n+=`
  The following subgraph names are not unique:
   "`+e.join('", "')+'"');for(let r of t)n+=`
- ${r}`;return new Error(n)}function Wne(e){return new Error(`The directive "${e}" must only be defined once.`)}function Xne(e,t){return new Error(`The Enum "${e}" must only define the Enum Value definition "${t}" once.`)}function Zne(e,t,n){return new Error(`The ${e} "${t}" must only define the Field definition "${n}" once.`)}function ere(e,t){return new Error(`The Input Object "${e}" must only define the Input Field definition "${t}" once.`)}function tre(e,t,n){return new Error(`The ${e} "${t}" must only implement the Interface "${n}" once.`)}function nre(e,t){return new Error(`The Union "${e}" must only define the Union Member "${t}" once.`)}function rre(e,t){return new Error(`The ${e} "${t}" must only be defined once.`)}function ire(e,t,n){return new Error(`The operation type "${e}" cannot be defined as "${t}" because it has already been defined as "${n}".`)}function sre(e,t){return new Error(`The ${e} "${t}" is an extension, but no base ${e} definition of "${t}" is defined in any subgraph.`)}function ore(e){return new Error(`The Scalar extension "${e}" is invalid because no base Scalar definition of "${e} is defined in the subgraph.`)}function are(e){return new Error(`The Union "${e}" must define at least one Union Member.`)}function ure(e){return new Error(`The Enum "${e}" must define at least one Enum Value.`)}function cre(e,t,n){return new Error(`Expected the response type "${e}" for operation "${t}" to be type object but received "${n}.`)}function lre(e,t){let n=e.name,r=[];for(let[i,s]of e.fieldDataByFieldName){if(!t.has(i))continue;let o=[],u=[];for(let[l,d]of s.isShareableBySubgraphName)d?o.push(l):u.push(l);o.length<1?r.push(`
+ ${r}`;return new Error(n)}function Wne(e){return new Error(`The directive "${e}" must only be defined once.`)}function Xne(e,t){return new Error(`The Enum "${e}" must only define the Enum Value definition "${t}" once.`)}function Zne(e,t,n){return new Error(`The ${e} "${t}" must only define the Field definition "${n}" once.`)}function ere(e,t){return new Error(`The Input Object "${e}" must only define the Input Field definition "${t}" once.`)}function tre(e,t,n){return new Error(`The ${e} "${t}" must only implement the Interface "${n}" once.`)}function nre(e,t){return new Error(`The Union "${e}" must only define the Union Member "${t}" once.`)}function rre(e,t){return new Error(`The ${e} "${t}" must only be defined once.`)}function ire(e,t,n){return new Error(`The operation type "${e}" cannot be defined as "${t}" because it has already been defined as "${n}".`)}function sre(e,t){return cmE(`The ${e} "${t}" is an extension, but no base ${e} definition of "${t}" is defined in any subgraph.`,{coordinate:t})}function ore(e){return new Error(`The Scalar extension "${e}" is invalid because no base Scalar definition of "${e} is defined in the subgraph.`)}function are(e){return new Error(`The Union "${e}" must define at least one Union Member.`)}function ure(e){return new Error(`The Enum "${e}" must define at least one Enum Value.`)}function cre(e,t,n){return new Error(`Expected the response type "${e}" for operation "${t}" to be type object but received "${n}.`)}function lre(e,t){let n=e.name,r=[];for(let[i,s]of e.fieldDataByFieldName){if(!t.has(i))continue;let o=[],u=[];for(let[l,d]of s.isShareableBySubgraphName)d?o.push(l):u.push(l);o.length<1?r.push(`
  The field "${i}" is defined in the following subgraphs: "${[...s.subgraphNames].join('", "')}".
  However, it is not declared "@shareable" in any of them.`):r.push(`
  The field "${i}" is defined and declared "@shareable" in the following subgraph`+(o.length>1?"s":"")+': "'+o.join(ft.QUOTATION_JOIN)+`".
- However, it is not declared "@shareable" in the following subgraph`+(u.length>1?"s":"")+`: "${u.join(ft.QUOTATION_JOIN)}".`)}return new Error(`The object "${n}" defines the same fields in multiple subgraphs without the "@shareable" directive:${r.join(`
-`)}`)}function dre(e,t){return new Error(`The directive "@${e}" declared on coordinates "${t}" is not defined in the schema.`)}function pre(e){return new Error(` The type "${e}" was referenced in the schema, but it was never defined.`)}function fre(e){return`The definition for the directive "@${e}" does not define it as repeatable, but it is declared more than once on these coordinates.`}function mre(e,t,n,r){return new Error(`The ${n} instance of the directive "@${e}" declared on coordinates "${t}" is invalid for the following reason`+(r.length>1?`s:
+ However, it is not declared "@shareable" in the following subgraph`+(u.length>1?"s":"")+`: "${u.join(ft.QUOTATION_JOIN)}".`)}return cmE(`The object "${n}" defines the same fields in multiple subgraphs without the "@shareable" directive:${r.join(`
+`)}`,{coordinate:cmC(e.name,t)})}function dre(e,t){return new Error(`The directive "@${e}" declared on coordinates "${t}" is not defined in the schema.`)}function pre(e){return cmE(` The type "${e}" was referenced in the schema, but it was never defined.`,{coordinate:e})}function fre(e){return`The definition for the directive "@${e}" does not define it as repeatable, but it is declared more than once on these coordinates.`}function mre(e,t,n,r){return new Error(`The ${n} instance of the directive "@${e}" declared on coordinates "${t}" is invalid for the following reason`+(r.length>1?`s:
 `:`:
 `)+r.join(`
-`))}function hre(e,t){return new Error(`The definition for the directive "@${e}" does not define it as repeatable, but the directive has been declared on more than one instance of the type "${t}".`)}function Tre(e,t){return` The definition for "@${e}" does not define "${t}" as a valid location.`}function Nre(e,t,n){let r=` The definition for "@${e}" defines the following `+t.length+" required argument"+(t.length>1?"s: ":": ")+'"'+t.join('", "')+`".
+`))}function hre(e,t){return cmE(`The definition for the directive "@${e}" does not define it as repeatable, but the directive has been declared on more than one instance of the type "${t}".`,{coordinate:t})}function Tre(e,t){return` The definition for "@${e}" does not define "${t}" as a valid location.`}function Nre(e,t,n){let r=` The definition for "@${e}" defines the following `+t.length+" required argument"+(t.length>1?"s: ":": ")+'"'+t.join('", "')+`".
  However,`;return n.length<1?r+" no arguments are defined on this instance.":r+" the following required argument"+(n.length>1?"s are":" is")+' not defined on this instance: "'+n.join(ft.QUOTATION_JOIN)+'".'}function Ere(e,t){return` The definition for "@${e}" does not define the following argument`+(t.length>1?"s that are":" that is")+' provided: "'+t.join(ft.QUOTATION_JOIN)+'".'}function yre(e){return" The following argument"+(e.length>1?"s are":" is")+' defined more than once: "'+e.join(ft.QUOTATION_JOIN)+'"'}function Ire(e,t,n,r){return` The value "${e}" provided to argument "${t}(${n}: ...)" is not a valid "${r}" type.`}function gre(e,t,n,r){return` The ${e?"required ":""}argument "${t} must be type "${n}" and not type "${r}".`}function _re(e,t){return new Error(`The entity "${e}" defines the following invalid "key" directive`+(t.length>1?"s":"")+`:
 `+t.join(`
-`))}function vre(e){return new Error(` The type defined at path "${e}" has more than ${aj.MAXIMUM_TYPE_NESTING} layers of nesting, or there is a cyclical error.`)}function Ore(e){return new Error(`Fatal: Unexpected type for "${e}"`)}function Sre(e,t,n){return new Error(`Fatal: Expected "${e}" to be type ${(0,pT.kindToTypeString)(t)} but received "${(0,pT.kindToTypeString)(n)}".`)}function Dre(e,t){return new Error(`Fatal: The type "${e}" visited the following unexpected edge`+(t.length>1?"s":"")+`:
- " ${t.join(ft.QUOTATION_JOIN)}".`)}function bre(e,t,n){return new Error(` When merging types, expected "${e}" to be type "${t}" but received "${n}".`)}function Are(e){return new Error(`Fatal: Unsuccessfully merged the cross-subgraph types of field "${e}" without producing a type error object.`)}function Rre(e){return new Error(`Fatal: Unknown directive location "${e}".`)}function Fre(e){return new Error(`Fatal: Expected all constituent types at path "${e}" to be one of the following: "LIST_TYPE", "NAMED_TYPE", or "NON_NULL_TYPE".`)}function wre(e,t){return new Error(`Fatal: Expected key "${e}" to exist in the map "${t}".`)}function Pre(e){return new Error(`Fatal: Expected either errors or configurations for the path ${e}" but received neither".`)}ee.subgraphValidationFailureError=new Error(" Fatal: Subgraph validation did not return a valid AST.");ee.federationFactoryInitializationFatalError=new Error("Fatal: FederationFactory was unsuccessfully initialized.");function Lre(e,t,n,r,i){return new Error(` Expected "${e}" to be type ${t} but received "${n}" when handling child "${r}" of type "${i}".`)}function Cre(e,t){return new Error(`The subgraph "${e}" could not be federated for the following reason`+(t.length>1?"s":"")+`:
+`))}function vre(e){return cmE(` The type defined at path "${e}" has more than ${aj.MAXIMUM_TYPE_NESTING} layers of nesting, or there is a cyclical error.`,{coordinate:e})}function Ore(e){return new Error(`Fatal: Unexpected type for "${e}"`)}function Sre(e,t,n){return new Error(`Fatal: Expected "${e}" to be type ${(0,pT.kindToTypeString)(t)} but received "${(0,pT.kindToTypeString)(n)}".`)}function Dre(e,t){return new Error(`Fatal: The type "${e}" visited the following unexpected edge`+(t.length>1?"s":"")+`:
+ " ${t.join(ft.QUOTATION_JOIN)}".`)}function bre(e,t,n){return cmE(` When merging types, expected "${e}" to be type "${t}" but received "${n}".`,{coordinate:e})}function Are(e){return new Error(`Fatal: Unsuccessfully merged the cross-subgraph types of field "${e}" without producing a type error object.`)}function Rre(e){return new Error(`Fatal: Unknown directive location "${e}".`)}function Fre(e){return new Error(`Fatal: Expected all constituent types at path "${e}" to be one of the following: "LIST_TYPE", "NAMED_TYPE", or "NON_NULL_TYPE".`)}function wre(e,t){return new Error(`Fatal: Expected key "${e}" to exist in the map "${t}".`)}function Pre(e){return new Error(`Fatal: Expected either errors or configurations for the path ${e}" but received neither".`)}ee.subgraphValidationFailureError=new Error(" Fatal: Subgraph validation did not return a valid AST.");ee.federationFactoryInitializationFatalError=new Error("Fatal: FederationFactory was unsuccessfully initialized.");function Lre(e,t,n,r,i){return new Error(` Expected "${e}" to be type ${t} but received "${n}" when handling child "${r}" of type "${i}".`)}function Cre(e,t){return new Error(`The subgraph "${e}" could not be federated for the following reason`+(t.length>1?"s":"")+`:
 `+t.map(n=>n.message).join(`
 `))}function Ure(e,t){return`The ${(0,pT.numberToOrdinal)(e+1)} subgraph in the array did not define a name. Consequently, any further errors will temporarily identify this subgraph as "${t}".`}function kre(e,t,n){return new Error(`The schema definition defines the "${e}" operation as type "${t}". However, "${t}" was also used for the "${n}" operation.
  If explicitly defined, each operation type must be a unique and valid Object type.`)}function xre(e,t,n){return new Error(`The schema definition defines the "${e}" operation as type "${t}". However, the schema also defines another type named "${n}", which is the default (root) type name for the "${e}" operation.
@@ -403,13 +403,13 @@ For federation, it is only possible to use the default root types names ("Mutati
 `),d.implementedResponseType&&(o+=`   The implemented response type "${d.implementedResponseType}" is not a valid subset (equally or more restrictive) of the response type "`+d.originalResponseType+`" for "${i}.${l}".
 `),d.isInaccessible&&(o+=`   The field has been declared "@inaccessible"; however, the same field has not been declared "@inaccessible" on the Interface definition.
    Consequently, the Interface implementation cannot be satisfied.
-`)}r.push(o)}return new Error(`The ${t} "${e}" has the following Interface implementation errors:
+`)}r.push(o)}return cmE(`The ${t} "${e}" has the following Interface implementation errors:
 `+r.join(`
-`))}function Vre(e,t,n,r=!0){let i=r?ft.ARGUMENT:ft.INPUT_FIELD,s=`The ${e} "${t}" could not be federated because:
+`),{coordinate:e})}function Vre(e,t,n,r=!0){let i=r?ft.ARGUMENT:ft.INPUT_FIELD,s=`The ${e} "${t}" could not be federated because:
 `;for(let o of n)s+=` The ${i} "${o.inputValueName}" is required in the following subgraph`+(o.requiredSubgraphs.length>1?"s":"")+': "'+o.requiredSubgraphs.join('", "')+`"
  However, the ${i} "${o.inputValueName}" is not defined in the following subgraph`+(o.missingSubgraphs.length>1?"s":"")+': "'+o.missingSubgraphs.join('", "')+`"
  If an ${i} is required on a ${e} in any one subgraph, it must be at least defined as optional on all other definitions of that ${e} in all other subgraphs.
-`;return new Error(s)}function jre(e,t){return new Error(`The field "${e}" is invalid because:
+`;return cmE(s,{coordinate:t})}function jre(e,t){return new Error(`The field "${e}" is invalid because:
  The following argument`+(t.length>1?"s are":" is")+' defined more than once: "'+t.join(ft.QUOTATION_JOIN)+`"
 `)}function qre(e,t){let n=`The field "${e}" is invalid because:
  The named type (root type) of an input must be on of Enum, Input Object, or Scalar type. For example: "Float", "[[String!]]!", or "[SomeInputObjectName]"
@@ -463,7 +463,7 @@ For federation, it is only possible to use the default root types names ("Mutati
  This is because an inline fragment with the type condition "${n}" is defined on the selection set corresponding to the `+uI(t,i,r);return r===ft.INTERFACE?s+` However, "${n}" does not implement "${i}"`:s+` However, "${n}" is not a member of "${i}".`}function lie(e,t,n){return` The following field set is invalid:
   "${e}"
  This is because of the selection set corresponding to the `+uI(t,n,ft.UNION)+` Union types such as "${n}" must define field selections (besides "__typename") on an inline fragment whose type condition corresponds to a constituent union member.`}function die(e,t){return` The field "${e}" declares an @override directive in the following subgraphs: "`+t.join(ft.QUOTATION_JOIN)+'".'}function pie(e){return new Error('The "@override" directive must only be declared on one single instance of a field. However, an "@override" directive was declared on more than one instance of the following field'+(e.length>1?"s":"")+': "'+e.join(ft.QUOTATION_JOIN)+`".
-`)}function fie(e,t){return new Error(`The ${e} "${t}" is invalid because it does not define any fields.`)}function mie(e){return new Error(`The Input Object "${e}" is invalid because it does not define any input values.`)}function hie(e,t,n){return new Error(`The ${e} "${t}" is invalid because all its ${n} definitions are declared "@inaccessible".`)}function Tie(e,t){return`Cannot override field "${t}" because the source and target subgraph names are both "${e}"`}function Nie(e,t){let n=`Federation was unsuccessful because any one subgraph that defines a specific entity interface must also define each and every entity object that implements that entity interface.
+`)}function fie(e,t){return new Error(`The ${e} "${t}" is invalid because it does not define any fields.`)}function mie(e){return new Error(`The Input Object "${e}" is invalid because it does not define any input values.`)}function hie(e,t,n){return cmE(`The ${e} "${t}" is invalid because all its ${n} definitions are declared "@inaccessible".`,{coordinate:t})}function Tie(e,t){return`Cannot override field "${t}" because the source and target subgraph names are both "${e}"`}function Nie(e,t){let n=`Federation was unsuccessful because any one subgraph that defines a specific entity interface must also define each and every entity object that implements that entity interface.
 `;for(let[r,i]of e){let o=(0,pT.getOrThrowError)(t,r,"entityInterfaceFederationDataByTypeName").concreteTypeNames;n+=` Across all subgraphs, the entity interface "${r}" is implemented by the following entity object`+(o.size>1?"s":"")+`:
   "`+Array.from(o).join(ft.QUOTATION_JOIN)+`"
  However, the definition of at least one of these implementations is missing in a subgraph that defines the entity interface "${r}":
@@ -519,11 +519,11 @@ Consequently, the following object extension definition`+(e.length>1?"s are":" i
    streamName: String!
   }`;function Rie(e){return new Error(`Could not retrieve definition for Event-Driven Federated Subscription directive "${e}".`)}function Fie(e,t){let n=` Only interfaces can be implemented. However, the type "${e}" attempts to implement the following invalid type`+(t.size>1?"s":"")+`:
 `;for(let[r,i]of t)n+=`  "${r}", which is type "${i}"
-`;return new Error(n)}function wie(e){return new Error(` The interface "${e}" must not implement itself.`)}function Pie(e){return`The "${e}" argument must be string with a minimum length of one.`}function Lie(e){return`The "${e}" argument must be a list of strings.`}function Cie(e){return`Each item in the "${e}" argument list must be a string with a minimum length of one. However, at least one value provided in the list was invalid.`}function Uie(e){return`An argument template references the invalid argument "${e}".`}function kie(e){return`An argument template references the undefined argument "${e}".`}ee.invalidEventProviderIdErrorMessage='If explicitly defined, the "providerId" argument must be a string with a minimum length of one.';function xie(e,t,n){return new Error(`The event directive "${e}" declared on "${t}" is invalid for the following reason`+(n.length>1?"s":"")+`:
+`;return cmE(n,{coordinate:e})}function wie(e){return new Error(` The interface "${e}" must not implement itself.`)}function Pie(e){return`The "${e}" argument must be string with a minimum length of one.`}function Lie(e){return`The "${e}" argument must be a list of strings.`}function Cie(e){return`Each item in the "${e}" argument list must be a string with a minimum length of one. However, at least one value provided in the list was invalid.`}function Uie(e){return`An argument template references the invalid argument "${e}".`}function kie(e){return`An argument template references the undefined argument "${e}".`}ee.invalidEventProviderIdErrorMessage='If explicitly defined, the "providerId" argument must be a string with a minimum length of one.';function xie(e,t,n){return new Error(`The event directive "${e}" declared on "${t}" is invalid for the following reason`+(n.length>1?"s":"")+`:
  `+n.join(`
- `))}function Mie(e,t,n){return new Error(`The ${e} "${t}" is declared @inaccessible; however, the ${e} is still referenced at the following paths:
+ `))}function Mie(e,t,n){return cmE(`The ${e} "${t}" is declared @inaccessible; however, the ${e} is still referenced at the following paths:
  "`+n.join(ft.QUOTATION_JOIN)+`"
-`)}function Bie(e,t,n){return new Error(`The argument "${e}" on path "${t}" is declared @inaccessible; however, it is a required argument for field "${n}".`)}function Vie(e,t){return new Error(` The union "${e}" defines the following member`+(t.length>1?"s that are not object types":" that is not an object type")+`:
+`,{coordinate:t})}function Bie(e,t,n){return cmE(`The argument "${e}" on path "${t}" is declared @inaccessible; however, it is a required argument for field "${n}".`,{coordinate:t})}function Vie(e,t){return new Error(` The union "${e}" defines the following member`+(t.length>1?"s that are not object types":" that is not an object type")+`:
   `+t.join(`
   `))}function jie(e){return new Error(`Expected type "${e}" to be a root type but could not find its respective OperationTypeNode.`)}function qie(e){return new Error(`The "@${ft.SUBSCRIPTION_FILTER}" directive must only be defined on a subscription root field, but it was defined on the path "${e}".`)}function $ie(e,t){return new Error(`The "@${ft.SUBSCRIPTION_FILTER}" directive defined on path "${e}" is invalid for the following reason`+(t.length>1?"s":"")+`:
 `+t.join(`
@@ -546,37 +546,37 @@ Consequently, the following object extension definition`+(e.length>1?"s are":" i
  However, only fields that are defined in the same graph as the "@${ft.SUBSCRIPTION_FILTER}" directive can compose part of an "IN" condition's "fieldPath" input value field.
  Consequently, the path "${n}" is invalid because field "${r}" is not defined in subgraph "${i}".`}function ose(e,t,n,r){return` Input path "${e}" defines the value "${t}".
   The path segment "${n}" is invalid because it refers to "${r}", which is declared @inaccessible.`}function ase(e,t,n,r,i){return` Input path "${e}" defines the value "${t}".
- However, the final field "${n}" is ${r} "${i}", which is not a leaf type; therefore, it requires further selections.`}function use({fieldName:e,selectionSet:t},n){let r=`The field "${e}" is unresolvable at the following path:
+ However, the final field "${n}" is ${r} "${i}", which is not a leaf type; therefore, it requires further selections.`}function use({fieldName:e,selectionSet:t,subgraphNames:i,typeName:s},n){let r=`The field "${e}" is unresolvable at the following path:
 ${t}
 This is because:
  - `+n.join(`
- - `);return new Error(r)}function cse(e,t){let n=`The Object "${e}" is invalid because the following Field definition`+(t.size>1?"s are":" is")+` declared "@external" on all instances of that Field:
+ - `);return cmE(r,{subgraphName:i.size===1?[...i][0]:void 0,coordinate:`${s}.${e}`})}function cse(e,t){let n=`The Object "${e}" is invalid because the following Field definition`+(t.size>1?"s are":" is")+` declared "@external" on all instances of that Field:
 `;for(let[r,i]of t)n+=` "${r}" in subgraph`+(i.length>1?"s":"")+' "'+i.join(ft.QUOTATION_JOIN)+`"
-`;return n+='At least one instance of a Field definition must always be resolvable (and therefore not declared "@external").',new Error(n)}function lse(e,t){return new Error(`The interface "${e}" is invalid because the following field definition`+(t.length>1?"s are":" is")+` declared @external:
+`;return n+='At least one instance of a Field definition must always be resolvable (and therefore not declared "@external").',cmE(n,{coordinate:cmC(e,t.keys())})}function lse(e,t){return new Error(`The interface "${e}" is invalid because the following field definition`+(t.length>1?"s are":" is")+` declared @external:
  "`+t.join(ft.QUOTATION_JOIN)+`"
 Interface fields should not be declared @external. This is because interface fields do not resolve directly, but the "@external" directive relates to whether a field instance can be resolved by the subgraph in which it is defined.`)}function dse(e,t,n,r,i){return new Error(`The Field "${e}" in subgraph "${t}" defines a "@${i}" directive with the following field set:
  "${r}".
 However, neither the field "${n}" nor any of its field set ancestors are declared @external.
-Consequently, "${n}" is already provided by subgraph "${t}" and should not form part of a "@${i}" directive field set.`)}function pse(e,t){let n=[];for(let[r,i]of t){let s=[...i];n.push(` The Named Type "${r}" is returned by the following subgraph`+(s.length>1?"s":"")+': "'+s.join(ft.QUOTATION_JOIN)+'".')}return new Error(`Each instance of a shared Field must resolve identically across subgraphs.
+Consequently, "${n}" is already provided by subgraph "${t}" and should not form part of a "@${i}" directive field set.`)}function pse(e,t){let n=[];for(let[r,i]of t){let s=[...i];n.push(` The Named Type "${r}" is returned by the following subgraph`+(s.length>1?"s":"")+': "'+s.join(ft.QUOTATION_JOIN)+'".')}return cmE(`Each instance of a shared Field must resolve identically across subgraphs.
 The Field "${e}" could not be federated due to incompatible types across subgraphs.
 The discrepancies are as follows:
 `+n.join(`
-`))}function dj(e,t){return`The Field "${e}" returns the unknown named type "${t}".`}function fse(e,t){return new Error(dj(e,t))}function mse(e){return new Error(`Could not find FieldData for Field "${e}"
+`),{coordinate:e})}function dj(e,t){return`The Field "${e}" returns the unknown named type "${t}".`}function fse(e,t){return cmE(dj(e,t),{coordinate:e})}function mse(e){return new Error(`Could not find FieldData for Field "${e}"
 .This should never happen. Please report this issue on GitHub.`)}function hse(e,t){return new Error(`Expected named type "${e}" to be a composite output type (Object or Interface) but received "${t}".
-This should never happen. Please report this issue on GitHub.`)}function Tse(e){return new Error(`The Object Field "${e}" is invalidly declared "@external". An Object Field should only be declared "@external" if it is part of a "@key", "@provides", or "@requires" FieldSet, or the Field is necessary to satisfy an Interface implementation. In the case that none of these conditions is true, the "@external" directive should be removed.`)}function Nse(e,t){return new Error(`The "@openfed__configureDescription" directive defined on ${e} "${t}" is invalid because neither a description nor the "descriptionOverride" argument is defined.`)}function Ese(e,t){return new Error(`The coordinates "${e}" declare "@openfed__configureDescription(propagate: true)" in the following subgraphs:
+This should never happen. Please report this issue on GitHub.`)}function Tse(e){return new Error(`The Object Field "${e}" is invalidly declared "@external". An Object Field should only be declared "@external" if it is part of a "@key", "@provides", or "@requires" FieldSet, or the Field is necessary to satisfy an Interface implementation. In the case that none of these conditions is true, the "@external" directive should be removed.`)}function Nse(e,t){return new Error(`The "@openfed__configureDescription" directive defined on ${e} "${t}" is invalid because neither a description nor the "descriptionOverride" argument is defined.`)}function Ese(e,t){return cmE(`The coordinates "${e}" declare "@openfed__configureDescription(propagate: true)" in the following subgraphs:
  "`+t.join(ft.QUOTATION_JOIN)+`"
-A federated graph only supports a single description; consequently, only one subgraph may define argument "propagate" as true (this is the default value).`)}function yse(e){return"- The following argument"+(e.length>1?"s are":" is")+` defined more than once:
+A federated graph only supports a single description; consequently, only one subgraph may define argument "propagate" as true (this is the default value).`,{coordinate:e})}function yse(e){return"- The following argument"+(e.length>1?"s are":" is")+` defined more than once:
  "`+e.join(ft.QUOTATION_JOIN)+'"'}function Ise(e){return`- The location "${e}" is defined multiple times.`}function gse(e){return`- "${e}" is not a valid directive location.`}function _se(e,t){return new Error(`The directive definition for "@${e}" is invalid for the following reason`+(t.length>1?"s":"")+`:
-`+t.join(ft.LITERAL_NEW_LINE)+'"')}});var fj=M(pj=>{"use strict";m();T();h();Object.defineProperty(pj,"__esModule",{value:!0})});var Vc=M(Oi=>{"use strict";m();T();h();Object.defineProperty(Oi,"__esModule",{value:!0});Oi.getMutableDirectiveDefinitionNode=Ose;Oi.getMutableEnumNode=Sse;Oi.getMutableEnumValueNode=Dse;Oi.getMutableFieldNode=bse;Oi.getMutableInputObjectNode=Ase;Oi.getMutableInputValueNode=Rse;Oi.getMutableInterfaceNode=Fse;Oi.getMutableObjectNode=wse;Oi.getMutableObjectExtensionNode=Pse;Oi.getMutableScalarNode=Lse;Oi.getMutableTypeNode=AA;Oi.getMutableUnionNode=Cse;Oi.getTypeNodeNamedTypeName=RA;Oi.getNamedTypeNode=hj;var vi=(Ue(),Be(qe)),qp=Xi(),mj=Fo(),vse=kp();function Ose(e){return{arguments:[],kind:e.kind,locations:[],name:C({},e.name),repeatable:e.repeatable,description:(0,qp.formatDescription)(e.description)}}function Sse(e){return{kind:vi.Kind.ENUM_TYPE_DEFINITION,name:C({},e)}}function Dse(e){return{directives:[],kind:e.kind,name:C({},e.name),description:(0,qp.formatDescription)(e.description)}}function bse(e,t,n){return{arguments:[],directives:[],kind:e.kind,name:C({},e.name),type:AA(e.type,t,n),description:(0,qp.formatDescription)(e.description)}}function Ase(e){return{kind:vi.Kind.INPUT_OBJECT_TYPE_DEFINITION,name:C({},e)}}function Rse(e,t,n){return{directives:[],kind:e.kind,name:C({},e.name),type:AA(e.type,t,n),defaultValue:e.defaultValue,description:(0,qp.formatDescription)(e.description)}}function Fse(e){return{kind:vi.Kind.INTERFACE_TYPE_DEFINITION,name:C({},e)}}function wse(e){return{kind:vi.Kind.OBJECT_TYPE_DEFINITION,name:C({},e)}}function Pse(e){let t=e.kind===vi.Kind.OBJECT_TYPE_DEFINITION?e.description:void 0;return{kind:vi.Kind.OBJECT_TYPE_EXTENSION,name:C({},e.name),description:(0,qp.formatDescription)(t)}}function Lse(e){return{kind:vi.Kind.SCALAR_TYPE_DEFINITION,name:C({},e)}}function AA(e,t,n){let r={kind:e.kind},i=r;for(let s=0;s<vse.MAXIMUM_TYPE_NESTING;s++)switch(e.kind){case vi.Kind.NAMED_TYPE:return i.name=C({},e.name),r;case vi.Kind.LIST_TYPE:i.kind=e.kind,i.type={kind:e.type.kind},i=i.type,e=e.type;continue;case vi.Kind.NON_NULL_TYPE:i.kind=e.kind,i.type={kind:e.type.kind},i=i.type,e=e.type;continue;default:throw(0,mj.unexpectedTypeNodeKindFatalError)(t)}return n.push((0,mj.maximumTypeNestingExceededError)(t)),{kind:vi.Kind.NAMED_TYPE,name:(0,qp.stringToNameNode)(RA(e))}}function Cse(e){return{kind:vi.Kind.UNION_TYPE_DEFINITION,name:C({},e)}}function RA(e){switch(e.kind){case vi.Kind.NAMED_TYPE:return e.name.value;case vi.Kind.LIST_TYPE:case vi.Kind.NON_NULL_TYPE:return RA(e.type)}}function hj(e){switch(e.kind){case vi.Kind.NAMED_TYPE:return e;default:return hj(e.type)}}});var FA=M(cI=>{"use strict";m();T();h();Object.defineProperty(cI,"__esModule",{value:!0});cI.DEFAULT_CONSUMER_INACTIVE_THRESHOLD=void 0;cI.DEFAULT_CONSUMER_INACTIVE_THRESHOLD=30});var jc=M(le=>{"use strict";m();T();h();Object.defineProperty(le,"__esModule",{value:!0});le.EDFS_ARGS_REGEXP=le.CONFIGURE_CHILD_DESCRIPTIONS_DEFINITION=le.CONFIGURE_DESCRIPTION_DEFINITION=le.EDFS_NATS_STREAM_CONFIGURATION_DEFINITION=le.SCOPE_SCALAR_DEFINITION=le.FIELD_SET_SCALAR_DEFINITION=le.VERSION_TWO_DIRECTIVE_DEFINITIONS=le.EVENT_DRIVEN_DIRECTIVE_DEFINITIONS_BY_DIRECTIVE_NAME=le.BASE_DIRECTIVE_DEFINITIONS=le.V2_DIRECTIVE_DEFINITION_BY_DIRECTIVE_NAME=le.SUBSCRIPTION_FIELD_CONDITION_DEFINITION=le.SUBSCRIPTION_FILTER_VALUE_DEFINITION=le.SUBSCRIPTION_FILTER_CONDITION_DEFINITION=le.SUBSCRIPTION_FILTER_DEFINITION=le.SHAREABLE_DEFINITION=le.REQUIRES_SCOPES_DEFINITION=le.OVERRIDE_DEFINITION=le.LINK_DEFINITION=le.LINK_PURPOSE_DEFINITION=le.LINK_IMPORT_DEFINITION=le.INTERFACE_OBJECT_DEFINITION=le.INACCESSIBLE_DEFINITION=le.COMPOSE_DIRECTIVE_DEFINITION=le.AUTHENTICATED_DEFINITION=le.ALL_IN_BUILT_DIRECTIVE_NAMES=le.BASE_DIRECTIVE_DEFINITION_BY_DIRECTIVE_NAME=le.TAG_DEFINITION=le.SPECIFIED_BY_DEFINITION=le.REQUIRES_DEFINITION=le.PROVIDES_DEFINITION=le.KEY_DEFINITION=le.REQUIRED_FIELDSET_TYPE_NODE=le.EDFS_NATS_SUBSCRIBE_DEFINITION=le.EDFS_NATS_REQUEST_DEFINITION=le.EDFS_NATS_PUBLISH_DEFINITION=le.EDFS_KAFKA_SUBSCRIBE_DEFINITION=le.EDFS_KAFKA_PUBLISH_DEFINITION=le.EXTERNAL_DEFINITION=le.EXTENDS_DEFINITION=le.DEPRECATED_DEFINITION=le.BASE_SCALARS=le.REQUIRED_STRING_TYPE_NODE=void 0;var ye=(Ue(),Be(qe)),he=Xi(),Use=FA(),q=zr();le.REQUIRED_STRING_TYPE_NODE={kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR)};le.BASE_SCALARS=new Set(["_Any","_Entities",q.BOOLEAN_SCALAR,q.FLOAT_SCALAR,q.ID_SCALAR,q.INT_SCALAR,q.FIELD_SET_SCALAR,q.SCOPE_SCALAR,q.STRING_SCALAR]);le.DEPRECATED_DEFINITION={arguments:[{directives:[],kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.REASON),type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR),defaultValue:{kind:ye.Kind.STRING,value:ye.DEFAULT_DEPRECATION_REASON}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.ARGUMENT_DEFINITION_UPPER,q.ENUM_VALUE_UPPER,q.FIELD_DEFINITION_UPPER,q.INPUT_FIELD_DEFINITION_UPPER]),name:(0,he.stringToNameNode)(q.DEPRECATED),repeatable:!1};le.EXTENDS_DEFINITION={kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.INTERFACE_UPPER,q.OBJECT_UPPER]),name:(0,he.stringToNameNode)(q.EXTENDS),repeatable:!1};le.EXTERNAL_DEFINITION={kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.FIELD_DEFINITION_UPPER,q.OBJECT_UPPER]),name:(0,he.stringToNameNode)(q.EXTERNAL),repeatable:!1};le.EDFS_KAFKA_PUBLISH_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.TOPIC),type:le.REQUIRED_STRING_TYPE_NODE},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.PROVIDER_ID),type:le.REQUIRED_STRING_TYPE_NODE,defaultValue:{kind:ye.Kind.STRING,value:q.DEFAULT_EDFS_PROVIDER_ID}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:[(0,he.stringToNameNode)(q.FIELD_DEFINITION_UPPER)],name:(0,he.stringToNameNode)(q.EDFS_KAFKA_PUBLISH),repeatable:!1};le.EDFS_KAFKA_SUBSCRIBE_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.TOPICS),type:{kind:ye.Kind.NON_NULL_TYPE,type:{kind:ye.Kind.LIST_TYPE,type:le.REQUIRED_STRING_TYPE_NODE}}},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.PROVIDER_ID),type:le.REQUIRED_STRING_TYPE_NODE,defaultValue:{kind:ye.Kind.STRING,value:q.DEFAULT_EDFS_PROVIDER_ID}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:[(0,he.stringToNameNode)(q.FIELD_DEFINITION_UPPER)],name:(0,he.stringToNameNode)(q.EDFS_KAFKA_SUBSCRIBE),repeatable:!1};le.EDFS_NATS_PUBLISH_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.SUBJECT),type:le.REQUIRED_STRING_TYPE_NODE},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.PROVIDER_ID),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR)},defaultValue:{kind:ye.Kind.STRING,value:q.DEFAULT_EDFS_PROVIDER_ID}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:[(0,he.stringToNameNode)(q.FIELD_DEFINITION_UPPER)],name:(0,he.stringToNameNode)(q.EDFS_NATS_PUBLISH),repeatable:!1};le.EDFS_NATS_REQUEST_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.SUBJECT),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR)}},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.PROVIDER_ID),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR)},defaultValue:{kind:ye.Kind.STRING,value:q.DEFAULT_EDFS_PROVIDER_ID}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:[(0,he.stringToNameNode)(q.FIELD_DEFINITION_UPPER)],name:(0,he.stringToNameNode)(q.EDFS_NATS_REQUEST),repeatable:!1};le.EDFS_NATS_SUBSCRIBE_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.SUBJECTS),type:{kind:ye.Kind.NON_NULL_TYPE,type:{kind:ye.Kind.LIST_TYPE,type:le.REQUIRED_STRING_TYPE_NODE}}},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.PROVIDER_ID),type:le.REQUIRED_STRING_TYPE_NODE,defaultValue:{kind:ye.Kind.STRING,value:q.DEFAULT_EDFS_PROVIDER_ID}},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.STREAM_CONFIGURATION),type:(0,he.stringToNamedTypeNode)(q.EDFS_NATS_STREAM_CONFIGURATION)}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:[(0,he.stringToNameNode)(q.FIELD_DEFINITION_UPPER)],name:(0,he.stringToNameNode)(q.EDFS_NATS_SUBSCRIBE),repeatable:!1};le.REQUIRED_FIELDSET_TYPE_NODE={kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.FIELD_SET_SCALAR)};le.KEY_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.FIELDS),type:le.REQUIRED_FIELDSET_TYPE_NODE},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.RESOLVABLE),type:(0,he.stringToNamedTypeNode)(q.BOOLEAN_SCALAR),defaultValue:{kind:ye.Kind.BOOLEAN,value:!0}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.INTERFACE_UPPER,q.OBJECT_UPPER]),name:(0,he.stringToNameNode)(q.KEY),repeatable:!0};le.PROVIDES_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.FIELDS),type:le.REQUIRED_FIELDSET_TYPE_NODE}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:[(0,he.stringToNameNode)(q.FIELD_DEFINITION_UPPER)],name:(0,he.stringToNameNode)(q.PROVIDES),repeatable:!1};le.REQUIRES_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.FIELDS),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.FIELD_SET_SCALAR)}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:[(0,he.stringToNameNode)(q.FIELD_DEFINITION_UPPER)],name:(0,he.stringToNameNode)(q.REQUIRES),repeatable:!1};le.SPECIFIED_BY_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.URL_LOWER),type:le.REQUIRED_STRING_TYPE_NODE}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.SCALAR_UPPER]),name:(0,he.stringToNameNode)(q.SPECIFIED_BY),repeatable:!1};le.TAG_DEFINITION={arguments:[{directives:[],kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.NAME),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR)}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.ARGUMENT_DEFINITION_UPPER,q.ENUM_UPPER,q.ENUM_VALUE_UPPER,q.FIELD_DEFINITION_UPPER,q.INPUT_FIELD_DEFINITION_UPPER,q.INPUT_OBJECT_UPPER,q.INTERFACE_UPPER,q.OBJECT_UPPER,q.SCALAR_UPPER,q.UNION_UPPER]),name:(0,he.stringToNameNode)(q.TAG),repeatable:!0};le.BASE_DIRECTIVE_DEFINITION_BY_DIRECTIVE_NAME=new Map([[q.DEPRECATED,le.DEPRECATED_DEFINITION],[q.EXTENDS,le.EXTENDS_DEFINITION],[q.EXTERNAL,le.EXTERNAL_DEFINITION],[q.EDFS_KAFKA_PUBLISH,le.EDFS_KAFKA_PUBLISH_DEFINITION],[q.EDFS_KAFKA_SUBSCRIBE,le.EDFS_KAFKA_SUBSCRIBE_DEFINITION],[q.EDFS_NATS_PUBLISH,le.EDFS_NATS_PUBLISH_DEFINITION],[q.EDFS_NATS_REQUEST,le.EDFS_NATS_REQUEST_DEFINITION],[q.EDFS_NATS_SUBSCRIBE,le.EDFS_NATS_SUBSCRIBE_DEFINITION],[q.KEY,le.KEY_DEFINITION],[q.PROVIDES,le.PROVIDES_DEFINITION],[q.REQUIRES,le.REQUIRES_DEFINITION],[q.SPECIFIED_BY,le.SPECIFIED_BY_DEFINITION],[q.TAG,le.TAG_DEFINITION]]);le.ALL_IN_BUILT_DIRECTIVE_NAMES=new Set([q.AUTHENTICATED,q.COMPOSE_DIRECTIVE,q.CONFIGURE_DESCRIPTION,q.CONFIGURE_CHILD_DESCRIPTIONS,q.DEPRECATED,q.EDFS_NATS_PUBLISH,q.EDFS_NATS_REQUEST,q.EDFS_NATS_SUBSCRIBE,q.EDFS_KAFKA_PUBLISH,q.EDFS_KAFKA_SUBSCRIBE,q.EXTENDS,q.EXTERNAL,q.INACCESSIBLE,q.INTERFACE_OBJECT,q.KEY,q.LINK,q.OVERRIDE,q.PROVIDES,q.REQUIRES,q.REQUIRES_SCOPES,q.SHAREABLE,q.SPECIFIED_BY,q.SUBSCRIPTION_FILTER,q.TAG]);le.AUTHENTICATED_DEFINITION={arguments:[],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.ENUM_UPPER,q.FIELD_DEFINITION_UPPER,q.INTERFACE_UPPER,q.OBJECT_UPPER,q.SCALAR_UPPER]),name:(0,he.stringToNameNode)(q.AUTHENTICATED),repeatable:!1};le.COMPOSE_DIRECTIVE_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.NAME),type:le.REQUIRED_STRING_TYPE_NODE}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.SCHEMA_UPPER]),name:(0,he.stringToNameNode)(q.COMPOSE_DIRECTIVE),repeatable:!0};le.INACCESSIBLE_DEFINITION={arguments:[],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.ARGUMENT_DEFINITION_UPPER,q.ENUM_UPPER,q.ENUM_VALUE_UPPER,q.FIELD_DEFINITION_UPPER,q.INPUT_FIELD_DEFINITION_UPPER,q.INPUT_OBJECT_UPPER,q.INTERFACE_UPPER,q.OBJECT_UPPER,q.SCALAR_UPPER,q.UNION_UPPER]),name:(0,he.stringToNameNode)(q.INACCESSIBLE),repeatable:!1};le.INTERFACE_OBJECT_DEFINITION={kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.OBJECT_UPPER]),name:(0,he.stringToNameNode)(q.INTERFACE_OBJECT),repeatable:!1};le.LINK_IMPORT_DEFINITION={kind:ye.Kind.SCALAR_TYPE_DEFINITION,name:(0,he.stringToNameNode)(q.LINK_IMPORT)};le.LINK_PURPOSE_DEFINITION={kind:ye.Kind.ENUM_TYPE_DEFINITION,name:(0,he.stringToNameNode)(q.LINK_PURPOSE),values:[{directives:[],kind:ye.Kind.ENUM_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.EXECUTION)},{directives:[],kind:ye.Kind.ENUM_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.SECURITY)}]};le.LINK_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.URL_LOWER),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR)}},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.AS),type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR)},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.FOR),type:(0,he.stringToNamedTypeNode)(q.LINK_PURPOSE)},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.IMPORT),type:{kind:ye.Kind.LIST_TYPE,type:(0,he.stringToNamedTypeNode)(q.LINK_IMPORT)}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.SCHEMA_UPPER]),name:(0,he.stringToNameNode)(q.LINK),repeatable:!0};le.OVERRIDE_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.FROM),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR)}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.FIELD_DEFINITION_UPPER]),name:(0,he.stringToNameNode)(q.OVERRIDE),repeatable:!1};le.REQUIRES_SCOPES_DEFINITION={arguments:[{directives:[],kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.SCOPES),type:{kind:ye.Kind.NON_NULL_TYPE,type:{kind:ye.Kind.LIST_TYPE,type:{kind:ye.Kind.NON_NULL_TYPE,type:{kind:ye.Kind.LIST_TYPE,type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.SCOPE_SCALAR)}}}}}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.ENUM_UPPER,q.FIELD_DEFINITION_UPPER,q.INTERFACE_UPPER,q.OBJECT_UPPER,q.SCALAR_UPPER]),name:(0,he.stringToNameNode)(q.REQUIRES_SCOPES),repeatable:!1};le.SHAREABLE_DEFINITION={kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.FIELD_DEFINITION_UPPER,q.OBJECT_UPPER]),name:(0,he.stringToNameNode)(q.SHAREABLE),repeatable:!0};le.SUBSCRIPTION_FILTER_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.CONDITION),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.SUBSCRIPTION_FILTER_CONDITION)}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.FIELD_DEFINITION_UPPER]),name:(0,he.stringToNameNode)(q.SUBSCRIPTION_FILTER),repeatable:!1};le.SUBSCRIPTION_FILTER_CONDITION_DEFINITION={fields:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.AND_UPPER),type:{kind:ye.Kind.LIST_TYPE,type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.SUBSCRIPTION_FILTER_CONDITION)}}},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.IN_UPPER),type:(0,he.stringToNamedTypeNode)(q.SUBSCRIPTION_FIELD_CONDITION)},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.OR_UPPER),type:{kind:ye.Kind.LIST_TYPE,type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.SUBSCRIPTION_FILTER_CONDITION)}}},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.NOT_UPPER),type:(0,he.stringToNamedTypeNode)(q.SUBSCRIPTION_FILTER_CONDITION)}],kind:ye.Kind.INPUT_OBJECT_TYPE_DEFINITION,name:(0,he.stringToNameNode)(q.SUBSCRIPTION_FILTER_CONDITION)};le.SUBSCRIPTION_FILTER_VALUE_DEFINITION={kind:ye.Kind.SCALAR_TYPE_DEFINITION,name:(0,he.stringToNameNode)(q.SUBSCRIPTION_FILTER_VALUE)};le.SUBSCRIPTION_FIELD_CONDITION_DEFINITION={fields:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.FIELD_PATH),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR)}},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.VALUES),type:{kind:ye.Kind.NON_NULL_TYPE,type:{kind:ye.Kind.LIST_TYPE,type:(0,he.stringToNamedTypeNode)(q.SUBSCRIPTION_FILTER_VALUE)}}}],kind:ye.Kind.INPUT_OBJECT_TYPE_DEFINITION,name:(0,he.stringToNameNode)(q.SUBSCRIPTION_FIELD_CONDITION)};le.V2_DIRECTIVE_DEFINITION_BY_DIRECTIVE_NAME=new Map([[q.AUTHENTICATED,le.AUTHENTICATED_DEFINITION],[q.COMPOSE_DIRECTIVE,le.COMPOSE_DIRECTIVE_DEFINITION],[q.INACCESSIBLE,le.INACCESSIBLE_DEFINITION],[q.INTERFACE_OBJECT,le.INTERFACE_OBJECT_DEFINITION],[q.LINK,le.LINK_DEFINITION],[q.OVERRIDE,le.OVERRIDE_DEFINITION],[q.REQUIRES_SCOPES,le.REQUIRES_SCOPES_DEFINITION],[q.SHAREABLE,le.SHAREABLE_DEFINITION]]);le.BASE_DIRECTIVE_DEFINITIONS=[le.DEPRECATED_DEFINITION,le.EXTENDS_DEFINITION,le.EXTERNAL_DEFINITION,le.KEY_DEFINITION,le.PROVIDES_DEFINITION,le.REQUIRES_DEFINITION,le.SPECIFIED_BY_DEFINITION,le.TAG_DEFINITION];le.EVENT_DRIVEN_DIRECTIVE_DEFINITIONS_BY_DIRECTIVE_NAME=new Map([[q.EDFS_KAFKA_PUBLISH,le.EDFS_KAFKA_PUBLISH_DEFINITION],[q.EDFS_KAFKA_SUBSCRIBE,le.EDFS_KAFKA_SUBSCRIBE_DEFINITION],[q.EDFS_NATS_PUBLISH,le.EDFS_NATS_PUBLISH_DEFINITION],[q.EDFS_NATS_REQUEST,le.EDFS_NATS_REQUEST_DEFINITION],[q.EDFS_NATS_SUBSCRIBE,le.EDFS_NATS_SUBSCRIBE_DEFINITION]]);le.VERSION_TWO_DIRECTIVE_DEFINITIONS=[le.AUTHENTICATED_DEFINITION,le.COMPOSE_DIRECTIVE_DEFINITION,le.INACCESSIBLE_DEFINITION,le.INTERFACE_OBJECT_DEFINITION,le.OVERRIDE_DEFINITION,le.REQUIRES_SCOPES_DEFINITION,le.SHAREABLE_DEFINITION];le.FIELD_SET_SCALAR_DEFINITION={kind:ye.Kind.SCALAR_TYPE_DEFINITION,name:(0,he.stringToNameNode)(q.FIELD_SET_SCALAR)};le.SCOPE_SCALAR_DEFINITION={kind:ye.Kind.SCALAR_TYPE_DEFINITION,name:(0,he.stringToNameNode)(q.SCOPE_SCALAR)};le.EDFS_NATS_STREAM_CONFIGURATION_DEFINITION={kind:ye.Kind.INPUT_OBJECT_TYPE_DEFINITION,name:(0,he.stringToNameNode)(q.EDFS_NATS_STREAM_CONFIGURATION),fields:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.CONSUMER_NAME),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR)}},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.STREAM_NAME),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR)}},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.CONSUMER_INACTIVE_THRESHOLD),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.INT_SCALAR)},defaultValue:{kind:ye.Kind.INT,value:Use.DEFAULT_CONSUMER_INACTIVE_THRESHOLD.toString()}}]};le.CONFIGURE_DESCRIPTION_DEFINITION={arguments:[{directives:[],kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.PROPAGATE),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.BOOLEAN_SCALAR)},defaultValue:{kind:ye.Kind.BOOLEAN,value:!0}},{directives:[],kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.DESCRIPTION_OVERRIDE),type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR)}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.ARGUMENT_DEFINITION_UPPER,q.ENUM_UPPER,q.ENUM_VALUE_UPPER,q.FIELD_DEFINITION_UPPER,q.INTERFACE_UPPER,q.INPUT_OBJECT_UPPER,q.INPUT_FIELD_DEFINITION_UPPER,q.OBJECT_UPPER,q.SCALAR_UPPER,q.SCHEMA_UPPER,q.UNION_UPPER]),name:(0,he.stringToNameNode)(q.CONFIGURE_DESCRIPTION),repeatable:!1};le.CONFIGURE_CHILD_DESCRIPTIONS_DEFINITION={arguments:[{directives:[],kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.PROPAGATE),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.BOOLEAN_SCALAR)},defaultValue:{kind:ye.Kind.BOOLEAN,value:!0}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.ENUM_UPPER,q.INPUT_OBJECT_UPPER,q.INTERFACE_UPPER,q.OBJECT_UPPER]),name:(0,he.stringToNameNode)(q.CONFIGURE_CHILD_DESCRIPTIONS),repeatable:!1};le.EDFS_ARGS_REGEXP=/{{\s*args\.([a-zA-Z0-9_]+)\s*}}/g});var PA=M(wA=>{"use strict";m();T();h();Object.defineProperty(wA,"__esModule",{value:!0});wA.newFieldSetConditionData=kse;function kse({fieldCoordinatesPath:e,fieldPath:t}){return{fieldCoordinatesPath:e,fieldPath:t}}});var fT=M(lI=>{"use strict";m();T();h();Object.defineProperty(lI,"__esModule",{value:!0});lI.ExtensionType=void 0;var Tj;(function(e){e[e.EXTENDS=0]="EXTENDS",e[e.NONE=1]="NONE",e[e.REAL=2]="REAL"})(Tj||(lI.ExtensionType=Tj={}))});var Kp=M(Vt=>{"use strict";m();T();h();Object.defineProperty(Vt,"__esModule",{value:!0});Vt.FieldSetDirective=Vt.MergeMethod=void 0;Vt.newPersistedDirectivesData=xse;Vt.isNodeExternalOrShareable=Mse;Vt.isTypeRequired=yj;Vt.areDefaultValuesCompatible=Ij;Vt.compareAndValidateInputValueDefaultValues=Bse;Vt.setMutualExecutableLocations=Vse;Vt.isTypeNameRootType=jse;Vt.getRenamedRootTypeName=qse;Vt.childMapToValueArray=Gse;Vt.removeInheritableDirectivesFromObjectParent=Qse;Vt.removeIgnoredDirectives=Yse;Vt.setLongestDescription=Jse;Vt.isParentDataRootType=gj;Vt.isParentDataInterfaceType=zse;Vt.setParentDataExtensionType=Hse;Vt.extractPersistedDirectives=Zse;Vt.pushAuthorizationDirectives=eoe;Vt.generateDeprecatedDirective=UA;Vt.getClientPersistedDirectiveNodes=CA;Vt.getNodeForRouterSchemaByData=noe;Vt.getClientSchemaFieldNodeByFieldData=roe;Vt.getNodeWithPersistedDirectivesByInputValueData=kA;Vt.getValidFieldArgumentNodes=ioe;Vt.addValidPersistedDirectiveDefinitionNodeByData=ooe;Vt.newInvalidFieldNames=aoe;Vt.validateExternalAndShareable=uoe;Vt.isTypeValidImplementation=dI;Vt.isNodeDataInaccessible=vj;Vt.isLeafKind=coe;Vt.getSubscriptionFilterValue=loe;Vt.getParentTypeName=doe;Vt.newConditionalFieldData=poe;Vt.getDefinitionDataCoords=foe;var It=(Ue(),Be(qe)),LA=fT(),$p=Xi(),pI=Fo(),ln=zr(),Wl=rs();function xse(){return{deprecatedReason:"",directives:new Map,isDeprecated:!1,tags:new Map}}function Mse(e,t,n){var i;let r={isExternal:n.has(ln.EXTERNAL),isShareable:t||n.has(ln.SHAREABLE)};if(!((i=e.directives)!=null&&i.length))return r;for(let s of e.directives){let o=s.name.value;if(o===ln.EXTERNAL){r.isExternal=!0;continue}o===ln.SHAREABLE&&(r.isShareable=!0)}return r}function yj(e){return e.kind===It.Kind.NON_NULL_TYPE}function Ij(e,t){switch(e.kind){case It.Kind.LIST_TYPE:return t.kind===It.Kind.LIST||t.kind===It.Kind.NULL;case It.Kind.NAMED_TYPE:if(t.kind===It.Kind.NULL)return!0;switch(e.name.value){case ln.BOOLEAN_SCALAR:return t.kind===It.Kind.BOOLEAN;case ln.FLOAT_SCALAR:return t.kind===It.Kind.INT||t.kind===It.Kind.FLOAT;case ln.INT_SCALAR:return t.kind===It.Kind.INT;case ln.STRING_SCALAR:return t.kind===It.Kind.STRING;default:return!0}case It.Kind.NON_NULL_TYPE:return t.kind===It.Kind.NULL?!1:Ij(e.type,t)}}function Bse(e,t,n){if(!e.defaultValue)return;if(!t.defaultValue){e.includeDefaultValue=!1;return}let r=(0,It.print)(e.defaultValue),i=(0,It.print)(t.defaultValue);if(r!==i){n.push((0,pI.incompatibleInputValueDefaultValuesError)(`${e.isArgument?ln.ARGUMENT:ln.INPUT_FIELD} "${e.name}"`,e.originalPath,[...t.subgraphNames],r,i));return}}function Vse(e,t){let n=new Set;for(let r of t)e.executableLocations.has(r)&&n.add(r);e.executableLocations=n}function jse(e,t){return ln.ROOT_TYPE_NAMES.has(e)||t.has(e)}function qse(e,t){let n=t.get(e);if(!n)return e;switch(n){case It.OperationTypeNode.MUTATION:return ln.MUTATION;case It.OperationTypeNode.SUBSCRIPTION:return ln.SUBSCRIPTION;default:return ln.QUERY}}function $se(e,t){let n=e.get(t.originalParentTypeName);if(!n)return;let r=n.fieldAuthorizationDataByFieldName.get(t.name);if(r){if(r.requiresAuthentication){let i=(0,Wl.generateSimpleDirective)(ln.AUTHENTICATED);t.directivesByDirectiveName.set(ln.AUTHENTICATED,[i])}if(r.requiredScopes.length>0){let i=(0,Wl.generateRequiresScopesDirective)(r.requiredScopes);t.directivesByDirectiveName.set(ln.REQUIRES_SCOPES,[i])}}}function Kse(e){for(let t of e.argumentDataByArgumentName.values()){for(let n of t.directivesByDirectiveName.values())t.node.directives.push(...n);e.node.arguments.push(t.node)}}function Gse(e,t){let n=[];for(let r of e.values()){if(r.node.kind===It.Kind.FIELD_DEFINITION){let i=r;$se(t,i),Kse(i)}for(let i of r.directivesByDirectiveName.values())r.node.directives.push(...i);n.push(r.node)}return n}function Qse(e){if(e.kind===It.Kind.OBJECT_TYPE_DEFINITION)for(let t of ln.INHERITABLE_DIRECTIVE_NAMES)e.directivesByDirectiveName.delete(t)}function Yse(e){for(let t of ln.IGNORED_PARENT_DIRECTIVES)e.directivesByDirectiveName.delete(t)}function Jse(e,t){if(t.description){if("configureDescriptionDataBySubgraphName"in t){for(let{propagate:n}of t.configureDescriptionDataBySubgraphName.values())if(!n)return}(!e.description||e.description.value.length<t.description.value.length)&&(e.description=Q(C({},t.description),{block:!0}))}}function gj(e){return e.kind!==It.Kind.OBJECT_TYPE_DEFINITION?!1:e.isRootType}function zse(e){return e.kind===It.Kind.INTERFACE_TYPE_DEFINITION}function Hse(e,t){e.extensionType===t.extensionType||e.extensionType===LA.ExtensionType.NONE||t.extensionType!==LA.ExtensionType.NONE&&!gj(t)||(e.extensionType=LA.ExtensionType.NONE)}function Wse(e,t){var r;if(!((r=t.arguments)!=null&&r.length))return;let n=t.arguments[0].value.value;e.deprecatedReason.length<n.length&&(e.deprecatedReason=n)}function Xse(e,t){for(let n of t){let r=n.arguments[0].value.value;e.tags.set(r,n)}}function Zse(e,t,n){for(let[r,i]of t){if(!n.has(r))continue;if(r===ln.DEPRECATED){e.isDeprecated=!0,Wse(e,i[0]);continue}if(r===ln.TAG){Xse(e,i);continue}let s=e.directives.get(r);if(!s){e.directives.set(r,i);continue}r!==ln.INACCESSIBLE&&s.push(...i)}return e}function eoe(e,t){if(!t)return;let n=t.fieldAuthorizationDataByFieldName.get(e.name);n&&(n.requiresAuthentication&&e.persistedDirectivesData.directives.set(ln.AUTHENTICATED,[(0,Wl.generateSimpleDirective)(ln.AUTHENTICATED)]),n.requiredScopes.length>0&&e.persistedDirectivesData.directives.set(ln.REQUIRES_SCOPES,[(0,Wl.generateRequiresScopesDirective)(n.requiredScopes)]))}function UA(e){return{kind:It.Kind.DIRECTIVE,name:(0,$p.stringToNameNode)(ln.DEPRECATED),arguments:[{kind:It.Kind.ARGUMENT,name:(0,$p.stringToNameNode)(ln.REASON),value:{kind:It.Kind.STRING,value:e||ln.DEPRECATED_DEFAULT_ARGUMENT_VALUE}}]}}function toe(e,t,n,r){let i=[];for(let[s,o]of e){let u=t.get(s);if(u){if(o.length<2){i.push(...o);continue}if(!u.repeatable){r.push((0,pI.invalidRepeatedFederatedDirectiveErrorMessage)(s,n));continue}i.push(...o)}}return i}function _j(e,t,n){let r=[...e.persistedDirectivesData.tags.values()];return e.persistedDirectivesData.isDeprecated&&r.push(UA(e.persistedDirectivesData.deprecatedReason)),r.push(...toe(e.persistedDirectivesData.directives,t,e.name,n)),r}function CA(e){let t=[];e.persistedDirectivesData.isDeprecated&&t.push(UA(e.persistedDirectivesData.deprecatedReason));for(let[n,r]of e.persistedDirectivesData.directives)ln.PERSISTED_CLIENT_DIRECTIVES.has(n)&&t.push(r[0]);return t}function noe(e,t,n){return e.node.name=(0,$p.stringToNameNode)(e.name),e.node.description=e.description,e.node.directives=_j(e,t,n),e.node}function roe(e){let t=CA(e),n=[];for(let r of e.argumentDataByArgumentName.values())vj(r)||n.push(Q(C({},r.node),{directives:CA(r)}));return Q(C({},e.node),{directives:t,arguments:n})}function kA(e,t,n){return e.node.name=(0,$p.stringToNameNode)(e.name),e.node.type=e.type,e.node.description=e.description,e.node.directives=_j(e,t,n),e.includeDefaultValue&&(e.node.defaultValue=e.defaultValue),e.node}function ioe(e,t,n,r){let i=[],s=[],o=[],u=`${e.renamedParentTypeName}.${e.name}`;for(let[l,d]of e.argumentDataByArgumentName)e.subgraphNames.size===d.subgraphNames.size?(s.push(l),i.push(kA(d,t,r))):yj(d.type)&&o.push({inputValueName:l,missingSubgraphs:(0,Wl.getEntriesNotInHashSet)(e.subgraphNames,d.subgraphNames),requiredSubgraphs:[...d.requiredSubgraphNames]});return o.length>0?r.push((0,pI.invalidRequiredInputValueError)(ln.FIELD,u,o)):s.length>0&&((0,Wl.getValueOrDefault)(n,u,()=>({argumentNames:s,fieldName:e.name,typeName:e.renamedParentTypeName})).argumentNames=s),i}function soe(e,t,n,r,i){let s=[];for(let[o,u]of t.argumentDataByArgumentName){let l=(0,Wl.getEntriesNotInHashSet)(t.subgraphNames,u.subgraphNames);if(l.length>0){u.requiredSubgraphNames.size>0&&s.push({inputValueName:o,missingSubgraphs:l,requiredSubgraphs:[...u.requiredSubgraphNames]});continue}e.push(kA(u,n,r)),i&&i.add(o)}return s.length>0?(r.push((0,pI.invalidRequiredInputValueError)(ln.DIRECTIVE_DEFINITION,`@${t.name}`,s)),!1):!0}function ooe(e,t,n,r){let i=[];soe(i,t,n,r)&&e.push({arguments:i,kind:It.Kind.DIRECTIVE_DEFINITION,locations:(0,$p.setToNameNodeArray)(t.executableLocations),name:(0,$p.stringToNameNode)(t.name),repeatable:t.repeatable,description:t.description})}function aoe(){return{byShareable:new Set,subgraphNamesByExternalFieldName:new Map}}function uoe(e,t){let n=e.isShareableBySubgraphName.size,r=[],i=0;for(let[s,o]of e.isShareableBySubgraphName){if(e.isExternalBySubgraphName.get(s)){r.push(s);continue}o||(i+=1)}switch(i){case 0:n===r.length&&t.subgraphNamesByExternalFieldName.set(e.name,r);return;case 1:if(n===1)return;n-r.length!==1&&t.byShareable.add(e.name);return;default:t.byShareable.add(e.name)}}var Nj;(function(e){e[e.UNION=0]="UNION",e[e.INTERSECTION=1]="INTERSECTION",e[e.CONSISTENT=2]="CONSISTENT"})(Nj||(Vt.MergeMethod=Nj={}));function dI(e,t,n){if(e.kind===It.Kind.NON_NULL_TYPE)return t.kind!==It.Kind.NON_NULL_TYPE?!1:dI(e.type,t.type,n);if(t.kind===It.Kind.NON_NULL_TYPE)return dI(e,t.type,n);switch(e.kind){case It.Kind.NAMED_TYPE:if(t.kind===It.Kind.NAMED_TYPE){let r=e.name.value,i=t.name.value;if(r===i)return!0;let s=n.get(r);return s?s.has(i):!1}return!1;default:return t.kind===It.Kind.LIST_TYPE?dI(e.type,t.type,n):!1}}function vj(e){return e.persistedDirectivesData.directives.has(ln.INACCESSIBLE)||e.directivesByDirectiveName.has(ln.INACCESSIBLE)}function coe(e){return e===It.Kind.SCALAR_TYPE_DEFINITION||e===It.Kind.ENUM_TYPE_DEFINITION}function loe(e){switch(e.kind){case It.Kind.BOOLEAN:return e.value;case It.Kind.ENUM:case It.Kind.STRING:return e.value;case It.Kind.FLOAT:case It.Kind.INT:try{return parseFloat(e.value)}catch(t){return"NaN"}case It.Kind.NULL:return null}}function doe(e){return e.kind===It.Kind.OBJECT_TYPE_DEFINITION?e.renamedTypeName:e.name}var Ej;(function(e){e.PROVIDES="provides",e.REQUIRES="requires"})(Ej||(Vt.FieldSetDirective=Ej={}));function poe(){return{providedBy:[],requiredBy:[]}}function foe(e,t){switch(e.kind){case It.Kind.ENUM_VALUE_DEFINITION:return`${e.parentTypeName}.${e.name}`;case It.Kind.FIELD_DEFINITION:return`${t?e.renamedParentTypeName:e.originalParentTypeName}.${e.name}`;case It.Kind.ARGUMENT:case It.Kind.INPUT_VALUE_DEFINITION:return t?e.renamedPath:e.originalPath;case It.Kind.OBJECT_TYPE_DEFINITION:return t?e.renamedTypeName:e.name;default:return e.name}}});var MA=M(fI=>{"use strict";m();T();h();Object.defineProperty(fI,"__esModule",{value:!0});fI.Warning=void 0;var xA=class extends Error{constructor(n){super(n.message);O(this,"subgraph");this.name="Warning",this.subgraph=n.subgraph}};fI.Warning=xA});var mT=M(tu=>{"use strict";m();T();h();Object.defineProperty(tu,"__esModule",{value:!0});tu.invalidOverrideTargetSubgraphNameWarning=moe;tu.externalInterfaceFieldsWarning=hoe;tu.nonExternalConditionalFieldWarning=Toe;tu.unimplementedInterfaceOutputTypeWarning=Noe;tu.invalidExternalFieldWarning=Eoe;tu.requiresDefinedOnNonEntityFieldWarning=yoe;tu.consumerInactiveThresholdInvalidValueWarning=Ioe;var Xl=MA(),Oj=zr();function moe(e,t,n,r){return new Xl.Warning({message:`The Object type "${t}" defines the directive "@override(from: "${e}")" on the following field`+(n.length>1?"s":"")+': "'+n.join(Oj.QUOTATION_JOIN)+`".
+`+t.join(ft.LITERAL_NEW_LINE)+'"')}});var fj=M(pj=>{"use strict";m();T();h();Object.defineProperty(pj,"__esModule",{value:!0})});var Vc=M(Oi=>{"use strict";m();T();h();Object.defineProperty(Oi,"__esModule",{value:!0});Oi.getMutableDirectiveDefinitionNode=Ose;Oi.getMutableEnumNode=Sse;Oi.getMutableEnumValueNode=Dse;Oi.getMutableFieldNode=bse;Oi.getMutableInputObjectNode=Ase;Oi.getMutableInputValueNode=Rse;Oi.getMutableInterfaceNode=Fse;Oi.getMutableObjectNode=wse;Oi.getMutableObjectExtensionNode=Pse;Oi.getMutableScalarNode=Lse;Oi.getMutableTypeNode=AA;Oi.getMutableUnionNode=Cse;Oi.getTypeNodeNamedTypeName=RA;Oi.getNamedTypeNode=hj;var vi=(Ue(),Be(qe)),qp=Xi(),mj=Fo(),vse=kp();function Ose(e){return{arguments:[],kind:e.kind,locations:[],name:C({},e.name),repeatable:e.repeatable,description:(0,qp.formatDescription)(e.description)}}function Sse(e){return{kind:vi.Kind.ENUM_TYPE_DEFINITION,name:C({},e)}}function Dse(e){return{directives:[],kind:e.kind,name:C({},e.name),description:(0,qp.formatDescription)(e.description)}}function bse(e,t,n){return{arguments:[],directives:[],kind:e.kind,name:C({},e.name),type:AA(e.type,t,n),description:(0,qp.formatDescription)(e.description)}}function Ase(e){return{kind:vi.Kind.INPUT_OBJECT_TYPE_DEFINITION,name:C({},e)}}function Rse(e,t,n){return{directives:[],kind:e.kind,name:C({},e.name),type:AA(e.type,t,n),defaultValue:e.defaultValue,description:(0,qp.formatDescription)(e.description)}}function Fse(e){return{kind:vi.Kind.INTERFACE_TYPE_DEFINITION,name:C({},e)}}function wse(e){return{kind:vi.Kind.OBJECT_TYPE_DEFINITION,name:C({},e)}}function Pse(e){let t=e.kind===vi.Kind.OBJECT_TYPE_DEFINITION?e.description:void 0;return{kind:vi.Kind.OBJECT_TYPE_EXTENSION,name:C({},e.name),description:(0,qp.formatDescription)(t)}}function Lse(e){return{kind:vi.Kind.SCALAR_TYPE_DEFINITION,name:C({},e)}}function AA(e,t,n){let r={kind:e.kind},i=r;for(let s=0;s<vse.MAXIMUM_TYPE_NESTING;s++)switch(e.kind){case vi.Kind.NAMED_TYPE:return i.name=C({},e.name),r;case vi.Kind.LIST_TYPE:i.kind=e.kind,i.type={kind:e.type.kind},i=i.type,e=e.type;continue;case vi.Kind.NON_NULL_TYPE:i.kind=e.kind,i.type={kind:e.type.kind},i=i.type,e=e.type;continue;default:throw(0,mj.unexpectedTypeNodeKindFatalError)(t)}return n.push((0,mj.maximumTypeNestingExceededError)(t)),{kind:vi.Kind.NAMED_TYPE,name:(0,qp.stringToNameNode)(RA(e))}}function Cse(e){return{kind:vi.Kind.UNION_TYPE_DEFINITION,name:C({},e)}}function RA(e){switch(e.kind){case vi.Kind.NAMED_TYPE:return e.name.value;case vi.Kind.LIST_TYPE:case vi.Kind.NON_NULL_TYPE:return RA(e.type)}}function hj(e){switch(e.kind){case vi.Kind.NAMED_TYPE:return e;default:return hj(e.type)}}});var FA=M(cI=>{"use strict";m();T();h();Object.defineProperty(cI,"__esModule",{value:!0});cI.DEFAULT_CONSUMER_INACTIVE_THRESHOLD=void 0;cI.DEFAULT_CONSUMER_INACTIVE_THRESHOLD=30});var jc=M(le=>{"use strict";m();T();h();Object.defineProperty(le,"__esModule",{value:!0});le.EDFS_ARGS_REGEXP=le.CONFIGURE_CHILD_DESCRIPTIONS_DEFINITION=le.CONFIGURE_DESCRIPTION_DEFINITION=le.EDFS_NATS_STREAM_CONFIGURATION_DEFINITION=le.SCOPE_SCALAR_DEFINITION=le.FIELD_SET_SCALAR_DEFINITION=le.VERSION_TWO_DIRECTIVE_DEFINITIONS=le.EVENT_DRIVEN_DIRECTIVE_DEFINITIONS_BY_DIRECTIVE_NAME=le.BASE_DIRECTIVE_DEFINITIONS=le.V2_DIRECTIVE_DEFINITION_BY_DIRECTIVE_NAME=le.SUBSCRIPTION_FIELD_CONDITION_DEFINITION=le.SUBSCRIPTION_FILTER_VALUE_DEFINITION=le.SUBSCRIPTION_FILTER_CONDITION_DEFINITION=le.SUBSCRIPTION_FILTER_DEFINITION=le.SHAREABLE_DEFINITION=le.REQUIRES_SCOPES_DEFINITION=le.OVERRIDE_DEFINITION=le.LINK_DEFINITION=le.LINK_PURPOSE_DEFINITION=le.LINK_IMPORT_DEFINITION=le.INTERFACE_OBJECT_DEFINITION=le.INACCESSIBLE_DEFINITION=le.COMPOSE_DIRECTIVE_DEFINITION=le.AUTHENTICATED_DEFINITION=le.ALL_IN_BUILT_DIRECTIVE_NAMES=le.BASE_DIRECTIVE_DEFINITION_BY_DIRECTIVE_NAME=le.TAG_DEFINITION=le.SPECIFIED_BY_DEFINITION=le.REQUIRES_DEFINITION=le.PROVIDES_DEFINITION=le.KEY_DEFINITION=le.REQUIRED_FIELDSET_TYPE_NODE=le.EDFS_NATS_SUBSCRIBE_DEFINITION=le.EDFS_NATS_REQUEST_DEFINITION=le.EDFS_NATS_PUBLISH_DEFINITION=le.EDFS_KAFKA_SUBSCRIBE_DEFINITION=le.EDFS_KAFKA_PUBLISH_DEFINITION=le.EXTERNAL_DEFINITION=le.EXTENDS_DEFINITION=le.DEPRECATED_DEFINITION=le.BASE_SCALARS=le.REQUIRED_STRING_TYPE_NODE=void 0;var ye=(Ue(),Be(qe)),he=Xi(),Use=FA(),q=zr();le.REQUIRED_STRING_TYPE_NODE={kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR)};le.BASE_SCALARS=new Set(["_Any","_Entities",q.BOOLEAN_SCALAR,q.FLOAT_SCALAR,q.ID_SCALAR,q.INT_SCALAR,q.FIELD_SET_SCALAR,q.SCOPE_SCALAR,q.STRING_SCALAR]);le.DEPRECATED_DEFINITION={arguments:[{directives:[],kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.REASON),type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR),defaultValue:{kind:ye.Kind.STRING,value:ye.DEFAULT_DEPRECATION_REASON}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.ARGUMENT_DEFINITION_UPPER,q.ENUM_VALUE_UPPER,q.FIELD_DEFINITION_UPPER,q.INPUT_FIELD_DEFINITION_UPPER]),name:(0,he.stringToNameNode)(q.DEPRECATED),repeatable:!1};le.EXTENDS_DEFINITION={kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.INTERFACE_UPPER,q.OBJECT_UPPER]),name:(0,he.stringToNameNode)(q.EXTENDS),repeatable:!1};le.EXTERNAL_DEFINITION={kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.FIELD_DEFINITION_UPPER,q.OBJECT_UPPER]),name:(0,he.stringToNameNode)(q.EXTERNAL),repeatable:!1};le.EDFS_KAFKA_PUBLISH_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.TOPIC),type:le.REQUIRED_STRING_TYPE_NODE},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.PROVIDER_ID),type:le.REQUIRED_STRING_TYPE_NODE,defaultValue:{kind:ye.Kind.STRING,value:q.DEFAULT_EDFS_PROVIDER_ID}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:[(0,he.stringToNameNode)(q.FIELD_DEFINITION_UPPER)],name:(0,he.stringToNameNode)(q.EDFS_KAFKA_PUBLISH),repeatable:!1};le.EDFS_KAFKA_SUBSCRIBE_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.TOPICS),type:{kind:ye.Kind.NON_NULL_TYPE,type:{kind:ye.Kind.LIST_TYPE,type:le.REQUIRED_STRING_TYPE_NODE}}},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.PROVIDER_ID),type:le.REQUIRED_STRING_TYPE_NODE,defaultValue:{kind:ye.Kind.STRING,value:q.DEFAULT_EDFS_PROVIDER_ID}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:[(0,he.stringToNameNode)(q.FIELD_DEFINITION_UPPER)],name:(0,he.stringToNameNode)(q.EDFS_KAFKA_SUBSCRIBE),repeatable:!1};le.EDFS_NATS_PUBLISH_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.SUBJECT),type:le.REQUIRED_STRING_TYPE_NODE},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.PROVIDER_ID),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR)},defaultValue:{kind:ye.Kind.STRING,value:q.DEFAULT_EDFS_PROVIDER_ID}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:[(0,he.stringToNameNode)(q.FIELD_DEFINITION_UPPER)],name:(0,he.stringToNameNode)(q.EDFS_NATS_PUBLISH),repeatable:!1};le.EDFS_NATS_REQUEST_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.SUBJECT),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR)}},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.PROVIDER_ID),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR)},defaultValue:{kind:ye.Kind.STRING,value:q.DEFAULT_EDFS_PROVIDER_ID}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:[(0,he.stringToNameNode)(q.FIELD_DEFINITION_UPPER)],name:(0,he.stringToNameNode)(q.EDFS_NATS_REQUEST),repeatable:!1};le.EDFS_NATS_SUBSCRIBE_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.SUBJECTS),type:{kind:ye.Kind.NON_NULL_TYPE,type:{kind:ye.Kind.LIST_TYPE,type:le.REQUIRED_STRING_TYPE_NODE}}},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.PROVIDER_ID),type:le.REQUIRED_STRING_TYPE_NODE,defaultValue:{kind:ye.Kind.STRING,value:q.DEFAULT_EDFS_PROVIDER_ID}},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.STREAM_CONFIGURATION),type:(0,he.stringToNamedTypeNode)(q.EDFS_NATS_STREAM_CONFIGURATION)}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:[(0,he.stringToNameNode)(q.FIELD_DEFINITION_UPPER)],name:(0,he.stringToNameNode)(q.EDFS_NATS_SUBSCRIBE),repeatable:!1};le.REQUIRED_FIELDSET_TYPE_NODE={kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.FIELD_SET_SCALAR)};le.KEY_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.FIELDS),type:le.REQUIRED_FIELDSET_TYPE_NODE},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.RESOLVABLE),type:(0,he.stringToNamedTypeNode)(q.BOOLEAN_SCALAR),defaultValue:{kind:ye.Kind.BOOLEAN,value:!0}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.INTERFACE_UPPER,q.OBJECT_UPPER]),name:(0,he.stringToNameNode)(q.KEY),repeatable:!0};le.PROVIDES_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.FIELDS),type:le.REQUIRED_FIELDSET_TYPE_NODE}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:[(0,he.stringToNameNode)(q.FIELD_DEFINITION_UPPER)],name:(0,he.stringToNameNode)(q.PROVIDES),repeatable:!1};le.REQUIRES_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.FIELDS),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.FIELD_SET_SCALAR)}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:[(0,he.stringToNameNode)(q.FIELD_DEFINITION_UPPER)],name:(0,he.stringToNameNode)(q.REQUIRES),repeatable:!1};le.SPECIFIED_BY_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.URL_LOWER),type:le.REQUIRED_STRING_TYPE_NODE}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.SCALAR_UPPER]),name:(0,he.stringToNameNode)(q.SPECIFIED_BY),repeatable:!1};le.TAG_DEFINITION={arguments:[{directives:[],kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.NAME),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR)}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.ARGUMENT_DEFINITION_UPPER,q.ENUM_UPPER,q.ENUM_VALUE_UPPER,q.FIELD_DEFINITION_UPPER,q.INPUT_FIELD_DEFINITION_UPPER,q.INPUT_OBJECT_UPPER,q.INTERFACE_UPPER,q.OBJECT_UPPER,q.SCALAR_UPPER,q.UNION_UPPER]),name:(0,he.stringToNameNode)(q.TAG),repeatable:!0};le.BASE_DIRECTIVE_DEFINITION_BY_DIRECTIVE_NAME=new Map([[q.DEPRECATED,le.DEPRECATED_DEFINITION],[q.EXTENDS,le.EXTENDS_DEFINITION],[q.EXTERNAL,le.EXTERNAL_DEFINITION],[q.EDFS_KAFKA_PUBLISH,le.EDFS_KAFKA_PUBLISH_DEFINITION],[q.EDFS_KAFKA_SUBSCRIBE,le.EDFS_KAFKA_SUBSCRIBE_DEFINITION],[q.EDFS_NATS_PUBLISH,le.EDFS_NATS_PUBLISH_DEFINITION],[q.EDFS_NATS_REQUEST,le.EDFS_NATS_REQUEST_DEFINITION],[q.EDFS_NATS_SUBSCRIBE,le.EDFS_NATS_SUBSCRIBE_DEFINITION],[q.KEY,le.KEY_DEFINITION],[q.PROVIDES,le.PROVIDES_DEFINITION],[q.REQUIRES,le.REQUIRES_DEFINITION],[q.SPECIFIED_BY,le.SPECIFIED_BY_DEFINITION],[q.TAG,le.TAG_DEFINITION]]);le.ALL_IN_BUILT_DIRECTIVE_NAMES=new Set([q.AUTHENTICATED,q.COMPOSE_DIRECTIVE,q.CONFIGURE_DESCRIPTION,q.CONFIGURE_CHILD_DESCRIPTIONS,q.DEPRECATED,q.EDFS_NATS_PUBLISH,q.EDFS_NATS_REQUEST,q.EDFS_NATS_SUBSCRIBE,q.EDFS_KAFKA_PUBLISH,q.EDFS_KAFKA_SUBSCRIBE,q.EXTENDS,q.EXTERNAL,q.INACCESSIBLE,q.INTERFACE_OBJECT,q.KEY,q.LINK,q.OVERRIDE,q.PROVIDES,q.REQUIRES,q.REQUIRES_SCOPES,q.SHAREABLE,q.SPECIFIED_BY,q.SUBSCRIPTION_FILTER,q.TAG]);le.AUTHENTICATED_DEFINITION={arguments:[],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.ENUM_UPPER,q.FIELD_DEFINITION_UPPER,q.INTERFACE_UPPER,q.OBJECT_UPPER,q.SCALAR_UPPER]),name:(0,he.stringToNameNode)(q.AUTHENTICATED),repeatable:!1};le.COMPOSE_DIRECTIVE_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.NAME),type:le.REQUIRED_STRING_TYPE_NODE}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.SCHEMA_UPPER]),name:(0,he.stringToNameNode)(q.COMPOSE_DIRECTIVE),repeatable:!0};le.INACCESSIBLE_DEFINITION={arguments:[],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.ARGUMENT_DEFINITION_UPPER,q.ENUM_UPPER,q.ENUM_VALUE_UPPER,q.FIELD_DEFINITION_UPPER,q.INPUT_FIELD_DEFINITION_UPPER,q.INPUT_OBJECT_UPPER,q.INTERFACE_UPPER,q.OBJECT_UPPER,q.SCALAR_UPPER,q.UNION_UPPER]),name:(0,he.stringToNameNode)(q.INACCESSIBLE),repeatable:!1};le.INTERFACE_OBJECT_DEFINITION={kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.OBJECT_UPPER]),name:(0,he.stringToNameNode)(q.INTERFACE_OBJECT),repeatable:!1};le.LINK_IMPORT_DEFINITION={kind:ye.Kind.SCALAR_TYPE_DEFINITION,name:(0,he.stringToNameNode)(q.LINK_IMPORT)};le.LINK_PURPOSE_DEFINITION={kind:ye.Kind.ENUM_TYPE_DEFINITION,name:(0,he.stringToNameNode)(q.LINK_PURPOSE),values:[{directives:[],kind:ye.Kind.ENUM_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.EXECUTION)},{directives:[],kind:ye.Kind.ENUM_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.SECURITY)}]};le.LINK_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.URL_LOWER),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR)}},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.AS),type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR)},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.FOR),type:(0,he.stringToNamedTypeNode)(q.LINK_PURPOSE)},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.IMPORT),type:{kind:ye.Kind.LIST_TYPE,type:(0,he.stringToNamedTypeNode)(q.LINK_IMPORT)}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.SCHEMA_UPPER]),name:(0,he.stringToNameNode)(q.LINK),repeatable:!0};le.OVERRIDE_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.FROM),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR)}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.FIELD_DEFINITION_UPPER]),name:(0,he.stringToNameNode)(q.OVERRIDE),repeatable:!1};le.REQUIRES_SCOPES_DEFINITION={arguments:[{directives:[],kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.SCOPES),type:{kind:ye.Kind.NON_NULL_TYPE,type:{kind:ye.Kind.LIST_TYPE,type:{kind:ye.Kind.NON_NULL_TYPE,type:{kind:ye.Kind.LIST_TYPE,type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.SCOPE_SCALAR)}}}}}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.ENUM_UPPER,q.FIELD_DEFINITION_UPPER,q.INTERFACE_UPPER,q.OBJECT_UPPER,q.SCALAR_UPPER]),name:(0,he.stringToNameNode)(q.REQUIRES_SCOPES),repeatable:!1};le.SHAREABLE_DEFINITION={kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.FIELD_DEFINITION_UPPER,q.OBJECT_UPPER]),name:(0,he.stringToNameNode)(q.SHAREABLE),repeatable:!0};le.SUBSCRIPTION_FILTER_DEFINITION={arguments:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.CONDITION),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.SUBSCRIPTION_FILTER_CONDITION)}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.FIELD_DEFINITION_UPPER]),name:(0,he.stringToNameNode)(q.SUBSCRIPTION_FILTER),repeatable:!1};le.SUBSCRIPTION_FILTER_CONDITION_DEFINITION={fields:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.AND_UPPER),type:{kind:ye.Kind.LIST_TYPE,type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.SUBSCRIPTION_FILTER_CONDITION)}}},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.IN_UPPER),type:(0,he.stringToNamedTypeNode)(q.SUBSCRIPTION_FIELD_CONDITION)},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.OR_UPPER),type:{kind:ye.Kind.LIST_TYPE,type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.SUBSCRIPTION_FILTER_CONDITION)}}},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.NOT_UPPER),type:(0,he.stringToNamedTypeNode)(q.SUBSCRIPTION_FILTER_CONDITION)}],kind:ye.Kind.INPUT_OBJECT_TYPE_DEFINITION,name:(0,he.stringToNameNode)(q.SUBSCRIPTION_FILTER_CONDITION)};le.SUBSCRIPTION_FILTER_VALUE_DEFINITION={kind:ye.Kind.SCALAR_TYPE_DEFINITION,name:(0,he.stringToNameNode)(q.SUBSCRIPTION_FILTER_VALUE)};le.SUBSCRIPTION_FIELD_CONDITION_DEFINITION={fields:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.FIELD_PATH),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR)}},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.VALUES),type:{kind:ye.Kind.NON_NULL_TYPE,type:{kind:ye.Kind.LIST_TYPE,type:(0,he.stringToNamedTypeNode)(q.SUBSCRIPTION_FILTER_VALUE)}}}],kind:ye.Kind.INPUT_OBJECT_TYPE_DEFINITION,name:(0,he.stringToNameNode)(q.SUBSCRIPTION_FIELD_CONDITION)};le.V2_DIRECTIVE_DEFINITION_BY_DIRECTIVE_NAME=new Map([[q.AUTHENTICATED,le.AUTHENTICATED_DEFINITION],[q.COMPOSE_DIRECTIVE,le.COMPOSE_DIRECTIVE_DEFINITION],[q.INACCESSIBLE,le.INACCESSIBLE_DEFINITION],[q.INTERFACE_OBJECT,le.INTERFACE_OBJECT_DEFINITION],[q.LINK,le.LINK_DEFINITION],[q.OVERRIDE,le.OVERRIDE_DEFINITION],[q.REQUIRES_SCOPES,le.REQUIRES_SCOPES_DEFINITION],[q.SHAREABLE,le.SHAREABLE_DEFINITION]]);le.BASE_DIRECTIVE_DEFINITIONS=[le.DEPRECATED_DEFINITION,le.EXTENDS_DEFINITION,le.EXTERNAL_DEFINITION,le.KEY_DEFINITION,le.PROVIDES_DEFINITION,le.REQUIRES_DEFINITION,le.SPECIFIED_BY_DEFINITION,le.TAG_DEFINITION];le.EVENT_DRIVEN_DIRECTIVE_DEFINITIONS_BY_DIRECTIVE_NAME=new Map([[q.EDFS_KAFKA_PUBLISH,le.EDFS_KAFKA_PUBLISH_DEFINITION],[q.EDFS_KAFKA_SUBSCRIBE,le.EDFS_KAFKA_SUBSCRIBE_DEFINITION],[q.EDFS_NATS_PUBLISH,le.EDFS_NATS_PUBLISH_DEFINITION],[q.EDFS_NATS_REQUEST,le.EDFS_NATS_REQUEST_DEFINITION],[q.EDFS_NATS_SUBSCRIBE,le.EDFS_NATS_SUBSCRIBE_DEFINITION]]);le.VERSION_TWO_DIRECTIVE_DEFINITIONS=[le.AUTHENTICATED_DEFINITION,le.COMPOSE_DIRECTIVE_DEFINITION,le.INACCESSIBLE_DEFINITION,le.INTERFACE_OBJECT_DEFINITION,le.OVERRIDE_DEFINITION,le.REQUIRES_SCOPES_DEFINITION,le.SHAREABLE_DEFINITION];le.FIELD_SET_SCALAR_DEFINITION={kind:ye.Kind.SCALAR_TYPE_DEFINITION,name:(0,he.stringToNameNode)(q.FIELD_SET_SCALAR)};le.SCOPE_SCALAR_DEFINITION={kind:ye.Kind.SCALAR_TYPE_DEFINITION,name:(0,he.stringToNameNode)(q.SCOPE_SCALAR)};le.EDFS_NATS_STREAM_CONFIGURATION_DEFINITION={kind:ye.Kind.INPUT_OBJECT_TYPE_DEFINITION,name:(0,he.stringToNameNode)(q.EDFS_NATS_STREAM_CONFIGURATION),fields:[{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.CONSUMER_NAME),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR)}},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.STREAM_NAME),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR)}},{kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.CONSUMER_INACTIVE_THRESHOLD),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.INT_SCALAR)},defaultValue:{kind:ye.Kind.INT,value:Use.DEFAULT_CONSUMER_INACTIVE_THRESHOLD.toString()}}]};le.CONFIGURE_DESCRIPTION_DEFINITION={arguments:[{directives:[],kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.PROPAGATE),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.BOOLEAN_SCALAR)},defaultValue:{kind:ye.Kind.BOOLEAN,value:!0}},{directives:[],kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.DESCRIPTION_OVERRIDE),type:(0,he.stringToNamedTypeNode)(q.STRING_SCALAR)}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.ARGUMENT_DEFINITION_UPPER,q.ENUM_UPPER,q.ENUM_VALUE_UPPER,q.FIELD_DEFINITION_UPPER,q.INTERFACE_UPPER,q.INPUT_OBJECT_UPPER,q.INPUT_FIELD_DEFINITION_UPPER,q.OBJECT_UPPER,q.SCALAR_UPPER,q.SCHEMA_UPPER,q.UNION_UPPER]),name:(0,he.stringToNameNode)(q.CONFIGURE_DESCRIPTION),repeatable:!1};le.CONFIGURE_CHILD_DESCRIPTIONS_DEFINITION={arguments:[{directives:[],kind:ye.Kind.INPUT_VALUE_DEFINITION,name:(0,he.stringToNameNode)(q.PROPAGATE),type:{kind:ye.Kind.NON_NULL_TYPE,type:(0,he.stringToNamedTypeNode)(q.BOOLEAN_SCALAR)},defaultValue:{kind:ye.Kind.BOOLEAN,value:!0}}],kind:ye.Kind.DIRECTIVE_DEFINITION,locations:(0,he.stringArrayToNameNodeArray)([q.ENUM_UPPER,q.INPUT_OBJECT_UPPER,q.INTERFACE_UPPER,q.OBJECT_UPPER]),name:(0,he.stringToNameNode)(q.CONFIGURE_CHILD_DESCRIPTIONS),repeatable:!1};le.EDFS_ARGS_REGEXP=/{{\s*args\.([a-zA-Z0-9_]+)\s*}}/g});var PA=M(wA=>{"use strict";m();T();h();Object.defineProperty(wA,"__esModule",{value:!0});wA.newFieldSetConditionData=kse;function kse({fieldCoordinatesPath:e,fieldPath:t}){return{fieldCoordinatesPath:e,fieldPath:t}}});var fT=M(lI=>{"use strict";m();T();h();Object.defineProperty(lI,"__esModule",{value:!0});lI.ExtensionType=void 0;var Tj;(function(e){e[e.EXTENDS=0]="EXTENDS",e[e.NONE=1]="NONE",e[e.REAL=2]="REAL"})(Tj||(lI.ExtensionType=Tj={}))});var Kp=M(Vt=>{"use strict";m();T();h();Object.defineProperty(Vt,"__esModule",{value:!0});Vt.FieldSetDirective=Vt.MergeMethod=void 0;Vt.newPersistedDirectivesData=xse;Vt.isNodeExternalOrShareable=Mse;Vt.isTypeRequired=yj;Vt.areDefaultValuesCompatible=Ij;Vt.compareAndValidateInputValueDefaultValues=Bse;Vt.setMutualExecutableLocations=Vse;Vt.isTypeNameRootType=jse;Vt.getRenamedRootTypeName=qse;Vt.childMapToValueArray=Gse;Vt.removeInheritableDirectivesFromObjectParent=Qse;Vt.removeIgnoredDirectives=Yse;Vt.setLongestDescription=Jse;Vt.isParentDataRootType=gj;Vt.isParentDataInterfaceType=zse;Vt.setParentDataExtensionType=Hse;Vt.extractPersistedDirectives=Zse;Vt.pushAuthorizationDirectives=eoe;Vt.generateDeprecatedDirective=UA;Vt.getClientPersistedDirectiveNodes=CA;Vt.getNodeForRouterSchemaByData=noe;Vt.getClientSchemaFieldNodeByFieldData=roe;Vt.getNodeWithPersistedDirectivesByInputValueData=kA;Vt.getValidFieldArgumentNodes=ioe;Vt.addValidPersistedDirectiveDefinitionNodeByData=ooe;Vt.newInvalidFieldNames=aoe;Vt.validateExternalAndShareable=uoe;Vt.isTypeValidImplementation=dI;Vt.isNodeDataInaccessible=vj;Vt.isLeafKind=coe;Vt.getSubscriptionFilterValue=loe;Vt.getParentTypeName=doe;Vt.newConditionalFieldData=poe;Vt.getDefinitionDataCoords=foe;var It=(Ue(),Be(qe)),LA=fT(),$p=Xi(),pI=Fo(),ln=zr(),Wl=rs();function xse(){return{deprecatedReason:"",directives:new Map,isDeprecated:!1,tags:new Map}}function Mse(e,t,n){var i;let r={isExternal:n.has(ln.EXTERNAL),isShareable:t||n.has(ln.SHAREABLE)};if(!((i=e.directives)!=null&&i.length))return r;for(let s of e.directives){let o=s.name.value;if(o===ln.EXTERNAL){r.isExternal=!0;continue}o===ln.SHAREABLE&&(r.isShareable=!0)}return r}function yj(e){return e.kind===It.Kind.NON_NULL_TYPE}function Ij(e,t){switch(e.kind){case It.Kind.LIST_TYPE:return t.kind===It.Kind.LIST||t.kind===It.Kind.NULL;case It.Kind.NAMED_TYPE:if(t.kind===It.Kind.NULL)return!0;switch(e.name.value){case ln.BOOLEAN_SCALAR:return t.kind===It.Kind.BOOLEAN;case ln.FLOAT_SCALAR:return t.kind===It.Kind.INT||t.kind===It.Kind.FLOAT;case ln.INT_SCALAR:return t.kind===It.Kind.INT;case ln.STRING_SCALAR:return t.kind===It.Kind.STRING;default:return!0}case It.Kind.NON_NULL_TYPE:return t.kind===It.Kind.NULL?!1:Ij(e.type,t)}}function Bse(e,t,n){if(!e.defaultValue)return;if(!t.defaultValue){e.includeDefaultValue=!1;return}let r=(0,It.print)(e.defaultValue),i=(0,It.print)(t.defaultValue);if(r!==i){n.push((0,pI.incompatibleInputValueDefaultValuesError)(`${e.isArgument?ln.ARGUMENT:ln.INPUT_FIELD} "${e.name}"`,e.originalPath,[...t.subgraphNames],r,i));return}}function Vse(e,t){let n=new Set;for(let r of t)e.executableLocations.has(r)&&n.add(r);e.executableLocations=n}function jse(e,t){return ln.ROOT_TYPE_NAMES.has(e)||t.has(e)}function qse(e,t){let n=t.get(e);if(!n)return e;switch(n){case It.OperationTypeNode.MUTATION:return ln.MUTATION;case It.OperationTypeNode.SUBSCRIPTION:return ln.SUBSCRIPTION;default:return ln.QUERY}}function $se(e,t){let n=e.get(t.originalParentTypeName);if(!n)return;let r=n.fieldAuthorizationDataByFieldName.get(t.name);if(r){if(r.requiresAuthentication){let i=(0,Wl.generateSimpleDirective)(ln.AUTHENTICATED);t.directivesByDirectiveName.set(ln.AUTHENTICATED,[i])}if(r.requiredScopes.length>0){let i=(0,Wl.generateRequiresScopesDirective)(r.requiredScopes);t.directivesByDirectiveName.set(ln.REQUIRES_SCOPES,[i])}}}function Kse(e){for(let t of e.argumentDataByArgumentName.values()){for(let n of t.directivesByDirectiveName.values())t.node.directives.push(...n);e.node.arguments.push(t.node)}}function Gse(e,t){let n=[];for(let r of e.values()){if(r.node.kind===It.Kind.FIELD_DEFINITION){let i=r;$se(t,i),Kse(i)}for(let i of r.directivesByDirectiveName.values())r.node.directives.push(...i);n.push(r.node)}return n}function Qse(e){if(e.kind===It.Kind.OBJECT_TYPE_DEFINITION)for(let t of ln.INHERITABLE_DIRECTIVE_NAMES)e.directivesByDirectiveName.delete(t)}function Yse(e){for(let t of ln.IGNORED_PARENT_DIRECTIVES)e.directivesByDirectiveName.delete(t)}function Jse(e,t){if(t.description){if("configureDescriptionDataBySubgraphName"in t){for(let{propagate:n}of t.configureDescriptionDataBySubgraphName.values())if(!n)return}(!e.description||e.description.value.length<t.description.value.length)&&(e.description=Q(C({},t.description),{block:!0}))}}function gj(e){return e.kind!==It.Kind.OBJECT_TYPE_DEFINITION?!1:e.isRootType}function zse(e){return e.kind===It.Kind.INTERFACE_TYPE_DEFINITION}function Hse(e,t){e.extensionType===t.extensionType||e.extensionType===LA.ExtensionType.NONE||t.extensionType!==LA.ExtensionType.NONE&&!gj(t)||(e.extensionType=LA.ExtensionType.NONE)}function Wse(e,t){var r;if(!((r=t.arguments)!=null&&r.length))return;let n=t.arguments[0].value.value;e.deprecatedReason.length<n.length&&(e.deprecatedReason=n)}function Xse(e,t){for(let n of t){let r=n.arguments[0].value.value;e.tags.set(r,n)}}function Zse(e,t,n){for(let[r,i]of t){if(!n.has(r))continue;if(r===ln.DEPRECATED){e.isDeprecated=!0,Wse(e,i[0]);continue}if(r===ln.TAG){Xse(e,i);continue}let s=e.directives.get(r);if(!s){e.directives.set(r,i);continue}r!==ln.INACCESSIBLE&&s.push(...i)}return e}function eoe(e,t){if(!t)return;let n=t.fieldAuthorizationDataByFieldName.get(e.name);n&&(n.requiresAuthentication&&e.persistedDirectivesData.directives.set(ln.AUTHENTICATED,[(0,Wl.generateSimpleDirective)(ln.AUTHENTICATED)]),n.requiredScopes.length>0&&e.persistedDirectivesData.directives.set(ln.REQUIRES_SCOPES,[(0,Wl.generateRequiresScopesDirective)(n.requiredScopes)]))}function UA(e){return{kind:It.Kind.DIRECTIVE,name:(0,$p.stringToNameNode)(ln.DEPRECATED),arguments:[{kind:It.Kind.ARGUMENT,name:(0,$p.stringToNameNode)(ln.REASON),value:{kind:It.Kind.STRING,value:e||ln.DEPRECATED_DEFAULT_ARGUMENT_VALUE}}]}}function toe(e,t,n,r){let i=[];for(let[s,o]of e){let u=t.get(s);if(u){if(o.length<2){i.push(...o);continue}if(!u.repeatable){r.push((0,pI.invalidRepeatedFederatedDirectiveErrorMessage)(s,n));continue}i.push(...o)}}return i}function _j(e,t,n){let r=[...e.persistedDirectivesData.tags.values()];return e.persistedDirectivesData.isDeprecated&&r.push(UA(e.persistedDirectivesData.deprecatedReason)),r.push(...toe(e.persistedDirectivesData.directives,t,e.name,n)),r}function CA(e){let t=[];e.persistedDirectivesData.isDeprecated&&t.push(UA(e.persistedDirectivesData.deprecatedReason));for(let[n,r]of e.persistedDirectivesData.directives)ln.PERSISTED_CLIENT_DIRECTIVES.has(n)&&t.push(r[0]);return t}function noe(e,t,n){return e.node.name=(0,$p.stringToNameNode)(e.name),e.node.description=e.description,e.node.directives=_j(e,t,n),e.node}function roe(e){let t=CA(e),n=[];for(let r of e.argumentDataByArgumentName.values())vj(r)||n.push(Q(C({},r.node),{directives:CA(r)}));return Q(C({},e.node),{directives:t,arguments:n})}function kA(e,t,n){return e.node.name=(0,$p.stringToNameNode)(e.name),e.node.type=e.type,e.node.description=e.description,e.node.directives=_j(e,t,n),e.includeDefaultValue&&(e.node.defaultValue=e.defaultValue),e.node}function ioe(e,t,n,r){let i=[],s=[],o=[],u=`${e.renamedParentTypeName}.${e.name}`;for(let[l,d]of e.argumentDataByArgumentName)e.subgraphNames.size===d.subgraphNames.size?(s.push(l),i.push(kA(d,t,r))):yj(d.type)&&o.push({inputValueName:l,missingSubgraphs:(0,Wl.getEntriesNotInHashSet)(e.subgraphNames,d.subgraphNames),requiredSubgraphs:[...d.requiredSubgraphNames]});return o.length>0?r.push((0,pI.invalidRequiredInputValueError)(ln.FIELD,u,o)):s.length>0&&((0,Wl.getValueOrDefault)(n,u,()=>({argumentNames:s,fieldName:e.name,typeName:e.renamedParentTypeName})).argumentNames=s),i}function soe(e,t,n,r,i){let s=[];for(let[o,u]of t.argumentDataByArgumentName){let l=(0,Wl.getEntriesNotInHashSet)(t.subgraphNames,u.subgraphNames);if(l.length>0){u.requiredSubgraphNames.size>0&&s.push({inputValueName:o,missingSubgraphs:l,requiredSubgraphs:[...u.requiredSubgraphNames]});continue}e.push(kA(u,n,r)),i&&i.add(o)}return s.length>0?(r.push((0,pI.invalidRequiredInputValueError)(ln.DIRECTIVE_DEFINITION,`@${t.name}`,s)),!1):!0}function ooe(e,t,n,r){let i=[];soe(i,t,n,r)&&e.push({arguments:i,kind:It.Kind.DIRECTIVE_DEFINITION,locations:(0,$p.setToNameNodeArray)(t.executableLocations),name:(0,$p.stringToNameNode)(t.name),repeatable:t.repeatable,description:t.description})}function aoe(){return{byShareable:new Set,subgraphNamesByExternalFieldName:new Map}}function uoe(e,t){let n=e.isShareableBySubgraphName.size,r=[],i=0;for(let[s,o]of e.isShareableBySubgraphName){if(e.isExternalBySubgraphName.get(s)){r.push(s);continue}o||(i+=1)}switch(i){case 0:n===r.length&&t.subgraphNamesByExternalFieldName.set(e.name,r);return;case 1:if(n===1)return;n-r.length!==1&&t.byShareable.add(e.name);return;default:t.byShareable.add(e.name)}}var Nj;(function(e){e[e.UNION=0]="UNION",e[e.INTERSECTION=1]="INTERSECTION",e[e.CONSISTENT=2]="CONSISTENT"})(Nj||(Vt.MergeMethod=Nj={}));function dI(e,t,n){if(e.kind===It.Kind.NON_NULL_TYPE)return t.kind!==It.Kind.NON_NULL_TYPE?!1:dI(e.type,t.type,n);if(t.kind===It.Kind.NON_NULL_TYPE)return dI(e,t.type,n);switch(e.kind){case It.Kind.NAMED_TYPE:if(t.kind===It.Kind.NAMED_TYPE){let r=e.name.value,i=t.name.value;if(r===i)return!0;let s=n.get(r);return s?s.has(i):!1}return!1;default:return t.kind===It.Kind.LIST_TYPE?dI(e.type,t.type,n):!1}}function vj(e){return e.persistedDirectivesData.directives.has(ln.INACCESSIBLE)||e.directivesByDirectiveName.has(ln.INACCESSIBLE)}function coe(e){return e===It.Kind.SCALAR_TYPE_DEFINITION||e===It.Kind.ENUM_TYPE_DEFINITION}function loe(e){switch(e.kind){case It.Kind.BOOLEAN:return e.value;case It.Kind.ENUM:case It.Kind.STRING:return e.value;case It.Kind.FLOAT:case It.Kind.INT:try{return parseFloat(e.value)}catch(t){return"NaN"}case It.Kind.NULL:return null}}function doe(e){return e.kind===It.Kind.OBJECT_TYPE_DEFINITION?e.renamedTypeName:e.name}var Ej;(function(e){e.PROVIDES="provides",e.REQUIRES="requires"})(Ej||(Vt.FieldSetDirective=Ej={}));function poe(){return{providedBy:[],requiredBy:[]}}function foe(e,t){switch(e.kind){case It.Kind.ENUM_VALUE_DEFINITION:return`${e.parentTypeName}.${e.name}`;case It.Kind.FIELD_DEFINITION:return`${t?e.renamedParentTypeName:e.originalParentTypeName}.${e.name}`;case It.Kind.ARGUMENT:case It.Kind.INPUT_VALUE_DEFINITION:return t?e.renamedPath:e.originalPath;case It.Kind.OBJECT_TYPE_DEFINITION:return t?e.renamedTypeName:e.name;default:return e.name}}});var MA=M(fI=>{"use strict";m();T();h();Object.defineProperty(fI,"__esModule",{value:!0});fI.Warning=void 0;var xA=class extends Error{constructor(n){super(n.message);O(this,"subgraph");O(this,"coordinate");this.name="Warning",this.subgraph=n.subgraph,this.coordinate=n.coordinate}};fI.Warning=xA});var mT=M(tu=>{"use strict";m();T();h();Object.defineProperty(tu,"__esModule",{value:!0});tu.invalidOverrideTargetSubgraphNameWarning=moe;tu.externalInterfaceFieldsWarning=hoe;tu.nonExternalConditionalFieldWarning=Toe;tu.unimplementedInterfaceOutputTypeWarning=Noe;tu.invalidExternalFieldWarning=Eoe;tu.requiresDefinedOnNonEntityFieldWarning=yoe;tu.consumerInactiveThresholdInvalidValueWarning=Ioe;var Xl=MA(),Oj=zr();function moe(e,t,n,r){return new Xl.Warning({message:`The Object type "${t}" defines the directive "@override(from: "${e}")" on the following field`+(n.length>1?"s":"")+': "'+n.join(Oj.QUOTATION_JOIN)+`".
 The required "from" argument of type "String!" should be provided with an existing subgraph name.
 However, a subgraph by the name of "${e}" does not exist.
-If this subgraph has been recently deleted, remember to clean up unused "@override" directives that reference this subgraph.`,subgraph:{name:r}})}function BA(e){return`The subgraph "${e}" is currently a "version one" subgraph, but if it were updated to "version two" in its current state, composition would be unsuccessful due to the following warning that would instead propagate as an error:
+If this subgraph has been recently deleted, remember to clean up unused "@override" directives that reference this subgraph.`,coordinate:n.length===1?`${t}.${n[0]}`:t,subgraph:{name:r}})}function BA(e){return`The subgraph "${e}" is currently a "version one" subgraph, but if it were updated to "version two" in its current state, composition would be unsuccessful due to the following warning that would instead propagate as an error:
 `}function hoe(e,t,n){return new Xl.Warning({message:BA(e)+`The Interface "${t}" is invalid because the following Field definition`+(n.length>1?"s are":" is")+` declared "@external":
  "`+n.join(Oj.QUOTATION_JOIN)+`"
-Interface Fields should not be declared "@external". This is because Interface Fields do not resolve directly, but the "@external" directive relates to whether a Field instance can be resolved by the subgraph in which it is defined.`,subgraph:{name:e}})}function Toe(e,t,n,r,i){return new Xl.Warning({message:BA(t)+`The Field "${e}" in subgraph "${t}" defines a "@${i}" directive with the following field set:
+Interface Fields should not be declared "@external". This is because Interface Fields do not resolve directly, but the "@external" directive relates to whether a Field instance can be resolved by the subgraph in which it is defined.`,coordinate:t,subgraph:{name:e}})}function Toe(e,t,n,r,i){return new Xl.Warning({message:BA(t)+`The Field "${e}" in subgraph "${t}" defines a "@${i}" directive with the following field set:
  "${r}".
 However, neither the Field "${n}" nor any of its field set ancestors are declared @external.
-Consequently, "${n}" is already provided by subgraph "${t}" and should not form part of a "@${i}" directive field set.`,subgraph:{name:t}})}function Noe(e,t){return new Xl.Warning({message:`Subgraph "${e}": The Interface "${t}" is used as an output type without at least one Object type implementation defined in the schema.`,subgraph:{name:e}})}function Eoe(e,t){return new Xl.Warning({message:BA(t)+` The Object Field "${e}" is invalidly declared "@external". An Object Field should only be declared "@external" if it is part of a "@key", "@provides", or "@requires" FieldSet, or the Field is necessary to satisfy an Interface implementation. In the case that none of these conditions is true, the "@external" directive should be removed.`,subgraph:{name:t}})}function yoe(e,t){return new Xl.Warning({message:` The Object Field "${e}" defines a "@requires" directive, but the Object is not an entity. Consequently, the "@requires" FieldSet cannot be satisfied because there is no entity resolver with which to provide the required Fields.`,subgraph:{name:t}})}function Ioe(e,t=""){return new Xl.Warning({message:'The "consumerInactiveThreshold" argument of type "Int" should be positive and smaller than 2,147,483,648.'+ +t?`
+Consequently, "${n}" is already provided by subgraph "${t}" and should not form part of a "@${i}" directive field set.`,coordinate:e,subgraph:{name:t}})}function Noe(e,t){return new Xl.Warning({message:`Subgraph "${e}": The Interface "${t}" is used as an output type without at least one Object type implementation defined in the schema.`,coordinate:t,subgraph:{name:e}})}function Eoe(e,t){return new Xl.Warning({message:BA(t)+` The Object Field "${e}" is invalidly declared "@external". An Object Field should only be declared "@external" if it is part of a "@key", "@provides", or "@requires" FieldSet, or the Field is necessary to satisfy an Interface implementation. In the case that none of these conditions is true, the "@external" directive should be removed.`,coordinate:e,subgraph:{name:t}})}function yoe(e,t){return new Xl.Warning({message:` The Object Field "${e}" defines a "@requires" directive, but the Object is not an entity. Consequently, the "@requires" FieldSet cannot be satisfied because there is no entity resolver with which to provide the required Fields.`,coordinate:e,subgraph:{name:t}})}function Ioe(e,t=""){return new Xl.Warning({message:'The "consumerInactiveThreshold" argument of type "Int" should be positive and smaller than 2,147,483,648.'+ +t?`
 ${t}`:"",subgraph:{name:e}})}});var VA=M(pt=>{"use strict";m();T();h();Object.defineProperty(pt,"__esModule",{value:!0});pt.TAG_DEFINITION_DATA=pt.SUBSCRIPTION_FILTER_DEFINITION_DATA=pt.SHAREABLE_DEFINITION_DATA=pt.SPECIFIED_BY_DEFINITION_DATA=pt.REQUIRES_SCOPES_DEFINITION_DATA=pt.REQUIRES_DEFINITION_DATA=pt.PROVIDES_DEFINITION_DATA=pt.LINK_DEFINITION_DATA=pt.KEY_DEFINITION_DATA=pt.OVERRIDE_DEFINITION_DATA=pt.NATS_SUBSCRIBE_DEFINITION_DATA=pt.NATS_REQUEST_DEFINITION_DATA=pt.NATS_PUBLISH_DEFINITION_DATA=pt.KAFKA_SUBSCRIBE_DEFINITION_DATA=pt.KAFKA_PUBLISH_DEFINITION_DATA=pt.INTERFACE_OBJECT_DEFINITION_DATA=pt.INACCESSIBLE_DEFINITION_DATA=pt.EXTERNAL_DEFINITION_DATA=pt.EXTENDS_DEFINITION_DATA=pt.DEPRECATED_DEFINITION_DATA=pt.CONFIGURE_CHILD_DESCRIPTIONS_DEFINITION_DATA=pt.CONFIGURE_DESCRIPTION_DEFINITION_DATA=pt.COMPOSE_DIRECTIVE_DEFINITION_DATA=pt.AUTHENTICATED_DEFINITION_DATA=void 0;var Ft=jc(),wo=Xi(),ar=(Ue(),Be(qe)),z=zr();pt.AUTHENTICATED_DEFINITION_DATA={argumentTypeNodeByArgumentName:new Map([]),isRepeatable:!1,locations:new Set([z.ENUM_UPPER,z.FIELD_DEFINITION_UPPER,z.INTERFACE_UPPER,z.OBJECT_UPPER,z.SCALAR_UPPER]),name:z.AUTHENTICATED,node:Ft.AUTHENTICATED_DEFINITION,optionalArgumentNames:new Set,requiredArgumentNames:new Set};pt.COMPOSE_DIRECTIVE_DEFINITION_DATA={argumentTypeNodeByArgumentName:new Map([[z.NAME,{name:z.NAME,typeNode:Ft.REQUIRED_STRING_TYPE_NODE}]]),isRepeatable:!0,locations:new Set([z.SCHEMA_UPPER]),name:z.COMPOSE_DIRECTIVE,node:Ft.COMPOSE_DIRECTIVE_DEFINITION,optionalArgumentNames:new Set,requiredArgumentNames:new Set([z.NAME])};pt.CONFIGURE_DESCRIPTION_DEFINITION_DATA={argumentTypeNodeByArgumentName:new Map([[z.PROPAGATE,{name:z.PROPAGATE,typeNode:{kind:ar.Kind.NON_NULL_TYPE,type:(0,wo.stringToNamedTypeNode)(z.BOOLEAN_SCALAR)},defaultValue:{kind:ar.Kind.BOOLEAN,value:!0}}],[z.DESCRIPTION_OVERRIDE,{name:z.DESCRIPTION_OVERRIDE,typeNode:(0,wo.stringToNamedTypeNode)(z.STRING_SCALAR)}]]),isRepeatable:!1,locations:new Set([z.ARGUMENT_DEFINITION_UPPER,z.ENUM_UPPER,z.ENUM_VALUE_UPPER,z.FIELD_DEFINITION_UPPER,z.INTERFACE_UPPER,z.INPUT_OBJECT_UPPER,z.INPUT_FIELD_DEFINITION_UPPER,z.OBJECT_UPPER,z.SCALAR_UPPER,z.SCHEMA_UPPER,z.UNION_UPPER]),name:z.CONFIGURE_DESCRIPTION,node:Ft.CONFIGURE_DESCRIPTION_DEFINITION,optionalArgumentNames:new Set([z.PROPAGATE,z.DESCRIPTION_OVERRIDE]),requiredArgumentNames:new Set};pt.CONFIGURE_CHILD_DESCRIPTIONS_DEFINITION_DATA={argumentTypeNodeByArgumentName:new Map([[z.PROPAGATE,{name:z.PROPAGATE,typeNode:{kind:ar.Kind.NON_NULL_TYPE,type:(0,wo.stringToNamedTypeNode)(z.BOOLEAN_SCALAR)},defaultValue:{kind:ar.Kind.BOOLEAN,value:!0}}]]),isRepeatable:!1,locations:new Set([z.ENUM_UPPER,z.INPUT_OBJECT_UPPER,z.INTERFACE_UPPER,z.OBJECT_UPPER]),name:z.CONFIGURE_CHILD_DESCRIPTIONS,node:Ft.CONFIGURE_CHILD_DESCRIPTIONS_DEFINITION,optionalArgumentNames:new Set([z.PROPAGATE]),requiredArgumentNames:new Set};pt.DEPRECATED_DEFINITION_DATA={argumentTypeNodeByArgumentName:new Map([[z.REASON,{name:z.REASON,typeNode:(0,wo.stringToNamedTypeNode)(z.STRING_SCALAR),defaultValue:{kind:ar.Kind.STRING,value:ar.DEFAULT_DEPRECATION_REASON}}]]),isRepeatable:!1,locations:new Set([z.ARGUMENT_DEFINITION_UPPER,z.ENUM_VALUE_UPPER,z.FIELD_DEFINITION_UPPER,z.INPUT_FIELD_DEFINITION_UPPER]),name:z.DEPRECATED,node:Ft.DEPRECATED_DEFINITION,optionalArgumentNames:new Set([z.REASON]),requiredArgumentNames:new Set};pt.EXTENDS_DEFINITION_DATA={argumentTypeNodeByArgumentName:new Map,isRepeatable:!1,locations:new Set([z.INTERFACE_UPPER,z.OBJECT_UPPER]),name:z.EXTENDS,node:Ft.EXTENDS_DEFINITION,optionalArgumentNames:new Set,requiredArgumentNames:new Set};pt.EXTERNAL_DEFINITION_DATA={argumentTypeNodeByArgumentName:new Map,isRepeatable:!1,locations:new Set([z.FIELD_DEFINITION_UPPER,z.OBJECT_UPPER]),name:z.EXTERNAL,node:Ft.EXTERNAL_DEFINITION,optionalArgumentNames:new Set,requiredArgumentNames:new Set};pt.INACCESSIBLE_DEFINITION_DATA={argumentTypeNodeByArgumentName:new Map,isRepeatable:!1,locations:new Set([z.ARGUMENT_DEFINITION_UPPER,z.ENUM_UPPER,z.ENUM_VALUE_UPPER,z.FIELD_DEFINITION_UPPER,z.INPUT_FIELD_DEFINITION_UPPER,z.INPUT_OBJECT_UPPER,z.INTERFACE_UPPER,z.OBJECT_UPPER,z.SCALAR_UPPER,z.UNION_UPPER]),name:z.INACCESSIBLE,node:Ft.INACCESSIBLE_DEFINITION,optionalArgumentNames:new Set,requiredArgumentNames:new Set};pt.INTERFACE_OBJECT_DEFINITION_DATA={argumentTypeNodeByArgumentName:new Map,isRepeatable:!1,locations:new Set([z.OBJECT_UPPER]),name:z.INTERFACE_OBJECT,node:Ft.INTERFACE_OBJECT_DEFINITION,optionalArgumentNames:new Set,requiredArgumentNames:new Set};pt.KAFKA_PUBLISH_DEFINITION_DATA={argumentTypeNodeByArgumentName:new Map([[z.TOPIC,{name:z.TOPIC,typeNode:Ft.REQUIRED_STRING_TYPE_NODE}],[z.PROVIDER_ID,{name:z.PROVIDER_ID,typeNode:Ft.REQUIRED_STRING_TYPE_NODE,defaultValue:{kind:ar.Kind.STRING,value:z.DEFAULT_EDFS_PROVIDER_ID}}]]),isRepeatable:!1,locations:new Set([z.FIELD_DEFINITION_UPPER]),name:z.EDFS_KAFKA_PUBLISH,node:Ft.EDFS_KAFKA_PUBLISH_DEFINITION,optionalArgumentNames:new Set([z.PROVIDER_ID]),requiredArgumentNames:new Set([z.TOPIC])};pt.KAFKA_SUBSCRIBE_DEFINITION_DATA={argumentTypeNodeByArgumentName:new Map([[z.TOPICS,{name:z.TOPICS,typeNode:{kind:ar.Kind.NON_NULL_TYPE,type:{kind:ar.Kind.LIST_TYPE,type:Ft.REQUIRED_STRING_TYPE_NODE}}}],[z.PROVIDER_ID,{name:z.PROVIDER_ID,typeNode:Ft.REQUIRED_STRING_TYPE_NODE,defaultValue:{kind:ar.Kind.STRING,value:z.DEFAULT_EDFS_PROVIDER_ID}}]]),isRepeatable:!1,locations:new Set([z.FIELD_DEFINITION_UPPER]),name:z.EDFS_KAFKA_SUBSCRIBE,node:Ft.EDFS_KAFKA_SUBSCRIBE_DEFINITION,optionalArgumentNames:new Set([z.PROVIDER_ID]),requiredArgumentNames:new Set([z.TOPICS])};pt.NATS_PUBLISH_DEFINITION_DATA={argumentTypeNodeByArgumentName:new Map([[z.SUBJECT,{name:z.SUBJECT,typeNode:Ft.REQUIRED_STRING_TYPE_NODE}],[z.PROVIDER_ID,{name:z.PROVIDER_ID,typeNode:Ft.REQUIRED_STRING_TYPE_NODE,defaultValue:{kind:ar.Kind.STRING,value:z.DEFAULT_EDFS_PROVIDER_ID}}]]),isRepeatable:!1,locations:new Set([z.FIELD_DEFINITION_UPPER]),name:z.EDFS_NATS_PUBLISH,node:Ft.EDFS_NATS_PUBLISH_DEFINITION,optionalArgumentNames:new Set([z.PROVIDER_ID]),requiredArgumentNames:new Set([z.SUBJECT])};pt.NATS_REQUEST_DEFINITION_DATA={argumentTypeNodeByArgumentName:new Map([[z.SUBJECT,{name:z.SUBJECT,typeNode:Ft.REQUIRED_STRING_TYPE_NODE}],[z.PROVIDER_ID,{name:z.PROVIDER_ID,typeNode:Ft.REQUIRED_STRING_TYPE_NODE,defaultValue:{kind:ar.Kind.STRING,value:z.DEFAULT_EDFS_PROVIDER_ID}}]]),isRepeatable:!1,locations:new Set([z.FIELD_DEFINITION_UPPER]),name:z.EDFS_NATS_REQUEST,node:Ft.EDFS_NATS_REQUEST_DEFINITION,optionalArgumentNames:new Set([z.PROVIDER_ID]),requiredArgumentNames:new Set([z.SUBJECT])};pt.NATS_SUBSCRIBE_DEFINITION_DATA={argumentTypeNodeByArgumentName:new Map([[z.SUBJECTS,{name:z.SUBJECTS,typeNode:{kind:ar.Kind.NON_NULL_TYPE,type:{kind:ar.Kind.LIST_TYPE,type:Ft.REQUIRED_STRING_TYPE_NODE}}}],[z.PROVIDER_ID,{name:z.PROVIDER_ID,typeNode:Ft.REQUIRED_STRING_TYPE_NODE,defaultValue:{kind:ar.Kind.STRING,value:z.DEFAULT_EDFS_PROVIDER_ID}}],[z.STREAM_CONFIGURATION,{name:z.STREAM_CONFIGURATION,typeNode:(0,wo.stringToNamedTypeNode)(z.EDFS_NATS_STREAM_CONFIGURATION)}]]),isRepeatable:!1,locations:new Set([z.FIELD_DEFINITION_UPPER]),name:z.EDFS_NATS_SUBSCRIBE,node:Ft.EDFS_NATS_SUBSCRIBE_DEFINITION,optionalArgumentNames:new Set([z.PROVIDER_ID]),requiredArgumentNames:new Set([z.SUBJECTS])};pt.OVERRIDE_DEFINITION_DATA={argumentTypeNodeByArgumentName:new Map([[z.FROM,{name:z.FROM,typeNode:Ft.REQUIRED_STRING_TYPE_NODE}]]),isRepeatable:!1,locations:new Set([z.FIELD_DEFINITION_UPPER]),name:z.OVERRIDE,node:Ft.OVERRIDE_DEFINITION,optionalArgumentNames:new Set,requiredArgumentNames:new Set([z.FROM])};pt.KEY_DEFINITION_DATA={argumentTypeNodeByArgumentName:new Map([[z.FIELDS,{name:z.FIELDS,typeNode:Ft.REQUIRED_FIELDSET_TYPE_NODE}],[z.RESOLVABLE,{name:z.RESOLVABLE,typeNode:(0,wo.stringToNamedTypeNode)(z.BOOLEAN_SCALAR),defaultValue:{kind:ar.Kind.BOOLEAN,value:!0}}]]),isRepeatable:!0,locations:new Set([z.INTERFACE_UPPER,z.OBJECT_UPPER]),name:z.KEY,node:Ft.KEY_DEFINITION,optionalArgumentNames:new Set([z.RESOLVABLE]),requiredArgumentNames:new Set([z.FIELDS])};pt.LINK_DEFINITION_DATA={argumentTypeNodeByArgumentName:new Map([[z.URL_LOWER,{name:z.URL_LOWER,typeNode:Ft.REQUIRED_STRING_TYPE_NODE}],[z.AS,{name:z.AS,typeNode:(0,wo.stringToNamedTypeNode)(z.STRING_SCALAR)}],[z.FOR,{name:z.FOR,typeNode:(0,wo.stringToNamedTypeNode)(z.LINK_PURPOSE)}],[z.IMPORT,{name:z.IMPORT,typeNode:{kind:ar.Kind.LIST_TYPE,type:(0,wo.stringToNamedTypeNode)(z.LINK_IMPORT)}}]]),isRepeatable:!0,locations:new Set([z.SCHEMA_UPPER]),name:z.LINK,node:Ft.LINK_DEFINITION,optionalArgumentNames:new Set([z.AS,z.FOR,z.IMPORT]),requiredArgumentNames:new Set([z.URL_LOWER])};pt.PROVIDES_DEFINITION_DATA={argumentTypeNodeByArgumentName:new Map([[z.FIELDS,{name:z.FIELDS,typeNode:Ft.REQUIRED_FIELDSET_TYPE_NODE}]]),isRepeatable:!1,locations:new Set([z.FIELD_DEFINITION_UPPER]),name:z.PROVIDES,node:Ft.PROVIDES_DEFINITION,optionalArgumentNames:new Set,requiredArgumentNames:new Set([z.FIELDS])};pt.REQUIRES_DEFINITION_DATA={argumentTypeNodeByArgumentName:new Map([[z.FIELDS,{name:z.FIELDS,typeNode:Ft.REQUIRED_FIELDSET_TYPE_NODE}]]),isRepeatable:!1,locations:new Set([z.FIELD_DEFINITION_UPPER]),name:z.REQUIRES,node:Ft.REQUIRES_DEFINITION,optionalArgumentNames:new Set,requiredArgumentNames:new Set([z.FIELDS])};pt.REQUIRES_SCOPES_DEFINITION_DATA={argumentTypeNodeByArgumentName:new Map([[z.SCOPES,{name:z.SCOPES,typeNode:{kind:ar.Kind.NON_NULL_TYPE,type:{kind:ar.Kind.LIST_TYPE,type:{kind:ar.Kind.NON_NULL_TYPE,type:{kind:ar.Kind.LIST_TYPE,type:{kind:ar.Kind.NON_NULL_TYPE,type:(0,wo.stringToNamedTypeNode)(z.SCOPE_SCALAR)}}}}}}]]),isRepeatable:!1,locations:new Set([z.ENUM_UPPER,z.FIELD_DEFINITION_UPPER,z.INTERFACE_UPPER,z.OBJECT_UPPER,z.SCALAR_UPPER]),name:z.REQUIRES_SCOPES,node:Ft.REQUIRES_SCOPES_DEFINITION,optionalArgumentNames:new Set,requiredArgumentNames:new Set([z.SCOPES])};pt.SPECIFIED_BY_DEFINITION_DATA={argumentTypeNodeByArgumentName:new Map([[z.URL_LOWER,{name:z.URL_LOWER,typeNode:Ft.REQUIRED_STRING_TYPE_NODE}]]),isRepeatable:!1,locations:new Set([z.SCALAR_UPPER]),name:z.SPECIFIED_BY,node:Ft.SPECIFIED_BY_DEFINITION,optionalArgumentNames:new Set,requiredArgumentNames:new Set([z.URL_LOWER])};pt.SHAREABLE_DEFINITION_DATA={argumentTypeNodeByArgumentName:new Map,isRepeatable:!0,locations:new Set([z.FIELD_DEFINITION_UPPER,z.OBJECT_UPPER]),name:z.SHAREABLE,node:Ft.SHAREABLE_DEFINITION,optionalArgumentNames:new Set,requiredArgumentNames:new Set};pt.SUBSCRIPTION_FILTER_DEFINITION_DATA={argumentTypeNodeByArgumentName:new Map([[z.CONDITION,{name:z.CONDITION,typeNode:{kind:ar.Kind.NON_NULL_TYPE,type:(0,wo.stringToNamedTypeNode)(z.SUBSCRIPTION_FILTER_CONDITION)}}]]),isRepeatable:!1,locations:new Set([z.FIELD_DEFINITION_UPPER]),name:z.SUBSCRIPTION_FILTER,node:Ft.SUBSCRIPTION_FILTER_DEFINITION,optionalArgumentNames:new Set,requiredArgumentNames:new Set([z.CONDITION])};pt.TAG_DEFINITION_DATA={argumentTypeNodeByArgumentName:new Map([[z.NAME,{name:z.NAME,typeNode:Ft.REQUIRED_STRING_TYPE_NODE}]]),isRepeatable:!0,locations:new Set([z.ARGUMENT_DEFINITION_UPPER,z.ENUM_UPPER,z.ENUM_VALUE_UPPER,z.FIELD_DEFINITION_UPPER,z.INPUT_FIELD_DEFINITION_UPPER,z.INPUT_OBJECT_UPPER,z.INTERFACE_UPPER,z.OBJECT_UPPER,z.SCALAR_UPPER,z.UNION_UPPER]),name:z.TAG,node:Ft.TAG_DEFINITION,optionalArgumentNames:new Set,requiredArgumentNames:new Set([z.NAME])}});var Gp=M(Zs=>{"use strict";m();T();h();Object.defineProperty(Zs,"__esModule",{value:!0});Zs.newKeyFieldSetData=_oe;Zs.newFieldSetData=voe;Zs.addFieldNamesToConfigurationData=Ooe;Zs.extractFieldSetValue=Soe;Zs.getNormalizedFieldSet=qA;Zs.validateKeyFieldSets=Aoe;Zs.validateAndAddConditionalFieldSetsToConfiguration=Foe;Zs.isNodeQuery=woe;Zs.validateArgumentTemplateReferences=Poe;Zs.initializeDirectiveDefinitionDatas=Loe;var Et=(Ue(),Be(qe)),hT=Xi(),qt=Fo(),jA=jc(),Sj=PA(),TT=Vc(),da=Kp(),goe=mT(),tr=VA(),An=zr(),is=rs();function _oe(){return{isUnresolvableByKeyFieldSet:new Map}}function voe(){return{provides:new Map,requires:new Map}}function Ooe(e,t){let n=new Set;for(let[r,i]of e)i.directivesByDirectiveName.has(An.EXTERNAL)?t.externalFieldNames?t.externalFieldNames.add(r):n.add(r):t.fieldNames.add(r);n.size>0&&(t.externalFieldNames=n)}function Soe(e,t,n){if(!n||n.length>1)return;let r=n[0].arguments;if(!r||r.length!==1)return;let i=r[0];i.name.value!==An.FIELDS||i.value.kind!==Et.Kind.STRING||t.set(e,i.value.value)}function qA(e){return(0,Et.print)((0,hT.lexicographicallySortDocumentNode)(e)).replaceAll(/\s+/g," ").slice(2,-2)}function Doe(e,t,n){switch(e){case da.FieldSetDirective.PROVIDES:return[`${t}.${n}`];default:return[]}}function boe(e,t,n,r,i,s){let{error:o,documentNode:u}=(0,hT.safeParse)("{"+n+"}");if(o||!u)return{errorMessage:(0,qt.unparsableFieldSetErrorMessage)(n,o)};let l=[t],d=[],p=Doe(i,s,r),N=[r],E=new Set,g,A=-1,k=!0,j=r;return(0,Et.visit)(u,{Argument:{enter(){return!1}},Field:{enter(Y){let ie=l[A],re=ie.name;if(ie.kind===Et.Kind.UNION_TYPE_DEFINITION)return g=(0,qt.invalidSelectionOnUnionErrorMessage)(n,p,re),Et.BREAK;let oe=Y.name.value,Oe=`${re}.${oe}`;if(e.unvalidatedExternalFieldCoords.delete(Oe),k)return g=(0,qt.invalidSelectionSetErrorMessage)(n,p,re,(0,is.kindToTypeString)(ie.kind)),Et.BREAK;p.push(Oe),N.push(oe),j=oe;let Ce=ie.fieldDataByFieldName.get(oe);if(!Ce)return g=(0,qt.undefinedFieldInFieldSetErrorMessage)(n,re,oe),Et.BREAK;if(d[A].has(oe))return g=(0,qt.duplicateFieldInFieldSetErrorMessage)(n,Oe),Et.BREAK;d[A].add(oe);let bt=Ce.isExternalBySubgraphName.get(e.subgraphName),xt=(0,TT.getTypeNodeNamedTypeName)(Ce.node.type),V=e.parentDefinitionDataByTypeName.get(xt);if(jA.BASE_SCALARS.has(xt)||(V==null?void 0:V.kind)===Et.Kind.SCALAR_TYPE_DEFINITION||(V==null?void 0:V.kind)===Et.Kind.ENUM_TYPE_DEFINITION){if(E.size<1&&!bt){e.isSubgraphVersionTwo?e.errors.push((0,qt.nonExternalConditionalFieldError)(`${s}.${r}`,e.subgraphName,Oe,n,i)):e.warnings.push((0,goe.nonExternalConditionalFieldWarning)(`${s}.${r}`,e.subgraphName,Oe,n,i));return}let ge=(0,is.getValueOrDefault)(e.conditionalFieldDataByCoordinates,Oe,da.newConditionalFieldData),it=(0,Sj.newFieldSetConditionData)({fieldCoordinatesPath:[...p],fieldPath:[...N]});i===da.FieldSetDirective.PROVIDES?ge.providedBy.push(it):ge.requiredBy.push(it);return}if(!V)return g=(0,qt.unknownTypeInFieldSetErrorMessage)(n,Oe,xt),Et.BREAK;if(bt){let ge=(0,is.getValueOrDefault)(e.conditionalFieldDataByCoordinates,Oe,da.newConditionalFieldData);switch(i){case da.FieldSetDirective.PROVIDES:ge.providedBy.push((0,Sj.newFieldSetConditionData)({fieldCoordinatesPath:[...p],fieldPath:[...N]}));break;default:break}E.add(Oe)}if(V.kind===Et.Kind.OBJECT_TYPE_DEFINITION||V.kind===Et.Kind.INTERFACE_TYPE_DEFINITION||V.kind===Et.Kind.UNION_TYPE_DEFINITION){k=!0,l.push(V);return}},leave(){E.delete(p.pop()||""),N.pop()}},InlineFragment:{enter(Y){let ie=l[A],re=ie.name,oe=p.length<1?t.name:p[p.length-1];if(!Y.typeCondition)return g=(0,qt.inlineFragmentWithoutTypeConditionErrorMessage)(n,oe),Et.BREAK;let Oe=Y.typeCondition.name.value;if(Oe===re){l.push(ie),k=!0;return}if(!(0,hT.isKindAbstract)(ie.kind))return g=(0,qt.invalidInlineFragmentTypeErrorMessage)(n,p,Oe,re),Et.BREAK;let Ce=e.parentDefinitionDataByTypeName.get(Oe);if(!Ce)return g=(0,qt.unknownInlineFragmentTypeConditionErrorMessage)(n,p,re,Oe),Et.BREAK;switch(k=!0,Ce.kind){case Et.Kind.INTERFACE_TYPE_DEFINITION:{if(!Ce.implementedInterfaceTypeNames.has(re))break;l.push(Ce);return}case Et.Kind.OBJECT_TYPE_DEFINITION:{let bt=e.concreteTypeNamesByAbstractTypeName.get(re);if(!bt||!bt.has(Oe))break;l.push(Ce);return}case Et.Kind.UNION_TYPE_DEFINITION:{l.push(Ce);return}default:return g=(0,qt.invalidInlineFragmentTypeConditionTypeErrorMessage)(n,p,re,Oe,(0,is.kindToTypeString)(Ce.kind)),Et.BREAK}return g=(0,qt.invalidInlineFragmentTypeConditionErrorMessage)(n,p,Oe,(0,is.kindToTypeString)(ie.kind),re),Et.BREAK}},SelectionSet:{enter(){if(!k){let Y=l[A];if(Y.kind===Et.Kind.UNION_TYPE_DEFINITION)return g=(0,qt.unparsableFieldSetSelectionErrorMessage)(n,j),Et.BREAK;let ie=Y.fieldDataByFieldName.get(j);if(!ie)return g=(0,qt.undefinedFieldInFieldSetErrorMessage)(n,Y.name,j),Et.BREAK;let re=(0,TT.getTypeNodeNamedTypeName)(ie.node.type),oe=e.parentDefinitionDataByTypeName.get(re),Oe=oe?oe.kind:Et.Kind.SCALAR_TYPE_DEFINITION;return g=(0,qt.invalidSelectionSetDefinitionErrorMessage)(n,p,re,(0,is.kindToTypeString)(Oe)),Et.BREAK}if(A+=1,k=!1,A<0||A>=l.length)return g=(0,qt.unparsableFieldSetSelectionErrorMessage)(n,j),Et.BREAK;d.push(new Set)},leave(){if(k){let Y=l[A+1];g=(0,qt.invalidSelectionSetErrorMessage)(n,p,Y.name,(0,is.kindToTypeString)(Y.kind)),k=!1}A-=1,l.pop(),d.pop()}}}),g?{errorMessage:g}:{configuration:{fieldName:r,selectionSet:qA(u)}}}function Aoe(e,t,n,r){let i=e.entityInterfaceDataByTypeName.has(t.name),s=t.name,o=[],u=[],l=new Set,d=[],p=i?void 0:e.internalGraph.addEntityDataNode(t.name),N=e.internalGraph.addOrUpdateNode(t.name);for(let[E,g]of n){let{error:A,documentNode:k}=(0,hT.safeParse)("{"+E+"}");if(A||!k){o.push((0,qt.unparsableFieldSetErrorMessage)(E,A));continue}let j=[t],Y=[],ie=[],re=new Set,oe=-1,Oe=!0,Ce="";if((0,Et.visit)(k,{Argument:{enter(xt){return o.push((0,qt.unexpectedArgumentErrorMessage)(E,`${j[oe].name}.${Ce}`,xt.name.value)),Et.BREAK}},Field:{enter(xt){let V=j[oe-1],ge=j[oe],it=ge.name,Ot=xt.name.value,sn=`${it}.${Ot}`;if(e.unvalidatedExternalFieldCoords.delete(sn),Oe)return o.push((0,qt.invalidSelectionSetErrorMessage)(E,[`${V.name}.${Ce}`],it,(0,is.kindToTypeString)(ge.kind))),Et.BREAK;Ce=Ot;let Er=ge.fieldDataByFieldName.get(Ot);if(!Er)return o.push((0,qt.undefinedFieldInFieldSetErrorMessage)(E,it,Ot)),Et.BREAK;if(Er.argumentDataByArgumentName.size)return o.push((0,qt.argumentsInKeyFieldSetErrorMessage)(E,sn)),Et.BREAK;if(Y[oe].has(Ot))return o.push((0,qt.duplicateFieldInFieldSetErrorMessage)(E,sn)),Et.BREAK;if(ie.push(Ot),Er.isShareableBySubgraphName.set(e.subgraphName,!0),Y[oe].add(Ot),oe===0)l.add(Ot),r.add(Ot);else{let bi=e.configurationDataByParentTypeName.get(it);if(!bi)return o.push((0,qt.invalidConfigurationDataErrorMessage)(it,Ot,E)),Et.BREAK;bi.fieldNames.add(Ot)}(0,is.getValueOrDefault)(e.keyFieldNamesByParentTypeName,it,()=>new Set).add(Ot);let xn=(0,TT.getTypeNodeNamedTypeName)(Er.node.type);if(jA.BASE_SCALARS.has(xn)){re.add(ie.join(An.PERIOD)),ie.pop();return}let Sn=e.parentDefinitionDataByTypeName.get(xn);if(!Sn)return o.push((0,qt.unknownTypeInFieldSetErrorMessage)(E,sn,xn)),Et.BREAK;if(Sn.kind===Et.Kind.OBJECT_TYPE_DEFINITION){Oe=!0,j.push(Sn);return}if((0,hT.isKindAbstract)(Sn.kind))return o.push((0,qt.abstractTypeInKeyFieldSetErrorMessage)(E,sn,xn,(0,is.kindToTypeString)(Sn.kind))),Et.BREAK;re.add(ie.join(An.PERIOD)),ie.pop()}},InlineFragment:{enter(){return o.push(qt.inlineFragmentInFieldSetErrorMessage),Et.BREAK}},SelectionSet:{enter(){if(!Oe){let xt=j[oe],ge=`${xt.name}.${Ce}`,it=xt.fieldDataByFieldName.get(Ce);if(!it)return o.push((0,qt.undefinedFieldInFieldSetErrorMessage)(E,ge,Ce)),Et.BREAK;let Ot=(0,TT.getTypeNodeNamedTypeName)(it.node.type),sn=e.parentDefinitionDataByTypeName.get(Ot),Er=sn?sn.kind:Et.Kind.SCALAR_TYPE_DEFINITION;return o.push((0,qt.invalidSelectionSetDefinitionErrorMessage)(E,[ge],Ot,(0,is.kindToTypeString)(Er))),Et.BREAK}if(oe+=1,Oe=!1,oe<0||oe>=j.length)return o.push((0,qt.unparsableFieldSetSelectionErrorMessage)(E,Ce)),Et.BREAK;Y.push(new Set)},leave(){if(Oe){let V=j[oe].name,ge=j[oe+1],it=`${V}.${Ce}`;o.push((0,qt.invalidSelectionSetErrorMessage)(E,[it],ge.name,(0,is.kindToTypeString)(ge.kind))),Oe=!1}oe-=1,j.pop(),Y.pop()}}}),o.length>0)continue;let bt=qA(k);u.push(C({fieldName:"",selectionSet:bt},g?{disableEntityResolver:!0}:{})),N.satisfiedFieldSets.add(bt),!g&&(p==null||p.addTargetSubgraphByFieldSet(bt,e.subgraphName),d.push(re))}if(o.length){e.errors.push((0,qt.invalidKeyDirectivesError)(s,o));return}if(u.length)return u}function Roe(e,t,n,r,i){if(t!==da.FieldSetDirective.PROVIDES)return{fieldSetParentData:n};let s=(0,is.getOrThrowError)(n.fieldDataByFieldName,r,`${i}.fieldDataByFieldName`),o=(0,TT.getTypeNodeNamedTypeName)(s.node.type),u=e.parentDefinitionDataByTypeName.get(o);return u?u.kind!==Et.Kind.INTERFACE_TYPE_DEFINITION&&u.kind!==Et.Kind.OBJECT_TYPE_DEFINITION?{errorString:(0,qt.incompatibleTypeWithProvidesErrorMessage)(`${i}.${r}`,o)}:{fieldSetParentData:u}:{errorString:(0,qt.unknownNamedTypeErrorMessage)(`${i}.${r}`,o)}}function Dj(e,t,n,r){let i=[],s=[],o=(0,da.getParentTypeName)(t);for(let[u,l]of n){let{fieldSetParentData:d,errorString:p}=Roe(e,r,t,u,o),N=`${o}.${u}`;if(p){i.push(p);continue}if(!d)continue;let{errorMessage:E,configuration:g}=boe(e,d,l,u,r,o);if(E){i.push(` On "${N}" \u2014`+E);continue}if(g){s.push(g);continue}throw(0,qt.invalidConfigurationResultFatalError)(N)}if(i.length>0){e.errors.push((0,qt.invalidProvidesOrRequiresDirectivesError)(r,i));return}if(s.length>0)return s}function Foe(e,t,n){let r=(0,is.getOrThrowError)(e.configurationDataByParentTypeName,(0,da.getParentTypeName)(t),"configurationDataByParentTypeName"),i=Dj(e,t,n.provides,da.FieldSetDirective.PROVIDES);i&&(r.provides=i);let s=Dj(e,t,n.requires,da.FieldSetDirective.REQUIRES);s&&(r.requires=s)}function woe(e,t){return e===An.QUERY||t===Et.OperationTypeNode.QUERY}function Poe(e,t,n){let r=e.matchAll(jA.EDFS_ARGS_REGEXP),i=new Set,s=new Set;for(let o of r){if(o.length<2){s.add(o[0]);continue}t.has(o[1])||i.add(o[1])}for(let o of i)n.push((0,qt.undefinedEventSubjectsArgumentErrorMessage)(o));for(let o of s)n.push((0,qt.invalidEventSubjectsArgumentErrorMessage)(o))}function Loe(){return new Map([[An.AUTHENTICATED,tr.AUTHENTICATED_DEFINITION_DATA],[An.COMPOSE_DIRECTIVE,tr.COMPOSE_DIRECTIVE_DEFINITION_DATA],[An.CONFIGURE_DESCRIPTION,tr.CONFIGURE_DESCRIPTION_DEFINITION_DATA],[An.CONFIGURE_CHILD_DESCRIPTIONS,tr.CONFIGURE_CHILD_DESCRIPTIONS_DEFINITION_DATA],[An.DEPRECATED,tr.DEPRECATED_DEFINITION_DATA],[An.EDFS_KAFKA_PUBLISH,tr.KAFKA_PUBLISH_DEFINITION_DATA],[An.EDFS_KAFKA_SUBSCRIBE,tr.KAFKA_SUBSCRIBE_DEFINITION_DATA],[An.EDFS_NATS_PUBLISH,tr.NATS_PUBLISH_DEFINITION_DATA],[An.EDFS_NATS_REQUEST,tr.NATS_REQUEST_DEFINITION_DATA],[An.EDFS_NATS_SUBSCRIBE,tr.NATS_SUBSCRIBE_DEFINITION_DATA],[An.EXTENDS,tr.EXTENDS_DEFINITION_DATA],[An.EXTERNAL,tr.EXTERNAL_DEFINITION_DATA],[An.INACCESSIBLE,tr.INACCESSIBLE_DEFINITION_DATA],[An.INTERFACE_OBJECT,tr.INTERFACE_OBJECT_DEFINITION_DATA],[An.KEY,tr.KEY_DEFINITION_DATA],[An.LINK,tr.LINK_DEFINITION_DATA],[An.OVERRIDE,tr.OVERRIDE_DEFINITION_DATA],[An.PROVIDES,tr.PROVIDES_DEFINITION_DATA],[An.REQUIRES,tr.REQUIRES_DEFINITION_DATA],[An.REQUIRES_SCOPES,tr.REQUIRES_SCOPES_DEFINITION_DATA],[An.SHAREABLE,tr.SHAREABLE_DEFINITION_DATA],[An.SPECIFIED_BY,tr.SPECIFIED_BY_DEFINITION_DATA],[An.SUBSCRIPTION_FILTER,tr.SUBSCRIPTION_FILTER_DEFINITION_DATA],[An.TAG,tr.TAG_DEFINITION_DATA]])}});var $A=M(Zl=>{"use strict";m();T();h();Object.defineProperty(Zl,"__esModule",{value:!0});Zl.newParentTagData=Moe;Zl.newChildTagData=Boe;Zl.validateImplicitFieldSets=Voe;Zl.newContractTagOptionsFromArrays=joe;Zl.getDescriptionFromString=qoe;var Coe=Vc(),Ds=SA(),Uoe=jc(),bj=Xi(),koe=Gp(),xoe=rs();function Moe(e){return{childTagDataByChildName:new Map,tagNames:new Set,typeName:e}}function Boe(e){return{name:e,tagNames:new Set,tagNamesByArgumentName:new Map}}function Voe({conditionalFieldDataByCoordinates:e,configurationData:t,fieldSets:n,implicitKeys:r,objectData:i,parentDefinitionDataByTypeName:s,graphNode:o}){for(let u of n){let{error:l,documentNode:d}=(0,bj.safeParse)("{"+u+"}");if(l||!d)continue;let p=[i],N=[],E=new Set,g=[],A=-1,k=!0,j=!0;if((0,Ds.visit)(d,{Argument:{enter(){return j=!1,Ds.BREAK}},Field:{enter(ie){let re=p[A];if(k)return j=!1,Ds.BREAK;let oe=ie.name.value,Oe=re.fieldDataByFieldName.get(oe);if(!Oe||Oe.argumentDataByArgumentName.size||N[A].has(oe))return j=!1,Ds.BREAK;let Ce=e.get(`${Oe.renamedParentTypeName}.${oe}`);if(Ce){if(Ce.providedBy.length>0)g.push(...Ce.providedBy);else if(Ce.requiredBy.length>0)return j=!1,Ds.BREAK}N[A].add(oe),A===0&&E.add(oe);let bt=(0,Coe.getTypeNodeNamedTypeName)(Oe.node.type);if(Uoe.BASE_SCALARS.has(bt))return;let xt=s.get(bt);if(!xt)return j=!1,Ds.BREAK;if(xt.kind===Ds.Kind.OBJECT_TYPE_DEFINITION){k=!0,p.push(xt);return}if((0,bj.isKindAbstract)(xt.kind))return j=!1,Ds.BREAK}},InlineFragment:{enter(){return j=!1,Ds.BREAK}},SelectionSet:{enter(){if(!k||(A+=1,k=!1,A<0||A>=p.length))return j=!1,Ds.BREAK;N.push(new Set)},leave(){if(k)return j=!1,Ds.BREAK;A-=1,p.pop(),N.pop()}}}),!j)continue;(0,xoe.addIterableValuesToSet)(E,t.fieldNames);let Y=(0,koe.getNormalizedFieldSet)(d);r.push(Q(C({fieldName:"",selectionSet:Y},g.length>0?{conditions:g}:{}),{disableEntityResolver:!0})),o&&o.satisfiedFieldSets.add(Y)}}function joe(e,t){return{tagNamesToExclude:new Set(e),tagNamesToInclude:new Set(t)}}function qoe(e){if(e)return{block:!0,kind:Ds.Kind.STRING,value:e}}});var NT=M(eo=>{"use strict";m();T();h();Object.defineProperty(eo,"__esModule",{value:!0});eo.SUBSCRIPTION_FILTER_LIST_INPUT_NAMES=eo.SUBSCRIPTION_FILTER_INPUT_NAMES=eo.STREAM_CONFIGURATION_FIELD_NAMES=eo.EVENT_DIRECTIVE_NAMES=eo.TYPE_SYSTEM_DIRECTIVE_LOCATIONS=void 0;var Qn=zr();eo.TYPE_SYSTEM_DIRECTIVE_LOCATIONS=new Set([Qn.ARGUMENT_DEFINITION_UPPER,Qn.ENUM_UPPER,Qn.ENUM_VALUE_UPPER,Qn.FIELD_DEFINITION_UPPER,Qn.INPUT_FIELD_DEFINITION_UPPER,Qn.INPUT_OBJECT_UPPER,Qn.INTERFACE_UPPER,Qn.OBJECT_UPPER,Qn.SCALAR_UPPER,Qn.SCHEMA_UPPER,Qn.UNION_UPPER]);eo.EVENT_DIRECTIVE_NAMES=new Set([Qn.EDFS_KAFKA_PUBLISH,Qn.EDFS_KAFKA_SUBSCRIBE,Qn.EDFS_NATS_PUBLISH,Qn.EDFS_NATS_REQUEST,Qn.EDFS_NATS_SUBSCRIBE]);eo.STREAM_CONFIGURATION_FIELD_NAMES=new Set([Qn.CONSUMER_INACTIVE_THRESHOLD,Qn.CONSUMER_NAME,Qn.STREAM_NAME]);eo.SUBSCRIPTION_FILTER_INPUT_NAMES=new Set([Qn.AND_UPPER,Qn.IN_UPPER,Qn.NOT_UPPER,Qn.OR_UPPER]);eo.SUBSCRIPTION_FILTER_LIST_INPUT_NAMES=new Set([Qn.AND_UPPER,Qn.OR_UPPER])});var ET=M(yn=>{"use strict";m();T();h();Object.defineProperty(yn,"__esModule",{value:!0});yn.maxOrScopes=void 0;yn.subtractSourceSetFromTargetSet=$oe;yn.mapToArrayOfValues=Koe;yn.addSetsAndReturnMutationBoolean=Aj;yn.kindToConvertedTypeString=Goe;yn.fieldDatasToSimpleFieldDatas=Qoe;yn.isNodeLeaf=Yoe;yn.newEntityInterfaceFederationData=Joe;yn.upsertEntityInterfaceFederationData=zoe;yn.newEntityData=Rj;yn.upsertEntityDataProperties=Hoe;yn.upsertEntityData=Woe;yn.newFieldAuthorizationData=KA;yn.resetAuthorizationData=Xoe;yn.getAuthorizationDataToUpdate=Zoe;yn.newAuthorizationData=eae;yn.mergeAuthorizationDataByAND=wj;yn.upsertFieldAuthorizationData=Pj;yn.upsertAuthorizationData=tae;yn.upsertAuthorizationConfiguration=nae;yn.setAndGetValue=rae;yn.isNodeKindInterface=iae;yn.isNodeKindObject=sae;yn.addMapEntries=oae;yn.getSingleSetEntry=aae;var On=(Ue(),Be(qe)),ss=zr(),ed=rs();function $oe(e,t){for(let n of e)t.delete(n)}function Koe(e){let t=[];for(let n of e.values())t.push(n);return t}function Aj(e,t){let n=!1;for(let r of e)t.has(r)||(n=!0,t.add(r));return n}function Goe(e){switch(e){case On.Kind.BOOLEAN:return ss.BOOLEAN_SCALAR;case On.Kind.ENUM:case On.Kind.ENUM_TYPE_DEFINITION:case On.Kind.ENUM_TYPE_EXTENSION:return ss.ENUM;case On.Kind.ENUM_VALUE_DEFINITION:return ss.ENUM_VALUE;case On.Kind.FIELD_DEFINITION:return ss.FIELD;case On.Kind.FLOAT:return ss.FLOAT_SCALAR;case On.Kind.INPUT_OBJECT_TYPE_DEFINITION:case On.Kind.INPUT_OBJECT_TYPE_EXTENSION:return ss.INPUT_OBJECT;case On.Kind.INPUT_VALUE_DEFINITION:return ss.INPUT_VALUE;case On.Kind.INT:return ss.INT_SCALAR;case On.Kind.INTERFACE_TYPE_DEFINITION:case On.Kind.INTERFACE_TYPE_EXTENSION:return ss.INTERFACE;case On.Kind.NULL:return ss.NULL;case On.Kind.OBJECT:case On.Kind.OBJECT_TYPE_DEFINITION:case On.Kind.OBJECT_TYPE_EXTENSION:return ss.OBJECT;case On.Kind.STRING:return ss.STRING_SCALAR;case On.Kind.SCALAR_TYPE_DEFINITION:case On.Kind.SCALAR_TYPE_EXTENSION:return ss.SCALAR;case On.Kind.UNION_TYPE_DEFINITION:case On.Kind.UNION_TYPE_EXTENSION:return ss.UNION;default:return e}}function Qoe(e){let t=[];for(let{name:n,namedTypeName:r}of e)t.push({name:n,namedTypeName:r});return t}function Yoe(e){if(!e)return!0;switch(e){case On.Kind.OBJECT_TYPE_DEFINITION:case On.Kind.INTERFACE_TYPE_DEFINITION:case On.Kind.UNION_TYPE_DEFINITION:return!1;default:return!0}}function Joe(e,t){return C({fieldDatasBySubgraphName:new Map().set(t,e.fieldDatas),interfaceFieldNames:new Set(e.interfaceFieldNames),interfaceObjectFieldNames:new Set(e.interfaceObjectFieldNames),interfaceObjectSubgraphs:new Set(e.isInterfaceObject?[t]:[]),typeName:e.typeName},e.isInterfaceObject?{}:{concreteTypeNames:new Set(e.concreteTypeNames)})}function zoe(e,t,n){return e.fieldDatasBySubgraphName.set(n,t.fieldDatas),(0,ed.addIterableValuesToSet)(t.interfaceFieldNames,e.interfaceFieldNames),(0,ed.addIterableValuesToSet)(t.interfaceObjectFieldNames,e.interfaceObjectFieldNames),t.isInterfaceObject?(e.interfaceObjectSubgraphs.add(n),!1):e.concreteTypeNames?Aj(t.concreteTypeNames||new Set,e.concreteTypeNames):(e.concreteTypeNames=new Set(t.concreteTypeNames),!1)}function Rj(e){return{fieldNames:new Set(e.fieldNames),keyFieldSets:new Set(e.keyFieldSets),subgraphNames:new Set(e.subgraphNames),typeName:e.typeName}}function Fj(e,t){(0,ed.addIterableValuesToSet)(e.fieldNames||[],t.fieldNames),(0,ed.addIterableValuesToSet)(e.keyFieldSets||[],t.keyFieldSets),(0,ed.addIterableValuesToSet)(e.subgraphNames||[],t.subgraphNames)}function Hoe(e,t){let n=e.get(t.typeName);n?Fj(t,n):e.set(t.typeName,Rj(t))}function Woe(e,t){let n=e.get(t.typeName);n?Fj(t,n):e.set(t.typeName,t)}function KA(e){return{fieldName:e,requiresAuthentication:!1,requiredScopes:[]}}function Xoe(e){e&&(e.requiresAuthentication=!1,e.requiredScopes=[],e.hasParentLevelAuthorization=!1)}function Zoe(e,t){if(t.kind===On.Kind.FIELD_DEFINITION){let n=t.name.value;return(0,ed.getValueOrDefault)(e.fieldAuthorizationDataByFieldName,n,()=>KA(n))}return e.hasParentLevelAuthorization=!0,e}function eae(e){return{fieldAuthorizationDataByFieldName:new Map,hasParentLevelAuthorization:!1,requiresAuthentication:!1,requiredScopes:[],typeName:e}}yn.maxOrScopes=16;function wj(e,t){t.requiresAuthentication||(t.requiresAuthentication=e.requiresAuthentication);let n=e.requiredScopes.length;if(n<1)return!0;let r=t.requiredScopes.length;if(r<1){if(n>yn.maxOrScopes)return!1;for(let s of e.requiredScopes)t.requiredScopes.push(new Set(s));return!0}if(n*r>yn.maxOrScopes)return!1;let i=[];for(let s of t.requiredScopes)for(let o of e.requiredScopes){let u=new Set(s);(0,ed.addIterableValuesToSet)(o,u),i.push(u)}return t.requiredScopes=i,!0}function Pj(e,t){let n=t.fieldName,r=e.get(n);if(!r){if(t.requiredScopes.length>yn.maxOrScopes)return!1;let i=KA(n);i.requiresAuthentication||(i.requiresAuthentication=t.requiresAuthentication);for(let s of t.requiredScopes)i.requiredScopes.push(new Set(s));return e.set(n,i),!0}return r.requiresAuthentication||(r.requiresAuthentication=t.requiresAuthentication),wj(t,r)}function tae(e,t,n){let r=e.get(t.typeName);if(!r){e.set(t.typeName,t);return}for(let[i,s]of t.fieldAuthorizationDataByFieldName)Pj(r.fieldAuthorizationDataByFieldName,s)||n.add(`${t.typeName}.${i}`)}function nae(e,t){let n=t.typeName;for(let[r,i]of t.fieldAuthorizationDataByFieldName){let s=`${n}.${r}`,o=e.get(s);o?(o.requiresAuthentication=i.requiresAuthentication,o.requiredScopes=i.requiredScopes.map(u=>[...u])):e.set(s,{argumentNames:[],typeName:n,fieldName:r,requiresAuthentication:i.requiresAuthentication,requiredScopes:i.requiredScopes.map(u=>[...u])})}}function rae(e,t,n){return e.set(t,n),n}function iae(e){return e===On.Kind.INTERFACE_TYPE_DEFINITION||e===On.Kind.INTERFACE_TYPE_EXTENSION}function sae(e){return e===On.Kind.OBJECT_TYPE_DEFINITION||e===On.Kind.OBJECT_TYPE_EXTENSION}function oae(e,t){for(let[n,r]of e)t.set(n,r)}function aae(e){for(let t of e)return t}});var YA={};Yf(YA,{__addDisposableResource:()=>Zj,__assign:()=>mI,__asyncDelegator:()=>Gj,__asyncGenerator:()=>Kj,__asyncValues:()=>Qj,__await:()=>Qp,__awaiter:()=>Mj,__classPrivateFieldGet:()=>Hj,__classPrivateFieldIn:()=>Xj,__classPrivateFieldSet:()=>Wj,__createBinding:()=>TI,__decorate:()=>Uj,__disposeResources:()=>e1,__esDecorate:()=>uae,__exportStar:()=>Vj,__extends:()=>Lj,__generator:()=>Bj,__importDefault:()=>zj,__importStar:()=>Jj,__makeTemplateObject:()=>Yj,__metadata:()=>xj,__param:()=>kj,__propKey:()=>lae,__read:()=>QA,__rest:()=>Cj,__runInitializers:()=>cae,__setFunctionName:()=>dae,__spread:()=>jj,__spreadArray:()=>$j,__spreadArrays:()=>qj,__values:()=>hI,default:()=>mae});function Lj(e,t){if(typeof t!="function"&&t!==null)throw new TypeError("Class extends value "+String(t)+" is not a constructor or null");GA(e,t);function n(){this.constructor=e}e.prototype=t===null?Object.create(t):(n.prototype=t.prototype,new n)}function Cj(e,t){var n={};for(var r in e)Object.prototype.hasOwnProperty.call(e,r)&&t.indexOf(r)<0&&(n[r]=e[r]);if(e!=null&&typeof Object.getOwnPropertySymbols=="function")for(var i=0,r=Object.getOwnPropertySymbols(e);i<r.length;i++)t.indexOf(r[i])<0&&Object.prototype.propertyIsEnumerable.call(e,r[i])&&(n[r[i]]=e[r[i]]);return n}function Uj(e,t,n,r){var i=arguments.length,s=i<3?t:r===null?r=Object.getOwnPropertyDescriptor(t,n):r,o;if(typeof Reflect=="object"&&typeof Reflect.decorate=="function")s=Reflect.decorate(e,t,n,r);else for(var u=e.length-1;u>=0;u--)(o=e[u])&&(s=(i<3?o(s):i>3?o(t,n,s):o(t,n))||s);return i>3&&s&&Object.defineProperty(t,n,s),s}function kj(e,t){return function(n,r){t(n,r,e)}}function uae(e,t,n,r,i,s){function o(Y){if(Y!==void 0&&typeof Y!="function")throw new TypeError("Function expected");return Y}for(var u=r.kind,l=u==="getter"?"get":u==="setter"?"set":"value",d=!t&&e?r.static?e:e.prototype:null,p=t||(d?Object.getOwnPropertyDescriptor(d,r.name):{}),N,E=!1,g=n.length-1;g>=0;g--){var A={};for(var k in r)A[k]=k==="access"?{}:r[k];for(var k in r.access)A.access[k]=r.access[k];A.addInitializer=function(Y){if(E)throw new TypeError("Cannot add initializers after decoration has completed");s.push(o(Y||null))};var j=(0,n[g])(u==="accessor"?{get:p.get,set:p.set}:p[l],A);if(u==="accessor"){if(j===void 0)continue;if(j===null||typeof j!="object")throw new TypeError("Object expected");(N=o(j.get))&&(p.get=N),(N=o(j.set))&&(p.set=N),(N=o(j.init))&&i.unshift(N)}else(N=o(j))&&(u==="field"?i.unshift(N):p[l]=N)}d&&Object.defineProperty(d,r.name,p),E=!0}function cae(e,t,n){for(var r=arguments.length>2,i=0;i<t.length;i++)n=r?t[i].call(e,n):t[i].call(e);return r?n:void 0}function lae(e){return typeof e=="symbol"?e:"".concat(e)}function dae(e,t,n){return typeof t=="symbol"&&(t=t.description?"[".concat(t.description,"]"):""),Object.defineProperty(e,"name",{configurable:!0,value:n?"".concat(n," ",t):t})}function xj(e,t){if(typeof Reflect=="object"&&typeof Reflect.metadata=="function")return Reflect.metadata(e,t)}function Mj(e,t,n,r){function i(s){return s instanceof n?s:new n(function(o){o(s)})}return new(n||(n=Promise))(function(s,o){function u(p){try{d(r.next(p))}catch(N){o(N)}}function l(p){try{d(r.throw(p))}catch(N){o(N)}}function d(p){p.done?s(p.value):i(p.value).then(u,l)}d((r=r.apply(e,t||[])).next())})}function Bj(e,t){var n={label:0,sent:function(){if(s[0]&1)throw s[1];return s[1]},trys:[],ops:[]},r,i,s,o;return o={next:u(0),throw:u(1),return:u(2)},typeof Symbol=="function"&&(o[Symbol.iterator]=function(){return this}),o;function u(d){return function(p){return l([d,p])}}function l(d){if(r)throw new TypeError("Generator is already executing.");for(;o&&(o=0,d[0]&&(n=0)),n;)try{if(r=1,i&&(s=d[0]&2?i.return:d[0]?i.throw||((s=i.return)&&s.call(i),0):i.next)&&!(s=s.call(i,d[1])).done)return s;switch(i=0,s&&(d=[d[0]&2,s.value]),d[0]){case 0:case 1:s=d;break;case 4:return n.label++,{value:d[1],done:!1};case 5:n.label++,i=d[1],d=[0];continue;case 7:d=n.ops.pop(),n.trys.pop();continue;default:if(s=n.trys,!(s=s.length>0&&s[s.length-1])&&(d[0]===6||d[0]===2)){n=0;continue}if(d[0]===3&&(!s||d[1]>s[0]&&d[1]<s[3])){n.label=d[1];break}if(d[0]===6&&n.label<s[1]){n.label=s[1],s=d;break}if(s&&n.label<s[2]){n.label=s[2],n.ops.push(d);break}s[2]&&n.ops.pop(),n.trys.pop();continue}d=t.call(e,n)}catch(p){d=[6,p],i=0}finally{r=s=0}if(d[0]&5)throw d[1];return{value:d[0]?d[1]:void 0,done:!0}}}function Vj(e,t){for(var n in e)n!=="default"&&!Object.prototype.hasOwnProperty.call(t,n)&&TI(t,e,n)}function hI(e){var t=typeof Symbol=="function"&&Symbol.iterator,n=t&&e[t],r=0;if(n)return n.call(e);if(e&&typeof e.length=="number")return{next:function(){return e&&r>=e.length&&(e=void 0),{value:e&&e[r++],done:!e}}};throw new TypeError(t?"Object is not iterable.":"Symbol.iterator is not defined.")}function QA(e,t){var n=typeof Symbol=="function"&&e[Symbol.iterator];if(!n)return e;var r=n.call(e),i,s=[],o;try{for(;(t===void 0||t-- >0)&&!(i=r.next()).done;)s.push(i.value)}catch(u){o={error:u}}finally{try{i&&!i.done&&(n=r.return)&&n.call(r)}finally{if(o)throw o.error}}return s}function jj(){for(var e=[],t=0;t<arguments.length;t++)e=e.concat(QA(arguments[t]));return e}function qj(){for(var e=0,t=0,n=arguments.length;t<n;t++)e+=arguments[t].length;for(var r=Array(e),i=0,t=0;t<n;t++)for(var s=arguments[t],o=0,u=s.length;o<u;o++,i++)r[i]=s[o];return r}function $j(e,t,n){if(n||arguments.length===2)for(var r=0,i=t.length,s;r<i;r++)(s||!(r in t))&&(s||(s=Array.prototype.slice.call(t,0,r)),s[r]=t[r]);return e.concat(s||Array.prototype.slice.call(t))}function Qp(e){return this instanceof Qp?(this.v=e,this):new Qp(e)}function Kj(e,t,n){if(!Symbol.asyncIterator)throw new TypeError("Symbol.asyncIterator is not defined.");var r=n.apply(e,t||[]),i,s=[];return i={},o("next"),o("throw"),o("return"),i[Symbol.asyncIterator]=function(){return this},i;function o(E){r[E]&&(i[E]=function(g){return new Promise(function(A,k){s.push([E,g,A,k])>1||u(E,g)})})}function u(E,g){try{l(r[E](g))}catch(A){N(s[0][3],A)}}function l(E){E.value instanceof Qp?Promise.resolve(E.value.v).then(d,p):N(s[0][2],E)}function d(E){u("next",E)}function p(E){u("throw",E)}function N(E,g){E(g),s.shift(),s.length&&u(s[0][0],s[0][1])}}function Gj(e){var t,n;return t={},r("next"),r("throw",function(i){throw i}),r("return"),t[Symbol.iterator]=function(){return this},t;function r(i,s){t[i]=e[i]?function(o){return(n=!n)?{value:Qp(e[i](o)),done:!1}:s?s(o):o}:s}}function Qj(e){if(!Symbol.asyncIterator)throw new TypeError("Symbol.asyncIterator is not defined.");var t=e[Symbol.asyncIterator],n;return t?t.call(e):(e=typeof hI=="function"?hI(e):e[Symbol.iterator](),n={},r("next"),r("throw"),r("return"),n[Symbol.asyncIterator]=function(){return this},n);function r(s){n[s]=e[s]&&function(o){return new Promise(function(u,l){o=e[s](o),i(u,l,o.done,o.value)})}}function i(s,o,u,l){Promise.resolve(l).then(function(d){s({value:d,done:u})},o)}}function Yj(e,t){return Object.defineProperty?Object.defineProperty(e,"raw",{value:t}):e.raw=t,e}function Jj(e){if(e&&e.__esModule)return e;var t={};if(e!=null)for(var n in e)n!=="default"&&Object.prototype.hasOwnProperty.call(e,n)&&TI(t,e,n);return pae(t,e),t}function zj(e){return e&&e.__esModule?e:{default:e}}function Hj(e,t,n,r){if(n==="a"&&!r)throw new TypeError("Private accessor was defined without a getter");if(typeof t=="function"?e!==t||!r:!t.has(e))throw new TypeError("Cannot read private member from an object whose class did not declare it");return n==="m"?r:n==="a"?r.call(e):r?r.value:t.get(e)}function Wj(e,t,n,r,i){if(r==="m")throw new TypeError("Private method is not writable");if(r==="a"&&!i)throw new TypeError("Private accessor was defined without a setter");if(typeof t=="function"?e!==t||!i:!t.has(e))throw new TypeError("Cannot write private member to an object whose class did not declare it");return r==="a"?i.call(e,n):i?i.value=n:t.set(e,n),n}function Xj(e,t){if(t===null||typeof t!="object"&&typeof t!="function")throw new TypeError("Cannot use 'in' operator on non-object");return typeof e=="function"?t===e:e.has(t)}function Zj(e,t,n){if(t!=null){if(typeof t!="object"&&typeof t!="function")throw new TypeError("Object expected.");var r;if(n){if(!Symbol.asyncDispose)throw new TypeError("Symbol.asyncDispose is not defined.");r=t[Symbol.asyncDispose]}if(r===void 0){if(!Symbol.dispose)throw new TypeError("Symbol.dispose is not defined.");r=t[Symbol.dispose]}if(typeof r!="function")throw new TypeError("Object not disposable.");e.stack.push({value:t,dispose:r,async:n})}else n&&e.stack.push({async:!0});return t}function e1(e){function t(r){e.error=e.hasError?new fae(r,e.error,"An error was suppressed during disposal."):r,e.hasError=!0}function n(){for(;e.stack.length;){var r=e.stack.pop();try{var i=r.dispose&&r.dispose.call(r.value);if(r.async)return Promise.resolve(i).then(n,function(s){return t(s),n()})}catch(s){t(s)}}if(e.hasError)throw e.error}return n()}var GA,mI,TI,pae,fae,mae,JA=de(()=>{"use strict";m();T();h();GA=function(e,t){return GA=Object.setPrototypeOf||{__proto__:[]}instanceof Array&&function(n,r){n.__proto__=r}||function(n,r){for(var i in r)Object.prototype.hasOwnProperty.call(r,i)&&(n[i]=r[i])},GA(e,t)};mI=function(){return mI=Object.assign||function(t){for(var n,r=1,i=arguments.length;r<i;r++){n=arguments[r];for(var s in n)Object.prototype.hasOwnProperty.call(n,s)&&(t[s]=n[s])}return t},mI.apply(this,arguments)};TI=Object.create?function(e,t,n,r){r===void 0&&(r=n);var i=Object.getOwnPropertyDescriptor(t,n);(!i||("get"in i?!t.__esModule:i.writable||i.configurable))&&(i={enumerable:!0,get:function(){return t[n]}}),Object.defineProperty(e,r,i)}:function(e,t,n,r){r===void 0&&(r=n),e[r]=t[n]};pae=Object.create?function(e,t){Object.defineProperty(e,"default",{enumerable:!0,value:t})}:function(e,t){e.default=t};fae=typeof SuppressedError=="function"?SuppressedError:function(e,t,n){var r=new Error(n);return r.name="SuppressedError",r.error=e,r.suppressed=t,r};mae={__extends:Lj,__assign:mI,__rest:Cj,__decorate:Uj,__param:kj,__metadata:xj,__awaiter:Mj,__generator:Bj,__createBinding:TI,__exportStar:Vj,__values:hI,__read:QA,__spread:jj,__spreadArrays:qj,__spreadArray:$j,__await:Qp,__asyncGenerator:Kj,__asyncDelegator:Gj,__asyncValues:Qj,__makeTemplateObject:Yj,__importStar:Jj,__importDefault:zj,__classPrivateFieldGet:Hj,__classPrivateFieldSet:Wj,__classPrivateFieldIn:Xj,__addDisposableResource:Zj,__disposeResources:e1}});var v1={};Yf(v1,{__addDisposableResource:()=>g1,__assign:()=>NI,__asyncDelegator:()=>f1,__asyncGenerator:()=>p1,__asyncValues:()=>m1,__await:()=>Yp,__awaiter:()=>o1,__classPrivateFieldGet:()=>E1,__classPrivateFieldIn:()=>I1,__classPrivateFieldSet:()=>y1,__createBinding:()=>yI,__decorate:()=>r1,__disposeResources:()=>_1,__esDecorate:()=>hae,__exportStar:()=>u1,__extends:()=>t1,__generator:()=>a1,__importDefault:()=>N1,__importStar:()=>T1,__makeTemplateObject:()=>h1,__metadata:()=>s1,__param:()=>i1,__propKey:()=>Nae,__read:()=>HA,__rest:()=>n1,__runInitializers:()=>Tae,__setFunctionName:()=>Eae,__spread:()=>c1,__spreadArray:()=>d1,__spreadArrays:()=>l1,__values:()=>EI,default:()=>gae});function t1(e,t){if(typeof t!="function"&&t!==null)throw new TypeError("Class extends value "+String(t)+" is not a constructor or null");zA(e,t);function n(){this.constructor=e}e.prototype=t===null?Object.create(t):(n.prototype=t.prototype,new n)}function n1(e,t){var n={};for(var r in e)Object.prototype.hasOwnProperty.call(e,r)&&t.indexOf(r)<0&&(n[r]=e[r]);if(e!=null&&typeof Object.getOwnPropertySymbols=="function")for(var i=0,r=Object.getOwnPropertySymbols(e);i<r.length;i++)t.indexOf(r[i])<0&&Object.prototype.propertyIsEnumerable.call(e,r[i])&&(n[r[i]]=e[r[i]]);return n}function r1(e,t,n,r){var i=arguments.length,s=i<3?t:r===null?r=Object.getOwnPropertyDescriptor(t,n):r,o;if(typeof Reflect=="object"&&typeof Reflect.decorate=="function")s=Reflect.decorate(e,t,n,r);else for(var u=e.length-1;u>=0;u--)(o=e[u])&&(s=(i<3?o(s):i>3?o(t,n,s):o(t,n))||s);return i>3&&s&&Object.defineProperty(t,n,s),s}function i1(e,t){return function(n,r){t(n,r,e)}}function hae(e,t,n,r,i,s){function o(Y){if(Y!==void 0&&typeof Y!="function")throw new TypeError("Function expected");return Y}for(var u=r.kind,l=u==="getter"?"get":u==="setter"?"set":"value",d=!t&&e?r.static?e:e.prototype:null,p=t||(d?Object.getOwnPropertyDescriptor(d,r.name):{}),N,E=!1,g=n.length-1;g>=0;g--){var A={};for(var k in r)A[k]=k==="access"?{}:r[k];for(var k in r.access)A.access[k]=r.access[k];A.addInitializer=function(Y){if(E)throw new TypeError("Cannot add initializers after decoration has completed");s.push(o(Y||null))};var j=(0,n[g])(u==="accessor"?{get:p.get,set:p.set}:p[l],A);if(u==="accessor"){if(j===void 0)continue;if(j===null||typeof j!="object")throw new TypeError("Object expected");(N=o(j.get))&&(p.get=N),(N=o(j.set))&&(p.set=N),(N=o(j.init))&&i.unshift(N)}else(N=o(j))&&(u==="field"?i.unshift(N):p[l]=N)}d&&Object.defineProperty(d,r.name,p),E=!0}function Tae(e,t,n){for(var r=arguments.length>2,i=0;i<t.length;i++)n=r?t[i].call(e,n):t[i].call(e);return r?n:void 0}function Nae(e){return typeof e=="symbol"?e:"".concat(e)}function Eae(e,t,n){return typeof t=="symbol"&&(t=t.description?"[".concat(t.description,"]"):""),Object.defineProperty(e,"name",{configurable:!0,value:n?"".concat(n," ",t):t})}function s1(e,t){if(typeof Reflect=="object"&&typeof Reflect.metadata=="function")return Reflect.metadata(e,t)}function o1(e,t,n,r){function i(s){return s instanceof n?s:new n(function(o){o(s)})}return new(n||(n=Promise))(function(s,o){function u(p){try{d(r.next(p))}catch(N){o(N)}}function l(p){try{d(r.throw(p))}catch(N){o(N)}}function d(p){p.done?s(p.value):i(p.value).then(u,l)}d((r=r.apply(e,t||[])).next())})}function a1(e,t){var n={label:0,sent:function(){if(s[0]&1)throw s[1];return s[1]},trys:[],ops:[]},r,i,s,o;return o={next:u(0),throw:u(1),return:u(2)},typeof Symbol=="function"&&(o[Symbol.iterator]=function(){return this}),o;function u(d){return function(p){return l([d,p])}}function l(d){if(r)throw new TypeError("Generator is already executing.");for(;o&&(o=0,d[0]&&(n=0)),n;)try{if(r=1,i&&(s=d[0]&2?i.return:d[0]?i.throw||((s=i.return)&&s.call(i),0):i.next)&&!(s=s.call(i,d[1])).done)return s;switch(i=0,s&&(d=[d[0]&2,s.value]),d[0]){case 0:case 1:s=d;break;case 4:return n.label++,{value:d[1],done:!1};case 5:n.label++,i=d[1],d=[0];continue;case 7:d=n.ops.pop(),n.trys.pop();continue;default:if(s=n.trys,!(s=s.length>0&&s[s.length-1])&&(d[0]===6||d[0]===2)){n=0;continue}if(d[0]===3&&(!s||d[1]>s[0]&&d[1]<s[3])){n.label=d[1];break}if(d[0]===6&&n.label<s[1]){n.label=s[1],s=d;break}if(s&&n.label<s[2]){n.label=s[2],n.ops.push(d);break}s[2]&&n.ops.pop(),n.trys.pop();continue}d=t.call(e,n)}catch(p){d=[6,p],i=0}finally{r=s=0}if(d[0]&5)throw d[1];return{value:d[0]?d[1]:void 0,done:!0}}}function u1(e,t){for(var n in e)n!=="default"&&!Object.prototype.hasOwnProperty.call(t,n)&&yI(t,e,n)}function EI(e){var t=typeof Symbol=="function"&&Symbol.iterator,n=t&&e[t],r=0;if(n)return n.call(e);if(e&&typeof e.length=="number")return{next:function(){return e&&r>=e.length&&(e=void 0),{value:e&&e[r++],done:!e}}};throw new TypeError(t?"Object is not iterable.":"Symbol.iterator is not defined.")}function HA(e,t){var n=typeof Symbol=="function"&&e[Symbol.iterator];if(!n)return e;var r=n.call(e),i,s=[],o;try{for(;(t===void 0||t-- >0)&&!(i=r.next()).done;)s.push(i.value)}catch(u){o={error:u}}finally{try{i&&!i.done&&(n=r.return)&&n.call(r)}finally{if(o)throw o.error}}return s}function c1(){for(var e=[],t=0;t<arguments.length;t++)e=e.concat(HA(arguments[t]));return e}function l1(){for(var e=0,t=0,n=arguments.length;t<n;t++)e+=arguments[t].length;for(var r=Array(e),i=0,t=0;t<n;t++)for(var s=arguments[t],o=0,u=s.length;o<u;o++,i++)r[i]=s[o];return r}function d1(e,t,n){if(n||arguments.length===2)for(var r=0,i=t.length,s;r<i;r++)(s||!(r in t))&&(s||(s=Array.prototype.slice.call(t,0,r)),s[r]=t[r]);return e.concat(s||Array.prototype.slice.call(t))}function Yp(e){return this instanceof Yp?(this.v=e,this):new Yp(e)}function p1(e,t,n){if(!Symbol.asyncIterator)throw new TypeError("Symbol.asyncIterator is not defined.");var r=n.apply(e,t||[]),i,s=[];return i={},o("next"),o("throw"),o("return"),i[Symbol.asyncIterator]=function(){return this},i;function o(E){r[E]&&(i[E]=function(g){return new Promise(function(A,k){s.push([E,g,A,k])>1||u(E,g)})})}function u(E,g){try{l(r[E](g))}catch(A){N(s[0][3],A)}}function l(E){E.value instanceof Yp?Promise.resolve(E.value.v).then(d,p):N(s[0][2],E)}function d(E){u("next",E)}function p(E){u("throw",E)}function N(E,g){E(g),s.shift(),s.length&&u(s[0][0],s[0][1])}}function f1(e){var t,n;return t={},r("next"),r("throw",function(i){throw i}),r("return"),t[Symbol.iterator]=function(){return this},t;function r(i,s){t[i]=e[i]?function(o){return(n=!n)?{value:Yp(e[i](o)),done:!1}:s?s(o):o}:s}}function m1(e){if(!Symbol.asyncIterator)throw new TypeError("Symbol.asyncIterator is not defined.");var t=e[Symbol.asyncIterator],n;return t?t.call(e):(e=typeof EI=="function"?EI(e):e[Symbol.iterator](),n={},r("next"),r("throw"),r("return"),n[Symbol.asyncIterator]=function(){return this},n);function r(s){n[s]=e[s]&&function(o){return new Promise(function(u,l){o=e[s](o),i(u,l,o.done,o.value)})}}function i(s,o,u,l){Promise.resolve(l).then(function(d){s({value:d,done:u})},o)}}function h1(e,t){return Object.defineProperty?Object.defineProperty(e,"raw",{value:t}):e.raw=t,e}function T1(e){if(e&&e.__esModule)return e;var t={};if(e!=null)for(var n in e)n!=="default"&&Object.prototype.hasOwnProperty.call(e,n)&&yI(t,e,n);return yae(t,e),t}function N1(e){return e&&e.__esModule?e:{default:e}}function E1(e,t,n,r){if(n==="a"&&!r)throw new TypeError("Private accessor was defined without a getter");if(typeof t=="function"?e!==t||!r:!t.has(e))throw new TypeError("Cannot read private member from an object whose class did not declare it");return n==="m"?r:n==="a"?r.call(e):r?r.value:t.get(e)}function y1(e,t,n,r,i){if(r==="m")throw new TypeError("Private method is not writable");if(r==="a"&&!i)throw new TypeError("Private accessor was defined without a setter");if(typeof t=="function"?e!==t||!i:!t.has(e))throw new TypeError("Cannot write private member to an object whose class did not declare it");return r==="a"?i.call(e,n):i?i.value=n:t.set(e,n),n}function I1(e,t){if(t===null||typeof t!="object"&&typeof t!="function")throw new TypeError("Cannot use 'in' operator on non-object");return typeof e=="function"?t===e:e.has(t)}function g1(e,t,n){if(t!=null){if(typeof t!="object"&&typeof t!="function")throw new TypeError("Object expected.");var r;if(n){if(!Symbol.asyncDispose)throw new TypeError("Symbol.asyncDispose is not defined.");r=t[Symbol.asyncDispose]}if(r===void 0){if(!Symbol.dispose)throw new TypeError("Symbol.dispose is not defined.");r=t[Symbol.dispose]}if(typeof r!="function")throw new TypeError("Object not disposable.");e.stack.push({value:t,dispose:r,async:n})}else n&&e.stack.push({async:!0});return t}function _1(e){function t(r){e.error=e.hasError?new Iae(r,e.error,"An error was suppressed during disposal."):r,e.hasError=!0}function n(){for(;e.stack.length;){var r=e.stack.pop();try{var i=r.dispose&&r.dispose.call(r.value);if(r.async)return Promise.resolve(i).then(n,function(s){return t(s),n()})}catch(s){t(s)}}if(e.hasError)throw e.error}return n()}var zA,NI,yI,yae,Iae,gae,O1=de(()=>{"use strict";m();T();h();zA=function(e,t){return zA=Object.setPrototypeOf||{__proto__:[]}instanceof Array&&function(n,r){n.__proto__=r}||function(n,r){for(var i in r)Object.prototype.hasOwnProperty.call(r,i)&&(n[i]=r[i])},zA(e,t)};NI=function(){return NI=Object.assign||function(t){for(var n,r=1,i=arguments.length;r<i;r++){n=arguments[r];for(var s in n)Object.prototype.hasOwnProperty.call(n,s)&&(t[s]=n[s])}return t},NI.apply(this,arguments)};yI=Object.create?function(e,t,n,r){r===void 0&&(r=n);var i=Object.getOwnPropertyDescriptor(t,n);(!i||("get"in i?!t.__esModule:i.writable||i.configurable))&&(i={enumerable:!0,get:function(){return t[n]}}),Object.defineProperty(e,r,i)}:function(e,t,n,r){r===void 0&&(r=n),e[r]=t[n]};yae=Object.create?function(e,t){Object.defineProperty(e,"default",{enumerable:!0,value:t})}:function(e,t){e.default=t};Iae=typeof SuppressedError=="function"?SuppressedError:function(e,t,n){var r=new Error(n);return r.name="SuppressedError",r.error=e,r.suppressed=t,r};gae={__extends:t1,__assign:NI,__rest:n1,__decorate:r1,__param:i1,__metadata:s1,__awaiter:o1,__generator:a1,__createBinding:yI,__exportStar:u1,__values:EI,__read:HA,__spread:c1,__spreadArrays:l1,__spreadArray:d1,__await:Yp,__asyncGenerator:p1,__asyncDelegator:f1,__asyncValues:m1,__makeTemplateObject:h1,__importStar:T1,__importDefault:N1,__classPrivateFieldGet:E1,__classPrivateFieldSet:y1,__classPrivateFieldIn:I1,__addDisposableResource:g1,__disposeResources:_1}});var S1=M(()=>{"use strict";m();T();h()});var yT=M(Xr=>{"use strict";m();T();h();Object.defineProperty(Xr,"__esModule",{value:!0});Xr.assertSome=Xr.isSome=Xr.compareNodes=Xr.nodeToString=Xr.compareStrings=Xr.isValidPath=Xr.isDocumentString=Xr.asArray=void 0;var _ae=(Ue(),Be(qe)),vae=e=>Array.isArray(e)?e:e?[e]:[];Xr.asArray=vae;var Oae=/\.[a-z0-9]+$/i;function Sae(e){if(typeof e!="string"||Oae.test(e))return!1;try{return(0,_ae.parse)(e),!0}catch(t){}return!1}Xr.isDocumentString=Sae;var Dae=/[‘“!%^<>`]/;function bae(e){return typeof e=="string"&&!Dae.test(e)}Xr.isValidPath=bae;function D1(e,t){return String(e)<String(t)?-1:String(e)>String(t)?1:0}Xr.compareStrings=D1;function WA(e){var n,r;let t;return"alias"in e&&(t=(n=e.alias)==null?void 0:n.value),t==null&&"name"in e&&(t=(r=e.name)==null?void 0:r.value),t==null&&(t=e.kind),t}Xr.nodeToString=WA;function Aae(e,t,n){let r=WA(e),i=WA(t);return typeof n=="function"?n(r,i):D1(r,i)}Xr.compareNodes=Aae;function Rae(e){return e!=null}Xr.isSome=Rae;function Fae(e,t="Value should be something"){if(e==null)throw new Error(t)}Xr.assertSome=Fae});var IT=M(gI=>{"use strict";m();T();h();Object.defineProperty(gI,"__esModule",{value:!0});gI.inspect=void 0;var R1=3;function wae(e){return II(e,[])}gI.inspect=wae;function II(e,t){switch(typeof e){case"string":return JSON.stringify(e);case"function":return e.name?`[function ${e.name}]`:"[function]";case"object":return Pae(e,t);default:return String(e)}}function b1(e){return(e.name="GraphQLError")?e.toString():`${e.name}: ${e.message};
  ${e.stack}`}function Pae(e,t){if(e===null)return"null";if(e instanceof Error)return e.name==="AggregateError"?b1(e)+`
 `+A1(e.errors,t):b1(e);if(t.includes(e))return"[Circular]";let n=[...t,e];if(Lae(e)){let r=e.toJSON();if(r!==e)return typeof r=="string"?r:II(r,n)}else if(Array.isArray(e))return A1(e,n);return Cae(e,n)}function Lae(e){return typeof e.toJSON=="function"}function Cae(e,t){let n=Object.entries(e);return n.length===0?"{}":t.length>R1?"["+Uae(e)+"]":"{ "+n.map(([i,s])=>i+": "+II(s,t)).join(", ")+" }"}function A1(e,t){if(e.length===0)return"[]";if(t.length>R1)return"[Array]";let n=e.length,r=[];for(let i=0;i<n;++i)r.push(II(e[i],t));return"["+r.join(", ")+"]"}function Uae(e){let t=Object.prototype.toString.call(e).replace(/^\[object /,"").replace(/]$/,"");if(t==="Object"&&typeof e.constructor=="function"){let n=e.constructor.name;if(typeof n=="string"&&n!=="")return n}return t}});var _I=M(Jp=>{"use strict";m();T();h();Object.defineProperty(Jp,"__esModule",{value:!0});Jp.relocatedError=Jp.createGraphQLError=void 0;var XA=(Ue(),Be(qe)),kae=["message","locations","path","nodes","source","positions","originalError","name","stack","extensions"];function xae(e){return e!=null&&typeof e=="object"&&Object.keys(e).every(t=>kae.includes(t))}function ZA(e,t){return t!=null&&t.originalError&&!(t.originalError instanceof Error)&&xae(t.originalError)&&(t.originalError=ZA(t.originalError.message,t.originalError)),XA.versionInfo.major>=17?new XA.GraphQLError(e,t):new XA.GraphQLError(e,t==null?void 0:t.nodes,t==null?void 0:t.source,t==null?void 0:t.positions,t==null?void 0:t.path,t==null?void 0:t.originalError,t==null?void 0:t.extensions)}Jp.createGraphQLError=ZA;function Mae(e,t){return ZA(e.message,{nodes:e.nodes,source:e.source,positions:e.positions,path:t==null?e.path:t,originalError:e,extensions:e.extensions})}Jp.relocatedError=Mae});var gT=M(to=>{"use strict";m();T();h();Object.defineProperty(to,"__esModule",{value:!0});to.hasOwnProperty=to.promiseReduce=to.isPromise=to.isObjectLike=to.isIterableObject=void 0;function Bae(e){return e!=null&&typeof e=="object"&&Symbol.iterator in e}to.isIterableObject=Bae;function Vae(e){return typeof e=="object"&&e!==null}to.isObjectLike=Vae;function F1(e){return(e==null?void 0:e.then)!=null}to.isPromise=F1;function jae(e,t,n){let r=n;for(let i of e)r=F1(r)?r.then(s=>t(s,i)):t(r,i);return r}to.promiseReduce=jae;function qae(e,t){return Object.prototype.hasOwnProperty.call(e,t)}to.hasOwnProperty=qae});var tR=M(OI=>{"use strict";m();T();h();Object.defineProperty(OI,"__esModule",{value:!0});OI.getArgumentValues=void 0;var eR=IT(),td=(Ue(),Be(qe)),vI=_I(),$ae=gT();function Kae(e,t,n={}){var o;let r={},s=((o=t.arguments)!=null?o:[]).reduce((u,l)=>Q(C({},u),{[l.name.value]:l}),{});for(let{name:u,type:l,defaultValue:d}of e.args){let p=s[u];if(!p){if(d!==void 0)r[u]=d;else if((0,td.isNonNullType)(l))throw(0,vI.createGraphQLError)(`Argument "${u}" of required type "${(0,eR.inspect)(l)}" was not provided.`,{nodes:[t]});continue}let N=p.value,E=N.kind===td.Kind.NULL;if(N.kind===td.Kind.VARIABLE){let A=N.name.value;if(n==null||!(0,$ae.hasOwnProperty)(n,A)){if(d!==void 0)r[u]=d;else if((0,td.isNonNullType)(l))throw(0,vI.createGraphQLError)(`Argument "${u}" of required type "${(0,eR.inspect)(l)}" was provided the variable "$${A}" which was not provided a runtime value.`,{nodes:[N]});continue}E=n[A]==null}if(E&&(0,td.isNonNullType)(l))throw(0,vI.createGraphQLError)(`Argument "${u}" of non-null type "${(0,eR.inspect)(l)}" must not be null.`,{nodes:[N]});let g=(0,td.valueFromAST)(N,l,n);if(g===void 0)throw(0,vI.createGraphQLError)(`Argument "${u}" has invalid value ${(0,td.print)(N)}.`,{nodes:[N]});r[u]=g}return r}OI.getArgumentValues=Kae});var nR=M(pa=>{"use strict";m();T();h();Object.defineProperty(pa,"__esModule",{value:!0});pa.getDirective=pa.getDirectives=pa.getDirectiveInExtensions=pa.getDirectivesInExtensions=void 0;var P1=tR();function L1(e,t=["directives"]){return t.reduce((n,r)=>n==null?n:n[r],e==null?void 0:e.extensions)}pa.getDirectivesInExtensions=L1;function w1(e,t){let n=e.filter(r=>r.name===t);if(n.length)return n.map(r=>{var i;return(i=r.args)!=null?i:{}})}function C1(e,t,n=["directives"]){let r=n.reduce((s,o)=>s==null?s:s[o],e==null?void 0:e.extensions);if(r===void 0)return;if(Array.isArray(r))return w1(r,t);let i=[];for(let[s,o]of Object.entries(r))if(Array.isArray(o))for(let u of o)i.push({name:s,args:u});else i.push({name:s,args:o});return w1(i,t)}pa.getDirectiveInExtensions=C1;function Gae(e,t,n=["directives"]){let r=L1(t,n);if(r!=null&&r.length>0)return r;let s=(e&&e.getDirectives?e.getDirectives():[]).reduce((l,d)=>(l[d.name]=d,l),{}),o=[];t.astNode&&o.push(t.astNode),"extensionASTNodes"in t&&t.extensionASTNodes&&(o=[...o,...t.extensionASTNodes]);let u=[];for(let l of o)if(l.directives)for(let d of l.directives){let p=s[d.name.value];p&&u.push({name:d.name.value,args:(0,P1.getArgumentValues)(p,d)})}return u}pa.getDirectives=Gae;function Qae(e,t,n,r=["directives"]){let i=C1(t,n,r);if(i!=null)return i;let s=e&&e.getDirective?e.getDirective(n):void 0;if(s==null)return;let o=[];t.astNode&&o.push(t.astNode),"extensionASTNodes"in t&&t.extensionASTNodes&&(o=[...o,...t.extensionASTNodes]);let u=[];for(let l of o)if(l.directives)for(let d of l.directives)d.name.value===n&&u.push((0,P1.getArgumentValues)(s,d));if(u.length)return u}pa.getDirective=Qae});var rR=M(SI=>{"use strict";m();T();h();Object.defineProperty(SI,"__esModule",{value:!0});SI.getFieldsWithDirectives=void 0;var Yae=(Ue(),Be(qe));function Jae(e,t={}){let n={},r=["ObjectTypeDefinition","ObjectTypeExtension"];t.includeInputTypes&&(r=[...r,"InputObjectTypeDefinition","InputObjectTypeExtension"]);let i=e.definitions.filter(s=>r.includes(s.kind));for(let s of i){let o=s.name.value;if(s.fields!=null){for(let u of s.fields)if(u.directives&&u.directives.length>0){let l=u.name.value,d=`${o}.${l}`,p=u.directives.map(N=>({name:N.name.value,args:(N.arguments||[]).reduce((E,g)=>Q(C({},E),{[g.name.value]:(0,Yae.valueFromASTUntyped)(g.value)}),{})}));n[d]=p}}}return n}SI.getFieldsWithDirectives=Jae});var U1=M(DI=>{"use strict";m();T();h();Object.defineProperty(DI,"__esModule",{value:!0});DI.getArgumentsWithDirectives=void 0;var iR=(Ue(),Be(qe));function zae(e){return e.kind===iR.Kind.OBJECT_TYPE_DEFINITION||e.kind===iR.Kind.OBJECT_TYPE_EXTENSION}function Hae(e){var r;let t={},n=e.definitions.filter(zae);for(let i of n)if(i.fields!=null)for(let s of i.fields){let o=(r=s.arguments)==null?void 0:r.filter(l=>{var d;return(d=l.directives)==null?void 0:d.length});if(!(o!=null&&o.length))continue;let u=t[`${i.name.value}.${s.name.value}`]={};for(let l of o){let d=l.directives.map(p=>({name:p.name.value,args:(p.arguments||[]).reduce((N,E)=>Q(C({},N),{[E.name.value]:(0,iR.valueFromASTUntyped)(E.value)}),{})}));u[l.name.value]=d}}return t}DI.getArgumentsWithDirectives=Hae});var sR=M(bI=>{"use strict";m();T();h();Object.defineProperty(bI,"__esModule",{value:!0});bI.getImplementingTypes=void 0;var Wae=(Ue(),Be(qe));function Xae(e,t){let n=t.getTypeMap(),r=[];for(let i in n){let s=n[i];(0,Wae.isObjectType)(s)&&s.getInterfaces().find(u=>u.name===e)&&r.push(s.name)}return r}bI.getImplementingTypes=Xae});var aR=M(AI=>{"use strict";m();T();h();Object.defineProperty(AI,"__esModule",{value:!0});AI.astFromType=void 0;var Zae=IT(),nd=(Ue(),Be(qe));function oR(e){if((0,nd.isNonNullType)(e)){let t=oR(e.ofType);if(t.kind===nd.Kind.NON_NULL_TYPE)throw new Error(`Invalid type node ${(0,Zae.inspect)(e)}. Inner type of non-null type cannot be a non-null type.`);return{kind:nd.Kind.NON_NULL_TYPE,type:t}}else if((0,nd.isListType)(e))return{kind:nd.Kind.LIST_TYPE,type:oR(e.ofType)};return{kind:nd.Kind.NAMED_TYPE,name:{kind:nd.Kind.NAME,value:e.name}}}AI.astFromType=oR});var FI=M(RI=>{"use strict";m();T();h();Object.defineProperty(RI,"__esModule",{value:!0});RI.astFromValueUntyped=void 0;var fa=(Ue(),Be(qe));function uR(e){if(e===null)return{kind:fa.Kind.NULL};if(e===void 0)return null;if(Array.isArray(e)){let t=[];for(let n of e){let r=uR(n);r!=null&&t.push(r)}return{kind:fa.Kind.LIST,values:t}}if(typeof e=="object"){let t=[];for(let n in e){let r=e[n],i=uR(r);i&&t.push({kind:fa.Kind.OBJECT_FIELD,name:{kind:fa.Kind.NAME,value:n},value:i})}return{kind:fa.Kind.OBJECT,fields:t}}if(typeof e=="boolean")return{kind:fa.Kind.BOOLEAN,value:e};if(typeof e=="bigint")return{kind:fa.Kind.INT,value:String(e)};if(typeof e=="number"&&isFinite(e)){let t=String(e);return eue.test(t)?{kind:fa.Kind.INT,value:t}:{kind:fa.Kind.FLOAT,value:t}}if(typeof e=="string")return{kind:fa.Kind.STRING,value:e};throw new TypeError(`Cannot convert value to AST: ${e}.`)}RI.astFromValueUntyped=uR;var eue=/^-?(?:0|[1-9][0-9]*)$/});var x1=M(wI=>{"use strict";m();T();h();Object.defineProperty(wI,"__esModule",{value:!0});wI.astFromValue=void 0;var tue=IT(),bs=(Ue(),Be(qe)),nue=FI(),k1=gT();function _T(e,t){if((0,bs.isNonNullType)(t)){let n=_T(e,t.ofType);return(n==null?void 0:n.kind)===bs.Kind.NULL?null:n}if(e===null)return{kind:bs.Kind.NULL};if(e===void 0)return null;if((0,bs.isListType)(t)){let n=t.ofType;if((0,k1.isIterableObject)(e)){let r=[];for(let i of e){let s=_T(i,n);s!=null&&r.push(s)}return{kind:bs.Kind.LIST,values:r}}return _T(e,n)}if((0,bs.isInputObjectType)(t)){if(!(0,k1.isObjectLike)(e))return null;let n=[];for(let r of Object.values(t.getFields())){let i=_T(e[r.name],r.type);i&&n.push({kind:bs.Kind.OBJECT_FIELD,name:{kind:bs.Kind.NAME,value:r.name},value:i})}return{kind:bs.Kind.OBJECT,fields:n}}if((0,bs.isLeafType)(t)){let n=t.serialize(e);return n==null?null:(0,bs.isEnumType)(t)?{kind:bs.Kind.ENUM,value:n}:t.name==="ID"&&typeof n=="string"&&rue.test(n)?{kind:bs.Kind.INT,value:n}:(0,nue.astFromValueUntyped)(n)}console.assert(!1,"Unexpected input type: "+(0,tue.inspect)(t))}wI.astFromValue=_T;var rue=/^-?(?:0|[1-9][0-9]*)$/});var M1=M(PI=>{"use strict";m();T();h();Object.defineProperty(PI,"__esModule",{value:!0});PI.getDescriptionNode=void 0;var iue=(Ue(),Be(qe));function sue(e){var t;if((t=e.astNode)!=null&&t.description)return Q(C({},e.astNode.description),{block:!0});if(e.description)return{kind:iue.Kind.STRING,value:e.description,block:!0}}PI.getDescriptionNode=sue});var zp=M(Si=>{"use strict";m();T();h();Object.defineProperty(Si,"__esModule",{value:!0});Si.memoize2of5=Si.memoize2of4=Si.memoize5=Si.memoize4=Si.memoize3=Si.memoize2=Si.memoize1=void 0;function oue(e){let t=new WeakMap;return function(r){let i=t.get(r);if(i===void 0){let s=e(r);return t.set(r,s),s}return i}}Si.memoize1=oue;function aue(e){let t=new WeakMap;return function(r,i){let s=t.get(r);if(!s){s=new WeakMap,t.set(r,s);let u=e(r,i);return s.set(i,u),u}let o=s.get(i);if(o===void 0){let u=e(r,i);return s.set(i,u),u}return o}}Si.memoize2=aue;function uue(e){let t=new WeakMap;return function(r,i,s){let o=t.get(r);if(!o){o=new WeakMap,t.set(r,o);let d=new WeakMap;o.set(i,d);let p=e(r,i,s);return d.set(s,p),p}let u=o.get(i);if(!u){u=new WeakMap,o.set(i,u);let d=e(r,i,s);return u.set(s,d),d}let l=u.get(s);if(l===void 0){let d=e(r,i,s);return u.set(s,d),d}return l}}Si.memoize3=uue;function cue(e){let t=new WeakMap;return function(r,i,s,o){let u=t.get(r);if(!u){u=new WeakMap,t.set(r,u);let N=new WeakMap;u.set(i,N);let E=new WeakMap;N.set(s,E);let g=e(r,i,s,o);return E.set(o,g),g}let l=u.get(i);if(!l){l=new WeakMap,u.set(i,l);let N=new WeakMap;l.set(s,N);let E=e(r,i,s,o);return N.set(o,E),E}let d=l.get(s);if(!d){let N=new WeakMap;l.set(s,N);let E=e(r,i,s,o);return N.set(o,E),E}let p=d.get(o);if(p===void 0){let N=e(r,i,s,o);return d.set(o,N),N}return p}}Si.memoize4=cue;function lue(e){let t=new WeakMap;return function(r,i,s,o,u){let l=t.get(r);if(!l){l=new WeakMap,t.set(r,l);let g=new WeakMap;l.set(i,g);let A=new WeakMap;g.set(s,A);let k=new WeakMap;A.set(o,k);let j=e(r,i,s,o,u);return k.set(u,j),j}let d=l.get(i);if(!d){d=new WeakMap,l.set(i,d);let g=new WeakMap;d.set(s,g);let A=new WeakMap;g.set(o,A);let k=e(r,i,s,o,u);return A.set(u,k),k}let p=d.get(s);if(!p){p=new WeakMap,d.set(s,p);let g=new WeakMap;p.set(o,g);let A=e(r,i,s,o,u);return g.set(u,A),A}let N=p.get(o);if(!N){N=new WeakMap,p.set(o,N);let g=e(r,i,s,o,u);return N.set(u,g),g}let E=N.get(u);if(E===void 0){let g=e(r,i,s,o,u);return N.set(u,g),g}return E}}Si.memoize5=lue;function due(e){let t=new WeakMap;return function(r,i,s,o){let u=t.get(r);if(!u){u=new WeakMap,t.set(r,u);let d=e(r,i,s,o);return u.set(i,d),d}let l=u.get(i);if(l===void 0){let d=e(r,i,s,o);return u.set(i,d),d}return l}}Si.memoize2of4=due;function pue(e){let t=new WeakMap;return function(r,i,s,o,u){let l=t.get(r);if(!l){l=new WeakMap,t.set(r,l);let p=e(r,i,s,o,u);return l.set(i,p),p}let d=l.get(i);if(d===void 0){let p=e(r,i,s,o,u);return l.set(i,p),p}return d}}Si.memoize2of5=pue});var vT=M(As=>{"use strict";m();T();h();Object.defineProperty(As,"__esModule",{value:!0});As.getRootTypeMap=As.getRootTypes=As.getRootTypeNames=As.getDefinedRootType=void 0;var fue=_I(),cR=zp();function mue(e,t,n){let i=(0,As.getRootTypeMap)(e).get(t);if(i==null)throw(0,fue.createGraphQLError)(`Schema is not configured to execute ${t} operation.`,{nodes:n});return i}As.getDefinedRootType=mue;As.getRootTypeNames=(0,cR.memoize1)(function(t){let n=(0,As.getRootTypes)(t);return new Set([...n].map(r=>r.name))});As.getRootTypes=(0,cR.memoize1)(function(t){let n=(0,As.getRootTypeMap)(t);return new Set(n.values())});As.getRootTypeMap=(0,cR.memoize1)(function(t){let n=new Map,r=t.getQueryType();r&&n.set("query",r);let i=t.getMutationType();i&&n.set("mutation",i);let s=t.getSubscriptionType();return s&&n.set("subscription",s),n})});var mR=M(kt=>{"use strict";m();T();h();Object.defineProperty(kt,"__esModule",{value:!0});kt.makeDirectiveNodes=kt.makeDirectiveNode=kt.makeDeprecatedDirective=kt.astFromEnumValue=kt.astFromInputField=kt.astFromField=kt.astFromScalarType=kt.astFromEnumType=kt.astFromInputObjectType=kt.astFromUnionType=kt.astFromInterfaceType=kt.astFromObjectType=kt.astFromArg=kt.getDeprecatableDirectiveNodes=kt.getDirectiveNodes=kt.astFromDirective=kt.astFromSchema=kt.printSchemaWithDirectives=kt.getDocumentNodeFromSchema=void 0;var _t=(Ue(),Be(qe)),rd=aR(),lR=x1(),hue=FI(),no=M1(),dR=nR(),Tue=yT(),Nue=vT();function B1(e,t={}){let n=t.pathToDirectivesInExtensions,r=e.getTypeMap(),i=V1(e,n),s=i!=null?[i]:[],o=e.getDirectives();for(let u of o)(0,_t.isSpecifiedDirective)(u)||s.push(j1(u,e,n));for(let u in r){let l=r[u],d=(0,_t.isSpecifiedScalarType)(l),p=(0,_t.isIntrospectionType)(l);if(!(d||p))if((0,_t.isObjectType)(l))s.push(q1(l,e,n));else if((0,_t.isInterfaceType)(l))s.push($1(l,e,n));else if((0,_t.isUnionType)(l))s.push(K1(l,e,n));else if((0,_t.isInputObjectType)(l))s.push(G1(l,e,n));else if((0,_t.isEnumType)(l))s.push(Q1(l,e,n));else if((0,_t.isScalarType)(l))s.push(Y1(l,e,n));else throw new Error(`Unknown type ${l}.`)}return{kind:_t.Kind.DOCUMENT,definitions:s}}kt.getDocumentNodeFromSchema=B1;function Eue(e,t={}){let n=B1(e,t);return(0,_t.print)(n)}kt.printSchemaWithDirectives=Eue;function V1(e,t){let n=new Map([["query",void 0],["mutation",void 0],["subscription",void 0]]),r=[];if(e.astNode!=null&&r.push(e.astNode),e.extensionASTNodes!=null)for(let d of e.extensionASTNodes)r.push(d);for(let d of r)if(d.operationTypes)for(let p of d.operationTypes)n.set(p.operation,p);let i=(0,Nue.getRootTypeMap)(e);for(let[d,p]of n){let N=i.get(d);if(N!=null){let E=(0,rd.astFromType)(N);p!=null?p.type=E:n.set(d,{kind:_t.Kind.OPERATION_TYPE_DEFINITION,operation:d,type:E})}}let s=[...n.values()].filter(Tue.isSome),o=id(e,e,t);if(!s.length&&!o.length)return null;let u={kind:s!=null?_t.Kind.SCHEMA_DEFINITION:_t.Kind.SCHEMA_EXTENSION,operationTypes:s,directives:o},l=(0,no.getDescriptionNode)(e);return l&&(u.description=l),u}kt.astFromSchema=V1;function j1(e,t,n){var r,i;return{kind:_t.Kind.DIRECTIVE_DEFINITION,description:(0,no.getDescriptionNode)(e),name:{kind:_t.Kind.NAME,value:e.name},arguments:(r=e.args)==null?void 0:r.map(s=>pR(s,t,n)),repeatable:e.isRepeatable,locations:((i=e.locations)==null?void 0:i.map(s=>({kind:_t.Kind.NAME,value:s})))||[]}}kt.astFromDirective=j1;function id(e,t,n){let r=(0,dR.getDirectivesInExtensions)(e,n),i=[];e.astNode!=null&&i.push(e.astNode),"extensionASTNodes"in e&&e.extensionASTNodes!=null&&(i=i.concat(e.extensionASTNodes));let s;if(r!=null)s=LI(t,r);else{s=[];for(let o of i)o.directives&&s.push(...o.directives)}return s}kt.getDirectiveNodes=id;function ST(e,t,n){var u,l;let r=[],i=null,s=(0,dR.getDirectivesInExtensions)(e,n),o;return s!=null?o=LI(t,s):o=(u=e.astNode)==null?void 0:u.directives,o!=null&&(r=o.filter(d=>d.name.value!=="deprecated"),e.deprecationReason!=null&&(i=(l=o.filter(d=>d.name.value==="deprecated"))==null?void 0:l[0])),e.deprecationReason!=null&&i==null&&(i=H1(e.deprecationReason)),i==null?r:[i].concat(r)}kt.getDeprecatableDirectiveNodes=ST;function pR(e,t,n){var r;return{kind:_t.Kind.INPUT_VALUE_DEFINITION,description:(0,no.getDescriptionNode)(e),name:{kind:_t.Kind.NAME,value:e.name},type:(0,rd.astFromType)(e.type),defaultValue:e.defaultValue!==void 0&&(r=(0,lR.astFromValue)(e.defaultValue,e.type))!=null?r:void 0,directives:ST(e,t,n)}}kt.astFromArg=pR;function q1(e,t,n){return{kind:_t.Kind.OBJECT_TYPE_DEFINITION,description:(0,no.getDescriptionNode)(e),name:{kind:_t.Kind.NAME,value:e.name},fields:Object.values(e.getFields()).map(r=>fR(r,t,n)),interfaces:Object.values(e.getInterfaces()).map(r=>(0,rd.astFromType)(r)),directives:id(e,t,n)}}kt.astFromObjectType=q1;function $1(e,t,n){let r={kind:_t.Kind.INTERFACE_TYPE_DEFINITION,description:(0,no.getDescriptionNode)(e),name:{kind:_t.Kind.NAME,value:e.name},fields:Object.values(e.getFields()).map(i=>fR(i,t,n)),directives:id(e,t,n)};return"getInterfaces"in e&&(r.interfaces=Object.values(e.getInterfaces()).map(i=>(0,rd.astFromType)(i))),r}kt.astFromInterfaceType=$1;function K1(e,t,n){return{kind:_t.Kind.UNION_TYPE_DEFINITION,description:(0,no.getDescriptionNode)(e),name:{kind:_t.Kind.NAME,value:e.name},directives:id(e,t,n),types:e.getTypes().map(r=>(0,rd.astFromType)(r))}}kt.astFromUnionType=K1;function G1(e,t,n){return{kind:_t.Kind.INPUT_OBJECT_TYPE_DEFINITION,description:(0,no.getDescriptionNode)(e),name:{kind:_t.Kind.NAME,value:e.name},fields:Object.values(e.getFields()).map(r=>J1(r,t,n)),directives:id(e,t,n)}}kt.astFromInputObjectType=G1;function Q1(e,t,n){return{kind:_t.Kind.ENUM_TYPE_DEFINITION,description:(0,no.getDescriptionNode)(e),name:{kind:_t.Kind.NAME,value:e.name},values:Object.values(e.getValues()).map(r=>z1(r,t,n)),directives:id(e,t,n)}}kt.astFromEnumType=Q1;function Y1(e,t,n){var o;let r=(0,dR.getDirectivesInExtensions)(e,n),i=r?LI(t,r):((o=e.astNode)==null?void 0:o.directives)||[],s=e.specifiedByUrl||e.specifiedByURL;if(s&&!i.some(u=>u.name.value==="specifiedBy")){let u={url:s};i.push(OT("specifiedBy",u))}return{kind:_t.Kind.SCALAR_TYPE_DEFINITION,description:(0,no.getDescriptionNode)(e),name:{kind:_t.Kind.NAME,value:e.name},directives:i}}kt.astFromScalarType=Y1;function fR(e,t,n){return{kind:_t.Kind.FIELD_DEFINITION,description:(0,no.getDescriptionNode)(e),name:{kind:_t.Kind.NAME,value:e.name},arguments:e.args.map(r=>pR(r,t,n)),type:(0,rd.astFromType)(e.type),directives:ST(e,t,n)}}kt.astFromField=fR;function J1(e,t,n){var r;return{kind:_t.Kind.INPUT_VALUE_DEFINITION,description:(0,no.getDescriptionNode)(e),name:{kind:_t.Kind.NAME,value:e.name},type:(0,rd.astFromType)(e.type),directives:ST(e,t,n),defaultValue:(r=(0,lR.astFromValue)(e.defaultValue,e.type))!=null?r:void 0}}kt.astFromInputField=J1;function z1(e,t,n){return{kind:_t.Kind.ENUM_VALUE_DEFINITION,description:(0,no.getDescriptionNode)(e),name:{kind:_t.Kind.NAME,value:e.name},directives:ST(e,t,n)}}kt.astFromEnumValue=z1;function H1(e){return OT("deprecated",{reason:e},_t.GraphQLDeprecatedDirective)}kt.makeDeprecatedDirective=H1;function OT(e,t,n){let r=[];if(n!=null)for(let i of n.args){let s=i.name,o=t[s];if(o!==void 0){let u=(0,lR.astFromValue)(o,i.type);u&&r.push({kind:_t.Kind.ARGUMENT,name:{kind:_t.Kind.NAME,value:s},value:u})}}else for(let i in t){let s=t[i],o=(0,hue.astFromValueUntyped)(s);o&&r.push({kind:_t.Kind.ARGUMENT,name:{kind:_t.Kind.NAME,value:i},value:o})}return{kind:_t.Kind.DIRECTIVE,name:{kind:_t.Kind.NAME,value:e},arguments:r}}kt.makeDirectiveNode=OT;function LI(e,t){let n=[];for(let r in t){let i=t[r],s=e==null?void 0:e.getDirective(r);if(Array.isArray(i))for(let o of i)n.push(OT(r,o,s));else n.push(OT(r,i,s))}return n}kt.makeDirectiveNodes=LI});var X1=M(Hp=>{"use strict";m();T();h();Object.defineProperty(Hp,"__esModule",{value:!0});Hp.createDefaultRules=Hp.validateGraphQlDocuments=void 0;var DT=(Ue(),Be(qe));function yue(e,t,n=W1()){var u;let r=new Set,i=new Map;for(let l of t)for(let d of l.definitions)d.kind===DT.Kind.FRAGMENT_DEFINITION?i.set(d.name.value,d):r.add(d);let s={kind:DT.Kind.DOCUMENT,definitions:Array.from([...r,...i.values()])},o=(0,DT.validate)(e,s,n);for(let l of o)if(l.stack=l.message,l.locations)for(let d of l.locations)l.stack+=`
@@ -641,7 +641,7 @@ function print() { __p += __j.call(arguments, '') }
 }`;var Ut=BL(function(){return mn(P,He+"return "+Se).apply(e,B)});if(Ut.source=Se,hv(Ut))throw Ut;return Ut}function Jz(a){return In(a).toLowerCase()}function zz(a){return In(a).toUpperCase()}function Hz(a,c,f){if(a=In(a),a&&(f||c===e))return Jw(a);if(!a||!(c=ps(c)))return a;var y=lo(a),b=lo(c),P=zw(y,b),B=Hw(y,b)+1;return mc(y,P,B).join("")}function Wz(a,c,f){if(a=In(a),a&&(f||c===e))return a.slice(0,Xw(a)+1);if(!a||!(c=ps(c)))return a;var y=lo(a),b=Hw(y,lo(c))+1;return mc(y,0,b).join("")}function Xz(a,c,f){if(a=In(a),a&&(f||c===e))return a.replace(h_,"");if(!a||!(c=ps(c)))return a;var y=lo(a),b=zw(y,lo(c));return mc(y,b).join("")}function Zz(a,c){var f=xt,y=V;if(rr(c)){var b="separator"in c?c.separator:b;f="length"in c?Pt(c.length):f,y="omission"in c?ps(c.omission):y}a=In(a);var P=a.length;if(Fd(a)){var B=lo(a);P=B.length}if(f>=P)return a;var G=f-wd(y);if(G<1)return y;var Z=B?mc(B,0,G).join(""):a.slice(0,G);if(b===e)return Z+y;if(B&&(G+=Z.length-G),Tv(b)){if(a.slice(G).search(b)){var Ee,_e=Z;for(b.global||(b=P_(b.source,In(Tw.exec(b))+"g")),b.lastIndex=0;Ee=b.exec(_e);)var Se=Ee.index;Z=Z.slice(0,Se===e?G:Se)}}else if(a.indexOf(ps(b),G)!=G){var we=Z.lastIndexOf(b);we>-1&&(Z=Z.slice(0,we))}return Z+y}function eH(a){return a=In(a),a&&dG.test(a)?a.replace(fw,RQ):a}var tH=xd(function(a,c,f){return a+(f?" ":"")+c.toUpperCase()}),yv=VP("toUpperCase");function ML(a,c,f){return a=In(a),c=f?e:c,c===e?OQ(a)?PQ(a):hQ(a):a.match(c)||[]}var BL=Mt(function(a,c){try{return ls(a,e,c)}catch(f){return hv(f)?f:new gt(f)}}),nH=Ra(function(a,c){return Fs(c,function(f){f=qo(f),ba(a,f,fv(a[f],a))}),a});function rH(a){var c=a==null?0:a.length,f=ut();return a=c?zn(a,function(y){if(typeof y[1]!="function")throw new ws(i);return[f(y[0]),y[1]]}):[],Mt(function(y){for(var b=-1;++b<c;){var P=a[b];if(ls(P[0],this,y))return ls(P[1],this,y)}})}function iH(a){return R2(Ls(a,d))}function Iv(a){return function(){return a}}function sH(a,c){return a==null||a!==a?c:a}var oH=qP(),aH=qP(!0);function Ji(a){return a}function gv(a){return EP(typeof a=="function"?a:Ls(a,d))}function uH(a){return IP(Ls(a,d))}function cH(a,c){return gP(a,Ls(c,d))}var lH=Mt(function(a,c){return function(f){return Bf(f,a,c)}}),dH=Mt(function(a,c){return function(f){return Bf(a,f,c)}});function _v(a,c,f){var y=Gr(c),b=DN(c,y);f==null&&!(rr(c)&&(b.length||!y.length))&&(f=c,c=a,a=this,b=DN(c,Gr(c)));var P=!(rr(f)&&"chain"in f)||!!f.chain,B=wa(a);return Fs(b,function(G){var Z=c[G];a[G]=Z,B&&(a.prototype[G]=function(){var Ee=this.__chain__;if(P||Ee){var _e=a(this.__wrapped__),Se=_e.__actions__=Gi(this.__actions__);return Se.push({func:Z,args:arguments,thisArg:a}),_e.__chain__=Ee,_e}return Z.apply(a,ac([this.value()],arguments))})}),a}function pH(){return Kr._===this&&(Kr._=MQ),this}function vv(){}function fH(a){return a=Pt(a),Mt(function(c){return _P(c,a)})}var mH=ev(zn),hH=ev($w),TH=ev(S_);function VL(a){return av(a)?D_(qo(a)):Q2(a)}function NH(a){return function(c){return a==null?e:ul(a,c)}}var EH=KP(),yH=KP(!0);function Ov(){return[]}function Sv(){return!1}function IH(){return{}}function gH(){return""}function _H(){return!0}function vH(a,c){if(a=Pt(a),a<1||a>Sn)return[];var f=Mn,y=ci(a,Mn);c=ut(c),a-=Mn;for(var b=R_(y,c);++f<a;)c(f);return b}function OH(a){return St(a)?zn(a,qo):fs(a)?[a]:Gi(oL(In(a)))}function SH(a){var c=++kQ;return In(a)+c}var DH=PN(function(a,c){return a+c},0),bH=tv("ceil"),AH=PN(function(a,c){return a/c},1),RH=tv("floor");function FH(a){return a&&a.length?SN(a,Ji,V_):e}function wH(a,c){return a&&a.length?SN(a,ut(c,2),V_):e}function PH(a){return Qw(a,Ji)}function LH(a,c){return Qw(a,ut(c,2))}function CH(a){return a&&a.length?SN(a,Ji,K_):e}function UH(a,c){return a&&a.length?SN(a,ut(c,2),K_):e}var kH=PN(function(a,c){return a*c},1),xH=tv("round"),MH=PN(function(a,c){return a-c},0);function BH(a){return a&&a.length?A_(a,Ji):0}function VH(a,c){return a&&a.length?A_(a,ut(c,2)):0}return w.after=u3,w.ary=NL,w.assign=z3,w.assignIn=wL,w.assignInWith=GN,w.assignWith=H3,w.at=W3,w.before=EL,w.bind=fv,w.bindAll=nH,w.bindKey=yL,w.castArray=I3,w.chain=mL,w.chunk=RY,w.compact=FY,w.concat=wY,w.cond=rH,w.conforms=iH,w.constant=Iv,w.countBy=BJ,w.create=X3,w.curry=IL,w.curryRight=gL,w.debounce=_L,w.defaults=Z3,w.defaultsDeep=ez,w.defer=c3,w.delay=l3,w.difference=PY,w.differenceBy=LY,w.differenceWith=CY,w.drop=UY,w.dropRight=kY,w.dropRightWhile=xY,w.dropWhile=MY,w.fill=BY,w.filter=jJ,w.flatMap=KJ,w.flatMapDeep=GJ,w.flatMapDepth=QJ,w.flatten=lL,w.flattenDeep=VY,w.flattenDepth=jY,w.flip=d3,w.flow=oH,w.flowRight=aH,w.fromPairs=qY,w.functions=az,w.functionsIn=uz,w.groupBy=YJ,w.initial=KY,w.intersection=GY,w.intersectionBy=QY,w.intersectionWith=YY,w.invert=lz,w.invertBy=dz,w.invokeMap=zJ,w.iteratee=gv,w.keyBy=HJ,w.keys=Gr,w.keysIn=Yi,w.map=BN,w.mapKeys=fz,w.mapValues=mz,w.matches=uH,w.matchesProperty=cH,w.memoize=jN,w.merge=hz,w.mergeWith=PL,w.method=lH,w.methodOf=dH,w.mixin=_v,w.negate=qN,w.nthArg=fH,w.omit=Tz,w.omitBy=Nz,w.once=p3,w.orderBy=WJ,w.over=mH,w.overArgs=f3,w.overEvery=hH,w.overSome=TH,w.partial=mv,w.partialRight=vL,w.partition=XJ,w.pick=Ez,w.pickBy=LL,w.property=VL,w.propertyOf=NH,w.pull=WY,w.pullAll=pL,w.pullAllBy=XY,w.pullAllWith=ZY,w.pullAt=eJ,w.range=EH,w.rangeRight=yH,w.rearg=m3,w.reject=t3,w.remove=tJ,w.rest=h3,w.reverse=dv,w.sampleSize=r3,w.set=Iz,w.setWith=gz,w.shuffle=i3,w.slice=nJ,w.sortBy=a3,w.sortedUniq=cJ,w.sortedUniqBy=lJ,w.split=Kz,w.spread=T3,w.tail=dJ,w.take=pJ,w.takeRight=fJ,w.takeRightWhile=mJ,w.takeWhile=hJ,w.tap=FJ,w.throttle=N3,w.thru=MN,w.toArray=AL,w.toPairs=CL,w.toPairsIn=UL,w.toPath=OH,w.toPlainObject=FL,w.transform=_z,w.unary=E3,w.union=TJ,w.unionBy=NJ,w.unionWith=EJ,w.uniq=yJ,w.uniqBy=IJ,w.uniqWith=gJ,w.unset=vz,w.unzip=pv,w.unzipWith=fL,w.update=Oz,w.updateWith=Sz,w.values=Vd,w.valuesIn=Dz,w.without=_J,w.words=ML,w.wrap=y3,w.xor=vJ,w.xorBy=OJ,w.xorWith=SJ,w.zip=DJ,w.zipObject=bJ,w.zipObjectDeep=AJ,w.zipWith=RJ,w.entries=CL,w.entriesIn=UL,w.extend=wL,w.extendWith=GN,_v(w,w),w.add=DH,w.attempt=BL,w.camelCase=Fz,w.capitalize=kL,w.ceil=bH,w.clamp=bz,w.clone=g3,w.cloneDeep=v3,w.cloneDeepWith=O3,w.cloneWith=_3,w.conformsTo=S3,w.deburr=xL,w.defaultTo=sH,w.divide=AH,w.endsWith=wz,w.eq=fo,w.escape=Pz,w.escapeRegExp=Lz,w.every=VJ,w.find=qJ,w.findIndex=uL,w.findKey=tz,w.findLast=$J,w.findLastIndex=cL,w.findLastKey=nz,w.floor=RH,w.forEach=hL,w.forEachRight=TL,w.forIn=rz,w.forInRight=iz,w.forOwn=sz,w.forOwnRight=oz,w.get=Nv,w.gt=D3,w.gte=b3,w.has=cz,w.hasIn=Ev,w.head=dL,w.identity=Ji,w.includes=JJ,w.indexOf=$Y,w.inRange=Az,w.invoke=pz,w.isArguments=dl,w.isArray=St,w.isArrayBuffer=A3,w.isArrayLike=Qi,w.isArrayLikeObject=yr,w.isBoolean=R3,w.isBuffer=hc,w.isDate=F3,w.isElement=w3,w.isEmpty=P3,w.isEqual=L3,w.isEqualWith=C3,w.isError=hv,w.isFinite=U3,w.isFunction=wa,w.isInteger=OL,w.isLength=$N,w.isMap=SL,w.isMatch=k3,w.isMatchWith=x3,w.isNaN=M3,w.isNative=B3,w.isNil=j3,w.isNull=V3,w.isNumber=DL,w.isObject=rr,w.isObjectLike=cr,w.isPlainObject=Gf,w.isRegExp=Tv,w.isSafeInteger=q3,w.isSet=bL,w.isString=KN,w.isSymbol=fs,w.isTypedArray=Bd,w.isUndefined=$3,w.isWeakMap=K3,w.isWeakSet=G3,w.join=JY,w.kebabCase=Cz,w.last=Us,w.lastIndexOf=zY,w.lowerCase=Uz,w.lowerFirst=kz,w.lt=Q3,w.lte=Y3,w.max=FH,w.maxBy=wH,w.mean=PH,w.meanBy=LH,w.min=CH,w.minBy=UH,w.stubArray=Ov,w.stubFalse=Sv,w.stubObject=IH,w.stubString=gH,w.stubTrue=_H,w.multiply=kH,w.nth=HY,w.noConflict=pH,w.noop=vv,w.now=VN,w.pad=xz,w.padEnd=Mz,w.padStart=Bz,w.parseInt=Vz,w.random=Rz,w.reduce=ZJ,w.reduceRight=e3,w.repeat=jz,w.replace=qz,w.result=yz,w.round=xH,w.runInContext=X,w.sample=n3,w.size=s3,w.snakeCase=$z,w.some=o3,w.sortedIndex=rJ,w.sortedIndexBy=iJ,w.sortedIndexOf=sJ,w.sortedLastIndex=oJ,w.sortedLastIndexBy=aJ,w.sortedLastIndexOf=uJ,w.startCase=Gz,w.startsWith=Qz,w.subtract=MH,w.sum=BH,w.sumBy=VH,w.template=Yz,w.times=vH,w.toFinite=Pa,w.toInteger=Pt,w.toLength=RL,w.toLower=Jz,w.toNumber=ks,w.toSafeInteger=J3,w.toString=In,w.toUpper=zz,w.trim=Hz,w.trimEnd=Wz,w.trimStart=Xz,w.truncate=Zz,w.unescape=eH,w.uniqueId=SH,w.upperCase=tH,w.upperFirst=yv,w.each=hL,w.eachRight=TL,w.first=dL,_v(w,function(){var a={};return Vo(w,function(c,f){bn.call(w.prototype,f)||(a[f]=c)}),a}(),{chain:!1}),w.VERSION=t,Fs(["bind","bindKey","curry","curryRight","partial","partialRight"],function(a){w[a].placeholder=w}),Fs(["drop","take"],function(a,c){Gt.prototype[a]=function(f){f=f===e?1:kr(Pt(f),0);var y=this.__filtered__&&!c?new Gt(this):this.clone();return y.__filtered__?y.__takeCount__=ci(f,y.__takeCount__):y.__views__.push({size:ci(f,Mn),type:a+(y.__dir__<0?"Right":"")}),y},Gt.prototype[a+"Right"]=function(f){return this.reverse()[a](f).reverse()}}),Fs(["filter","map","takeWhile"],function(a,c){var f=c+1,y=f==Ot||f==Er;Gt.prototype[a]=function(b){var P=this.clone();return P.__iteratees__.push({iteratee:ut(b,3),type:f}),P.__filtered__=P.__filtered__||y,P}}),Fs(["head","last"],function(a,c){var f="take"+(c?"Right":"");Gt.prototype[a]=function(){return this[f](1).value()[0]}}),Fs(["initial","tail"],function(a,c){var f="drop"+(c?"":"Right");Gt.prototype[a]=function(){return this.__filtered__?new Gt(this):this[f](1)}}),Gt.prototype.compact=function(){return this.filter(Ji)},Gt.prototype.find=function(a){return this.filter(a).head()},Gt.prototype.findLast=function(a){return this.reverse().find(a)},Gt.prototype.invokeMap=Mt(function(a,c){return typeof a=="function"?new Gt(this):this.map(function(f){return Bf(f,a,c)})}),Gt.prototype.reject=function(a){return this.filter(qN(ut(a)))},Gt.prototype.slice=function(a,c){a=Pt(a);var f=this;return f.__filtered__&&(a>0||c<0)?new Gt(f):(a<0?f=f.takeRight(-a):a&&(f=f.drop(a)),c!==e&&(c=Pt(c),f=c<0?f.dropRight(-c):f.take(c-a)),f)},Gt.prototype.takeRightWhile=function(a){return this.reverse().takeWhile(a).reverse()},Gt.prototype.toArray=function(){return this.take(Mn)},Vo(Gt.prototype,function(a,c){var f=/^(?:filter|find|map|reject)|While$/.test(c),y=/^(?:head|last)$/.test(c),b=w[y?"take"+(c=="last"?"Right":""):c],P=y||/^find/.test(c);b&&(w.prototype[c]=function(){var B=this.__wrapped__,G=y?[1]:arguments,Z=B instanceof Gt,Ee=G[0],_e=Z||St(B),Se=function(Kt){var Wt=b.apply(w,ac([Kt],G));return y&&we?Wt[0]:Wt};_e&&f&&typeof Ee=="function"&&Ee.length!=1&&(Z=_e=!1);var we=this.__chain__,He=!!this.__actions__.length,ct=P&&!we,Ut=Z&&!He;if(!P&&_e){B=Ut?B:new Gt(this);var lt=a.apply(B,G);return lt.__actions__.push({func:MN,args:[Se],thisArg:e}),new Ps(lt,we)}return ct&&Ut?a.apply(this,G):(lt=this.thru(Se),ct?y?lt.value()[0]:lt.value():lt)})}),Fs(["pop","push","shift","sort","splice","unshift"],function(a){var c=lN[a],f=/^(?:push|sort|unshift)$/.test(a)?"tap":"thru",y=/^(?:pop|shift)$/.test(a);w.prototype[a]=function(){var b=arguments;if(y&&!this.__chain__){var P=this.value();return c.apply(St(P)?P:[],b)}return this[f](function(B){return c.apply(St(B)?B:[],b)})}}),Vo(Gt.prototype,function(a,c){var f=w[c];if(f){var y=f.name+"";bn.call(Cd,y)||(Cd[y]=[]),Cd[y].push({name:c,func:f})}}),Cd[wN(e,k).name]=[{name:"wrapper",func:e}],Gt.prototype.clone=e2,Gt.prototype.reverse=t2,Gt.prototype.value=n2,w.prototype.at=wJ,w.prototype.chain=PJ,w.prototype.commit=LJ,w.prototype.next=CJ,w.prototype.plant=kJ,w.prototype.reverse=xJ,w.prototype.toJSON=w.prototype.valueOf=w.prototype.value=MJ,w.prototype.first=w.prototype.head,Pf&&(w.prototype[Pf]=UJ),w},cc=LQ();typeof define=="function"&&typeof define.amd=="object"&&define.amd?(Kr._=cc,define(function(){return cc})):rl?((rl.exports=cc)._=cc,g_._=cc):Kr._=cc}).call(gf)});var J$=M(Id=>{"use strict";m();T();h();Object.defineProperty(Id,"__esModule",{value:!0});Id.FederationFactory=void 0;Id.federateSubgraphs=Wde;Id.federateSubgraphsWithContracts=Xde;Id.federateSubgraphsContract=Zde;var ke=(Ue(),Be(qe)),Hc=Vc(),qi=Xi(),xe=Fo(),Wc=$A(),G$=NT(),ti=ET(),Cg=oF(),Tr=jc(),Jde=_F(),zde=Gp(),Q$=fT(),Pe=Kp(),Hde=SF(),Y$=K$(),_f=Lg(),Le=zr(),Ug=kp(),Re=rs(),kg=class{constructor(t){O(this,"authorizationDataByParentTypeName");O(this,"concreteTypeNamesByAbstractTypeName");O(this,"clientDefinitions",[Tr.DEPRECATED_DEFINITION]);O(this,"currentSubgraphName","");O(this,"subgraphNamesByNamedTypeNameByFieldCoordinates",new Map);O(this,"entityDataByTypeName");O(this,"entityInterfaceFederationDataByTypeName");O(this,"errors",[]);O(this,"fieldConfigurationByFieldPath",new Map);O(this,"inaccessiblePaths",new Set);O(this,"isMaxDepth",!1);O(this,"internalGraph");O(this,"internalSubgraphBySubgraphName");O(this,"invalidOrScopesHostPaths",new Set);O(this,"isVersionTwo",!1);O(this,"namedInputValueTypeNames",new Set);O(this,"namedOutputTypeNames",new Set);O(this,"parentDefinitionDataByTypeName",new Map);O(this,"parentTagDataByTypeName",new Map);O(this,"pathsByNamedTypeName",new Map);O(this,"persistedDirectiveDefinitionByDirectiveName",new Map([[Le.AUTHENTICATED,Tr.AUTHENTICATED_DEFINITION],[Le.DEPRECATED,Tr.DEPRECATED_DEFINITION],[Le.INACCESSIBLE,Tr.INACCESSIBLE_DEFINITION],[Le.REQUIRES_SCOPES,Tr.REQUIRES_SCOPES_DEFINITION],[Le.TAG,Tr.TAG_DEFINITION]]));O(this,"persistedDirectiveDefinitions",new Set([Le.AUTHENTICATED,Le.DEPRECATED,Le.INACCESSIBLE,Le.TAG,Le.REQUIRES_SCOPES]));O(this,"potentialPersistedDirectiveDefinitionDataByDirectiveName",new Map);O(this,"routerDefinitions",[Tr.DEPRECATED_DEFINITION,Tr.TAG_DEFINITION]);O(this,"shareableErrorTypeNames",new Map);O(this,"subscriptionFilterDataByFieldPath",new Map);O(this,"tagNamesByPath",new Map);O(this,"warnings");this.authorizationDataByParentTypeName=t.authorizationDataByParentTypeName,this.concreteTypeNamesByAbstractTypeName=t.concreteTypeNamesByAbstractTypeName,this.entityDataByTypeName=t.entityDataByTypeName,this.entityInterfaceFederationDataByTypeName=t.entityInterfaceFederationDataByTypeName,this.internalSubgraphBySubgraphName=t.internalSubgraphBySubgraphName,this.internalGraph=t.internalGraph,this.warnings=t.warnings}getValidImplementedInterfaces(t){var o;let n=[];if(t.implementedInterfaceTypeNames.size<1)return n;let r=(0,Pe.isNodeDataInaccessible)(t),i=new Map,s=new Map;for(let u of t.implementedInterfaceTypeNames){n.push((0,qi.stringToNamedTypeNode)(u));let l=(0,Re.getOrThrowError)(this.parentDefinitionDataByTypeName,u,Le.PARENT_DEFINITION_DATA);if(l.kind!==ke.Kind.INTERFACE_TYPE_DEFINITION){s.set(l.name,(0,Re.kindToTypeString)(l.kind));continue}let d={invalidFieldImplementations:new Map,unimplementedFields:[]},p=!1;for(let[N,E]of l.fieldDataByFieldName){let g=!1,A=t.fieldDataByFieldName.get(N);if(!A){p=!0,d.unimplementedFields.push(N);continue}let k={invalidAdditionalArguments:new Set,invalidImplementedArguments:[],isInaccessible:!1,originalResponseType:(0,Cg.printTypeNode)(E.node.type),unimplementedArguments:new Set};(0,Pe.isTypeValidImplementation)(E.node.type,A.node.type,this.concreteTypeNamesByAbstractTypeName)||(p=!0,g=!0,k.implementedResponseType=(0,Cg.printTypeNode)(A.node.type));let j=new Set;for(let[Y,ie]of E.argumentDataByArgumentName){let re=ie.node;j.add(Y);let oe=(o=A.argumentDataByArgumentName.get(Y))==null?void 0:o.node;if(!oe){p=!0,g=!0,k.unimplementedArguments.add(Y);continue}let Oe=(0,Cg.printTypeNode)(oe.type),Ce=(0,Cg.printTypeNode)(re.type);Ce!==Oe&&(p=!0,g=!0,k.invalidImplementedArguments.push({actualType:Oe,argumentName:Y,expectedType:Ce}))}for(let[Y,ie]of A.argumentDataByArgumentName){let re=ie.node;j.has(Y)||re.type.kind===ke.Kind.NON_NULL_TYPE&&(p=!0,g=!0,k.invalidAdditionalArguments.add(Y))}!r&&A.isInaccessible&&!E.isInaccessible&&(p=!0,g=!0,k.isInaccessible=!0),g&&d.invalidFieldImplementations.set(N,k)}p&&i.set(u,d)}return s.size>0&&this.errors.push((0,xe.invalidImplementedTypeError)(t.name,s)),i.size&&this.errors.push((0,xe.invalidInterfaceImplementationError)(t.node.name.value,(0,Re.kindToTypeString)(t.kind),i)),n}addValidPrimaryKeyTargetsToEntityData(t){var p;let n=this.entityDataByTypeName.get(t);if(!n)return;let r=(0,Re.getOrThrowError)(this.internalSubgraphBySubgraphName,this.currentSubgraphName,"internalSubgraphBySubgraphName"),i=r.parentDefinitionDataByTypeName,s=i.get(n.typeName);if(!s||s.kind!==ke.Kind.OBJECT_TYPE_DEFINITION)throw(0,xe.incompatibleParentKindFatalError)(n.typeName,ke.Kind.OBJECT_TYPE_DEFINITION,(s==null?void 0:s.kind)||ke.Kind.NULL);let o=(0,Re.getOrThrowError)(r.configurationDataByTypeName,n.typeName,"internalSubgraph.configurationDataByParentTypeName"),u=[],l=this.internalGraph.nodeByNodeName.get(`${this.currentSubgraphName}.${n.typeName}`);(0,Wc.validateImplicitFieldSets)({conditionalFieldDataByCoordinates:r.conditionalFieldDataByCoordinates,configurationData:o,fieldSets:n.keyFieldSets,graphNode:l,implicitKeys:u,objectData:s,parentDefinitionDataByTypeName:i});for(let[N,E]of this.entityInterfaceFederationDataByTypeName){if(!((p=E.concreteTypeNames)!=null&&p.has(n.typeName)))continue;let g=this.entityDataByTypeName.get(N);g&&(0,Wc.validateImplicitFieldSets)({conditionalFieldDataByCoordinates:r.conditionalFieldDataByCoordinates,configurationData:o,fieldSets:g.keyFieldSets,implicitKeys:u,objectData:s,parentDefinitionDataByTypeName:i,graphNode:l})}if(u.length<1)return;if(!o.keys||o.keys.length<1){o.isRootNode=!0,o.keys=u;return}let d=new Set(o.keys.map(N=>N.selectionSet));for(let N of u)d.has(N.selectionSet)||(o.keys.push(N),d.add(N.selectionSet))}addValidPrimaryKeyTargetsFromInterfaceObject(t,n,r,i){let s=t.parentDefinitionDataByTypeName,o=s.get(n);if(!o||o.kind!==ke.Kind.INTERFACE_TYPE_DEFINITION)throw(0,xe.incompatibleParentKindFatalError)(n,ke.Kind.INTERFACE_TYPE_DEFINITION,(o==null?void 0:o.kind)||ke.Kind.NULL);let u=(0,Re.getOrThrowError)(t.configurationDataByTypeName,r.typeName,"internalSubgraph.configurationDataByParentTypeName"),l=[];if((0,Wc.validateImplicitFieldSets)({conditionalFieldDataByCoordinates:t.conditionalFieldDataByCoordinates,configurationData:u,fieldSets:r.keyFieldSets,implicitKeys:l,objectData:o,parentDefinitionDataByTypeName:s,graphNode:i}),l.length<1)return;if(!u.keys||u.keys.length<1){u.isRootNode=!0,u.keys=l;return}let d=new Set(u.keys.map(p=>p.selectionSet));for(let p of l)d.has(p.selectionSet)||(u.keys.push(p),d.add(p.selectionSet))}getEnumValueMergeMethod(t){return this.namedInputValueTypeNames.has(t)?this.namedOutputTypeNames.has(t)?Pe.MergeMethod.CONSISTENT:Pe.MergeMethod.INTERSECTION:Pe.MergeMethod.UNION}generateTagData(){for(let[t,n]of this.tagNamesByPath){let r=t.split(".");if(r.length<1)continue;let i=(0,Re.getValueOrDefault)(this.parentTagDataByTypeName,r[0],()=>(0,Wc.newParentTagData)(r[0]));switch(r.length){case 1:for(let l of n)i.tagNames.add(l);break;case 2:let s=(0,Re.getValueOrDefault)(i.childTagDataByChildName,r[1],()=>(0,Wc.newChildTagData)(r[1]));for(let l of n)s.tagNames.add(l);break;case 3:let o=(0,Re.getValueOrDefault)(i.childTagDataByChildName,r[1],()=>(0,Wc.newChildTagData)(r[1])),u=(0,Re.getValueOrDefault)(o.tagNamesByArgumentName,r[2],()=>new Set);for(let l of n)u.add(l);break;default:break}}}upsertEnumValueData(t,n,r){let i=t.get(n.name),s=i||n,o=`${n.parentTypeName}.${n.name}`;(0,Pe.extractPersistedDirectives)(s.persistedDirectivesData,n.directivesByDirectiveName,this.persistedDirectiveDefinitionByDirectiveName);let u=(0,Pe.isNodeDataInaccessible)(n);if((r||u)&&this.inaccessiblePaths.add(o),this.recordTagNamesByPath(s,o),!i){n.node={directives:[],kind:n.node.kind,name:(0,qi.stringToNameNode)(n.name)},t.set(n.name,n);return}i.appearances+=1,(0,ti.addMapEntries)(n.configureDescriptionDataBySubgraphName,i.configureDescriptionDataBySubgraphName),(0,Pe.setLongestDescription)(i,n),(0,Re.addIterableValuesToSet)(n.subgraphNames,i.subgraphNames)}upsertInputValueData(t,n,r){let i=t.get(n.name),s=i||n;if((0,Pe.extractPersistedDirectives)(s.persistedDirectivesData,n.directivesByDirectiveName,this.persistedDirectiveDefinitionByDirectiveName),this.recordTagNamesByPath(s,r||s.renamedPath),!i){n.node={directives:[],kind:n.node.kind,name:(0,qi.stringToNameNode)(n.name),type:n.type},t.set(n.name,n);return}(0,ti.addMapEntries)(n.configureDescriptionDataBySubgraphName,i.configureDescriptionDataBySubgraphName),(0,Pe.setLongestDescription)(i,n),(0,Re.addIterableValuesToSet)(n.requiredSubgraphNames,i.requiredSubgraphNames),(0,Re.addIterableValuesToSet)(n.subgraphNames,i.subgraphNames);let{typeErrors:o,typeNode:u}=(0,_f.getMostRestrictiveMergedTypeNode)(i.type,n.type,i.originalPath,this.errors);if(u)i.type=u;else{if(!o||o.length<2)throw(0,xe.fieldTypeMergeFatalError)(i.name);i.isArgument?this.errors.push((0,xe.incompatibleArgumentTypesError)(i.name,i.renamedPath,o[0],o[1])):this.errors.push((0,xe.incompatibleChildTypesError)(i.renamedPath,o[0],o[1]))}(0,Pe.compareAndValidateInputValueDefaultValues)(i,n,this.errors)}handleArgumentInaccessibility(t,n,r,i){if(t){this.inaccessiblePaths.add(r);return}(0,Pe.isNodeDataInaccessible)(n)&&((0,Pe.isTypeRequired)(n.type)?this.errors.push((0,xe.inaccessibleRequiredArgumentError)(n.name,r,i)):this.inaccessiblePaths.add(r))}handleSubscriptionFilterDirective(t,n,r){let i=t.directivesByDirectiveName.get(Le.SUBSCRIPTION_FILTER);if(i){let s=(0,ti.getSingleSetEntry)(t.subgraphNames);if(s===void 0){this.errors.push((0,xe.unknownFieldSubgraphNameError)(n));return}this.subscriptionFilterDataByFieldPath.set(n,{directive:i[0],fieldData:r||t,directiveSubgraphName:s})}}federateOutputType({current:t,other:n,hostPath:r,mostRestrictive:i}){n=(0,Hc.getMutableTypeNode)(n,r,this.errors);let s={kind:t.kind},o=_f.DivergentType.NONE,u=s;for(let l=0;l<Ug.MAXIMUM_TYPE_NESTING;l++){if(t.kind===n.kind)switch(t.kind){case ke.Kind.NAMED_TYPE:return u.kind=t.kind,u.name=t.name,{success:!0,typeNode:s};case ke.Kind.LIST_TYPE:u.kind=t.kind,u.type={kind:t.type.kind},u=u.type,t=t.type,n=n.type;continue;case ke.Kind.NON_NULL_TYPE:u.kind=t.kind,u.type={kind:t.type.kind},u=u.type,t=t.type,n=n.type;continue}if(t.kind===ke.Kind.NON_NULL_TYPE){if(o===_f.DivergentType.OTHER)return this.errors.push((0,xe.incompatibleChildTypesError)(r,t.kind,n.kind)),{success:!1};o=_f.DivergentType.CURRENT,i&&(u.kind=t.kind,u.type={kind:t.type.kind},u=u.type),t=t.type;continue}if(n.kind===ke.Kind.NON_NULL_TYPE){if(o===_f.DivergentType.CURRENT)return this.errors.push((0,xe.incompatibleChildTypesError)(r,t.kind,n.kind)),{success:!1};o=_f.DivergentType.OTHER,i&&(u.kind=n.kind,u.type={kind:n.type.kind},u=u.type),n=n.type;continue}return this.errors.push((0,xe.incompatibleChildTypesError)(r,t.kind,n.kind)),{success:!1}}return this.errors.push((0,xe.maximumTypeNestingExceededError)(r)),{success:!1}}addSubgraphNameToExistingFieldNamedTypeDisparity(t){let n=this.subgraphNamesByNamedTypeNameByFieldCoordinates.get(`${t.renamedParentTypeName}.${t.name}`);n&&(0,Re.addIterableValuesToSet)(t.subgraphNames,(0,Re.getValueOrDefault)(n,t.namedTypeName,()=>new Set))}upsertFieldData(t,n,r){let i=`${n.renamedParentTypeName}.${n.name}`;(0,Re.getValueOrDefault)(this.pathsByNamedTypeName,n.namedTypeName,()=>new Set).add(i),this.namedOutputTypeNames.add(n.namedTypeName);let s=t.get(n.name),o=s||n;this.handleSubscriptionFilterDirective(n,i,o),(0,Pe.extractPersistedDirectives)(o.persistedDirectivesData,n.directivesByDirectiveName,this.persistedDirectiveDefinitionByDirectiveName);let u=(0,Pe.isNodeDataInaccessible)(n);if((r||u)&&this.inaccessiblePaths.add(i),this.recordTagNamesByPath(o,i),!s){n.description=this.getInitialNodeDescription(n),t.set(n.name,n),n.node={arguments:[],directives:[],kind:n.node.kind,name:(0,qi.stringToNameNode)(n.name),type:n.type};for(let[d,p]of n.argumentDataByArgumentName){p.description=this.getInitialNodeDescription(p),p.node={directives:[],kind:p.node.kind,name:(0,qi.stringToNameNode)(p.name),type:p.type};let N=(0,Hc.getTypeNodeNamedTypeName)(p.type);(0,Re.getValueOrDefault)(this.pathsByNamedTypeName,N,()=>new Set).add(p.renamedPath),this.namedInputValueTypeNames.add(N),(0,Pe.extractPersistedDirectives)(p.persistedDirectivesData,p.directivesByDirectiveName,this.persistedDirectiveDefinitionByDirectiveName),this.handleArgumentInaccessibility(r||u,p,p.renamedPath,i),this.recordTagNamesByPath(p,`${i}.${d}`)}return}let l=this.federateOutputType({current:s.type,other:n.type,hostPath:i,mostRestrictive:!1});if(l.success)if(s.type=l.typeNode,s.namedTypeName!==n.namedTypeName){let d=(0,Re.getValueOrDefault)(this.subgraphNamesByNamedTypeNameByFieldCoordinates,`${s.renamedParentTypeName}.${s.name}`,()=>new Map),p=(0,Re.getValueOrDefault)(d,s.namedTypeName,()=>new Set);if(p.size<1)for(let N of s.subgraphNames)n.subgraphNames.has(N)||p.add(N);(0,Re.addIterableValuesToSet)(n.subgraphNames,(0,Re.getValueOrDefault)(d,n.namedTypeName,()=>new Set))}else this.addSubgraphNameToExistingFieldNamedTypeDisparity(n);for(let[d,p]of n.argumentDataByArgumentName){let N=(0,Hc.getTypeNodeNamedTypeName)(p.type);(0,Re.getValueOrDefault)(this.pathsByNamedTypeName,N,()=>new Set).add(p.renamedPath),this.namedInputValueTypeNames.add(N),this.handleArgumentInaccessibility(r||u,p,p.renamedPath,i),this.upsertInputValueData(s.argumentDataByArgumentName,p,`${i}.${d}`)}(0,ti.addMapEntries)(n.configureDescriptionDataBySubgraphName,s.configureDescriptionDataBySubgraphName),(0,Pe.setLongestDescription)(s,n),s.isInaccessible||(s.isInaccessible=n.isInaccessible),(0,ti.addMapEntries)(n.isExternalBySubgraphName,s.isExternalBySubgraphName),(0,ti.addMapEntries)(n.isShareableBySubgraphName,s.isShareableBySubgraphName),(0,Re.addIterableValuesToSet)(n.subgraphNames,s.subgraphNames)}getClientSchemaUnionMembers(t){let n=[];for(let[r,i]of t.memberByMemberTypeName)this.inaccessiblePaths.has(r)||n.push(i);return n}recordTagNamesByPath(t,n){let r=n||t.name;if(t.persistedDirectivesData.tags.size>0){let i=(0,Re.getValueOrDefault)(this.tagNamesByPath,r,()=>new Set);for(let s of t.persistedDirectivesData.tags.keys())i.add(s)}}upsertParentDefinitionData(t,n){let r=this.entityInterfaceFederationDataByTypeName.get(t.name),i=this.parentDefinitionDataByTypeName.get(t.name),s=i||t;(0,Pe.extractPersistedDirectives)(s.persistedDirectivesData,t.directivesByDirectiveName,this.persistedDirectiveDefinitionByDirectiveName),this.recordTagNamesByPath(s);let o=(0,Pe.isNodeDataInaccessible)(s);if(o&&this.inaccessiblePaths.add(t.name),r&&r.interfaceObjectSubgraphs.has(n)&&(t.kind=ke.Kind.INTERFACE_TYPE_DEFINITION),!i)switch(t.description=this.getInitialNodeDescription(t),t.node={kind:t.kind,name:(0,qi.stringToNameNode)(t.name)},this.parentDefinitionDataByTypeName.set(t.name,t),t.kind){case ke.Kind.ENUM_TYPE_DEFINITION:for(let[u,l]of t.enumValueDataByValueName){let d=`${t.name}.${u}`;l.description=this.getInitialNodeDescription(l),l.node={directives:[],kind:l.node.kind,name:(0,qi.stringToNameNode)(l.name)},(0,Pe.extractPersistedDirectives)(l.persistedDirectivesData,l.directivesByDirectiveName,this.persistedDirectiveDefinitionByDirectiveName),this.recordTagNamesByPath(l,d),(0,Pe.isNodeDataInaccessible)(l)&&this.inaccessiblePaths.add(d)}return;case ke.Kind.INPUT_OBJECT_TYPE_DEFINITION:for(let[u,l]of t.inputValueDataByValueName){l.description=this.getInitialNodeDescription(l),l.node={directives:[],kind:l.node.kind,name:(0,qi.stringToNameNode)(l.name),type:l.type};let d=(0,Hc.getTypeNodeNamedTypeName)(l.type);(0,Re.getValueOrDefault)(this.pathsByNamedTypeName,d,()=>new Set).add(l.renamedPath),this.namedInputValueTypeNames.add(d),(0,Pe.extractPersistedDirectives)(l.persistedDirectivesData,l.directivesByDirectiveName,this.persistedDirectiveDefinitionByDirectiveName),this.recordTagNamesByPath(l,`${t.name}.${u}`),(o||(0,Pe.isNodeDataInaccessible)(l))&&this.inaccessiblePaths.add(l.renamedPath)}return;case ke.Kind.INTERFACE_TYPE_DEFINITION:case ke.Kind.OBJECT_TYPE_DEFINITION:(0,Pe.isParentDataRootType)(t)&&(t.extensionType=Q$.ExtensionType.NONE);for(let[u,l]of t.fieldDataByFieldName){l.description=this.getInitialNodeDescription(l),l.node={arguments:[],directives:[],kind:l.node.kind,name:(0,qi.stringToNameNode)(l.name),type:l.type};let d=`${l.renamedParentTypeName}.${u}`;this.handleSubscriptionFilterDirective(l,d),(0,Re.getValueOrDefault)(this.pathsByNamedTypeName,l.namedTypeName,()=>new Set).add(d),this.namedOutputTypeNames.add(l.namedTypeName),(0,Pe.extractPersistedDirectives)(l.persistedDirectivesData,l.directivesByDirectiveName,this.persistedDirectiveDefinitionByDirectiveName),this.recordTagNamesByPath(l,d);let p=(0,Pe.isNodeDataInaccessible)(l);(o||p)&&this.inaccessiblePaths.add(d);for(let[N,E]of l.argumentDataByArgumentName){E.description=this.getInitialNodeDescription(E),E.node={directives:[],kind:E.node.kind,name:(0,qi.stringToNameNode)(E.name),type:E.type};let g=(0,Hc.getTypeNodeNamedTypeName)(E.type);(0,Re.getValueOrDefault)(this.pathsByNamedTypeName,g,()=>new Set).add(E.renamedPath),this.namedInputValueTypeNames.add(g),(0,Pe.extractPersistedDirectives)(E.persistedDirectivesData,E.directivesByDirectiveName,this.persistedDirectiveDefinitionByDirectiveName),this.recordTagNamesByPath(E,`${d}.${N}`),this.handleArgumentInaccessibility(o||p,E,E.renamedPath,d)}}return;default:return}if(i.kind!==t.kind&&(!r||!r.interfaceObjectSubgraphs.has(n)||i.kind!==ke.Kind.INTERFACE_TYPE_DEFINITION||t.kind!==ke.Kind.OBJECT_TYPE_DEFINITION)){this.errors.push((0,xe.incompatibleParentKindMergeError)(i.name,(0,Re.kindToTypeString)(i.kind),(0,Re.kindToTypeString)(t.kind)));return}switch((0,ti.addMapEntries)(t.configureDescriptionDataBySubgraphName,i.configureDescriptionDataBySubgraphName),(0,Pe.setLongestDescription)(i,t),(0,Pe.setParentDataExtensionType)(i,t),i.kind){case ke.Kind.ENUM_TYPE_DEFINITION:i.appearances+=1,(0,Re.addIterableValuesToSet)(t.subgraphNames,i.subgraphNames);for(let l of t.enumValueDataByValueName.values())this.upsertEnumValueData(i.enumValueDataByValueName,l,o);return;case ke.Kind.INPUT_OBJECT_TYPE_DEFINITION:o&&!i.isInaccessible&&this.propagateInaccessibilityToExistingChildren(i),(0,Re.addIterableValuesToSet)(t.subgraphNames,i.subgraphNames);for(let[l,d]of t.inputValueDataByValueName){let p=`${t.name}.${l}`,N=(0,Hc.getTypeNodeNamedTypeName)(d.type);(0,Re.getValueOrDefault)(this.pathsByNamedTypeName,N,()=>new Set).add(p),this.namedInputValueTypeNames.add(N),this.upsertInputValueData(i.inputValueDataByValueName,d),this.recordTagNamesByPath(d,p),(o||(0,Pe.isNodeDataInaccessible)(d))&&this.inaccessiblePaths.add(p)}return;case ke.Kind.INTERFACE_TYPE_DEFINITION:case ke.Kind.OBJECT_TYPE_DEFINITION:o&&!i.isInaccessible&&this.propagateInaccessibilityToExistingChildren(i);let u=t;(0,Re.addIterableValuesToSet)(u.implementedInterfaceTypeNames,i.implementedInterfaceTypeNames),(0,Re.addIterableValuesToSet)(u.subgraphNames,i.subgraphNames);for(let l of u.fieldDataByFieldName.values())this.upsertFieldData(i.fieldDataByFieldName,l,o||i.isInaccessible);return;case ke.Kind.UNION_TYPE_DEFINITION:(0,ti.addMapEntries)(t.memberByMemberTypeName,i.memberByMemberTypeName);return;default:return}}propagateInaccessibilityToExistingChildren(t){switch(t.isInaccessible=!0,t.kind){case ke.Kind.INPUT_OBJECT_TYPE_DEFINITION:for(let n of t.inputValueDataByValueName.keys())this.inaccessiblePaths.add(`${t.name}.${n}`);break;default:for(let[n,r]of t.fieldDataByFieldName){let i=`${r.renamedParentTypeName}.${n}`;this.inaccessiblePaths.add(i);for(let s of r.argumentDataByArgumentName.values())this.inaccessiblePaths.add(s.renamedPath)}}}upsertPersistedDirectiveDefinitionData(t,n){let r=t.name,i=this.potentialPersistedDirectiveDefinitionDataByDirectiveName.get(r);if(!i){if(n>1)return;let s=new Map;for(let o of t.argumentDataByArgumentName.values())this.namedInputValueTypeNames.add((0,Hc.getTypeNodeNamedTypeName)(o.type)),this.upsertInputValueData(s,o);this.potentialPersistedDirectiveDefinitionDataByDirectiveName.set(r,{argumentDataByArgumentName:s,executableLocations:new Set(t.executableLocations),name:r,repeatable:t.repeatable,subgraphNames:new Set(t.subgraphNames),description:t.description});return}if(i.subgraphNames.size+1!==n){this.potentialPersistedDirectiveDefinitionDataByDirectiveName.delete(r);return}if((0,Pe.setMutualExecutableLocations)(i,t.executableLocations),i.executableLocations.size<1){this.potentialPersistedDirectiveDefinitionDataByDirectiveName.delete(r);return}for(let s of t.argumentDataByArgumentName.values())this.namedInputValueTypeNames.add((0,Hc.getTypeNodeNamedTypeName)(s.type)),this.upsertInputValueData(i.argumentDataByArgumentName,s);(0,Pe.setLongestDescription)(i,t),i.repeatable&&(i.repeatable=t.repeatable),(0,Re.addIterableValuesToSet)(t.subgraphNames,i.subgraphNames)}shouldUpdateFederatedFieldAbstractNamedType(t,n){if(!t)return!1;let r=this.concreteTypeNamesByAbstractTypeName.get(t);if(!r||r.size<1)return!1;for(let i of n)if(!r.has(i))return!1;return!0}updateTypeNodeNamedType(t,n){let r=t;for(let i=0;i<Ug.MAXIMUM_TYPE_NESTING;i++){if(r.kind===ke.Kind.NAMED_TYPE){r.name=(0,qi.stringToNameNode)(n);return}r=r.type}}handleDisparateFieldNamedTypes(){for(let[t,n]of this.subgraphNamesByNamedTypeNameByFieldCoordinates){let r=t.split(Le.PERIOD);if(r.length!==2)continue;let i=this.parentDefinitionDataByTypeName.get(r[0]);if(!i){this.errors.push((0,xe.undefinedTypeError)(r[0]));continue}if(i.kind!==ke.Kind.INTERFACE_TYPE_DEFINITION&&i.kind!==ke.Kind.OBJECT_TYPE_DEFINITION){this.errors.push((0,xe.unexpectedNonCompositeOutputTypeError)(r[0],(0,Re.kindToTypeString)(i.kind)));continue}let s=i.fieldDataByFieldName.get(r[1]);if(!s){this.errors.push((0,xe.unknownFieldDataError)(t));continue}let o=new Map,u=new Set,l="";for(let p of n.keys()){if(Tr.BASE_SCALARS.has(p)){this.errors.push((0,xe.incompatibleFederatedFieldNamedTypeError)(t,n));break}let N=this.parentDefinitionDataByTypeName.get(p);if(!N){this.errors.push((0,xe.unknownNamedTypeError)(t,p));break}switch(N.kind){case ke.Kind.INTERFACE_TYPE_DEFINITION:{o.set(N.name,N);break}case ke.Kind.OBJECT_TYPE_DEFINITION:{if(u.add(N.name),u.size>1){this.errors.push((0,xe.incompatibleFederatedFieldNamedTypeError)(t,n));continue}break}case ke.Kind.UNION_TYPE_DEFINITION:{if(l){this.errors.push((0,xe.incompatibleFederatedFieldNamedTypeError)(t,n));continue}l=p;break}default:{this.errors.push((0,xe.incompatibleFederatedFieldNamedTypeError)(t,n));break}}}if(o.size<0&&!l){this.errors.push((0,xe.incompatibleFederatedFieldNamedTypeError)(t,n));continue}let d=l;if(o.size>0){if(l){this.errors.push((0,xe.incompatibleFederatedFieldNamedTypeError)(t,n));continue}for(let p of o.keys()){d=p;for(let[N,E]of o)if(p!==N&&!E.implementedInterfaceTypeNames.has(p)){d="";break}if(d)break}}if(!this.shouldUpdateFederatedFieldAbstractNamedType(d,u)){this.errors.push((0,xe.incompatibleFederatedFieldNamedTypeError)(t,n));continue}s.namedTypeName=d,this.updateTypeNodeNamedType(s.type,d)}}federateInternalSubgraphData(){let t=0,n=!1;for(let r of this.internalSubgraphBySubgraphName.values()){t+=1,this.currentSubgraphName=r.name,this.isVersionTwo||(this.isVersionTwo=r.isVersionTwo),(0,Hde.renameRootTypes)(this,r);for(let i of r.parentDefinitionDataByTypeName.values())this.upsertParentDefinitionData(i,r.name);if(!n){if(!r.persistedDirectiveDefinitionDataByDirectiveName.size){n=!0;continue}for(let i of r.persistedDirectiveDefinitionDataByDirectiveName.values())this.upsertPersistedDirectiveDefinitionData(i,t);this.potentialPersistedDirectiveDefinitionDataByDirectiveName.size<1&&(n=!0)}}this.handleDisparateFieldNamedTypes()}handleInterfaceObjectForInternalGraph({entityData:t,internalSubgraph:n,interfaceObjectData:r,interfaceObjectNode:i,resolvableKeyFieldSets:s,subgraphName:o}){let u=this.internalGraph.addOrUpdateNode(t.typeName),l=this.internalGraph.addEntityDataNode(t.typeName);for(let p of i.satisfiedFieldSets)u.satisfiedFieldSets.add(p),s.has(p)&&l.addTargetSubgraphByFieldSet(p,o);let d=r.fieldDatasBySubgraphName.get(o);for(let{name:p,namedTypeName:N}of d||[])this.internalGraph.addEdge(u,this.internalGraph.addOrUpdateNode(N),p);this.internalGraph.addEdge(i,u,t.typeName,!0),this.addValidPrimaryKeyTargetsFromInterfaceObject(n,i.typeName,t,u)}handleEntityInterfaces(){for(let[t,n]of this.entityInterfaceFederationDataByTypeName){(0,ti.subtractSourceSetFromTargetSet)(n.interfaceFieldNames,n.interfaceObjectFieldNames);let r=(0,Re.getOrThrowError)(this.parentDefinitionDataByTypeName,t,Le.PARENT_DEFINITION_DATA);if(r.kind===ke.Kind.INTERFACE_TYPE_DEFINITION)for(let i of n.interfaceObjectSubgraphs){let s=(0,Re.getOrThrowError)(this.internalSubgraphBySubgraphName,i,"internalSubgraphBySubgraphName"),o=s.configurationDataByTypeName,u=this.concreteTypeNamesByAbstractTypeName.get(t);if(!u)continue;let l=(0,Re.getOrThrowError)(o,t,"configurationDataMap"),d=l.keys;if(!d)continue;l.entityInterfaceConcreteTypeNames=n.concreteTypeNames;let p=l.fieldNames,N=this.authorizationDataByParentTypeName.get(n.typeName);this.internalGraph.setSubgraphName(i);let E=this.internalGraph.addOrUpdateNode(t,{isAbstract:!0});for(let g of u){if(o.has(g))continue;if(N){let ie=(0,Re.getValueOrDefault)(this.authorizationDataByParentTypeName,g,()=>(0,ti.newAuthorizationData)(g));for(let re of N.fieldAuthorizationDataByFieldName.values())(0,ti.upsertFieldAuthorizationData)(ie.fieldAuthorizationDataByFieldName,re)||this.invalidOrScopesHostPaths.add(`${g}.${re.fieldName}`)}let A=(0,Re.getOrThrowError)(this.parentDefinitionDataByTypeName,g,Le.PARENT_DEFINITION_DATA);if(A.kind!==ke.Kind.OBJECT_TYPE_DEFINITION)continue;let k=(0,Re.getOrThrowError)(this.entityDataByTypeName,g,"entityDataByTypeName");k.subgraphNames.add(i);let j={fieldNames:p,isRootNode:!0,keys:d,typeName:g},Y=new Set;for(let ie of d.filter(re=>!re.disableEntityResolver))Y.add(ie.selectionSet);for(let ie of n.interfaceObjectFieldNames){if(A.fieldDataByFieldName.get(ie))continue;let oe=(0,Re.getOrThrowError)(r.fieldDataByFieldName,ie,`${t}.fieldDataByFieldName`);A.fieldDataByFieldName.set(ie,C({},oe))}o.set(g,j),this.handleInterfaceObjectForInternalGraph({internalSubgraph:s,subgraphName:i,interfaceObjectData:n,interfaceObjectNode:E,resolvableKeyFieldSets:Y,entityData:k})}}}}fieldDataToGraphFieldData(t){var n;return{name:t.name,namedTypeName:t.namedTypeName,isLeaf:(0,ti.isNodeLeaf)((n=this.parentDefinitionDataByTypeName.get(t.namedTypeName))==null?void 0:n.kind),subgraphNames:t.subgraphNames}}getValidFlattenedPersistedDirectiveNodeArray(t,n){let r=[];for(let[i,s]of t){let o=this.persistedDirectiveDefinitionByDirectiveName.get(i);if(o){if(s.length<2){r.push(...s);continue}if(!o.repeatable){this.errors.push((0,xe.invalidRepeatedFederatedDirectiveErrorMessage)(i,n));continue}r.push(...s)}}return r}getRouterPersistedDirectiveNodes(t){let n=[...t.persistedDirectivesData.tags.values()];return t.persistedDirectivesData.isDeprecated&&n.push((0,Pe.generateDeprecatedDirective)(t.persistedDirectivesData.deprecatedReason)),n.push(...this.getValidFlattenedPersistedDirectiveNodeArray(t.persistedDirectivesData.directives,t.name)),n}getInitialNodeDescription(t){if(t.configureDescriptionDataBySubgraphName.size<1)return t.description;for(let{propagate:n,description:r}of t.configureDescriptionDataBySubgraphName.values())return n?(0,Wc.getDescriptionFromString)(r)||t.description:void 0}getFederatedGraphNodeDescription(t){if(t.configureDescriptionDataBySubgraphName.size<1)return t.description;let n=[],r="";for(let[i,{propagate:s,description:o}]of t.configureDescriptionDataBySubgraphName)s&&(n.push(i),r=o);if(n.length===1)return(0,Wc.getDescriptionFromString)(r);if(n.length<1)return t.description;this.errors.push((0,xe.configureDescriptionPropagationError)((0,Pe.getDefinitionDataCoords)(t,!0),n))}getNodeForRouterSchemaByData(t){return t.node.name=(0,qi.stringToNameNode)(t.name),t.node.description=this.getFederatedGraphNodeDescription(t),t.node.directives=this.getRouterPersistedDirectiveNodes(t),t.node}getNodeWithPersistedDirectivesByInputValueData(t){return t.node.name=(0,qi.stringToNameNode)(t.name),t.node.type=t.type,t.node.description=this.getFederatedGraphNodeDescription(t),t.node.directives=this.getRouterPersistedDirectiveNodes(t),t.includeDefaultValue&&(t.node.defaultValue=t.defaultValue),t.node}getValidFieldArgumentNodes(t){let n=[],r=[],i=[],s=`${t.renamedParentTypeName}.${t.name}`;for(let[o,u]of t.argumentDataByArgumentName)t.subgraphNames.size===u.subgraphNames.size?(r.push(o),n.push(this.getNodeWithPersistedDirectivesByInputValueData(u))):(0,Pe.isTypeRequired)(u.type)&&i.push({inputValueName:o,missingSubgraphs:(0,Re.getEntriesNotInHashSet)(t.subgraphNames,u.subgraphNames),requiredSubgraphs:[...u.requiredSubgraphNames]});return i.length>0?this.errors.push((0,xe.invalidRequiredInputValueError)(Le.FIELD,s,i)):r.length>0&&((0,Re.getValueOrDefault)(this.fieldConfigurationByFieldPath,s,()=>({argumentNames:r,fieldName:t.name,typeName:t.renamedParentTypeName})).argumentNames=r),n}getNodeWithPersistedDirectivesByFieldData(t,n){return t.node.arguments=n,t.node.name=(0,qi.stringToNameNode)(t.name),t.node.type=t.type,t.node.description=this.getFederatedGraphNodeDescription(t),t.node.directives=this.getRouterPersistedDirectiveNodes(t),t.node}pushParentDefinitionDataToDocumentDefinitions(t){for(let[n,r]of this.parentDefinitionDataByTypeName)switch(r.extensionType!==Q$.ExtensionType.NONE&&this.errors.push((0,xe.noBaseDefinitionForExtensionError)((0,Re.kindToTypeString)(r.kind),n)),r.kind){case ke.Kind.ENUM_TYPE_DEFINITION:let i=[],s=[],o=this.getEnumValueMergeMethod(n);for(let Y of r.enumValueDataByValueName.values()){let ie=(0,Pe.getNodeForRouterSchemaByData)(Y,this.persistedDirectiveDefinitionByDirectiveName,this.errors),re=(0,Pe.isNodeDataInaccessible)(Y),oe=Q(C({},Y.node),{directives:(0,Pe.getClientPersistedDirectiveNodes)(Y)});switch(o){case Pe.MergeMethod.CONSISTENT:!re&&r.appearances>Y.appearances&&this.errors.push((0,xe.incompatibleSharedEnumError)(n)),i.push(ie),re||s.push(oe);break;case Pe.MergeMethod.INTERSECTION:r.appearances===Y.appearances&&(i.push(ie),re||s.push(oe));break;default:i.push(ie),re||s.push(oe);break}}if(r.node.values=i,this.routerDefinitions.push(this.getNodeForRouterSchemaByData(r)),(0,Pe.isNodeDataInaccessible)(r)){this.validateReferencesOfInaccessibleType(r),this.internalGraph.setNodeInaccessible(r.name);break}if(s.length<1){this.errors.push((0,xe.allChildDefinitionsAreInaccessibleError)((0,Re.kindToTypeString)(r.kind),n,Le.ENUM_VALUE));break}this.clientDefinitions.push(Q(C({},r.node),{directives:(0,Pe.getClientPersistedDirectiveNodes)(r),values:s}));break;case ke.Kind.INPUT_OBJECT_TYPE_DEFINITION:let u=[],l=[],d=[];for(let[Y,ie]of r.inputValueDataByValueName)if(r.subgraphNames.size===ie.subgraphNames.size){if(l.push(this.getNodeWithPersistedDirectivesByInputValueData(ie)),(0,Pe.isNodeDataInaccessible)(ie))continue;d.push(Q(C({},ie.node),{directives:(0,Pe.getClientPersistedDirectiveNodes)(ie)}))}else(0,Pe.isTypeRequired)(ie.type)&&u.push({inputValueName:Y,missingSubgraphs:(0,Re.getEntriesNotInHashSet)(r.subgraphNames,ie.subgraphNames),requiredSubgraphs:[...ie.requiredSubgraphNames]});if(u.length>0){this.errors.push((0,xe.invalidRequiredInputValueError)(Le.INPUT_OBJECT,n,u,!1));break}if(r.node.fields=l,this.routerDefinitions.push(this.getNodeForRouterSchemaByData(r)),(0,Pe.isNodeDataInaccessible)(r)){this.validateReferencesOfInaccessibleType(r);break}if(d.length<1){this.errors.push((0,xe.allChildDefinitionsAreInaccessibleError)((0,Re.kindToTypeString)(r.kind),n,"input field"));break}this.clientDefinitions.push(Q(C({},r.node),{directives:(0,Pe.getClientPersistedDirectiveNodes)(r),fields:d}));break;case ke.Kind.INTERFACE_TYPE_DEFINITION:case ke.Kind.OBJECT_TYPE_DEFINITION:let p=[],N=[],E=new Map,g=(0,Pe.newInvalidFieldNames)(),A=r.kind===ke.Kind.OBJECT_TYPE_DEFINITION;for(let[Y,ie]of r.fieldDataByFieldName){(0,Pe.pushAuthorizationDirectives)(ie,this.authorizationDataByParentTypeName.get(n));let re=this.getValidFieldArgumentNodes(ie);A&&(0,Pe.validateExternalAndShareable)(ie,g),p.push(this.getNodeWithPersistedDirectivesByFieldData(ie,re)),!(0,Pe.isNodeDataInaccessible)(ie)&&(N.push((0,Pe.getClientSchemaFieldNodeByFieldData)(ie)),E.set(Y,this.fieldDataToGraphFieldData(ie)))}if(A&&(g.byShareable.size>0&&this.errors.push((0,xe.invalidFieldShareabilityError)(r,g.byShareable)),g.subgraphNamesByExternalFieldName.size>0&&this.errors.push((0,xe.allExternalFieldInstancesError)(n,g.subgraphNamesByExternalFieldName))),r.node.fields=p,this.internalGraph.initializeNode(n,E),r.implementedInterfaceTypeNames.size>0){t.push({data:r,clientSchemaFieldNodes:N});break}this.routerDefinitions.push(this.getNodeForRouterSchemaByData(r));let k=(0,zde.isNodeQuery)(n);if((0,Pe.isNodeDataInaccessible)(r)){if(k){this.errors.push(xe.inaccessibleQueryRootTypeError);break}this.validateReferencesOfInaccessibleType(r),this.internalGraph.setNodeInaccessible(r.name);break}if(N.length<1){let Y=k?(0,xe.noQueryRootTypeError)(!1):(0,xe.allChildDefinitionsAreInaccessibleError)((0,Re.kindToTypeString)(r.kind),n,Le.FIELD);this.errors.push(Y);break}this.clientDefinitions.push(Q(C({},r.node),{directives:(0,Pe.getClientPersistedDirectiveNodes)(r),fields:N}));break;case ke.Kind.SCALAR_TYPE_DEFINITION:if(Tr.BASE_SCALARS.has(n))break;if(this.routerDefinitions.push(this.getNodeForRouterSchemaByData(r)),(0,Pe.isNodeDataInaccessible)(r)){this.validateReferencesOfInaccessibleType(r),this.internalGraph.setNodeInaccessible(r.name);break}this.clientDefinitions.push(Q(C({},r.node),{directives:(0,Pe.getClientPersistedDirectiveNodes)(r)}));break;case ke.Kind.UNION_TYPE_DEFINITION:if(r.node.types=(0,ti.mapToArrayOfValues)(r.memberByMemberTypeName),this.routerDefinitions.push(this.getNodeForRouterSchemaByData(r)),(0,Pe.isNodeDataInaccessible)(r)){this.validateReferencesOfInaccessibleType(r),this.internalGraph.setNodeInaccessible(r.name);break}let j=this.getClientSchemaUnionMembers(r);if(j.length<1){this.errors.push((0,xe.allChildDefinitionsAreInaccessibleError)(Le.UNION,n,"union member type"));break}this.clientDefinitions.push(Q(C({},r.node),{directives:(0,Pe.getClientPersistedDirectiveNodes)(r),types:j}));break}}federateSubgraphData(){this.federateInternalSubgraphData(),this.handleEntityInterfaces(),this.generateTagData(),this.pushVersionTwoDirectiveDefinitionsToDocumentDefinitions()}validateInterfaceImplementationsAndPushToDocumentDefinitions(t){for(let{data:n,clientSchemaFieldNodes:r}of t){if(n.node.interfaces=this.getValidImplementedInterfaces(n),this.routerDefinitions.push((0,Pe.getNodeForRouterSchemaByData)(n,this.persistedDirectiveDefinitionByDirectiveName,this.errors)),(0,Pe.isNodeDataInaccessible)(n)){this.validateReferencesOfInaccessibleType(n),this.internalGraph.setNodeInaccessible(n.name);continue}let i=[];for(let s of n.implementedInterfaceTypeNames)this.inaccessiblePaths.has(s)||i.push((0,qi.stringToNamedTypeNode)(s));this.clientDefinitions.push(Q(C({},n.node),{directives:(0,Pe.getClientPersistedDirectiveNodes)(n),fields:r,interfaces:i}))}}pushVersionTwoDirectiveDefinitionsToDocumentDefinitions(){this.isVersionTwo&&(this.routerDefinitions=[Tr.AUTHENTICATED_DEFINITION,Tr.DEPRECATED_DEFINITION,Tr.INACCESSIBLE_DEFINITION,Tr.REQUIRES_SCOPES_DEFINITION,Tr.TAG_DEFINITION,Tr.SCOPE_SCALAR_DEFINITION],this.clientDefinitions=[Tr.AUTHENTICATED_DEFINITION,Tr.DEPRECATED_DEFINITION,Tr.REQUIRES_SCOPES_DEFINITION,Tr.SCOPE_SCALAR_DEFINITION])}validatePathSegmentInaccessibility(t){if(!t)return!1;let r=t.split(Le.LEFT_PARENTHESIS)[0].split(Le.PERIOD),i=r[0];for(let s=0;s<r.length;s++){if(this.inaccessiblePaths.has(i))return!0;i+=`.${r[s+1]}`}return!1}validateReferencesOfInaccessibleType(t){let n=this.pathsByNamedTypeName.get(t.name);if(!n||n.size<1)return;let r=[];for(let i of n)this.inaccessiblePaths.has(i)||this.validatePathSegmentInaccessibility(i)||r.push(i);r.length>0&&this.errors.push((0,xe.invalidReferencesOfInaccessibleTypeError)((0,Re.kindToTypeString)(t.kind),t.name,r))}validateQueryRootType(){let t=this.parentDefinitionDataByTypeName.get(Le.QUERY);if(!t||t.kind!==ke.Kind.OBJECT_TYPE_DEFINITION||t.fieldDataByFieldName.size<1){this.errors.push((0,xe.noQueryRootTypeError)());return}for(let n of t.fieldDataByFieldName.values())if(!(0,Pe.isNodeDataInaccessible)(n))return;this.errors.push((0,xe.noQueryRootTypeError)())}validateSubscriptionFieldConditionFieldPath(t,n,r,i,s){let o=t.split(Le.PERIOD);if(o.length<1)return s.push((0,xe.invalidSubscriptionFieldConditionFieldPathErrorMessage)(r,t)),[];let u=n;if(this.inaccessiblePaths.has(u.renamedTypeName))return s.push((0,xe.inaccessibleSubscriptionFieldConditionFieldPathFieldErrorMessage)(r,t,o[0],u.renamedTypeName)),[];let l="";for(let d=0;d<o.length;d++){let p=o[d];if(l+=l.length>0?`.${p}`:p,u.kind!==ke.Kind.OBJECT_TYPE_DEFINITION)return s.push((0,xe.invalidSubscriptionFieldConditionFieldPathParentErrorMessage)(r,t,l)),[];let N=u.fieldDataByFieldName.get(p);if(!N)return s.push((0,xe.undefinedSubscriptionFieldConditionFieldPathFieldErrorMessage)(r,t,l,p,u.renamedTypeName)),[];let E=`${u.renamedTypeName}.${p}`;if(!N.subgraphNames.has(i))return s.push((0,xe.invalidSubscriptionFieldConditionFieldPathFieldErrorMessage)(r,t,l,E,i)),[];if(this.inaccessiblePaths.has(E))return s.push((0,xe.inaccessibleSubscriptionFieldConditionFieldPathFieldErrorMessage)(r,t,l,E)),[];if(Tr.BASE_SCALARS.has(N.namedTypeName)){u={kind:ke.Kind.SCALAR_TYPE_DEFINITION,name:N.namedTypeName};continue}u=(0,Re.getOrThrowError)(this.parentDefinitionDataByTypeName,N.namedTypeName,Le.PARENT_DEFINITION_DATA)}return(0,Pe.isLeafKind)(u.kind)?o:(s.push((0,xe.nonLeafSubscriptionFieldConditionFieldPathFinalFieldErrorMessage)(r,t,o[o.length-1],(0,Re.kindToTypeString)(u.kind),u.name)),[])}validateSubscriptionFieldCondition(t,n,r,i,s,o,u){if(i>Ug.MAX_SUBSCRIPTION_FILTER_DEPTH||this.isMaxDepth)return u.push((0,xe.subscriptionFilterConditionDepthExceededErrorMessage)(s)),this.isMaxDepth=!0,!1;let l=!1,d=new Set([Le.FIELD_PATH,Le.VALUES]),p=new Set,N=new Set,E=[];for(let g of t.fields){let A=g.name.value,k=s+`.${A}`;switch(A){case Le.FIELD_PATH:{if(d.has(Le.FIELD_PATH))d.delete(Le.FIELD_PATH);else{l=!0,p.add(Le.FIELD_PATH);break}if(g.value.kind!==ke.Kind.STRING){E.push((0,xe.invalidInputFieldTypeErrorMessage)(k,Le.STRING,(0,Re.kindToTypeString)(g.value.kind))),l=!0;break}let j=this.validateSubscriptionFieldConditionFieldPath(g.value.value,r,k,o,E);if(j.length<1){l=!0;break}n.fieldPath=j;break}case Le.VALUES:{if(d.has(Le.VALUES))d.delete(Le.VALUES);else{l=!0,p.add(Le.VALUES);break}let j=g.value.kind;if(j==ke.Kind.NULL||j==ke.Kind.OBJECT){E.push((0,xe.invalidInputFieldTypeErrorMessage)(k,Le.LIST,(0,Re.kindToTypeString)(g.value.kind))),l=!0;break}if(j!==ke.Kind.LIST){n.values=[(0,Pe.getSubscriptionFilterValue)(g.value)];break}let Y=new Set,ie=[];for(let re=0;re<g.value.values.length;re++){let oe=g.value.values[re];if(oe.kind===ke.Kind.OBJECT||oe.kind===ke.Kind.LIST){l=!0,ie.push(re);continue}Y.add((0,Pe.getSubscriptionFilterValue)(oe))}if(ie.length>0){E.push((0,xe.subscriptionFieldConditionInvalidValuesArrayErrorMessage)(k,ie));continue}if(Y.size<1){l=!0,E.push((0,xe.subscriptionFieldConditionEmptyValuesArrayErrorMessage)(k));continue}n.values=[...Y];break}default:l=!0,N.add(A)}}return l?(u.push((0,xe.subscriptionFieldConditionInvalidInputFieldErrorMessage)(s,[...d],[...p],[...N],E)),!1):!0}validateSubscriptionFilterCondition(t,n,r,i,s,o,u){if(i>Ug.MAX_SUBSCRIPTION_FILTER_DEPTH||this.isMaxDepth)return u.push((0,xe.subscriptionFilterConditionDepthExceededErrorMessage)(s)),this.isMaxDepth=!0,!1;if(i+=1,t.fields.length!==1)return u.push((0,xe.subscriptionFilterConditionInvalidInputFieldNumberErrorMessage)(s,t.fields.length)),!1;let l=t.fields[0],d=l.name.value;if(!G$.SUBSCRIPTION_FILTER_INPUT_NAMES.has(d))return u.push((0,xe.subscriptionFilterConditionInvalidInputFieldErrorMessage)(s,d)),!1;let p=s+`.${d}`;switch(l.value.kind){case ke.Kind.OBJECT:switch(d){case Le.IN_UPPER:return n.in={fieldPath:[],values:[]},this.validateSubscriptionFieldCondition(l.value,n.in,r,i,s+".IN",o,u);case Le.NOT_UPPER:return n.not={},this.validateSubscriptionFilterCondition(l.value,n.not,r,i,s+".NOT",o,u);default:return u.push((0,xe.subscriptionFilterConditionInvalidInputFieldTypeErrorMessage)(p,Le.LIST,Le.OBJECT)),!1}case ke.Kind.LIST:{let N=[];switch(d){case Le.AND_UPPER:{n.and=N;break}case Le.OR_UPPER:{n.or=N;break}default:return u.push((0,xe.subscriptionFilterConditionInvalidInputFieldTypeErrorMessage)(p,Le.OBJECT,Le.LIST)),!1}let E=l.value.values.length;if(E<1||E>5)return u.push((0,xe.subscriptionFilterArrayConditionInvalidLengthErrorMessage)(p,E)),!1;let g=!0,A=[];for(let k=0;k<l.value.values.length;k++){let j=p+`[${k}]`,Y=l.value.values[k];if(Y.kind!==ke.Kind.OBJECT){A.push(k);continue}let ie={};g&&(g=this.validateSubscriptionFilterCondition(Y,ie,r,i,j,o,u)),g&&N.push(ie)}return A.length>0?(u.push((0,xe.subscriptionFilterArrayConditionInvalidItemTypeErrorMessage)(p,A)),!1):g}default:{let N=G$.SUBSCRIPTION_FILTER_LIST_INPUT_NAMES.has(d)?Le.LIST:Le.OBJECT;return u.push((0,xe.subscriptionFilterConditionInvalidInputFieldTypeErrorMessage)(p,N,(0,Re.kindToTypeString)(l.value.kind))),!1}}}validateSubscriptionFilterAndGenerateConfiguration(t,n,r,i,s,o){if(!t.arguments||t.arguments.length!==1)return;let u=t.arguments[0];if(u.value.kind!==ke.Kind.OBJECT){this.errors.push((0,xe.invalidSubscriptionFilterDirectiveError)(r,[(0,xe.subscriptionFilterConditionInvalidInputFieldTypeErrorMessage)(Le.CONDITION,Le.OBJECT,(0,Re.kindToTypeString)(u.value.kind))]));return}let l={},d=[];if(!this.validateSubscriptionFilterCondition(u.value,l,n,0,Le.CONDITION,o,d)){this.errors.push((0,xe.invalidSubscriptionFilterDirectiveError)(r,d)),this.isMaxDepth=!1;return}(0,Re.getValueOrDefault)(this.fieldConfigurationByFieldPath,r,()=>({argumentNames:[],fieldName:i,typeName:s})).subscriptionFilterCondition=l}validateSubscriptionFiltersAndGenerateConfiguration(){for(let[t,n]of this.subscriptionFilterDataByFieldPath){if(this.inaccessiblePaths.has(t))continue;let r=this.parentDefinitionDataByTypeName.get(n.fieldData.namedTypeName);if(!r){this.errors.push((0,xe.invalidSubscriptionFilterDirectiveError)(t,[(0,xe.subscriptionFilterNamedTypeErrorMessage)(n.fieldData.namedTypeName)]));continue}(0,Pe.isNodeDataInaccessible)(r)||r.kind===ke.Kind.OBJECT_TYPE_DEFINITION&&this.validateSubscriptionFilterAndGenerateConfiguration(n.directive,r,t,n.fieldData.name,n.fieldData.renamedParentTypeName,n.directiveSubgraphName)}}buildFederationResult(){this.subscriptionFilterDataByFieldPath.size>0&&this.validateSubscriptionFiltersAndGenerateConfiguration(),this.invalidOrScopesHostPaths.size>0&&this.errors.push((0,xe.orScopesLimitError)(ti.maxOrScopes,[...this.invalidOrScopesHostPaths]));for(let s of this.potentialPersistedDirectiveDefinitionDataByDirectiveName.values())(0,Pe.addValidPersistedDirectiveDefinitionNodeByData)(this.routerDefinitions,s,this.persistedDirectiveDefinitionByDirectiveName,this.errors);let t=[];if(this.pushParentDefinitionDataToDocumentDefinitions(t),this.validateInterfaceImplementationsAndPushToDocumentDefinitions(t),this.validateQueryRootType(),this.errors.length>0)return{errors:this.errors,success:!1,warnings:this.warnings};if(this.internalSubgraphBySubgraphName.size>1){let s=this.internalGraph.validate();if(s.length>0)return{errors:s,success:!1,warnings:this.warnings}}if(this.errors.length>0)return{errors:this.errors,success:!1,warnings:this.warnings};let n={kind:ke.Kind.DOCUMENT,definitions:this.routerDefinitions},r=(0,ke.buildASTSchema)({kind:ke.Kind.DOCUMENT,definitions:this.clientDefinitions},{assumeValid:!0,assumeValidSDL:!0}),i=new Map;for(let s of this.internalSubgraphBySubgraphName.values())i.set(s.name,{configurationDataByTypeName:s.configurationDataByTypeName,schema:s.schema});for(let s of this.authorizationDataByParentTypeName.values())(0,ti.upsertAuthorizationConfiguration)(this.fieldConfigurationByFieldPath,s);return C({fieldConfigurations:Array.from(this.fieldConfigurationByFieldPath.values()),subgraphConfigBySubgraphName:i,federatedGraphAST:n,federatedGraphSchema:(0,ke.buildASTSchema)(n,{assumeValid:!0,assumeValidSDL:!0}),federatedGraphClientSchema:r,parentDefinitionDataByTypeName:this.parentDefinitionDataByTypeName,success:!0,warnings:this.warnings},this.getClientSchemaObjectBoolean())}getClientSchemaObjectBoolean(){return this.inaccessiblePaths.size<1&&this.tagNamesByPath.size<1?{}:{shouldIncludeClientSchema:!0}}handleChildTagExclusions(t,n,r,i){let s=n.size;for(let[o,u]of r){let l=(0,Re.getOrThrowError)(n,o,`${t.name}.childDataByChildName`);if((0,Pe.isNodeDataInaccessible)(l)){s-=1;continue}i.isDisjointFrom(u.tagNames)||((0,Re.getValueOrDefault)(l.persistedDirectivesData.directives,Le.INACCESSIBLE,()=>[(0,Re.generateSimpleDirective)(Le.INACCESSIBLE)]),this.inaccessiblePaths.add(`${t.name}.${o}`),s-=1)}s<1&&(t.persistedDirectivesData.directives.set(Le.INACCESSIBLE,[(0,Re.generateSimpleDirective)(Le.INACCESSIBLE)]),this.inaccessiblePaths.add(t.name))}handleChildTagInclusions(t,n,r,i){let s=n.size;for(let[o,u]of n){if((0,Pe.isNodeDataInaccessible)(u)){s-=1;continue}let l=r.get(o);(!l||i.isDisjointFrom(l.tagNames))&&((0,Re.getValueOrDefault)(u.persistedDirectivesData.directives,Le.INACCESSIBLE,()=>[(0,Re.generateSimpleDirective)(Le.INACCESSIBLE)]),this.inaccessiblePaths.add(`${t.name}.${o}`),s-=1)}s<1&&(t.persistedDirectivesData.directives.set(Le.INACCESSIBLE,[(0,Re.generateSimpleDirective)(Le.INACCESSIBLE)]),this.inaccessiblePaths.add(t.name))}buildFederationContractResult(t){if(this.isVersionTwo||this.routerDefinitions.push(Tr.INACCESSIBLE_DEFINITION),t.tagNamesToExclude.size>0)for(let[o,u]of this.parentTagDataByTypeName){let l=(0,Re.getOrThrowError)(this.parentDefinitionDataByTypeName,o,Le.PARENT_DEFINITION_DATA);if(!(0,Pe.isNodeDataInaccessible)(l)){if(!t.tagNamesToExclude.isDisjointFrom(u.tagNames)){l.persistedDirectivesData.directives.set(Le.INACCESSIBLE,[(0,Re.generateSimpleDirective)(Le.INACCESSIBLE)]),this.inaccessiblePaths.add(o);continue}if(!(u.childTagDataByChildName.size<1))switch(l.kind){case ke.Kind.SCALAR_TYPE_DEFINITION:case ke.Kind.UNION_TYPE_DEFINITION:continue;case ke.Kind.ENUM_TYPE_DEFINITION:this.handleChildTagExclusions(l,l.enumValueDataByValueName,u.childTagDataByChildName,t.tagNamesToExclude);break;case ke.Kind.INPUT_OBJECT_TYPE_DEFINITION:this.handleChildTagExclusions(l,l.inputValueDataByValueName,u.childTagDataByChildName,t.tagNamesToExclude);break;default:let d=l.fieldDataByFieldName.size;for(let[p,N]of u.childTagDataByChildName){let E=(0,Re.getOrThrowError)(l.fieldDataByFieldName,p,`${o}.fieldDataByFieldName`);if((0,Pe.isNodeDataInaccessible)(E)){d-=1;continue}if(!t.tagNamesToExclude.isDisjointFrom(N.tagNames)){(0,Re.getValueOrDefault)(E.persistedDirectivesData.directives,Le.INACCESSIBLE,()=>[(0,Re.generateSimpleDirective)(Le.INACCESSIBLE)]),this.inaccessiblePaths.add(`${o}.${p}`),d-=1;continue}for(let[g,A]of N.tagNamesByArgumentName){let k=(0,Re.getOrThrowError)(E.argumentDataByArgumentName,g,`${p}.argumentDataByArgumentName`);(0,Pe.isNodeDataInaccessible)(k)||A.isDisjointFrom(A)||((0,Re.getValueOrDefault)(k.persistedDirectivesData.directives,Le.INACCESSIBLE,()=>[(0,Re.generateSimpleDirective)(Le.INACCESSIBLE)]),this.inaccessiblePaths.add(k.renamedPath))}}d<1&&(l.persistedDirectivesData.directives.set(Le.INACCESSIBLE,[(0,Re.generateSimpleDirective)(Le.INACCESSIBLE)]),this.inaccessiblePaths.add(o))}}}else if(t.tagNamesToInclude.size>0)for(let[o,u]of this.parentDefinitionDataByTypeName){if((0,Pe.isNodeDataInaccessible)(u))continue;let l=this.parentTagDataByTypeName.get(o);if(!l){u.persistedDirectivesData.directives.set(Le.INACCESSIBLE,[(0,Re.generateSimpleDirective)(Le.INACCESSIBLE)]),this.inaccessiblePaths.add(o);continue}if(t.tagNamesToInclude.isDisjointFrom(l.tagNames)){if(l.childTagDataByChildName.size<1){u.persistedDirectivesData.directives.set(Le.INACCESSIBLE,[(0,Re.generateSimpleDirective)(Le.INACCESSIBLE)]),this.inaccessiblePaths.add(o);continue}switch(u.kind){case ke.Kind.SCALAR_TYPE_DEFINITION:case ke.Kind.UNION_TYPE_DEFINITION:continue;case ke.Kind.ENUM_TYPE_DEFINITION:this.handleChildTagInclusions(u,u.enumValueDataByValueName,l.childTagDataByChildName,t.tagNamesToInclude);break;case ke.Kind.INPUT_OBJECT_TYPE_DEFINITION:this.handleChildTagInclusions(u,u.inputValueDataByValueName,l.childTagDataByChildName,t.tagNamesToInclude);break;default:let d=u.fieldDataByFieldName.size;for(let[p,N]of u.fieldDataByFieldName){if((0,Pe.isNodeDataInaccessible)(N)){d-=1;continue}let E=l.childTagDataByChildName.get(p);(!E||t.tagNamesToInclude.isDisjointFrom(E.tagNames))&&((0,Re.getValueOrDefault)(N.persistedDirectivesData.directives,Le.INACCESSIBLE,()=>[(0,Re.generateSimpleDirective)(Le.INACCESSIBLE)]),this.inaccessiblePaths.add(`${o}.${p}`),d-=1)}d<1&&(u.persistedDirectivesData.directives.set(Le.INACCESSIBLE,[(0,Re.generateSimpleDirective)(Le.INACCESSIBLE)]),this.inaccessiblePaths.add(o))}}}this.subscriptionFilterDataByFieldPath.size>0&&this.validateSubscriptionFiltersAndGenerateConfiguration();for(let o of this.potentialPersistedDirectiveDefinitionDataByDirectiveName.values())(0,Pe.addValidPersistedDirectiveDefinitionNodeByData)(this.routerDefinitions,o,this.persistedDirectiveDefinitionByDirectiveName,this.errors);let n=[];if(this.pushParentDefinitionDataToDocumentDefinitions(n),this.validateInterfaceImplementationsAndPushToDocumentDefinitions(n),this.validateQueryRootType(),this.errors.length>0)return{errors:this.errors,success:!1,warnings:this.warnings};let r={kind:ke.Kind.DOCUMENT,definitions:this.routerDefinitions},i=(0,ke.buildASTSchema)({kind:ke.Kind.DOCUMENT,definitions:this.clientDefinitions},{assumeValid:!0,assumeValidSDL:!0}),s=new Map;for(let o of this.internalSubgraphBySubgraphName.values())s.set(o.name,{configurationDataByTypeName:o.configurationDataByTypeName,schema:o.schema});for(let o of this.authorizationDataByParentTypeName.values())(0,ti.upsertAuthorizationConfiguration)(this.fieldConfigurationByFieldPath,o);return C({fieldConfigurations:Array.from(this.fieldConfigurationByFieldPath.values()),subgraphConfigBySubgraphName:s,federatedGraphAST:r,federatedGraphSchema:(0,ke.buildASTSchema)(r,{assumeValid:!0,assumeValidSDL:!0}),federatedGraphClientSchema:i,parentDefinitionDataByTypeName:this.parentDefinitionDataByTypeName,success:!0,warnings:this.warnings},this.getClientSchemaObjectBoolean())}federateSubgraphsInternal(){return this.federateSubgraphData(),this.buildFederationResult()}};Id.FederationFactory=kg;function DF(e){if(e.length<1)return{errors:[xe.minimumSubgraphRequirementError],success:!1,warnings:[]};let t=(0,Jde.batchNormalize)(e);if(!t.success)return{errors:t.errors,success:!1,warnings:t.warnings};let n=new Map,r=new Map,i=new Set;for(let[s,o]of t.internalSubgraphBySubgraphName)for(let[u,l]of o.entityInterfaces){(0,Re.getValueOrDefault)(r,u,()=>[]).push({subgraphName:s,concreteTypeNames:l.concreteTypeNames||new Set});let d=n.get(u);if(!d){i.add(u),n.set(u,(0,ti.newEntityInterfaceFederationData)(l,s));continue}(0,ti.upsertEntityInterfaceFederationData)(d,l,s)&&i.delete(u)}for(let s of i)r.delete(s);return r.size>0?{errors:[(0,xe.undefinedEntityInterfaceImplementationsError)(r,n)],success:!1,warnings:t.warnings}:{federationFactory:new kg({authorizationDataByParentTypeName:t.authorizationDataByParentTypeName,concreteTypeNamesByAbstractTypeName:t.concreteTypeNamesByAbstractTypeName,entityDataByTypeName:t.entityDataByTypeName,entityInterfaceFederationDataByTypeName:n,internalSubgraphBySubgraphName:t.internalSubgraphBySubgraphName,internalGraph:t.internalGraph,warnings:t.warnings}),success:!0,warnings:t.warnings}}function Wde(e){let t=DF(e);return t.success?t.federationFactory.federateSubgraphsInternal():{errors:t.errors,success:!1,warnings:t.warnings}}function Xde(e,t){let n=DF(e);if(!n.success)return{errors:n.errors,success:!1,warnings:n.warnings};n.federationFactory.federateSubgraphData();let r=[(0,Y$.cloneDeep)(n.federationFactory)],i=n.federationFactory.buildFederationResult();if(!i.success)return{errors:i.errors,success:!1,warnings:i.warnings};let s=t.size-1,o=new Map,u=0;for(let[l,d]of t){u!==s&&r.push((0,Y$.cloneDeep)(r[u]));let p=r[u].buildFederationContractResult(d);o.set(l,p),u++}return Q(C({},i),{federationResultByContractName:o})}function Zde(e,t){let n=DF(e);return n.success?(n.federationFactory.federateSubgraphData(),n.federationFactory.buildFederationContractResult(t)):{errors:n.errors,success:!1,warnings:n.warnings}}});var xg=M(au=>{"use strict";m();T();h();Object.defineProperty(au,"__esModule",{value:!0});au.LATEST_ROUTER_COMPATIBILITY_VERSION=au.ROUTER_COMPATIBILITY_VERSIONS=au.ROUTER_COMPATIBILITY_VERSION_ONE=void 0;au.ROUTER_COMPATIBILITY_VERSION_ONE="1";au.ROUTER_COMPATIBILITY_VERSIONS=new Set([au.ROUTER_COMPATIBILITY_VERSION_ONE]);au.LATEST_ROUTER_COMPATIBILITY_VERSION="1"});var z$=M(BT=>{"use strict";m();T();h();Object.defineProperty(BT,"__esModule",{value:!0});BT.federateSubgraphs=epe;BT.federateSubgraphsWithContracts=tpe;BT.federateSubgraphsContract=npe;var bF=J$(),AF=xg();function epe(e,t=AF.ROUTER_COMPATIBILITY_VERSION_ONE){switch(t){default:return(0,bF.federateSubgraphs)(e)}}function tpe(e,t,n=AF.ROUTER_COMPATIBILITY_VERSION_ONE){switch(n){default:return(0,bF.federateSubgraphsWithContracts)(e,t)}}function npe(e,t,n=AF.ROUTER_COMPATIBILITY_VERSION_ONE){switch(n){default:return(0,bF.federateSubgraphsContract)(e,t)}}});var W$=M(H$=>{"use strict";m();T();h();Object.defineProperty(H$,"__esModule",{value:!0})});var eK=M(Mg=>{"use strict";m();T();h();Object.defineProperty(Mg,"__esModule",{value:!0});Mg.normalizeSubgraphFromString=rpe;Mg.normalizeSubgraph=ipe;var X$=_F(),Z$=xg();function rpe(e,t=!0,n=Z$.ROUTER_COMPATIBILITY_VERSION_ONE){switch(n){default:return(0,X$.normalizeSubgraphFromString)(e,t)}}function ipe(e,t,n,r=Z$.ROUTER_COMPATIBILITY_VERSION_ONE){switch(r){default:return(0,X$.normalizeSubgraph)(e,t,n)}}});var nK=M(tK=>{"use strict";m();T();h();Object.defineProperty(tK,"__esModule",{value:!0})});var iK=M(rK=>{"use strict";m();T();h();Object.defineProperty(rK,"__esModule",{value:!0})});var sK=M(Bg=>{"use strict";m();T();h();Object.defineProperty(Bg,"__esModule",{value:!0});Bg.COMPOSITION_VERSION=void 0;Bg.COMPOSITION_VERSION="{{$COMPOSITION__VERSION}}"});var aK=M(oK=>{"use strict";m();T();h();Object.defineProperty(oK,"__esModule",{value:!0})});var Vg=M(jt=>{"use strict";m();T();h();var spe=jt&&jt.__createBinding||(Object.create?function(e,t,n,r){r===void 0&&(r=n);var i=Object.getOwnPropertyDescriptor(t,n);(!i||("get"in i?!t.__esModule:i.writable||i.configurable))&&(i={enumerable:!0,get:function(){return t[n]}}),Object.defineProperty(e,r,i)}:function(e,t,n,r){r===void 0&&(r=n),e[r]=t[n]}),dn=jt&&jt.__exportStar||function(e,t){for(var n in e)n!=="default"&&!Object.prototype.hasOwnProperty.call(t,n)&&spe(t,e,n)};Object.defineProperty(jt,"__esModule",{value:!0});dn(Xi(),jt);dn(R0(),jt);dn(Fo(),jt);dn(fj(),jt);dn(z$(),jt);dn(W$(),jt);dn(eK(),jt);dn(nK(),jt);dn(yF(),jt);dn(mF(),jt);dn(TF(),jt);dn(xg(),jt);dn(PA(),jt);dn(Vc(),jt);dn(fT(),jt);dn(Kp(),jt);dn(iK(),jt);dn(sK(),jt);dn(zr(),jt);dn(aK(),jt);dn(rs(),jt);dn(MA(),jt);dn($A(),jt);dn(SF(),jt);dn(VA(),jt);dn(Gp(),jt);dn(cF(),jt);dn(Lg(),jt);dn(uF(),jt);dn(jc(),jt);dn(ET(),jt);dn(NT(),jt);dn(mT(),jt)});var Xpe={};Yf(Xpe,{buildRouterConfiguration:()=>Wpe,federateSubgraphs:()=>Hpe});m();T();h();var Dd=bv(Vg());m();T();h();m();T();h();function RF(e){if(!e)return e;let t=new URL(e),n=t.pathname;n.endsWith("/")&&(n=n.slice(0,-1));let r=t.port?`:${t.port}`:"";return`${t.protocol}//${t.hostname}${r}${n}`}m();T();h();m();T();h();var uK={};m();T();h();function cK(e){return e!=null}m();T();h();m();T();h();Ue();m();T();h();var lK;if(typeof AggregateError=="undefined"){class e extends Error{constructor(n,r=""){super(r),this.errors=n,this.name="AggregateError",Error.captureStackTrace(this,e)}}lK=function(t,n){return new e(t,n)}}else lK=AggregateError;function dK(e){return"errors"in e&&Array.isArray(e.errors)}var mK=3;function hK(e){return jg(e,[])}function jg(e,t){switch(typeof e){case"string":return JSON.stringify(e);case"function":return e.name?`[function ${e.name}]`:"[function]";case"object":return ope(e,t);default:return String(e)}}function pK(e){return e instanceof H?e.toString():`${e.name}: ${e.message};
  ${e.stack}`}function ope(e,t){if(e===null)return"null";if(e instanceof Error)return dK(e)?pK(e)+`
 `+fK(e.errors,t):pK(e);if(t.includes(e))return"[Circular]";let n=[...t,e];if(ape(e)){let r=e.toJSON();if(r!==e)return typeof r=="string"?r:jg(r,n)}else if(Array.isArray(e))return fK(e,n);return upe(e,n)}function ape(e){return typeof e.toJSON=="function"}function upe(e,t){let n=Object.entries(e);return n.length===0?"{}":t.length>mK?"["+cpe(e)+"]":"{ "+n.map(([i,s])=>i+": "+jg(s,t)).join(", ")+" }"}function fK(e,t){if(e.length===0)return"[]";if(t.length>mK)return"[Array]";let n=e.length,r=[];for(let i=0;i<n;++i)r.push(jg(e[i],t));return"["+r.join(", ")+"]"}function cpe(e){let t=Object.prototype.toString.call(e).replace(/^\[object /,"").replace(/]$/,"");if(t==="Object"&&typeof e.constructor=="function"){let n=e.constructor.name;if(typeof n=="string"&&n!=="")return n}return t}function qg(e,t=["directives"]){return t.reduce((n,r)=>n==null?n:n[r],e==null?void 0:e.extensions)}m();T();h();Ue();m();T();h();Ue();function ga(e){if(Ye(e)){let t=ga(e.ofType);if(t.kind===R.NON_NULL_TYPE)throw new Error(`Invalid type node ${hK(e)}. Inner type of non-null type cannot be a non-null type.`);return{kind:R.NON_NULL_TYPE,type:t}}else if(Lt(e))return{kind:R.LIST_TYPE,type:ga(e.ofType)};return{kind:R.NAMED_TYPE,name:{kind:R.NAME,value:e.name}}}m();T();h();Ue();function $g(e){if(e===null)return{kind:R.NULL};if(e===void 0)return null;if(Array.isArray(e)){let t=[];for(let n of e){let r=$g(n);r!=null&&t.push(r)}return{kind:R.LIST,values:t}}if(typeof e=="object"){let t=[];for(let n in e){let r=e[n],i=$g(r);i&&t.push({kind:R.OBJECT_FIELD,name:{kind:R.NAME,value:n},value:i})}return{kind:R.OBJECT,fields:t}}if(typeof e=="boolean")return{kind:R.BOOLEAN,value:e};if(typeof e=="number"&&isFinite(e)){let t=String(e);return lpe.test(t)?{kind:R.INT,value:t}:{kind:R.FLOAT,value:t}}if(typeof e=="string")return{kind:R.STRING,value:e};throw new TypeError(`Cannot convert value to AST: ${e}.`)}var lpe=/^-?(?:0|[1-9][0-9]*)$/;m();T();h();m();T();h();function Kg(e){let t=new WeakMap;return function(r){let i=t.get(r);if(i===void 0){let s=e(r);return t.set(r,s),s}return i}}var a3e=Kg(function(t){let n=dpe(t);return new Set([...n].map(r=>r.name))}),dpe=Kg(function(t){let n=FF(t);return new Set(n.values())}),FF=Kg(function(t){let n=new Map,r=t.getQueryType();r&&n.set("query",r);let i=t.getMutationType();i&&n.set("mutation",i);let s=t.getSubscriptionType();return s&&n.set("subscription",s),n});function ppe(e,t={}){let n=t.pathToDirectivesInExtensions,r=e.getTypeMap(),i=fpe(e,n),s=i!=null?[i]:[],o=e.getDirectives();for(let u of o)Sc(u)||s.push(mpe(u,e,n));for(let u in r){let l=r[u],d=yo(l),p=Ei(l);if(!(d||p))if(ot(l))s.push(hpe(l,e,n));else if(Tt(l))s.push(Tpe(l,e,n));else if(hn(l))s.push(Npe(l,e,n));else if(yt(l))s.push(Epe(l,e,n));else if(rn(l))s.push(ype(l,e,n));else if(sr(l))s.push(Ipe(l,e,n));else throw new Error(`Unknown type ${l}.`)}return{kind:R.DOCUMENT,definitions:s}}function TK(e,t={}){let n=ppe(e,t);return Rt(n)}function fpe(e,t){var n,r;let i=new Map([["query",void 0],["mutation",void 0],["subscription",void 0]]),s=[];if(e.astNode!=null&&s.push(e.astNode),e.extensionASTNodes!=null)for(let p of e.extensionASTNodes)s.push(p);for(let p of s)if(p.operationTypes)for(let N of p.operationTypes)i.set(N.operation,N);let o=FF(e);for(let[p,N]of i){let E=o.get(p);if(E!=null){let g=ga(E);N!=null?N.type=g:i.set(p,{kind:R.OPERATION_TYPE_DEFINITION,operation:p,type:g})}}let u=[...i.values()].filter(cK),l=vf(e,e,t);if(!u.length&&!l.length)return null;let d={kind:u!=null?R.SCHEMA_DEFINITION:R.SCHEMA_EXTENSION,operationTypes:u,directives:l};return d.description=((r=(n=e.astNode)===null||n===void 0?void 0:n.description)!==null&&r!==void 0?r:e.description!=null)?{kind:R.STRING,value:e.description,block:!0}:void 0,d}function mpe(e,t,n){var r,i,s,o;return{kind:R.DIRECTIVE_DEFINITION,description:(i=(r=e.astNode)===null||r===void 0?void 0:r.description)!==null&&i!==void 0?i:e.description?{kind:R.STRING,value:e.description}:void 0,name:{kind:R.NAME,value:e.name},arguments:(s=e.args)===null||s===void 0?void 0:s.map(u=>NK(u,t,n)),repeatable:e.isRepeatable,locations:((o=e.locations)===null||o===void 0?void 0:o.map(u=>({kind:R.NAME,value:u})))||[]}}function vf(e,t,n){let r=qg(e,n),i=[];e.astNode!=null&&i.push(e.astNode),"extensionASTNodes"in e&&e.extensionASTNodes!=null&&(i=i.concat(e.extensionASTNodes));let s;if(r!=null)s=wF(t,r);else{s=[];for(let o of i)o.directives&&s.push(...o.directives)}return s}function Qg(e,t,n){var r,i;let s=[],o=null,u=qg(e,n),l;return u!=null?l=wF(t,u):l=(r=e.astNode)===null||r===void 0?void 0:r.directives,l!=null&&(s=l.filter(d=>d.name.value!=="deprecated"),e.deprecationReason!=null&&(o=(i=l.filter(d=>d.name.value==="deprecated"))===null||i===void 0?void 0:i[0])),e.deprecationReason!=null&&o==null&&(o=vpe(e.deprecationReason)),o==null?s:[o].concat(s)}function NK(e,t,n){var r,i,s;return{kind:R.INPUT_VALUE_DEFINITION,description:(i=(r=e.astNode)===null||r===void 0?void 0:r.description)!==null&&i!==void 0?i:e.description?{kind:R.STRING,value:e.description,block:!0}:void 0,name:{kind:R.NAME,value:e.name},type:ga(e.type),defaultValue:e.defaultValue!==void 0&&(s=ai(e.defaultValue,e.type))!==null&&s!==void 0?s:void 0,directives:Qg(e,t,n)}}function hpe(e,t,n){var r,i;return{kind:R.OBJECT_TYPE_DEFINITION,description:(i=(r=e.astNode)===null||r===void 0?void 0:r.description)!==null&&i!==void 0?i:e.description?{kind:R.STRING,value:e.description,block:!0}:void 0,name:{kind:R.NAME,value:e.name},fields:Object.values(e.getFields()).map(s=>EK(s,t,n)),interfaces:Object.values(e.getInterfaces()).map(s=>ga(s)),directives:vf(e,t,n)}}function Tpe(e,t,n){var r,i;let s={kind:R.INTERFACE_TYPE_DEFINITION,description:(i=(r=e.astNode)===null||r===void 0?void 0:r.description)!==null&&i!==void 0?i:e.description?{kind:R.STRING,value:e.description,block:!0}:void 0,name:{kind:R.NAME,value:e.name},fields:Object.values(e.getFields()).map(o=>EK(o,t,n)),directives:vf(e,t,n)};return"getInterfaces"in e&&(s.interfaces=Object.values(e.getInterfaces()).map(o=>ga(o))),s}function Npe(e,t,n){var r,i;return{kind:R.UNION_TYPE_DEFINITION,description:(i=(r=e.astNode)===null||r===void 0?void 0:r.description)!==null&&i!==void 0?i:e.description?{kind:R.STRING,value:e.description,block:!0}:void 0,name:{kind:R.NAME,value:e.name},directives:vf(e,t,n),types:e.getTypes().map(s=>ga(s))}}function Epe(e,t,n){var r,i;return{kind:R.INPUT_OBJECT_TYPE_DEFINITION,description:(i=(r=e.astNode)===null||r===void 0?void 0:r.description)!==null&&i!==void 0?i:e.description?{kind:R.STRING,value:e.description,block:!0}:void 0,name:{kind:R.NAME,value:e.name},fields:Object.values(e.getFields()).map(s=>gpe(s,t,n)),directives:vf(e,t,n)}}function ype(e,t,n){var r,i;return{kind:R.ENUM_TYPE_DEFINITION,description:(i=(r=e.astNode)===null||r===void 0?void 0:r.description)!==null&&i!==void 0?i:e.description?{kind:R.STRING,value:e.description,block:!0}:void 0,name:{kind:R.NAME,value:e.name},values:Object.values(e.getValues()).map(s=>_pe(s,t,n)),directives:vf(e,t,n)}}function Ipe(e,t,n){var r,i,s;let o=qg(e,n),u=o?wF(t,o):((r=e.astNode)===null||r===void 0?void 0:r.directives)||[],l=e.specifiedByUrl||e.specifiedByURL;if(l&&!u.some(d=>d.name.value==="specifiedBy")){let d={url:l};u.push(Gg("specifiedBy",d))}return{kind:R.SCALAR_TYPE_DEFINITION,description:(s=(i=e.astNode)===null||i===void 0?void 0:i.description)!==null&&s!==void 0?s:e.description?{kind:R.STRING,value:e.description,block:!0}:void 0,name:{kind:R.NAME,value:e.name},directives:u}}function EK(e,t,n){var r,i;return{kind:R.FIELD_DEFINITION,description:(i=(r=e.astNode)===null||r===void 0?void 0:r.description)!==null&&i!==void 0?i:e.description?{kind:R.STRING,value:e.description,block:!0}:void 0,name:{kind:R.NAME,value:e.name},arguments:e.args.map(s=>NK(s,t,n)),type:ga(e.type),directives:Qg(e,t,n)}}function gpe(e,t,n){var r,i,s;return{kind:R.INPUT_VALUE_DEFINITION,description:(i=(r=e.astNode)===null||r===void 0?void 0:r.description)!==null&&i!==void 0?i:e.description?{kind:R.STRING,value:e.description,block:!0}:void 0,name:{kind:R.NAME,value:e.name},type:ga(e.type),directives:Qg(e,t,n),defaultValue:(s=ai(e.defaultValue,e.type))!==null&&s!==void 0?s:void 0}}function _pe(e,t,n){var r,i;return{kind:R.ENUM_VALUE_DEFINITION,description:(i=(r=e.astNode)===null||r===void 0?void 0:r.description)!==null&&i!==void 0?i:e.description?{kind:R.STRING,value:e.description,block:!0}:void 0,name:{kind:R.NAME,value:e.name},directives:Qg(e,t,n)}}function vpe(e){return Gg("deprecated",{reason:e},Jo)}function Gg(e,t,n){let r=[];if(n!=null)for(let i of n.args){let s=i.name,o=t[s];if(o!==void 0){let u=ai(o,i.type);u&&r.push({kind:R.ARGUMENT,name:{kind:R.NAME,value:s},value:u})}}else for(let i in t){let s=t[i],o=$g(s);o&&r.push({kind:R.ARGUMENT,name:{kind:R.NAME,value:i},value:o})}return{kind:R.DIRECTIVE,name:{kind:R.NAME,value:e},arguments:r}}function wF(e,t){let n=[];for(let r in t){let i=t[r],s=e==null?void 0:e.getDirective(r);if(Array.isArray(i))for(let o of i)n.push(Gg(r,o,s));else n.push(Gg(r,i,s))}return n}var Ff=bv(Vg(),1);Ue();m();T();h();m();T();h();m();T();h();m();T();h();m();T();h();m();T();h();function Cn(e,t){if(!e)throw new Error(t)}var Ope=34028234663852886e22,Spe=-34028234663852886e22,Dpe=4294967295,bpe=2147483647,Ape=-2147483648;function Of(e){if(typeof e!="number")throw new Error("invalid int 32: "+typeof e);if(!Number.isInteger(e)||e>bpe||e<Ape)throw new Error("invalid int 32: "+e)}function VT(e){if(typeof e!="number")throw new Error("invalid uint 32: "+typeof e);if(!Number.isInteger(e)||e>Dpe||e<0)throw new Error("invalid uint 32: "+e)}function Yg(e){if(typeof e!="number")throw new Error("invalid float 32: "+typeof e);if(Number.isFinite(e)&&(e>Ope||e<Spe))throw new Error("invalid float 32: "+e)}var yK=Symbol("@bufbuild/protobuf/enum-type");function IK(e){let t=e[yK];return Cn(t,"missing enum type on enum object"),t}function PF(e,t,n,r){e[yK]=LF(t,n.map(i=>({no:i.no,name:i.name,localName:e[i.no]})),r)}function LF(e,t,n){let r=Object.create(null),i=Object.create(null),s=[];for(let o of t){let u=_K(o);s.push(u),r[o.name]=u,i[o.no]=u}return{typeName:e,values:s,findName(o){return r[o]},findNumber(o){return i[o]}}}function gK(e,t,n){let r={};for(let i of t){let s=_K(i);r[s.localName]=s.no,r[s.no]=s.localName}return PF(r,e,t,n),r}function _K(e){return"localName"in e?e:Object.assign(Object.assign({},e),{localName:e.name})}m();T();h();m();T();h();var tt=class{equals(t){return this.getType().runtime.util.equals(this.getType(),this,t)}clone(){return this.getType().runtime.util.clone(this)}fromBinary(t,n){let r=this.getType(),i=r.runtime.bin,s=i.makeReadOptions(n);return i.readMessage(this,s.readerFactory(t),t.byteLength,s),this}fromJson(t,n){let r=this.getType(),i=r.runtime.json,s=i.makeReadOptions(n);return i.readMessage(r,t,s,this),this}fromJsonString(t,n){let r;try{r=JSON.parse(t)}catch(i){throw new Error(`cannot decode ${this.getType().typeName} from JSON: ${i instanceof Error?i.message:String(i)}`)}return this.fromJson(r,n)}toBinary(t){let n=this.getType(),r=n.runtime.bin,i=r.makeWriteOptions(t),s=i.writerFactory();return r.writeMessage(this,s,i),s.finish()}toJson(t){let n=this.getType(),r=n.runtime.json,i=r.makeWriteOptions(t);return r.writeMessage(this,i)}toJsonString(t){var n;let r=this.toJson(t);return JSON.stringify(r,null,(n=t==null?void 0:t.prettySpaces)!==null&&n!==void 0?n:0)}toJSON(){return this.toJson({emitDefaultValues:!0})}getType(){return Object.getPrototypeOf(this).constructor}};function vK(e,t,n,r){var i;let s=(i=r==null?void 0:r.localName)!==null&&i!==void 0?i:t.substring(t.lastIndexOf(".")+1),o={[s]:function(u){e.util.initFields(this),e.util.initPartial(u,this)}}[s];return Object.setPrototypeOf(o.prototype,new tt),Object.assign(o,{runtime:e,typeName:t,fields:e.util.newFieldList(n),fromBinary(u,l){return new o().fromBinary(u,l)},fromJson(u,l){return new o().fromJson(u,l)},fromJsonString(u,l){return new o().fromJsonString(u,l)},equals(u,l){return e.util.equals(o,u,l)}}),o}m();T();h();m();T();h();m();T();h();m();T();h();function SK(){let e=0,t=0;for(let r=0;r<28;r+=7){let i=this.buf[this.pos++];if(e|=(i&127)<<r,!(i&128))return this.assertBounds(),[e,t]}let n=this.buf[this.pos++];if(e|=(n&15)<<28,t=(n&112)>>4,!(n&128))return this.assertBounds(),[e,t];for(let r=3;r<=31;r+=7){let i=this.buf[this.pos++];if(t|=(i&127)<<r,!(i&128))return this.assertBounds(),[e,t]}throw new Error("invalid varint")}function zg(e,t,n){for(let s=0;s<28;s=s+7){let o=e>>>s,u=!(!(o>>>7)&&t==0),l=(u?o|128:o)&255;if(n.push(l),!u)return}let r=e>>>28&15|(t&7)<<4,i=!!(t>>3);if(n.push((i?r|128:r)&255),!!i){for(let s=3;s<31;s=s+7){let o=t>>>s,u=!!(o>>>7),l=(u?o|128:o)&255;if(n.push(l),!u)return}n.push(t>>>31&1)}}var Jg=4294967296;function CF(e){let t=e[0]==="-";t&&(e=e.slice(1));let n=1e6,r=0,i=0;function s(o,u){let l=Number(e.slice(o,u));i*=n,r=r*n+l,r>=Jg&&(i=i+(r/Jg|0),r=r%Jg)}return s(-24,-18),s(-18,-12),s(-12,-6),s(-6),t?bK(r,i):kF(r,i)}function DK(e,t){let n=kF(e,t),r=n.hi&2147483648;r&&(n=bK(n.lo,n.hi));let i=UF(n.lo,n.hi);return r?"-"+i:i}function UF(e,t){if({lo:e,hi:t}=Rpe(e,t),t<=2097151)return String(Jg*t+e);let n=e&16777215,r=(e>>>24|t<<8)&16777215,i=t>>16&65535,s=n+r*6777216+i*6710656,o=r+i*8147497,u=i*2,l=1e7;return s>=l&&(o+=Math.floor(s/l),s%=l),o>=l&&(u+=Math.floor(o/l),o%=l),u.toString()+OK(o)+OK(s)}function Rpe(e,t){return{lo:e>>>0,hi:t>>>0}}function kF(e,t){return{lo:e|0,hi:t|0}}function bK(e,t){return t=~t,e?e=~e+1:t+=1,kF(e,t)}var OK=e=>{let t=String(e);return"0000000".slice(t.length)+t};function xF(e,t){if(e>=0){for(;e>127;)t.push(e&127|128),e=e>>>7;t.push(e)}else{for(let n=0;n<9;n++)t.push(e&127|128),e=e>>7;t.push(1)}}function AK(){let e=this.buf[this.pos++],t=e&127;if(!(e&128))return this.assertBounds(),t;if(e=this.buf[this.pos++],t|=(e&127)<<7,!(e&128))return this.assertBounds(),t;if(e=this.buf[this.pos++],t|=(e&127)<<14,!(e&128))return this.assertBounds(),t;if(e=this.buf[this.pos++],t|=(e&127)<<21,!(e&128))return this.assertBounds(),t;e=this.buf[this.pos++],t|=(e&15)<<28;for(let n=5;e&128&&n<10;n++)e=this.buf[this.pos++];if(e&128)throw new Error("invalid varint");return this.assertBounds(),t>>>0}function Fpe(){let e=new DataView(new ArrayBuffer(8));if(typeof BigInt=="function"&&typeof e.getBigInt64=="function"&&typeof e.getBigUint64=="function"&&typeof e.setBigInt64=="function"&&typeof e.setBigUint64=="function"&&(typeof v!="object"||typeof v.env!="object"||v.env.BUF_BIGINT_DISABLE!=="1")){let i=BigInt("-9223372036854775808"),s=BigInt("9223372036854775807"),o=BigInt("0"),u=BigInt("18446744073709551615");return{zero:BigInt(0),supported:!0,parse(l){let d=typeof l=="bigint"?l:BigInt(l);if(d>s||d<i)throw new Error(`int64 invalid: ${l}`);return d},uParse(l){let d=typeof l=="bigint"?l:BigInt(l);if(d>u||d<o)throw new Error(`uint64 invalid: ${l}`);return d},enc(l){return e.setBigInt64(0,this.parse(l),!0),{lo:e.getInt32(0,!0),hi:e.getInt32(4,!0)}},uEnc(l){return e.setBigInt64(0,this.uParse(l),!0),{lo:e.getInt32(0,!0),hi:e.getInt32(4,!0)}},dec(l,d){return e.setInt32(0,l,!0),e.setInt32(4,d,!0),e.getBigInt64(0,!0)},uDec(l,d){return e.setInt32(0,l,!0),e.setInt32(4,d,!0),e.getBigUint64(0,!0)}}}let n=i=>Cn(/^-?[0-9]+$/.test(i),`int64 invalid: ${i}`),r=i=>Cn(/^[0-9]+$/.test(i),`uint64 invalid: ${i}`);return{zero:"0",supported:!1,parse(i){return typeof i!="string"&&(i=i.toString()),n(i),i},uParse(i){return typeof i!="string"&&(i=i.toString()),r(i),i},enc(i){return typeof i!="string"&&(i=i.toString()),n(i),CF(i)},uEnc(i){return typeof i!="string"&&(i=i.toString()),r(i),CF(i)},dec(i,s){return DK(i,s)},uDec(i,s){return UF(i,s)}}}var br=Fpe();m();T();h();var ve;(function(e){e[e.DOUBLE=1]="DOUBLE",e[e.FLOAT=2]="FLOAT",e[e.INT64=3]="INT64",e[e.UINT64=4]="UINT64",e[e.INT32=5]="INT32",e[e.FIXED64=6]="FIXED64",e[e.FIXED32=7]="FIXED32",e[e.BOOL=8]="BOOL",e[e.STRING=9]="STRING",e[e.BYTES=12]="BYTES",e[e.UINT32=13]="UINT32",e[e.SFIXED32=15]="SFIXED32",e[e.SFIXED64=16]="SFIXED64",e[e.SINT32=17]="SINT32",e[e.SINT64=18]="SINT64"})(ve||(ve={}));var Uo;(function(e){e[e.BIGINT=0]="BIGINT",e[e.STRING=1]="STRING"})(Uo||(Uo={}));function uu(e,t,n){if(t===n)return!0;if(e==ve.BYTES){if(!(t instanceof Uint8Array)||!(n instanceof Uint8Array)||t.length!==n.length)return!1;for(let r=0;r<t.length;r++)if(t[r]!==n[r])return!1;return!0}switch(e){case ve.UINT64:case ve.FIXED64:case ve.INT64:case ve.SFIXED64:case ve.SINT64:return t==n}return!1}function ko(e,t){switch(e){case ve.BOOL:return!1;case ve.UINT64:case ve.FIXED64:case ve.INT64:case ve.SFIXED64:case ve.SINT64:return t==0?br.zero:"0";case ve.DOUBLE:case ve.FLOAT:return 0;case ve.BYTES:return new Uint8Array(0);case ve.STRING:return"";default:return 0}}function Hg(e,t){switch(e){case ve.BOOL:return t===!1;case ve.STRING:return t==="";case ve.BYTES:return t instanceof Uint8Array&&!t.byteLength;default:return t==0}}m();T();h();var Nr;(function(e){e[e.Varint=0]="Varint",e[e.Bit64=1]="Bit64",e[e.LengthDelimited=2]="LengthDelimited",e[e.StartGroup=3]="StartGroup",e[e.EndGroup=4]="EndGroup",e[e.Bit32=5]="Bit32"})(Nr||(Nr={}));var Wg=class{constructor(t){this.stack=[],this.textEncoder=t!=null?t:new TextEncoder,this.chunks=[],this.buf=[]}finish(){this.chunks.push(new Uint8Array(this.buf));let t=0;for(let i=0;i<this.chunks.length;i++)t+=this.chunks[i].length;let n=new Uint8Array(t),r=0;for(let i=0;i<this.chunks.length;i++)n.set(this.chunks[i],r),r+=this.chunks[i].length;return this.chunks=[],n}fork(){return this.stack.push({chunks:this.chunks,buf:this.buf}),this.chunks=[],this.buf=[],this}join(){let t=this.finish(),n=this.stack.pop();if(!n)throw new Error("invalid state, fork stack empty");return this.chunks=n.chunks,this.buf=n.buf,this.uint32(t.byteLength),this.raw(t)}tag(t,n){return this.uint32((t<<3|n)>>>0)}raw(t){return this.buf.length&&(this.chunks.push(new Uint8Array(this.buf)),this.buf=[]),this.chunks.push(t),this}uint32(t){for(VT(t);t>127;)this.buf.push(t&127|128),t=t>>>7;return this.buf.push(t),this}int32(t){return Of(t),xF(t,this.buf),this}bool(t){return this.buf.push(t?1:0),this}bytes(t){return this.uint32(t.byteLength),this.raw(t)}string(t){let n=this.textEncoder.encode(t);return this.uint32(n.byteLength),this.raw(n)}float(t){Yg(t);let n=new Uint8Array(4);return new DataView(n.buffer).setFloat32(0,t,!0),this.raw(n)}double(t){let n=new Uint8Array(8);return new DataView(n.buffer).setFloat64(0,t,!0),this.raw(n)}fixed32(t){VT(t);let n=new Uint8Array(4);return new DataView(n.buffer).setUint32(0,t,!0),this.raw(n)}sfixed32(t){Of(t);let n=new Uint8Array(4);return new DataView(n.buffer).setInt32(0,t,!0),this.raw(n)}sint32(t){return Of(t),t=(t<<1^t>>31)>>>0,xF(t,this.buf),this}sfixed64(t){let n=new Uint8Array(8),r=new DataView(n.buffer),i=br.enc(t);return r.setInt32(0,i.lo,!0),r.setInt32(4,i.hi,!0),this.raw(n)}fixed64(t){let n=new Uint8Array(8),r=new DataView(n.buffer),i=br.uEnc(t);return r.setInt32(0,i.lo,!0),r.setInt32(4,i.hi,!0),this.raw(n)}int64(t){let n=br.enc(t);return zg(n.lo,n.hi,this.buf),this}sint64(t){let n=br.enc(t),r=n.hi>>31,i=n.lo<<1^r,s=(n.hi<<1|n.lo>>>31)^r;return zg(i,s,this.buf),this}uint64(t){let n=br.uEnc(t);return zg(n.lo,n.hi,this.buf),this}},Xg=class{constructor(t,n){this.varint64=SK,this.uint32=AK,this.buf=t,this.len=t.length,this.pos=0,this.view=new DataView(t.buffer,t.byteOffset,t.byteLength),this.textDecoder=n!=null?n:new TextDecoder}tag(){let t=this.uint32(),n=t>>>3,r=t&7;if(n<=0||r<0||r>5)throw new Error("illegal tag: field no "+n+" wire type "+r);return[n,r]}skip(t){let n=this.pos;switch(t){case Nr.Varint:for(;this.buf[this.pos++]&128;);break;case Nr.Bit64:this.pos+=4;case Nr.Bit32:this.pos+=4;break;case Nr.LengthDelimited:let r=this.uint32();this.pos+=r;break;case Nr.StartGroup:let i;for(;(i=this.tag()[1])!==Nr.EndGroup;)this.skip(i);break;default:throw new Error("cant skip wire type "+t)}return this.assertBounds(),this.buf.subarray(n,this.pos)}assertBounds(){if(this.pos>this.len)throw new RangeError("premature EOF")}int32(){return this.uint32()|0}sint32(){let t=this.uint32();return t>>>1^-(t&1)}int64(){return br.dec(...this.varint64())}uint64(){return br.uDec(...this.varint64())}sint64(){let[t,n]=this.varint64(),r=-(t&1);return t=(t>>>1|(n&1)<<31)^r,n=n>>>1^r,br.dec(t,n)}bool(){let[t,n]=this.varint64();return t!==0||n!==0}fixed32(){return this.view.getUint32((this.pos+=4)-4,!0)}sfixed32(){return this.view.getInt32((this.pos+=4)-4,!0)}fixed64(){return br.uDec(this.sfixed32(),this.sfixed32())}sfixed64(){return br.dec(this.sfixed32(),this.sfixed32())}float(){return this.view.getFloat32((this.pos+=4)-4,!0)}double(){return this.view.getFloat64((this.pos+=8)-8,!0)}bytes(){let t=this.uint32(),n=this.pos;return this.pos+=t,this.assertBounds(),this.buf.subarray(n,n+t)}string(){return this.textDecoder.decode(this.bytes())}};function RK(e,t,n,r){let i;return{typeName:t,extendee:n,get field(){if(!i){let s=typeof r=="function"?r():r;s.name=t.split(".").pop(),s.jsonName=`[${t}]`,i=e.util.newFieldList([s]).list()[0]}return i},runtime:e}}function Zg(e){let t=e.field.localName,n=Object.create(null);return n[t]=wpe(e),[n,()=>n[t]]}function wpe(e){let t=e.field;if(t.repeated)return[];if(t.default!==void 0)return t.default;switch(t.kind){case"enum":return t.T.values[0].no;case"scalar":return ko(t.T,t.L);case"message":let n=t.T,r=new n;return n.fieldWrapper?n.fieldWrapper.unwrapField(r):r;case"map":throw"map fields are not allowed to be extensions"}}function FK(e,t){if(!t.repeated&&(t.kind=="enum"||t.kind=="scalar")){for(let n=e.length-1;n>=0;--n)if(e[n].no==t.no)return[e[n]];return[]}return e.filter(n=>n.no===t.no)}m();T();h();m();T();h();var cu="ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/".split(""),e_=[];for(let e=0;e<cu.length;e++)e_[cu[e].charCodeAt(0)]=e;e_[45]=cu.indexOf("+");e_[95]=cu.indexOf("/");var MF={dec(e){let t=e.length*3/4;e[e.length-2]=="="?t-=2:e[e.length-1]=="="&&(t-=1);let n=new Uint8Array(t),r=0,i=0,s,o=0;for(let u=0;u<e.length;u++){if(s=e_[e.charCodeAt(u)],s===void 0)switch(e[u]){case"=":i=0;case`
-`:case"\r":case"	":case" ":continue;default:throw Error("invalid base64 string.")}switch(i){case 0:o=s,i=1;break;case 1:n[r++]=o<<2|(s&48)>>4,o=s,i=2;break;case 2:n[r++]=(o&15)<<4|(s&60)>>2,o=s,i=3;break;case 3:n[r++]=(o&3)<<6|s,i=0;break}}if(i==1)throw Error("invalid base64 string.");return n.subarray(0,r)},enc(e){let t="",n=0,r,i=0;for(let s=0;s<e.length;s++)switch(r=e[s],n){case 0:t+=cu[r>>2],i=(r&3)<<4,n=1;break;case 1:t+=cu[i|r>>4],i=(r&15)<<2,n=2;break;case 2:t+=cu[i|r>>6],t+=cu[r&63],n=0;break}return n&&(t+=cu[i],t+="=",n==1&&(t+="=")),t}};m();T();h();function wK(e,t,n){LK(t,e);let r=t.runtime.bin.makeReadOptions(n),i=FK(e.getType().runtime.bin.listUnknownFields(e),t.field),[s,o]=Zg(t);for(let u of i)t.runtime.bin.readField(s,r.readerFactory(u.data),t.field,u.wireType,r);return o()}function PK(e,t,n,r){LK(t,e);let i=t.runtime.bin.makeReadOptions(r),s=t.runtime.bin.makeWriteOptions(r);if(BF(e,t)){let d=e.getType().runtime.bin.listUnknownFields(e).filter(p=>p.no!=t.field.no);e.getType().runtime.bin.discardUnknownFields(e);for(let p of d)e.getType().runtime.bin.onUnknownField(e,p.no,p.wireType,p.data)}let o=s.writerFactory(),u=t.field;!u.opt&&!u.repeated&&(u.kind=="enum"||u.kind=="scalar")&&(u=Object.assign(Object.assign({},t.field),{opt:!0})),t.runtime.bin.writeField(u,n,o,s);let l=i.readerFactory(o.finish());for(;l.pos<l.len;){let[d,p]=l.tag(),N=l.skip(p);e.getType().runtime.bin.onUnknownField(e,d,p,N)}}function BF(e,t){let n=e.getType();return t.extendee.typeName===n.typeName&&!!n.runtime.bin.listUnknownFields(e).find(r=>r.no==t.field.no)}function LK(e,t){Cn(e.extendee.typeName==t.getType().typeName,`extension ${e.typeName} can only be applied to message ${e.extendee.typeName}`)}m();T();h();function t_(e,t){let n=e.localName;if(e.repeated)return t[n].length>0;if(e.oneof)return t[e.oneof.localName].case===n;switch(e.kind){case"enum":case"scalar":return e.opt||e.req?t[n]!==void 0:e.kind=="enum"?t[n]!==e.T.values[0].no:!Hg(e.T,t[n]);case"message":return t[n]!==void 0;case"map":return Object.keys(t[n]).length>0}}function VF(e,t){let n=e.localName,r=!e.opt&&!e.req;if(e.repeated)t[n]=[];else if(e.oneof)t[e.oneof.localName]={case:void 0};else switch(e.kind){case"map":t[n]={};break;case"enum":t[n]=r?e.T.values[0].no:void 0;break;case"scalar":t[n]=r?ko(e.T,e.L):void 0;break;case"message":t[n]=void 0;break}}m();T();h();m();T();h();function xo(e,t){if(e===null||typeof e!="object"||!Object.getOwnPropertyNames(tt.prototype).every(r=>r in e&&typeof e[r]=="function"))return!1;let n=e.getType();return n===null||typeof n!="function"||!("typeName"in n)||typeof n.typeName!="string"?!1:t===void 0?!0:n.typeName==t.typeName}function n_(e,t){return xo(t)||!e.fieldWrapper?t:e.fieldWrapper.wrapField(t)}var jze={"google.protobuf.DoubleValue":ve.DOUBLE,"google.protobuf.FloatValue":ve.FLOAT,"google.protobuf.Int64Value":ve.INT64,"google.protobuf.UInt64Value":ve.UINT64,"google.protobuf.Int32Value":ve.INT32,"google.protobuf.UInt32Value":ve.UINT32,"google.protobuf.BoolValue":ve.BOOL,"google.protobuf.StringValue":ve.STRING,"google.protobuf.BytesValue":ve.BYTES};var CK={ignoreUnknownFields:!1},UK={emitDefaultValues:!1,enumAsInteger:!1,useProtoFieldName:!1,prettySpaces:0};function Ppe(e){return e?Object.assign(Object.assign({},CK),e):CK}function Lpe(e){return e?Object.assign(Object.assign({},UK),e):UK}var s_=Symbol(),r_=Symbol();function MK(){return{makeReadOptions:Ppe,makeWriteOptions:Lpe,readMessage(e,t,n,r){if(t==null||Array.isArray(t)||typeof t!="object")throw new Error(`cannot decode message ${e.typeName} from JSON: ${_a(t)}`);r=r!=null?r:new e;let i=new Map,s=n.typeRegistry;for(let[o,u]of Object.entries(t)){let l=e.fields.findJsonName(o);if(l){if(l.oneof){if(u===null&&l.kind=="scalar")continue;let d=i.get(l.oneof);if(d!==void 0)throw new Error(`cannot decode message ${e.typeName} from JSON: multiple keys for oneof "${l.oneof.name}" present: "${d}", "${o}"`);i.set(l.oneof,o)}kK(r,u,l,n,e)}else{let d=!1;if(s!=null&&s.findExtension&&o.startsWith("[")&&o.endsWith("]")){let p=s.findExtension(o.substring(1,o.length-1));if(p&&p.extendee.typeName==e.typeName){d=!0;let[N,E]=Zg(p);kK(N,u,p.field,n,p),PK(r,p,E(),n)}}if(!d&&!n.ignoreUnknownFields)throw new Error(`cannot decode message ${e.typeName} from JSON: key "${o}" is unknown`)}}return r},writeMessage(e,t){let n=e.getType(),r={},i;try{for(i of n.fields.byNumber()){if(!t_(i,e)){if(i.req)throw"required field not set";if(!t.emitDefaultValues||!Upe(i))continue}let o=i.oneof?e[i.oneof.localName].value:e[i.localName],u=xK(i,o,t);u!==void 0&&(r[t.useProtoFieldName?i.name:i.jsonName]=u)}let s=t.typeRegistry;if(s!=null&&s.findExtensionFor)for(let o of n.runtime.bin.listUnknownFields(e)){let u=s.findExtensionFor(n.typeName,o.no);if(u&&BF(e,u)){let l=wK(e,u,t),d=xK(u.field,l,t);d!==void 0&&(r[u.field.jsonName]=d)}}}catch(s){let o=i?`cannot encode field ${n.typeName}.${i.name} to JSON`:`cannot encode message ${n.typeName} to JSON`,u=s instanceof Error?s.message:String(s);throw new Error(o+(u.length>0?`: ${u}`:""))}return r},readScalar(e,t,n){return jT(e,t,n!=null?n:Uo.BIGINT,!0)},writeScalar(e,t,n){if(t!==void 0&&(n||Hg(e,t)))return i_(e,t)},debug:_a}}function _a(e){if(e===null)return"null";switch(typeof e){case"object":return Array.isArray(e)?"array":"object";case"string":return e.length>100?"string":`"${e.split('"').join('\\"')}"`;default:return String(e)}}function kK(e,t,n,r,i){let s=n.localName;if(n.repeated){if(Cn(n.kind!="map"),t===null)return;if(!Array.isArray(t))throw new Error(`cannot decode field ${i.typeName}.${n.name} from JSON: ${_a(t)}`);let o=e[s];for(let u of t){if(u===null)throw new Error(`cannot decode field ${i.typeName}.${n.name} from JSON: ${_a(u)}`);switch(n.kind){case"message":o.push(n.T.fromJson(u,r));break;case"enum":let l=jF(n.T,u,r.ignoreUnknownFields,!0);l!==r_&&o.push(l);break;case"scalar":try{o.push(jT(n.T,u,n.L,!0))}catch(d){let p=`cannot decode field ${i.typeName}.${n.name} from JSON: ${_a(u)}`;throw d instanceof Error&&d.message.length>0&&(p+=`: ${d.message}`),new Error(p)}break}}}else if(n.kind=="map"){if(t===null)return;if(typeof t!="object"||Array.isArray(t))throw new Error(`cannot decode field ${i.typeName}.${n.name} from JSON: ${_a(t)}`);let o=e[s];for(let[u,l]of Object.entries(t)){if(l===null)throw new Error(`cannot decode field ${i.typeName}.${n.name} from JSON: map value null`);let d;try{d=Cpe(n.K,u)}catch(p){let N=`cannot decode map key for field ${i.typeName}.${n.name} from JSON: ${_a(t)}`;throw p instanceof Error&&p.message.length>0&&(N+=`: ${p.message}`),new Error(N)}switch(n.V.kind){case"message":o[d]=n.V.T.fromJson(l,r);break;case"enum":let p=jF(n.V.T,l,r.ignoreUnknownFields,!0);p!==r_&&(o[d]=p);break;case"scalar":try{o[d]=jT(n.V.T,l,Uo.BIGINT,!0)}catch(N){let E=`cannot decode map value for field ${i.typeName}.${n.name} from JSON: ${_a(t)}`;throw N instanceof Error&&N.message.length>0&&(E+=`: ${N.message}`),new Error(E)}break}}}else switch(n.oneof&&(e=e[n.oneof.localName]={case:s},s="value"),n.kind){case"message":let o=n.T;if(t===null&&o.typeName!="google.protobuf.Value")return;let u=e[s];xo(u)?u.fromJson(t,r):(e[s]=u=o.fromJson(t,r),o.fieldWrapper&&!n.oneof&&(e[s]=o.fieldWrapper.unwrapField(u)));break;case"enum":let l=jF(n.T,t,r.ignoreUnknownFields,!1);switch(l){case s_:VF(n,e);break;case r_:break;default:e[s]=l;break}break;case"scalar":try{let d=jT(n.T,t,n.L,!1);switch(d){case s_:VF(n,e);break;default:e[s]=d;break}}catch(d){let p=`cannot decode field ${i.typeName}.${n.name} from JSON: ${_a(t)}`;throw d instanceof Error&&d.message.length>0&&(p+=`: ${d.message}`),new Error(p)}break}}function Cpe(e,t){if(e===ve.BOOL)switch(t){case"true":t=!0;break;case"false":t=!1;break}return jT(e,t,Uo.BIGINT,!0).toString()}function jT(e,t,n,r){if(t===null)return r?ko(e,n):s_;switch(e){case ve.DOUBLE:case ve.FLOAT:if(t==="NaN")return Number.NaN;if(t==="Infinity")return Number.POSITIVE_INFINITY;if(t==="-Infinity")return Number.NEGATIVE_INFINITY;if(t===""||typeof t=="string"&&t.trim().length!==t.length||typeof t!="string"&&typeof t!="number")break;let i=Number(t);if(Number.isNaN(i)||!Number.isFinite(i))break;return e==ve.FLOAT&&Yg(i),i;case ve.INT32:case ve.FIXED32:case ve.SFIXED32:case ve.SINT32:case ve.UINT32:let s;if(typeof t=="number"?s=t:typeof t=="string"&&t.length>0&&t.trim().length===t.length&&(s=Number(t)),s===void 0)break;return e==ve.UINT32||e==ve.FIXED32?VT(s):Of(s),s;case ve.INT64:case ve.SFIXED64:case ve.SINT64:if(typeof t!="number"&&typeof t!="string")break;let o=br.parse(t);return n?o.toString():o;case ve.FIXED64:case ve.UINT64:if(typeof t!="number"&&typeof t!="string")break;let u=br.uParse(t);return n?u.toString():u;case ve.BOOL:if(typeof t!="boolean")break;return t;case ve.STRING:if(typeof t!="string")break;try{encodeURIComponent(t)}catch(l){throw new Error("invalid UTF8")}return t;case ve.BYTES:if(t==="")return new Uint8Array(0);if(typeof t!="string")break;return MF.dec(t)}throw new Error}function jF(e,t,n,r){if(t===null)return e.typeName=="google.protobuf.NullValue"?0:r?e.values[0].no:s_;switch(typeof t){case"number":if(Number.isInteger(t))return t;break;case"string":let i=e.findName(t);if(i!==void 0)return i.no;if(n)return r_;break}throw new Error(`cannot decode enum ${e.typeName} from JSON: ${_a(t)}`)}function Upe(e){return e.repeated||e.kind=="map"?!0:!(e.oneof||e.kind=="message"||e.opt||e.req)}function xK(e,t,n){if(e.kind=="map"){Cn(typeof t=="object"&&t!=null);let r={},i=Object.entries(t);switch(e.V.kind){case"scalar":for(let[o,u]of i)r[o.toString()]=i_(e.V.T,u);break;case"message":for(let[o,u]of i)r[o.toString()]=u.toJson(n);break;case"enum":let s=e.V.T;for(let[o,u]of i)r[o.toString()]=qF(s,u,n.enumAsInteger);break}return n.emitDefaultValues||i.length>0?r:void 0}if(e.repeated){Cn(Array.isArray(t));let r=[];switch(e.kind){case"scalar":for(let i=0;i<t.length;i++)r.push(i_(e.T,t[i]));break;case"enum":for(let i=0;i<t.length;i++)r.push(qF(e.T,t[i],n.enumAsInteger));break;case"message":for(let i=0;i<t.length;i++)r.push(t[i].toJson(n));break}return n.emitDefaultValues||r.length>0?r:void 0}switch(e.kind){case"scalar":return i_(e.T,t);case"enum":return qF(e.T,t,n.enumAsInteger);case"message":return n_(e.T,t).toJson(n)}}function qF(e,t,n){var r;if(Cn(typeof t=="number"),e.typeName=="google.protobuf.NullValue")return null;if(n)return t;let i=e.findNumber(t);return(r=i==null?void 0:i.name)!==null&&r!==void 0?r:t}function i_(e,t){switch(e){case ve.INT32:case ve.SFIXED32:case ve.SINT32:case ve.FIXED32:case ve.UINT32:return Cn(typeof t=="number"),t;case ve.FLOAT:case ve.DOUBLE:return Cn(typeof t=="number"),Number.isNaN(t)?"NaN":t===Number.POSITIVE_INFINITY?"Infinity":t===Number.NEGATIVE_INFINITY?"-Infinity":t;case ve.STRING:return Cn(typeof t=="string"),t;case ve.BOOL:return Cn(typeof t=="boolean"),t;case ve.UINT64:case ve.FIXED64:case ve.INT64:case ve.SFIXED64:case ve.SINT64:return Cn(typeof t=="bigint"||typeof t=="string"||typeof t=="number"),t.toString();case ve.BYTES:return Cn(t instanceof Uint8Array),MF.enc(t)}}m();T();h();var Sf=Symbol("@bufbuild/protobuf/unknown-fields"),BK={readUnknownFields:!0,readerFactory:e=>new Xg(e)},VK={writeUnknownFields:!0,writerFactory:()=>new Wg};function kpe(e){return e?Object.assign(Object.assign({},BK),e):BK}function xpe(e){return e?Object.assign(Object.assign({},VK),e):VK}function KK(){return{makeReadOptions:kpe,makeWriteOptions:xpe,listUnknownFields(e){var t;return(t=e[Sf])!==null&&t!==void 0?t:[]},discardUnknownFields(e){delete e[Sf]},writeUnknownFields(e,t){let r=e[Sf];if(r)for(let i of r)t.tag(i.no,i.wireType).raw(i.data)},onUnknownField(e,t,n,r){let i=e;Array.isArray(i[Sf])||(i[Sf]=[]),i[Sf].push({no:t,wireType:n,data:r})},readMessage(e,t,n,r,i){let s=e.getType(),o=i?t.len:t.pos+n,u,l;for(;t.pos<o&&([u,l]=t.tag(),l!=Nr.EndGroup);){let d=s.fields.find(u);if(!d){let p=t.skip(l);r.readUnknownFields&&this.onUnknownField(e,u,l,p);continue}jK(e,t,d,l,r)}if(i&&(l!=Nr.EndGroup||u!==n))throw new Error("invalid end group tag")},readField:jK,writeMessage(e,t,n){let r=e.getType();for(let i of r.fields.byNumber()){if(!t_(i,e)){if(i.req)throw new Error(`cannot encode field ${r.typeName}.${i.name} to binary: required field not set`);continue}let s=i.oneof?e[i.oneof.localName].value:e[i.localName];qK(i,s,t,n)}return n.writeUnknownFields&&this.writeUnknownFields(e,t),t},writeField(e,t,n,r){t!==void 0&&qK(e,t,n,r)}}}function jK(e,t,n,r,i){let{repeated:s,localName:o}=n;switch(n.oneof&&(e=e[n.oneof.localName],e.case!=o&&delete e.value,e.case=o,o="value"),n.kind){case"scalar":case"enum":let u=n.kind=="enum"?ve.INT32:n.T,l=a_;if(n.kind=="scalar"&&n.L>0&&(l=Bpe),s){let E=e[o];if(r==Nr.LengthDelimited&&u!=ve.STRING&&u!=ve.BYTES){let A=t.uint32()+t.pos;for(;t.pos<A;)E.push(l(t,u))}else E.push(l(t,u))}else e[o]=l(t,u);break;case"message":let d=n.T;s?e[o].push(o_(t,new d,i,n)):xo(e[o])?o_(t,e[o],i,n):(e[o]=o_(t,new d,i,n),d.fieldWrapper&&!n.oneof&&!n.repeated&&(e[o]=d.fieldWrapper.unwrapField(e[o])));break;case"map":let[p,N]=Mpe(n,t,i);e[o][p]=N;break}}function o_(e,t,n,r){let i=t.getType().runtime.bin,s=r==null?void 0:r.delimited;return i.readMessage(t,e,s?r.no:e.uint32(),n,s),t}function Mpe(e,t,n){let r=t.uint32(),i=t.pos+r,s,o;for(;t.pos<i;){let[u]=t.tag();switch(u){case 1:s=a_(t,e.K);break;case 2:switch(e.V.kind){case"scalar":o=a_(t,e.V.T);break;case"enum":o=t.int32();break;case"message":o=o_(t,new e.V.T,n,void 0);break}break}}if(s===void 0&&(s=ko(e.K,Uo.BIGINT)),typeof s!="string"&&typeof s!="number"&&(s=s.toString()),o===void 0)switch(e.V.kind){case"scalar":o=ko(e.V.T,Uo.BIGINT);break;case"enum":o=e.V.T.values[0].no;break;case"message":o=new e.V.T;break}return[s,o]}function Bpe(e,t){let n=a_(e,t);return typeof n=="bigint"?n.toString():n}function a_(e,t){switch(t){case ve.STRING:return e.string();case ve.BOOL:return e.bool();case ve.DOUBLE:return e.double();case ve.FLOAT:return e.float();case ve.INT32:return e.int32();case ve.INT64:return e.int64();case ve.UINT64:return e.uint64();case ve.FIXED64:return e.fixed64();case ve.BYTES:return e.bytes();case ve.FIXED32:return e.fixed32();case ve.SFIXED32:return e.sfixed32();case ve.SFIXED64:return e.sfixed64();case ve.SINT64:return e.sint64();case ve.UINT32:return e.uint32();case ve.SINT32:return e.sint32()}}function qK(e,t,n,r){Cn(t!==void 0);let i=e.repeated;switch(e.kind){case"scalar":case"enum":let s=e.kind=="enum"?ve.INT32:e.T;if(i)if(Cn(Array.isArray(t)),e.packed)jpe(n,s,e.no,t);else for(let o of t)qT(n,s,e.no,o);else qT(n,s,e.no,t);break;case"message":if(i){Cn(Array.isArray(t));for(let o of t)$K(n,r,e,o)}else $K(n,r,e,t);break;case"map":Cn(typeof t=="object"&&t!=null);for(let[o,u]of Object.entries(t))Vpe(n,r,e,o,u);break}}function Vpe(e,t,n,r,i){e.tag(n.no,Nr.LengthDelimited),e.fork();let s=r;switch(n.K){case ve.INT32:case ve.FIXED32:case ve.UINT32:case ve.SFIXED32:case ve.SINT32:s=Number.parseInt(r);break;case ve.BOOL:Cn(r=="true"||r=="false"),s=r=="true";break}switch(qT(e,n.K,1,s),n.V.kind){case"scalar":qT(e,n.V.T,2,i);break;case"enum":qT(e,ve.INT32,2,i);break;case"message":Cn(i!==void 0),e.tag(2,Nr.LengthDelimited).bytes(i.toBinary(t));break}e.join()}function $K(e,t,n,r){let i=n_(n.T,r);n.delimited?e.tag(n.no,Nr.StartGroup).raw(i.toBinary(t)).tag(n.no,Nr.EndGroup):e.tag(n.no,Nr.LengthDelimited).bytes(i.toBinary(t))}function qT(e,t,n,r){Cn(r!==void 0);let[i,s]=GK(t);e.tag(n,i)[s](r)}function jpe(e,t,n,r){if(!r.length)return;e.tag(n,Nr.LengthDelimited).fork();let[,i]=GK(t);for(let s=0;s<r.length;s++)e[i](r[s]);e.join()}function GK(e){let t=Nr.Varint;switch(e){case ve.BYTES:case ve.STRING:t=Nr.LengthDelimited;break;case ve.DOUBLE:case ve.FIXED64:case ve.SFIXED64:t=Nr.Bit64;break;case ve.FIXED32:case ve.SFIXED32:case ve.FLOAT:t=Nr.Bit32;break}let n=ve[e].toLowerCase();return[t,n]}m();T();h();function QK(){return{setEnumType:PF,initPartial(e,t){if(e===void 0)return;let n=t.getType();for(let r of n.fields.byMember()){let i=r.localName,s=t,o=e;if(o[i]!==void 0)switch(r.kind){case"oneof":let u=o[i].case;if(u===void 0)continue;let l=r.findField(u),d=o[i].value;l&&l.kind=="message"&&!xo(d,l.T)?d=new l.T(d):l&&l.kind==="scalar"&&l.T===ve.BYTES&&(d=$T(d)),s[i]={case:u,value:d};break;case"scalar":case"enum":let p=o[i];r.T===ve.BYTES&&(p=r.repeated?p.map($T):$T(p)),s[i]=p;break;case"map":switch(r.V.kind){case"scalar":case"enum":if(r.V.T===ve.BYTES)for(let[g,A]of Object.entries(o[i]))s[i][g]=$T(A);else Object.assign(s[i],o[i]);break;case"message":let E=r.V.T;for(let g of Object.keys(o[i])){let A=o[i][g];E.fieldWrapper||(A=new E(A)),s[i][g]=A}break}break;case"message":let N=r.T;if(r.repeated)s[i]=o[i].map(E=>xo(E,N)?E:new N(E));else{let E=o[i];N.fieldWrapper?N.typeName==="google.protobuf.BytesValue"?s[i]=$T(E):s[i]=E:s[i]=xo(E,N)?E:new N(E)}break}}},equals(e,t,n){return t===n?!0:!t||!n?!1:e.fields.byMember().every(r=>{let i=t[r.localName],s=n[r.localName];if(r.repeated){if(i.length!==s.length)return!1;switch(r.kind){case"message":return i.every((o,u)=>r.T.equals(o,s[u]));case"scalar":return i.every((o,u)=>uu(r.T,o,s[u]));case"enum":return i.every((o,u)=>uu(ve.INT32,o,s[u]))}throw new Error(`repeated cannot contain ${r.kind}`)}switch(r.kind){case"message":return r.T.equals(i,s);case"enum":return uu(ve.INT32,i,s);case"scalar":return uu(r.T,i,s);case"oneof":if(i.case!==s.case)return!1;let o=r.findField(i.case);if(o===void 0)return!0;switch(o.kind){case"message":return o.T.equals(i.value,s.value);case"enum":return uu(ve.INT32,i.value,s.value);case"scalar":return uu(o.T,i.value,s.value)}throw new Error(`oneof cannot contain ${o.kind}`);case"map":let u=Object.keys(i).concat(Object.keys(s));switch(r.V.kind){case"message":let l=r.V.T;return u.every(p=>l.equals(i[p],s[p]));case"enum":return u.every(p=>uu(ve.INT32,i[p],s[p]));case"scalar":let d=r.V.T;return u.every(p=>uu(d,i[p],s[p]))}break}})},clone(e){let t=e.getType(),n=new t,r=n;for(let i of t.fields.byMember()){let s=e[i.localName],o;if(i.repeated)o=s.map(u_);else if(i.kind=="map"){o=r[i.localName];for(let[u,l]of Object.entries(s))o[u]=u_(l)}else i.kind=="oneof"?o=i.findField(s.case)?{case:s.case,value:u_(s.value)}:{case:void 0}:o=u_(s);r[i.localName]=o}for(let i of t.runtime.bin.listUnknownFields(e))t.runtime.bin.onUnknownField(r,i.no,i.wireType,i.data);return n}}}function u_(e){if(e===void 0)return e;if(xo(e))return e.clone();if(e instanceof Uint8Array){let t=new Uint8Array(e.byteLength);return t.set(e),t}return e}function $T(e){return e instanceof Uint8Array?e:new Uint8Array(e)}function YK(e,t,n){return{syntax:e,json:MK(),bin:KK(),util:Object.assign(Object.assign({},QK()),{newFieldList:t,initFields:n}),makeMessageType(r,i,s){return vK(this,r,i,s)},makeEnum:gK,makeEnumType:LF,getEnumType:IK,makeExtension(r,i,s){return RK(this,r,i,s)}}}m();T();h();var c_=class{constructor(t,n){this._fields=t,this._normalizer=n}findJsonName(t){if(!this.jsonNames){let n={};for(let r of this.list())n[r.jsonName]=n[r.name]=r;this.jsonNames=n}return this.jsonNames[t]}find(t){if(!this.numbers){let n={};for(let r of this.list())n[r.no]=r;this.numbers=n}return this.numbers[t]}list(){return this.all||(this.all=this._normalizer(this._fields)),this.all}byNumber(){return this.numbersAsc||(this.numbersAsc=this.list().concat().sort((t,n)=>t.no-n.no)),this.numbersAsc}byMember(){if(!this.members){this.members=[];let t=this.members,n;for(let r of this.list())r.oneof?r.oneof!==n&&(n=r.oneof,t.push(n)):t.push(r)}return this.members}};m();T();h();m();T();h();m();T();h();function $F(e,t){let n=HK(e);return t?n:Gpe(Kpe(n))}function JK(e){return $F(e,!1)}var zK=HK;function HK(e){let t=!1,n=[];for(let r=0;r<e.length;r++){let i=e.charAt(r);switch(i){case"_":t=!0;break;case"0":case"1":case"2":case"3":case"4":case"5":case"6":case"7":case"8":case"9":n.push(i),t=!1;break;default:t&&(t=!1,i=i.toUpperCase()),n.push(i);break}}return n.join("")}var qpe=new Set(["constructor","toString","toJSON","valueOf"]),$pe=new Set(["getType","clone","equals","fromBinary","fromJson","fromJsonString","toBinary","toJson","toJsonString","toObject"]),WK=e=>`${e}$`,Kpe=e=>$pe.has(e)?WK(e):e,Gpe=e=>qpe.has(e)?WK(e):e;var l_=class{constructor(t){this.kind="oneof",this.repeated=!1,this.packed=!1,this.opt=!1,this.req=!1,this.default=void 0,this.fields=[],this.name=t,this.localName=JK(t)}addField(t){Cn(t.oneof===this,`field ${t.name} not one of ${this.name}`),this.fields.push(t)}findField(t){if(!this._lookup){this._lookup=Object.create(null);for(let n=0;n<this.fields.length;n++)this._lookup[this.fields[n].localName]=this.fields[n]}return this._lookup[t]}};function XK(e,t){var n,r,i,s,o,u;let l=[],d;for(let p of typeof e=="function"?e():e){let N=p;if(N.localName=$F(p.name,p.oneof!==void 0),N.jsonName=(n=p.jsonName)!==null&&n!==void 0?n:zK(p.name),N.repeated=(r=p.repeated)!==null&&r!==void 0?r:!1,p.kind=="scalar"&&(N.L=(i=p.L)!==null&&i!==void 0?i:Uo.BIGINT),N.delimited=(s=p.delimited)!==null&&s!==void 0?s:!1,N.req=(o=p.req)!==null&&o!==void 0?o:!1,N.opt=(u=p.opt)!==null&&u!==void 0?u:!1,p.packed===void 0&&(t?N.packed=p.kind=="enum"||p.kind=="scalar"&&p.T!=ve.BYTES&&p.T!=ve.STRING:N.packed=!1),p.oneof!==void 0){let E=typeof p.oneof=="string"?p.oneof:p.oneof.name;(!d||d.name!=E)&&(d=new l_(E)),N.oneof=d,d.addField(N)}l.push(N)}return l}var K=YK("proto3",e=>new c_(e,t=>XK(t,!0)),e=>{for(let t of e.getType().fields.byMember()){if(t.opt)continue;let n=t.localName,r=e;if(t.repeated){r[n]=[];continue}switch(t.kind){case"oneof":r[n]={case:void 0};break;case"enum":r[n]=0;break;case"map":r[n]={};break;case"scalar":r[n]=ko(t.T,t.L);break;case"message":break}}});var Df;(function(e){e[e.OK=0]="OK",e[e.ERR=1]="ERR",e[e.ERR_NOT_FOUND=2]="ERR_NOT_FOUND",e[e.ERR_ALREADY_EXISTS=3]="ERR_ALREADY_EXISTS",e[e.ERR_INVALID_SUBGRAPH_SCHEMA=4]="ERR_INVALID_SUBGRAPH_SCHEMA",e[e.ERR_SUBGRAPH_COMPOSITION_FAILED=5]="ERR_SUBGRAPH_COMPOSITION_FAILED",e[e.ERR_SUBGRAPH_CHECK_FAILED=6]="ERR_SUBGRAPH_CHECK_FAILED",e[e.ERR_INVALID_LABELS=7]="ERR_INVALID_LABELS",e[e.ERR_ANALYTICS_DISABLED=8]="ERR_ANALYTICS_DISABLED",e[e.ERROR_NOT_AUTHENTICATED=9]="ERROR_NOT_AUTHENTICATED",e[e.ERR_OPENAI_DISABLED=10]="ERR_OPENAI_DISABLED",e[e.ERR_FREE_TRIAL_EXPIRED=11]="ERR_FREE_TRIAL_EXPIRED",e[e.ERROR_NOT_AUTHORIZED=12]="ERROR_NOT_AUTHORIZED",e[e.ERR_LIMIT_REACHED=13]="ERR_LIMIT_REACHED",e[e.ERR_DEPLOYMENT_FAILED=14]="ERR_DEPLOYMENT_FAILED",e[e.ERR_INVALID_NAME=15]="ERR_INVALID_NAME",e[e.ERR_UPGRADE_PLAN=16]="ERR_UPGRADE_PLAN",e[e.ERR_BAD_REQUEST=17]="ERR_BAD_REQUEST"})(Df||(Df={}));K.util.setEnumType(Df,"wg.cosmo.common.EnumStatusCode",[{no:0,name:"OK"},{no:1,name:"ERR"},{no:2,name:"ERR_NOT_FOUND"},{no:3,name:"ERR_ALREADY_EXISTS"},{no:4,name:"ERR_INVALID_SUBGRAPH_SCHEMA"},{no:5,name:"ERR_SUBGRAPH_COMPOSITION_FAILED"},{no:6,name:"ERR_SUBGRAPH_CHECK_FAILED"},{no:7,name:"ERR_INVALID_LABELS"},{no:8,name:"ERR_ANALYTICS_DISABLED"},{no:9,name:"ERROR_NOT_AUTHENTICATED"},{no:10,name:"ERR_OPENAI_DISABLED"},{no:11,name:"ERR_FREE_TRIAL_EXPIRED"},{no:12,name:"ERROR_NOT_AUTHORIZED"},{no:13,name:"ERR_LIMIT_REACHED"},{no:14,name:"ERR_DEPLOYMENT_FAILED"},{no:15,name:"ERR_INVALID_NAME"},{no:16,name:"ERR_UPGRADE_PLAN"},{no:17,name:"ERR_BAD_REQUEST"}]);var lu;(function(e){e[e.GRAPHQL_SUBSCRIPTION_PROTOCOL_WS=0]="GRAPHQL_SUBSCRIPTION_PROTOCOL_WS",e[e.GRAPHQL_SUBSCRIPTION_PROTOCOL_SSE=1]="GRAPHQL_SUBSCRIPTION_PROTOCOL_SSE",e[e.GRAPHQL_SUBSCRIPTION_PROTOCOL_SSE_POST=2]="GRAPHQL_SUBSCRIPTION_PROTOCOL_SSE_POST"})(lu||(lu={}));K.util.setEnumType(lu,"wg.cosmo.common.GraphQLSubscriptionProtocol",[{no:0,name:"GRAPHQL_SUBSCRIPTION_PROTOCOL_WS"},{no:1,name:"GRAPHQL_SUBSCRIPTION_PROTOCOL_SSE"},{no:2,name:"GRAPHQL_SUBSCRIPTION_PROTOCOL_SSE_POST"}]);var du;(function(e){e[e.GRAPHQL_WEBSOCKET_SUBPROTOCOL_AUTO=0]="GRAPHQL_WEBSOCKET_SUBPROTOCOL_AUTO",e[e.GRAPHQL_WEBSOCKET_SUBPROTOCOL_WS=1]="GRAPHQL_WEBSOCKET_SUBPROTOCOL_WS",e[e.GRAPHQL_WEBSOCKET_SUBPROTOCOL_TRANSPORT_WS=2]="GRAPHQL_WEBSOCKET_SUBPROTOCOL_TRANSPORT_WS"})(du||(du={}));K.util.setEnumType(du,"wg.cosmo.common.GraphQLWebsocketSubprotocol",[{no:0,name:"GRAPHQL_WEBSOCKET_SUBPROTOCOL_AUTO"},{no:1,name:"GRAPHQL_WEBSOCKET_SUBPROTOCOL_WS"},{no:2,name:"GRAPHQL_WEBSOCKET_SUBPROTOCOL_TRANSPORT_WS"}]);m();T();h();var KF;(function(e){e[e.RENDER_ARGUMENT_DEFAULT=0]="RENDER_ARGUMENT_DEFAULT",e[e.RENDER_ARGUMENT_AS_GRAPHQL_VALUE=1]="RENDER_ARGUMENT_AS_GRAPHQL_VALUE",e[e.RENDER_ARGUMENT_AS_ARRAY_CSV=2]="RENDER_ARGUMENT_AS_ARRAY_CSV"})(KF||(KF={}));K.util.setEnumType(KF,"wg.cosmo.node.v1.ArgumentRenderConfiguration",[{no:0,name:"RENDER_ARGUMENT_DEFAULT"},{no:1,name:"RENDER_ARGUMENT_AS_GRAPHQL_VALUE"},{no:2,name:"RENDER_ARGUMENT_AS_ARRAY_CSV"}]);var _d;(function(e){e[e.OBJECT_FIELD=0]="OBJECT_FIELD",e[e.FIELD_ARGUMENT=1]="FIELD_ARGUMENT"})(_d||(_d={}));K.util.setEnumType(_d,"wg.cosmo.node.v1.ArgumentSource",[{no:0,name:"OBJECT_FIELD"},{no:1,name:"FIELD_ARGUMENT"}]);var Xc;(function(e){e[e.STATIC=0]="STATIC",e[e.GRAPHQL=1]="GRAPHQL",e[e.PUBSUB=2]="PUBSUB"})(Xc||(Xc={}));K.util.setEnumType(Xc,"wg.cosmo.node.v1.DataSourceKind",[{no:0,name:"STATIC"},{no:1,name:"GRAPHQL"},{no:2,name:"PUBSUB"}]);var sc;(function(e){e[e.PUBLISH=0]="PUBLISH",e[e.REQUEST=1]="REQUEST",e[e.SUBSCRIBE=2]="SUBSCRIBE"})(sc||(sc={}));K.util.setEnumType(sc,"wg.cosmo.node.v1.EventType",[{no:0,name:"PUBLISH"},{no:1,name:"REQUEST"},{no:2,name:"SUBSCRIBE"}]);var Zc;(function(e){e[e.STATIC_CONFIGURATION_VARIABLE=0]="STATIC_CONFIGURATION_VARIABLE",e[e.ENV_CONFIGURATION_VARIABLE=1]="ENV_CONFIGURATION_VARIABLE",e[e.PLACEHOLDER_CONFIGURATION_VARIABLE=2]="PLACEHOLDER_CONFIGURATION_VARIABLE"})(Zc||(Zc={}));K.util.setEnumType(Zc,"wg.cosmo.node.v1.ConfigurationVariableKind",[{no:0,name:"STATIC_CONFIGURATION_VARIABLE"},{no:1,name:"ENV_CONFIGURATION_VARIABLE"},{no:2,name:"PLACEHOLDER_CONFIGURATION_VARIABLE"}]);var vd;(function(e){e[e.GET=0]="GET",e[e.POST=1]="POST",e[e.PUT=2]="PUT",e[e.DELETE=3]="DELETE",e[e.OPTIONS=4]="OPTIONS"})(vd||(vd={}));K.util.setEnumType(vd,"wg.cosmo.node.v1.HTTPMethod",[{no:0,name:"GET"},{no:1,name:"POST"},{no:2,name:"PUT"},{no:3,name:"DELETE"},{no:4,name:"OPTIONS"}]);var pu=class pu extends tt{constructor(n){super();O(this,"id","");O(this,"name","");O(this,"routingUrl","");K.util.initPartial(n,this)}static fromBinary(n,r){return new pu().fromBinary(n,r)}static fromJson(n,r){return new pu().fromJson(n,r)}static fromJsonString(n,r){return new pu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(pu,n,r)}};O(pu,"runtime",K),O(pu,"typeName","wg.cosmo.node.v1.Subgraph"),O(pu,"fields",K.util.newFieldList(()=>[{no:1,name:"id",kind:"scalar",T:9},{no:2,name:"name",kind:"scalar",T:9},{no:3,name:"routing_url",kind:"scalar",T:9}]));var d_=pu,fu=class fu extends tt{constructor(n){super();O(this,"configByFeatureFlagName",{});K.util.initPartial(n,this)}static fromBinary(n,r){return new fu().fromBinary(n,r)}static fromJson(n,r){return new fu().fromJson(n,r)}static fromJsonString(n,r){return new fu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(fu,n,r)}};O(fu,"runtime",K),O(fu,"typeName","wg.cosmo.node.v1.FeatureFlagRouterExecutionConfigs"),O(fu,"fields",K.util.newFieldList(()=>[{no:1,name:"config_by_feature_flag_name",kind:"map",K:9,V:{kind:"message",T:QF}}]));var GF=fu,mu=class mu extends tt{constructor(n){super();O(this,"engineConfig");O(this,"version","");O(this,"subgraphs",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new mu().fromBinary(n,r)}static fromJson(n,r){return new mu().fromJson(n,r)}static fromJsonString(n,r){return new mu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(mu,n,r)}};O(mu,"runtime",K),O(mu,"typeName","wg.cosmo.node.v1.FeatureFlagRouterExecutionConfig"),O(mu,"fields",K.util.newFieldList(()=>[{no:1,name:"engine_config",kind:"message",T:bf},{no:2,name:"version",kind:"scalar",T:9},{no:3,name:"subgraphs",kind:"message",T:d_,repeated:!0}]));var QF=mu,hu=class hu extends tt{constructor(n){super();O(this,"engineConfig");O(this,"version","");O(this,"subgraphs",[]);O(this,"featureFlagConfigs");O(this,"compatibilityVersion","");K.util.initPartial(n,this)}static fromBinary(n,r){return new hu().fromBinary(n,r)}static fromJson(n,r){return new hu().fromJson(n,r)}static fromJsonString(n,r){return new hu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(hu,n,r)}};O(hu,"runtime",K),O(hu,"typeName","wg.cosmo.node.v1.RouterConfig"),O(hu,"fields",K.util.newFieldList(()=>[{no:1,name:"engine_config",kind:"message",T:bf},{no:2,name:"version",kind:"scalar",T:9},{no:3,name:"subgraphs",kind:"message",T:d_,repeated:!0},{no:4,name:"feature_flag_configs",kind:"message",T:GF,opt:!0},{no:5,name:"compatibility_version",kind:"scalar",T:9}]));var KT=hu,Tu=class Tu extends tt{constructor(n){super();O(this,"code",Df.OK);O(this,"details");K.util.initPartial(n,this)}static fromBinary(n,r){return new Tu().fromBinary(n,r)}static fromJson(n,r){return new Tu().fromJson(n,r)}static fromJsonString(n,r){return new Tu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Tu,n,r)}};O(Tu,"runtime",K),O(Tu,"typeName","wg.cosmo.node.v1.Response"),O(Tu,"fields",K.util.newFieldList(()=>[{no:1,name:"code",kind:"enum",T:K.getEnumType(Df)},{no:2,name:"details",kind:"scalar",T:9,opt:!0}]));var YF=Tu,Nu=class Nu extends tt{constructor(n){super();O(this,"code",0);O(this,"message","");K.util.initPartial(n,this)}static fromBinary(n,r){return new Nu().fromBinary(n,r)}static fromJson(n,r){return new Nu().fromJson(n,r)}static fromJsonString(n,r){return new Nu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Nu,n,r)}};O(Nu,"runtime",K),O(Nu,"typeName","wg.cosmo.node.v1.ResponseStatus"),O(Nu,"fields",K.util.newFieldList(()=>[{no:1,name:"code",kind:"scalar",T:5},{no:2,name:"message",kind:"scalar",T:9}]));var ZK=Nu,Eu=class Eu extends tt{constructor(n){super();O(this,"accountLimits");O(this,"graphPublicKey","");K.util.initPartial(n,this)}static fromBinary(n,r){return new Eu().fromBinary(n,r)}static fromJson(n,r){return new Eu().fromJson(n,r)}static fromJsonString(n,r){return new Eu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Eu,n,r)}};O(Eu,"runtime",K),O(Eu,"typeName","wg.cosmo.node.v1.RegistrationInfo"),O(Eu,"fields",K.util.newFieldList(()=>[{no:1,name:"account_limits",kind:"message",T:zF},{no:2,name:"graph_public_key",kind:"scalar",T:9}]));var JF=Eu,yu=class yu extends tt{constructor(n){super();O(this,"traceSamplingRate",0);K.util.initPartial(n,this)}static fromBinary(n,r){return new yu().fromBinary(n,r)}static fromJson(n,r){return new yu().fromJson(n,r)}static fromJsonString(n,r){return new yu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(yu,n,r)}};O(yu,"runtime",K),O(yu,"typeName","wg.cosmo.node.v1.AccountLimits"),O(yu,"fields",K.util.newFieldList(()=>[{no:1,name:"trace_sampling_rate",kind:"scalar",T:2}]));var zF=yu,Iu=class Iu extends tt{constructor(t){super(),K.util.initPartial(t,this)}static fromBinary(t,n){return new Iu().fromBinary(t,n)}static fromJson(t,n){return new Iu().fromJson(t,n)}static fromJsonString(t,n){return new Iu().fromJsonString(t,n)}static equals(t,n){return K.util.equals(Iu,t,n)}};O(Iu,"runtime",K),O(Iu,"typeName","wg.cosmo.node.v1.SelfRegisterRequest"),O(Iu,"fields",K.util.newFieldList(()=>[]));var eG=Iu,gu=class gu extends tt{constructor(n){super();O(this,"response");O(this,"registrationInfo");K.util.initPartial(n,this)}static fromBinary(n,r){return new gu().fromBinary(n,r)}static fromJson(n,r){return new gu().fromJson(n,r)}static fromJsonString(n,r){return new gu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(gu,n,r)}};O(gu,"runtime",K),O(gu,"typeName","wg.cosmo.node.v1.SelfRegisterResponse"),O(gu,"fields",K.util.newFieldList(()=>[{no:1,name:"response",kind:"message",T:YF},{no:2,name:"registrationInfo",kind:"message",T:JF,opt:!0}]));var tG=gu,_u=class _u extends tt{constructor(n){super();O(this,"defaultFlushInterval",br.zero);O(this,"datasourceConfigurations",[]);O(this,"fieldConfigurations",[]);O(this,"graphqlSchema","");O(this,"typeConfigurations",[]);O(this,"stringStorage",{});O(this,"graphqlClientSchema");K.util.initPartial(n,this)}static fromBinary(n,r){return new _u().fromBinary(n,r)}static fromJson(n,r){return new _u().fromJson(n,r)}static fromJsonString(n,r){return new _u().fromJsonString(n,r)}static equals(n,r){return K.util.equals(_u,n,r)}};O(_u,"runtime",K),O(_u,"typeName","wg.cosmo.node.v1.EngineConfiguration"),O(_u,"fields",K.util.newFieldList(()=>[{no:1,name:"defaultFlushInterval",kind:"scalar",T:3},{no:2,name:"datasource_configurations",kind:"message",T:GT,repeated:!0},{no:3,name:"field_configurations",kind:"message",T:zT,repeated:!0},{no:4,name:"graphqlSchema",kind:"scalar",T:9},{no:5,name:"type_configurations",kind:"message",T:HF,repeated:!0},{no:6,name:"string_storage",kind:"map",K:9,V:{kind:"scalar",T:9}},{no:7,name:"graphql_client_schema",kind:"scalar",T:9,opt:!0}]));var bf=_u,vu=class vu extends tt{constructor(n){super();O(this,"kind",Xc.STATIC);O(this,"rootNodes",[]);O(this,"childNodes",[]);O(this,"overrideFieldPathFromAlias",!1);O(this,"customGraphql");O(this,"customStatic");O(this,"directives",[]);O(this,"requestTimeoutSeconds",br.zero);O(this,"id","");O(this,"keys",[]);O(this,"provides",[]);O(this,"requires",[]);O(this,"customEvents");O(this,"entityInterfaces",[]);O(this,"interfaceObjects",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new vu().fromBinary(n,r)}static fromJson(n,r){return new vu().fromJson(n,r)}static fromJsonString(n,r){return new vu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(vu,n,r)}};O(vu,"runtime",K),O(vu,"typeName","wg.cosmo.node.v1.DataSourceConfiguration"),O(vu,"fields",K.util.newFieldList(()=>[{no:1,name:"kind",kind:"enum",T:K.getEnumType(Xc)},{no:2,name:"root_nodes",kind:"message",T:Af,repeated:!0},{no:3,name:"child_nodes",kind:"message",T:Af,repeated:!0},{no:4,name:"override_field_path_from_alias",kind:"scalar",T:8},{no:5,name:"custom_graphql",kind:"message",T:XT},{no:6,name:"custom_static",kind:"message",T:XF},{no:7,name:"directives",kind:"message",T:ZF,repeated:!0},{no:8,name:"request_timeout_seconds",kind:"scalar",T:3},{no:9,name:"id",kind:"scalar",T:9},{no:10,name:"keys",kind:"message",T:gd,repeated:!0},{no:11,name:"provides",kind:"message",T:gd,repeated:!0},{no:12,name:"requires",kind:"message",T:gd,repeated:!0},{no:13,name:"custom_events",kind:"message",T:Sd},{no:14,name:"entity_interfaces",kind:"message",T:Rf,repeated:!0},{no:15,name:"interface_objects",kind:"message",T:Rf,repeated:!0}]));var GT=vu,Ou=class Ou extends tt{constructor(n){super();O(this,"name","");O(this,"sourceType",_d.OBJECT_FIELD);K.util.initPartial(n,this)}static fromBinary(n,r){return new Ou().fromBinary(n,r)}static fromJson(n,r){return new Ou().fromJson(n,r)}static fromJsonString(n,r){return new Ou().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Ou,n,r)}};O(Ou,"runtime",K),O(Ou,"typeName","wg.cosmo.node.v1.ArgumentConfiguration"),O(Ou,"fields",K.util.newFieldList(()=>[{no:1,name:"name",kind:"scalar",T:9},{no:2,name:"source_type",kind:"enum",T:K.getEnumType(_d)}]));var QT=Ou,Su=class Su extends tt{constructor(n){super();O(this,"requiredAndScopes",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new Su().fromBinary(n,r)}static fromJson(n,r){return new Su().fromJson(n,r)}static fromJsonString(n,r){return new Su().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Su,n,r)}};O(Su,"runtime",K),O(Su,"typeName","wg.cosmo.node.v1.Scopes"),O(Su,"fields",K.util.newFieldList(()=>[{no:1,name:"required_and_scopes",kind:"scalar",T:9,repeated:!0}]));var YT=Su,Du=class Du extends tt{constructor(n){super();O(this,"requiresAuthentication",!1);O(this,"requiredOrScopes",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new Du().fromBinary(n,r)}static fromJson(n,r){return new Du().fromJson(n,r)}static fromJsonString(n,r){return new Du().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Du,n,r)}};O(Du,"runtime",K),O(Du,"typeName","wg.cosmo.node.v1.AuthorizationConfiguration"),O(Du,"fields",K.util.newFieldList(()=>[{no:1,name:"requires_authentication",kind:"scalar",T:8},{no:2,name:"required_or_scopes",kind:"message",T:YT,repeated:!0}]));var JT=Du,bu=class bu extends tt{constructor(n){super();O(this,"typeName","");O(this,"fieldName","");O(this,"argumentsConfiguration",[]);O(this,"authorizationConfiguration");O(this,"subscriptionFilterCondition");K.util.initPartial(n,this)}static fromBinary(n,r){return new bu().fromBinary(n,r)}static fromJson(n,r){return new bu().fromJson(n,r)}static fromJsonString(n,r){return new bu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(bu,n,r)}};O(bu,"runtime",K),O(bu,"typeName","wg.cosmo.node.v1.FieldConfiguration"),O(bu,"fields",K.util.newFieldList(()=>[{no:1,name:"type_name",kind:"scalar",T:9},{no:2,name:"field_name",kind:"scalar",T:9},{no:3,name:"arguments_configuration",kind:"message",T:QT,repeated:!0},{no:4,name:"authorization_configuration",kind:"message",T:JT},{no:5,name:"subscription_filter_condition",kind:"message",T:el,opt:!0}]));var zT=bu,Au=class Au extends tt{constructor(n){super();O(this,"typeName","");O(this,"renameTo","");K.util.initPartial(n,this)}static fromBinary(n,r){return new Au().fromBinary(n,r)}static fromJson(n,r){return new Au().fromJson(n,r)}static fromJsonString(n,r){return new Au().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Au,n,r)}};O(Au,"runtime",K),O(Au,"typeName","wg.cosmo.node.v1.TypeConfiguration"),O(Au,"fields",K.util.newFieldList(()=>[{no:1,name:"type_name",kind:"scalar",T:9},{no:2,name:"rename_to",kind:"scalar",T:9}]));var HF=Au,Ru=class Ru extends tt{constructor(n){super();O(this,"typeName","");O(this,"fieldNames",[]);O(this,"externalFieldNames",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new Ru().fromBinary(n,r)}static fromJson(n,r){return new Ru().fromJson(n,r)}static fromJsonString(n,r){return new Ru().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Ru,n,r)}};O(Ru,"runtime",K),O(Ru,"typeName","wg.cosmo.node.v1.TypeField"),O(Ru,"fields",K.util.newFieldList(()=>[{no:1,name:"type_name",kind:"scalar",T:9},{no:2,name:"field_names",kind:"scalar",T:9,repeated:!0},{no:3,name:"external_field_names",kind:"scalar",T:9,repeated:!0}]));var Af=Ru,Fu=class Fu extends tt{constructor(n){super();O(this,"fieldName","");O(this,"typeName","");K.util.initPartial(n,this)}static fromBinary(n,r){return new Fu().fromBinary(n,r)}static fromJson(n,r){return new Fu().fromJson(n,r)}static fromJsonString(n,r){return new Fu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Fu,n,r)}};O(Fu,"runtime",K),O(Fu,"typeName","wg.cosmo.node.v1.FieldCoordinates"),O(Fu,"fields",K.util.newFieldList(()=>[{no:1,name:"field_name",kind:"scalar",T:9},{no:2,name:"type_name",kind:"scalar",T:9}]));var HT=Fu,wu=class wu extends tt{constructor(n){super();O(this,"fieldCoordinatesPath",[]);O(this,"fieldPath",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new wu().fromBinary(n,r)}static fromJson(n,r){return new wu().fromJson(n,r)}static fromJsonString(n,r){return new wu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(wu,n,r)}};O(wu,"runtime",K),O(wu,"typeName","wg.cosmo.node.v1.FieldSetCondition"),O(wu,"fields",K.util.newFieldList(()=>[{no:1,name:"field_coordinates_path",kind:"message",T:HT,repeated:!0},{no:2,name:"field_path",kind:"scalar",T:9,repeated:!0}]));var WT=wu,Pu=class Pu extends tt{constructor(n){super();O(this,"typeName","");O(this,"fieldName","");O(this,"selectionSet","");O(this,"disableEntityResolver",!1);O(this,"conditions",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new Pu().fromBinary(n,r)}static fromJson(n,r){return new Pu().fromJson(n,r)}static fromJsonString(n,r){return new Pu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Pu,n,r)}};O(Pu,"runtime",K),O(Pu,"typeName","wg.cosmo.node.v1.RequiredField"),O(Pu,"fields",K.util.newFieldList(()=>[{no:1,name:"type_name",kind:"scalar",T:9},{no:2,name:"field_name",kind:"scalar",T:9},{no:3,name:"selection_set",kind:"scalar",T:9},{no:4,name:"disable_entity_resolver",kind:"scalar",T:8},{no:5,name:"conditions",kind:"message",T:WT,repeated:!0}]));var gd=Pu,Lu=class Lu extends tt{constructor(n){super();O(this,"interfaceTypeName","");O(this,"concreteTypeNames",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new Lu().fromBinary(n,r)}static fromJson(n,r){return new Lu().fromJson(n,r)}static fromJsonString(n,r){return new Lu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Lu,n,r)}};O(Lu,"runtime",K),O(Lu,"typeName","wg.cosmo.node.v1.EntityInterfaceConfiguration"),O(Lu,"fields",K.util.newFieldList(()=>[{no:1,name:"interface_type_name",kind:"scalar",T:9},{no:2,name:"concrete_type_names",kind:"scalar",T:9,repeated:!0}]));var Rf=Lu,Cu=class Cu extends tt{constructor(n){super();O(this,"url");O(this,"method",vd.GET);O(this,"header",{});O(this,"body");O(this,"query",[]);O(this,"urlEncodeBody",!1);O(this,"mtls");O(this,"baseUrl");O(this,"path");O(this,"httpProxyUrl");K.util.initPartial(n,this)}static fromBinary(n,r){return new Cu().fromBinary(n,r)}static fromJson(n,r){return new Cu().fromJson(n,r)}static fromJsonString(n,r){return new Cu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Cu,n,r)}};O(Cu,"runtime",K),O(Cu,"typeName","wg.cosmo.node.v1.FetchConfiguration"),O(Cu,"fields",K.util.newFieldList(()=>[{no:1,name:"url",kind:"message",T:$i},{no:2,name:"method",kind:"enum",T:K.getEnumType(vd)},{no:3,name:"header",kind:"map",K:9,V:{kind:"message",T:tw}},{no:4,name:"body",kind:"message",T:$i},{no:5,name:"query",kind:"message",T:ew,repeated:!0},{no:7,name:"url_encode_body",kind:"scalar",T:8},{no:8,name:"mtls",kind:"message",T:nw},{no:9,name:"base_url",kind:"message",T:$i},{no:10,name:"path",kind:"message",T:$i},{no:11,name:"http_proxy_url",kind:"message",T:$i,opt:!0}]));var WF=Cu,Uu=class Uu extends tt{constructor(n){super();O(this,"statusCode",br.zero);O(this,"typeName","");O(this,"injectStatusCodeIntoBody",!1);K.util.initPartial(n,this)}static fromBinary(n,r){return new Uu().fromBinary(n,r)}static fromJson(n,r){return new Uu().fromJson(n,r)}static fromJsonString(n,r){return new Uu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Uu,n,r)}};O(Uu,"runtime",K),O(Uu,"typeName","wg.cosmo.node.v1.StatusCodeTypeMapping"),O(Uu,"fields",K.util.newFieldList(()=>[{no:1,name:"status_code",kind:"scalar",T:3},{no:2,name:"type_name",kind:"scalar",T:9},{no:3,name:"inject_status_code_into_body",kind:"scalar",T:8}]));var nG=Uu,ku=class ku extends tt{constructor(n){super();O(this,"fetch");O(this,"subscription");O(this,"federation");O(this,"upstreamSchema");O(this,"customScalarTypeFields",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new ku().fromBinary(n,r)}static fromJson(n,r){return new ku().fromJson(n,r)}static fromJsonString(n,r){return new ku().fromJsonString(n,r)}static equals(n,r){return K.util.equals(ku,n,r)}};O(ku,"runtime",K),O(ku,"typeName","wg.cosmo.node.v1.DataSourceCustom_GraphQL"),O(ku,"fields",K.util.newFieldList(()=>[{no:1,name:"fetch",kind:"message",T:WF},{no:2,name:"subscription",kind:"message",T:rw},{no:3,name:"federation",kind:"message",T:iw},{no:4,name:"upstream_schema",kind:"message",T:nN},{no:6,name:"custom_scalar_type_fields",kind:"message",T:sw,repeated:!0}]));var XT=ku,xu=class xu extends tt{constructor(n){super();O(this,"consumerName","");O(this,"streamName","");O(this,"consumerInactiveThreshold",0);K.util.initPartial(n,this)}static fromBinary(n,r){return new xu().fromBinary(n,r)}static fromJson(n,r){return new xu().fromJson(n,r)}static fromJsonString(n,r){return new xu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(xu,n,r)}};O(xu,"runtime",K),O(xu,"typeName","wg.cosmo.node.v1.NatsStreamConfiguration"),O(xu,"fields",K.util.newFieldList(()=>[{no:1,name:"consumer_name",kind:"scalar",T:9},{no:2,name:"stream_name",kind:"scalar",T:9},{no:3,name:"consumer_inactive_threshold",kind:"scalar",T:5}]));var ZT=xu,Mu=class Mu extends tt{constructor(n){super();O(this,"engineEventConfiguration");O(this,"subjects",[]);O(this,"streamConfiguration");K.util.initPartial(n,this)}static fromBinary(n,r){return new Mu().fromBinary(n,r)}static fromJson(n,r){return new Mu().fromJson(n,r)}static fromJsonString(n,r){return new Mu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Mu,n,r)}};O(Mu,"runtime",K),O(Mu,"typeName","wg.cosmo.node.v1.NatsEventConfiguration"),O(Mu,"fields",K.util.newFieldList(()=>[{no:1,name:"engine_event_configuration",kind:"message",T:Od},{no:2,name:"subjects",kind:"scalar",T:9,repeated:!0},{no:3,name:"stream_configuration",kind:"message",T:ZT}]));var eN=Mu,Bu=class Bu extends tt{constructor(n){super();O(this,"engineEventConfiguration");O(this,"topics",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new Bu().fromBinary(n,r)}static fromJson(n,r){return new Bu().fromJson(n,r)}static fromJsonString(n,r){return new Bu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Bu,n,r)}};O(Bu,"runtime",K),O(Bu,"typeName","wg.cosmo.node.v1.KafkaEventConfiguration"),O(Bu,"fields",K.util.newFieldList(()=>[{no:1,name:"engine_event_configuration",kind:"message",T:Od},{no:2,name:"topics",kind:"scalar",T:9,repeated:!0}]));var tN=Bu,Vu=class Vu extends tt{constructor(n){super();O(this,"providerId","");O(this,"type",sc.PUBLISH);O(this,"typeName","");O(this,"fieldName","");K.util.initPartial(n,this)}static fromBinary(n,r){return new Vu().fromBinary(n,r)}static fromJson(n,r){return new Vu().fromJson(n,r)}static fromJsonString(n,r){return new Vu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Vu,n,r)}};O(Vu,"runtime",K),O(Vu,"typeName","wg.cosmo.node.v1.EngineEventConfiguration"),O(Vu,"fields",K.util.newFieldList(()=>[{no:1,name:"provider_id",kind:"scalar",T:9},{no:2,name:"type",kind:"enum",T:K.getEnumType(sc)},{no:3,name:"type_name",kind:"scalar",T:9},{no:4,name:"field_name",kind:"scalar",T:9}]));var Od=Vu,ju=class ju extends tt{constructor(n){super();O(this,"nats",[]);O(this,"kafka",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new ju().fromBinary(n,r)}static fromJson(n,r){return new ju().fromJson(n,r)}static fromJsonString(n,r){return new ju().fromJsonString(n,r)}static equals(n,r){return K.util.equals(ju,n,r)}};O(ju,"runtime",K),O(ju,"typeName","wg.cosmo.node.v1.DataSourceCustomEvents"),O(ju,"fields",K.util.newFieldList(()=>[{no:1,name:"nats",kind:"message",T:eN,repeated:!0},{no:2,name:"kafka",kind:"message",T:tN,repeated:!0}]));var Sd=ju,qu=class qu extends tt{constructor(n){super();O(this,"data");K.util.initPartial(n,this)}static fromBinary(n,r){return new qu().fromBinary(n,r)}static fromJson(n,r){return new qu().fromJson(n,r)}static fromJsonString(n,r){return new qu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(qu,n,r)}};O(qu,"runtime",K),O(qu,"typeName","wg.cosmo.node.v1.DataSourceCustom_Static"),O(qu,"fields",K.util.newFieldList(()=>[{no:1,name:"data",kind:"message",T:$i}]));var XF=qu,$u=class $u extends tt{constructor(n){super();O(this,"kind",Zc.STATIC_CONFIGURATION_VARIABLE);O(this,"staticVariableContent","");O(this,"environmentVariableName","");O(this,"environmentVariableDefaultValue","");O(this,"placeholderVariableName","");K.util.initPartial(n,this)}static fromBinary(n,r){return new $u().fromBinary(n,r)}static fromJson(n,r){return new $u().fromJson(n,r)}static fromJsonString(n,r){return new $u().fromJsonString(n,r)}static equals(n,r){return K.util.equals($u,n,r)}};O($u,"runtime",K),O($u,"typeName","wg.cosmo.node.v1.ConfigurationVariable"),O($u,"fields",K.util.newFieldList(()=>[{no:1,name:"kind",kind:"enum",T:K.getEnumType(Zc)},{no:2,name:"static_variable_content",kind:"scalar",T:9},{no:3,name:"environment_variable_name",kind:"scalar",T:9},{no:4,name:"environment_variable_default_value",kind:"scalar",T:9},{no:5,name:"placeholder_variable_name",kind:"scalar",T:9}]));var $i=$u,Ku=class Ku extends tt{constructor(n){super();O(this,"directiveName","");O(this,"renameTo","");K.util.initPartial(n,this)}static fromBinary(n,r){return new Ku().fromBinary(n,r)}static fromJson(n,r){return new Ku().fromJson(n,r)}static fromJsonString(n,r){return new Ku().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Ku,n,r)}};O(Ku,"runtime",K),O(Ku,"typeName","wg.cosmo.node.v1.DirectiveConfiguration"),O(Ku,"fields",K.util.newFieldList(()=>[{no:1,name:"directive_name",kind:"scalar",T:9},{no:2,name:"rename_to",kind:"scalar",T:9}]));var ZF=Ku,Gu=class Gu extends tt{constructor(n){super();O(this,"name","");O(this,"value","");K.util.initPartial(n,this)}static fromBinary(n,r){return new Gu().fromBinary(n,r)}static fromJson(n,r){return new Gu().fromJson(n,r)}static fromJsonString(n,r){return new Gu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Gu,n,r)}};O(Gu,"runtime",K),O(Gu,"typeName","wg.cosmo.node.v1.URLQueryConfiguration"),O(Gu,"fields",K.util.newFieldList(()=>[{no:1,name:"name",kind:"scalar",T:9},{no:2,name:"value",kind:"scalar",T:9}]));var ew=Gu,Qu=class Qu extends tt{constructor(n){super();O(this,"values",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new Qu().fromBinary(n,r)}static fromJson(n,r){return new Qu().fromJson(n,r)}static fromJsonString(n,r){return new Qu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Qu,n,r)}};O(Qu,"runtime",K),O(Qu,"typeName","wg.cosmo.node.v1.HTTPHeader"),O(Qu,"fields",K.util.newFieldList(()=>[{no:1,name:"values",kind:"message",T:$i,repeated:!0}]));var tw=Qu,Yu=class Yu extends tt{constructor(n){super();O(this,"key");O(this,"cert");O(this,"insecureSkipVerify",!1);K.util.initPartial(n,this)}static fromBinary(n,r){return new Yu().fromBinary(n,r)}static fromJson(n,r){return new Yu().fromJson(n,r)}static fromJsonString(n,r){return new Yu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Yu,n,r)}};O(Yu,"runtime",K),O(Yu,"typeName","wg.cosmo.node.v1.MTLSConfiguration"),O(Yu,"fields",K.util.newFieldList(()=>[{no:1,name:"key",kind:"message",T:$i},{no:2,name:"cert",kind:"message",T:$i},{no:3,name:"insecureSkipVerify",kind:"scalar",T:8}]));var nw=Yu,Ju=class Ju extends tt{constructor(n){super();O(this,"enabled",!1);O(this,"url");O(this,"useSSE");O(this,"protocol");O(this,"websocketSubprotocol");K.util.initPartial(n,this)}static fromBinary(n,r){return new Ju().fromBinary(n,r)}static fromJson(n,r){return new Ju().fromJson(n,r)}static fromJsonString(n,r){return new Ju().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Ju,n,r)}};O(Ju,"runtime",K),O(Ju,"typeName","wg.cosmo.node.v1.GraphQLSubscriptionConfiguration"),O(Ju,"fields",K.util.newFieldList(()=>[{no:1,name:"enabled",kind:"scalar",T:8},{no:2,name:"url",kind:"message",T:$i},{no:3,name:"useSSE",kind:"scalar",T:8,opt:!0},{no:4,name:"protocol",kind:"enum",T:K.getEnumType(lu),opt:!0},{no:5,name:"websocketSubprotocol",kind:"enum",T:K.getEnumType(du),opt:!0}]));var rw=Ju,zu=class zu extends tt{constructor(n){super();O(this,"enabled",!1);O(this,"serviceSdl","");K.util.initPartial(n,this)}static fromBinary(n,r){return new zu().fromBinary(n,r)}static fromJson(n,r){return new zu().fromJson(n,r)}static fromJsonString(n,r){return new zu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(zu,n,r)}};O(zu,"runtime",K),O(zu,"typeName","wg.cosmo.node.v1.GraphQLFederationConfiguration"),O(zu,"fields",K.util.newFieldList(()=>[{no:1,name:"enabled",kind:"scalar",T:8},{no:2,name:"serviceSdl",kind:"scalar",T:9}]));var iw=zu,Hu=class Hu extends tt{constructor(n){super();O(this,"key","");K.util.initPartial(n,this)}static fromBinary(n,r){return new Hu().fromBinary(n,r)}static fromJson(n,r){return new Hu().fromJson(n,r)}static fromJsonString(n,r){return new Hu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Hu,n,r)}};O(Hu,"runtime",K),O(Hu,"typeName","wg.cosmo.node.v1.InternedString"),O(Hu,"fields",K.util.newFieldList(()=>[{no:1,name:"key",kind:"scalar",T:9}]));var nN=Hu,Wu=class Wu extends tt{constructor(n){super();O(this,"typeName","");O(this,"fieldName","");K.util.initPartial(n,this)}static fromBinary(n,r){return new Wu().fromBinary(n,r)}static fromJson(n,r){return new Wu().fromJson(n,r)}static fromJsonString(n,r){return new Wu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Wu,n,r)}};O(Wu,"runtime",K),O(Wu,"typeName","wg.cosmo.node.v1.SingleTypeField"),O(Wu,"fields",K.util.newFieldList(()=>[{no:1,name:"type_name",kind:"scalar",T:9},{no:2,name:"field_name",kind:"scalar",T:9}]));var sw=Wu,Xu=class Xu extends tt{constructor(n){super();O(this,"fieldPath",[]);O(this,"json","");K.util.initPartial(n,this)}static fromBinary(n,r){return new Xu().fromBinary(n,r)}static fromJson(n,r){return new Xu().fromJson(n,r)}static fromJsonString(n,r){return new Xu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Xu,n,r)}};O(Xu,"runtime",K),O(Xu,"typeName","wg.cosmo.node.v1.SubscriptionFieldCondition"),O(Xu,"fields",K.util.newFieldList(()=>[{no:1,name:"field_path",kind:"scalar",T:9,repeated:!0},{no:2,name:"json",kind:"scalar",T:9}]));var rN=Xu,co=class co extends tt{constructor(n){super();O(this,"and",[]);O(this,"in");O(this,"not");O(this,"or",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new co().fromBinary(n,r)}static fromJson(n,r){return new co().fromJson(n,r)}static fromJsonString(n,r){return new co().fromJsonString(n,r)}static equals(n,r){return K.util.equals(co,n,r)}};O(co,"runtime",K),O(co,"typeName","wg.cosmo.node.v1.SubscriptionFilterCondition"),O(co,"fields",K.util.newFieldList(()=>[{no:1,name:"and",kind:"message",T:co,repeated:!0},{no:2,name:"in",kind:"message",T:rN,opt:!0},{no:3,name:"not",kind:"message",T:co,opt:!0},{no:4,name:"or",kind:"message",T:co,repeated:!0}]));var el=co,Zu=class Zu extends tt{constructor(n){super();O(this,"operations",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new Zu().fromBinary(n,r)}static fromJson(n,r){return new Zu().fromJson(n,r)}static fromJsonString(n,r){return new Zu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Zu,n,r)}};O(Zu,"runtime",K),O(Zu,"typeName","wg.cosmo.node.v1.CacheWarmerOperations"),O(Zu,"fields",K.util.newFieldList(()=>[{no:1,name:"operations",kind:"message",T:ow,repeated:!0}]));var rG=Zu,ec=class ec extends tt{constructor(n){super();O(this,"request");O(this,"client");K.util.initPartial(n,this)}static fromBinary(n,r){return new ec().fromBinary(n,r)}static fromJson(n,r){return new ec().fromJson(n,r)}static fromJsonString(n,r){return new ec().fromJsonString(n,r)}static equals(n,r){return K.util.equals(ec,n,r)}};O(ec,"runtime",K),O(ec,"typeName","wg.cosmo.node.v1.Operation"),O(ec,"fields",K.util.newFieldList(()=>[{no:1,name:"request",kind:"message",T:aw},{no:2,name:"client",kind:"message",T:lw}]));var ow=ec,tc=class tc extends tt{constructor(n){super();O(this,"operationName","");O(this,"query","");O(this,"extensions");K.util.initPartial(n,this)}static fromBinary(n,r){return new tc().fromBinary(n,r)}static fromJson(n,r){return new tc().fromJson(n,r)}static fromJsonString(n,r){return new tc().fromJsonString(n,r)}static equals(n,r){return K.util.equals(tc,n,r)}};O(tc,"runtime",K),O(tc,"typeName","wg.cosmo.node.v1.OperationRequest"),O(tc,"fields",K.util.newFieldList(()=>[{no:1,name:"operation_name",kind:"scalar",T:9},{no:2,name:"query",kind:"scalar",T:9},{no:3,name:"extensions",kind:"message",T:uw}]));var aw=tc,nc=class nc extends tt{constructor(n){super();O(this,"persistedQuery");K.util.initPartial(n,this)}static fromBinary(n,r){return new nc().fromBinary(n,r)}static fromJson(n,r){return new nc().fromJson(n,r)}static fromJsonString(n,r){return new nc().fromJsonString(n,r)}static equals(n,r){return K.util.equals(nc,n,r)}};O(nc,"runtime",K),O(nc,"typeName","wg.cosmo.node.v1.Extension"),O(nc,"fields",K.util.newFieldList(()=>[{no:1,name:"persisted_query",kind:"message",T:cw}]));var uw=nc,rc=class rc extends tt{constructor(n){super();O(this,"sha256Hash","");O(this,"version",0);K.util.initPartial(n,this)}static fromBinary(n,r){return new rc().fromBinary(n,r)}static fromJson(n,r){return new rc().fromJson(n,r)}static fromJsonString(n,r){return new rc().fromJsonString(n,r)}static equals(n,r){return K.util.equals(rc,n,r)}};O(rc,"runtime",K),O(rc,"typeName","wg.cosmo.node.v1.PersistedQuery"),O(rc,"fields",K.util.newFieldList(()=>[{no:1,name:"sha256_hash",kind:"scalar",T:9},{no:2,name:"version",kind:"scalar",T:5}]));var cw=rc,ic=class ic extends tt{constructor(n){super();O(this,"name","");O(this,"version","");K.util.initPartial(n,this)}static fromBinary(n,r){return new ic().fromBinary(n,r)}static fromJson(n,r){return new ic().fromJson(n,r)}static fromJsonString(n,r){return new ic().fromJsonString(n,r)}static equals(n,r){return K.util.equals(ic,n,r)}};O(ic,"runtime",K),O(ic,"typeName","wg.cosmo.node.v1.ClientInfo"),O(ic,"fields",K.util.newFieldList(()=>[{no:1,name:"name",kind:"scalar",T:9},{no:2,name:"version",kind:"scalar",T:9}]));var lw=ic;m();T();h();var f_=bv(Vg(),1);function Qpe(e){if(!e.conditions)return;let t=[];for(let n of e.conditions){let r=[];for(let i of n.fieldCoordinatesPath){let s=i.split(".");if(s.length!==2)throw new Error(`fatal: malformed conditional field coordinates "${i}" for field set "${e.selectionSet}".`);r.push(new HT({fieldName:s[1],typeName:s[0]}))}t.push(new WT({fieldCoordinatesPath:r,fieldPath:n.fieldPath}))}return t}function dw(e,t,n){if(e)for(let r of e){let i=Qpe(r);t.push(new gd(C(C({typeName:n,fieldName:r.fieldName,selectionSet:r.selectionSet},r.disableEntityResolver?{disableEntityResolver:!0}:{}),i?{conditions:i}:{})))}}function iG(e){switch(e){case"publish":return sc.PUBLISH;case"request":return sc.REQUEST;case"subscribe":return sc.SUBSCRIBE}}function sG(e){var n;let t={rootNodes:[],childNodes:[],keys:[],provides:[],events:new Sd({nats:[],kafka:[]}),requires:[],entityInterfaces:[],interfaceObjects:[]};for(let r of e.values()){let i=r.typeName,s=[...r.fieldNames],o=new Af({typeName:i,fieldNames:s});if(r.externalFieldNames&&r.externalFieldNames.size>0&&(o.externalFieldNames=[...r.externalFieldNames]),r.isRootNode?t.rootNodes.push(o):t.childNodes.push(o),r.entityInterfaceConcreteTypeNames){let d=new Rf({interfaceTypeName:i,concreteTypeNames:[...r.entityInterfaceConcreteTypeNames]});r.isInterfaceObject?t.interfaceObjects.push(d):t.entityInterfaces.push(d)}dw(r.keys,t.keys,i),dw(r.provides,t.provides,i),dw(r.requires,t.requires,i);let u=[],l=[];for(let d of(n=r.events)!=null?n:[])switch(d.providerType){case f_.PROVIDER_TYPE_KAFKA:{l.push(new tN({engineEventConfiguration:new Od({fieldName:d.fieldName,providerId:d.providerId,type:iG(d.type),typeName:i}),topics:d.topics}));break}case f_.PROVIDER_TYPE_NATS:{u.push(new eN(C({engineEventConfiguration:new Od({fieldName:d.fieldName,providerId:d.providerId,type:iG(d.type),typeName:i}),subjects:d.subjects},d.streamConfiguration?{streamConfiguration:new ZT({consumerInactiveThreshold:d.streamConfiguration.consumerInactiveThreshold,consumerName:d.streamConfiguration.consumerName,streamName:d.streamConfiguration.streamName})}:{})));break}default:throw new Error("Fatal: Unknown event provider.")}t.events.nats.push(...u),t.events.kafka.push(...l)}return t}function oG(e){var n;let t=[];for(let r of e){let i=r.argumentNames.map(l=>new QT({name:l,sourceType:_d.FIELD_ARGUMENT})),s=new zT({argumentsConfiguration:i,fieldName:r.fieldName,typeName:r.typeName}),o=((n=r.requiredScopes)==null?void 0:n.map(l=>new YT({requiredAndScopes:l})))||[],u=o.length>0;if((r.requiresAuthentication||u)&&(s.authorizationConfiguration=new JT({requiresAuthentication:r.requiresAuthentication||u,requiredOrScopes:o})),r.subscriptionFilterCondition){let l=new el;p_(l,r.subscriptionFilterCondition),s.subscriptionFilterCondition=l}t.push(s)}return t}function p_(e,t){if(t.and!==void 0){let n=[];for(let r of t.and){let i=new el;p_(i,r),n.push(i)}e.and=n;return}if(t.in!==void 0){e.in=new rN({fieldPath:t.in.fieldPath,json:JSON.stringify(t.in.values)});return}if(t.not!==void 0){e.not=new el,p_(e.not,t.not);return}if(t.or!==void 0){let n=[];for(let r of t.or){let i=new el;p_(i,r),n.push(i)}e.or=n;return}throw new Error("Fatal: Incoming SubscriptionCondition object was malformed.")}m();T();h();function pw(e){return new Error(`Normalization failed to return a ${e}.`)}function aG(e){return new Error(`Invalid router compatibility version "${e}".`)}var Ype=(e,t)=>{let n=stringHash(t);return e.stringStorage[n]=t,new nN({key:n})},Jpe=e=>{switch(e){case"ws":return lu.GRAPHQL_SUBSCRIPTION_PROTOCOL_WS;case"sse":return lu.GRAPHQL_SUBSCRIPTION_PROTOCOL_SSE;case"sse_post":return lu.GRAPHQL_SUBSCRIPTION_PROTOCOL_SSE_POST}throw new Error(`Unsupported subscription protocol '${e}'`)},zpe=e=>{switch(e){case"auto":return du.GRAPHQL_WEBSOCKET_SUBPROTOCOL_AUTO;case"graphql-ws":return du.GRAPHQL_WEBSOCKET_SUBPROTOCOL_WS;case"graphql-transport-ws":return du.GRAPHQL_WEBSOCKET_SUBPROTOCOL_TRANSPORT_WS}throw new Error(`Unsupported  websocket subprotocol '${e}'`)},uG=function(e){if(!Ff.ROUTER_COMPATIBILITY_VERSIONS.has(e.routerCompatibilityVersion))throw aG(e.routerCompatibilityVersion);let t=new bf({defaultFlushInterval:BigInt(500),datasourceConfigurations:[],fieldConfigurations:[],graphqlSchema:"",stringStorage:{},typeConfigurations:[]});for(let n of e.subgraphs){if(!n.configurationDataByTypeName)throw pw("ConfigurationDataByTypeName");if(!n.schema)throw pw("GraphQLSchema");let r=Ype(t,TK(fh(n.schema))),{childNodes:i,entityInterfaces:s,events:o,interfaceObjects:u,keys:l,provides:d,requires:p,rootNodes:N}=sG(n.configurationDataByTypeName),E=Jpe(n.subscriptionProtocol||"ws"),g=zpe(n.websocketSubprotocol||"auto"),A,k,j;if(o.kafka.length>0||o.nats.length>0){A=Xc.PUBSUB,j=new Sd({kafka:o.kafka,nats:o.nats});let ie=Oe=>Ff.ROOT_TYPE_NAMES.has(Oe.typeName),re=0,oe=0;for(;re<N.length;){let Oe=N[re];ie(Oe)?N[oe++]=Oe:i.push(Oe),re++}N.length=oe}else A=Xc.GRAPHQL,k=new XT({customScalarTypeFields:[],federation:{enabled:!0,serviceSdl:n.sdl},upstreamSchema:r,fetch:{url:new $i({kind:Zc.STATIC_CONFIGURATION_VARIABLE,staticVariableContent:n.url}),method:vd.POST,header:{},body:{},baseUrl:{},path:{}},subscription:{enabled:!0,url:new $i({kind:Zc.STATIC_CONFIGURATION_VARIABLE,staticVariableContent:n.subscriptionUrl||n.url}),protocol:E,websocketSubprotocol:g}});let Y=new GT({id:n.id,childNodes:i,customEvents:j,customGraphql:k,directives:[],entityInterfaces:s,interfaceObjects:u,keys:l,kind:A,overrideFieldPathFromAlias:!0,provides:d,requestTimeoutSeconds:BigInt(10),requires:p,rootNodes:N});t.datasourceConfigurations.push(Y)}return t.fieldConfigurations=oG(e.fieldConfigurations),t.graphqlSchema=e.federatedSDL,e.federatedClientSDL!==""&&(t.graphqlClientSchema=e.federatedClientSDL),new KT({engineConfig:t,version:e.schemaVersionId,subgraphs:e.subgraphs.map(n=>({id:n.id,name:n.name,routingUrl:n.url})),compatibilityVersion:`${e.routerCompatibilityVersion}:${Ff.COMPOSITION_VERSION}`})};m();T();h();Ue();function cG(e){let t;try{t=ho(e.schema)}catch(n){throw new Error(`could not parse schema for Graph ${e.name}: ${n}`)}return{definitions:t,name:e.name,url:e.url}}function Hpe(e){let t=(0,Dd.federateSubgraphs)(e.map(cG),Dd.LATEST_ROUTER_COMPATIBILITY_VERSION);if(!t.success)throw new Error(`could not federate schema: ${t.errors.map(n=>n.message).join(", ")}`);return{fieldConfigurations:t.fieldConfigurations,sdl:Rt(t.federatedGraphAST)}}function Wpe(e){let t=(0,Dd.federateSubgraphs)(e.map(cG),Dd.LATEST_ROUTER_COMPATIBILITY_VERSION);if(!t.success)throw new Error(`could not federate schema: ${t.errors.map(r=>r.message).join(", ")}`);return uG({federatedClientSDL:yp(t.federatedGraphClientSchema),federatedSDL:yp(t.federatedGraphSchema),fieldConfigurations:t.fieldConfigurations,routerCompatibilityVersion:Dd.LATEST_ROUTER_COMPATIBILITY_VERSION,schemaVersionId:"",subgraphs:e.map((r,i)=>{var l,d;let s=t.subgraphConfigBySubgraphName.get(r.name),o=s==null?void 0:s.schema,u=s==null?void 0:s.configurationDataByTypeName;return{id:`${i}`,name:r.name,url:RF(r.url),sdl:r.schema,subscriptionUrl:RF((l=r.subscription_url)!=null?l:r.url),subscriptionProtocol:(d=r.subscription_protocol)!=null?d:"ws",websocketSubprotocol:r.subscription_protocol==="ws"?r.websocketSubprotocol||"auto":void 0,schema:o,configurationDataByTypeName:u}})}).toJsonString()}return Be(Xpe);})();
+`:case"\r":case"	":case" ":continue;default:throw Error("invalid base64 string.")}switch(i){case 0:o=s,i=1;break;case 1:n[r++]=o<<2|(s&48)>>4,o=s,i=2;break;case 2:n[r++]=(o&15)<<4|(s&60)>>2,o=s,i=3;break;case 3:n[r++]=(o&3)<<6|s,i=0;break}}if(i==1)throw Error("invalid base64 string.");return n.subarray(0,r)},enc(e){let t="",n=0,r,i=0;for(let s=0;s<e.length;s++)switch(r=e[s],n){case 0:t+=cu[r>>2],i=(r&3)<<4,n=1;break;case 1:t+=cu[i|r>>4],i=(r&15)<<2,n=2;break;case 2:t+=cu[i|r>>6],t+=cu[r&63],n=0;break}return n&&(t+=cu[i],t+="=",n==1&&(t+="=")),t}};m();T();h();function wK(e,t,n){LK(t,e);let r=t.runtime.bin.makeReadOptions(n),i=FK(e.getType().runtime.bin.listUnknownFields(e),t.field),[s,o]=Zg(t);for(let u of i)t.runtime.bin.readField(s,r.readerFactory(u.data),t.field,u.wireType,r);return o()}function PK(e,t,n,r){LK(t,e);let i=t.runtime.bin.makeReadOptions(r),s=t.runtime.bin.makeWriteOptions(r);if(BF(e,t)){let d=e.getType().runtime.bin.listUnknownFields(e).filter(p=>p.no!=t.field.no);e.getType().runtime.bin.discardUnknownFields(e);for(let p of d)e.getType().runtime.bin.onUnknownField(e,p.no,p.wireType,p.data)}let o=s.writerFactory(),u=t.field;!u.opt&&!u.repeated&&(u.kind=="enum"||u.kind=="scalar")&&(u=Object.assign(Object.assign({},t.field),{opt:!0})),t.runtime.bin.writeField(u,n,o,s);let l=i.readerFactory(o.finish());for(;l.pos<l.len;){let[d,p]=l.tag(),N=l.skip(p);e.getType().runtime.bin.onUnknownField(e,d,p,N)}}function BF(e,t){let n=e.getType();return t.extendee.typeName===n.typeName&&!!n.runtime.bin.listUnknownFields(e).find(r=>r.no==t.field.no)}function LK(e,t){Cn(e.extendee.typeName==t.getType().typeName,`extension ${e.typeName} can only be applied to message ${e.extendee.typeName}`)}m();T();h();function t_(e,t){let n=e.localName;if(e.repeated)return t[n].length>0;if(e.oneof)return t[e.oneof.localName].case===n;switch(e.kind){case"enum":case"scalar":return e.opt||e.req?t[n]!==void 0:e.kind=="enum"?t[n]!==e.T.values[0].no:!Hg(e.T,t[n]);case"message":return t[n]!==void 0;case"map":return Object.keys(t[n]).length>0}}function VF(e,t){let n=e.localName,r=!e.opt&&!e.req;if(e.repeated)t[n]=[];else if(e.oneof)t[e.oneof.localName]={case:void 0};else switch(e.kind){case"map":t[n]={};break;case"enum":t[n]=r?e.T.values[0].no:void 0;break;case"scalar":t[n]=r?ko(e.T,e.L):void 0;break;case"message":t[n]=void 0;break}}m();T();h();m();T();h();function xo(e,t){if(e===null||typeof e!="object"||!Object.getOwnPropertyNames(tt.prototype).every(r=>r in e&&typeof e[r]=="function"))return!1;let n=e.getType();return n===null||typeof n!="function"||!("typeName"in n)||typeof n.typeName!="string"?!1:t===void 0?!0:n.typeName==t.typeName}function n_(e,t){return xo(t)||!e.fieldWrapper?t:e.fieldWrapper.wrapField(t)}var jze={"google.protobuf.DoubleValue":ve.DOUBLE,"google.protobuf.FloatValue":ve.FLOAT,"google.protobuf.Int64Value":ve.INT64,"google.protobuf.UInt64Value":ve.UINT64,"google.protobuf.Int32Value":ve.INT32,"google.protobuf.UInt32Value":ve.UINT32,"google.protobuf.BoolValue":ve.BOOL,"google.protobuf.StringValue":ve.STRING,"google.protobuf.BytesValue":ve.BYTES};var CK={ignoreUnknownFields:!1},UK={emitDefaultValues:!1,enumAsInteger:!1,useProtoFieldName:!1,prettySpaces:0};function Ppe(e){return e?Object.assign(Object.assign({},CK),e):CK}function Lpe(e){return e?Object.assign(Object.assign({},UK),e):UK}var s_=Symbol(),r_=Symbol();function MK(){return{makeReadOptions:Ppe,makeWriteOptions:Lpe,readMessage(e,t,n,r){if(t==null||Array.isArray(t)||typeof t!="object")throw new Error(`cannot decode message ${e.typeName} from JSON: ${_a(t)}`);r=r!=null?r:new e;let i=new Map,s=n.typeRegistry;for(let[o,u]of Object.entries(t)){let l=e.fields.findJsonName(o);if(l){if(l.oneof){if(u===null&&l.kind=="scalar")continue;let d=i.get(l.oneof);if(d!==void 0)throw new Error(`cannot decode message ${e.typeName} from JSON: multiple keys for oneof "${l.oneof.name}" present: "${d}", "${o}"`);i.set(l.oneof,o)}kK(r,u,l,n,e)}else{let d=!1;if(s!=null&&s.findExtension&&o.startsWith("[")&&o.endsWith("]")){let p=s.findExtension(o.substring(1,o.length-1));if(p&&p.extendee.typeName==e.typeName){d=!0;let[N,E]=Zg(p);kK(N,u,p.field,n,p),PK(r,p,E(),n)}}if(!d&&!n.ignoreUnknownFields)throw new Error(`cannot decode message ${e.typeName} from JSON: key "${o}" is unknown`)}}return r},writeMessage(e,t){let n=e.getType(),r={},i;try{for(i of n.fields.byNumber()){if(!t_(i,e)){if(i.req)throw"required field not set";if(!t.emitDefaultValues||!Upe(i))continue}let o=i.oneof?e[i.oneof.localName].value:e[i.localName],u=xK(i,o,t);u!==void 0&&(r[t.useProtoFieldName?i.name:i.jsonName]=u)}let s=t.typeRegistry;if(s!=null&&s.findExtensionFor)for(let o of n.runtime.bin.listUnknownFields(e)){let u=s.findExtensionFor(n.typeName,o.no);if(u&&BF(e,u)){let l=wK(e,u,t),d=xK(u.field,l,t);d!==void 0&&(r[u.field.jsonName]=d)}}}catch(s){let o=i?`cannot encode field ${n.typeName}.${i.name} to JSON`:`cannot encode message ${n.typeName} to JSON`,u=s instanceof Error?s.message:String(s);throw new Error(o+(u.length>0?`: ${u}`:""))}return r},readScalar(e,t,n){return jT(e,t,n!=null?n:Uo.BIGINT,!0)},writeScalar(e,t,n){if(t!==void 0&&(n||Hg(e,t)))return i_(e,t)},debug:_a}}function _a(e){if(e===null)return"null";switch(typeof e){case"object":return Array.isArray(e)?"array":"object";case"string":return e.length>100?"string":`"${e.split('"').join('\\"')}"`;default:return String(e)}}function kK(e,t,n,r,i){let s=n.localName;if(n.repeated){if(Cn(n.kind!="map"),t===null)return;if(!Array.isArray(t))throw new Error(`cannot decode field ${i.typeName}.${n.name} from JSON: ${_a(t)}`);let o=e[s];for(let u of t){if(u===null)throw new Error(`cannot decode field ${i.typeName}.${n.name} from JSON: ${_a(u)}`);switch(n.kind){case"message":o.push(n.T.fromJson(u,r));break;case"enum":let l=jF(n.T,u,r.ignoreUnknownFields,!0);l!==r_&&o.push(l);break;case"scalar":try{o.push(jT(n.T,u,n.L,!0))}catch(d){let p=`cannot decode field ${i.typeName}.${n.name} from JSON: ${_a(u)}`;throw d instanceof Error&&d.message.length>0&&(p+=`: ${d.message}`),new Error(p)}break}}}else if(n.kind=="map"){if(t===null)return;if(typeof t!="object"||Array.isArray(t))throw new Error(`cannot decode field ${i.typeName}.${n.name} from JSON: ${_a(t)}`);let o=e[s];for(let[u,l]of Object.entries(t)){if(l===null)throw new Error(`cannot decode field ${i.typeName}.${n.name} from JSON: map value null`);let d;try{d=Cpe(n.K,u)}catch(p){let N=`cannot decode map key for field ${i.typeName}.${n.name} from JSON: ${_a(t)}`;throw p instanceof Error&&p.message.length>0&&(N+=`: ${p.message}`),new Error(N)}switch(n.V.kind){case"message":o[d]=n.V.T.fromJson(l,r);break;case"enum":let p=jF(n.V.T,l,r.ignoreUnknownFields,!0);p!==r_&&(o[d]=p);break;case"scalar":try{o[d]=jT(n.V.T,l,Uo.BIGINT,!0)}catch(N){let E=`cannot decode map value for field ${i.typeName}.${n.name} from JSON: ${_a(t)}`;throw N instanceof Error&&N.message.length>0&&(E+=`: ${N.message}`),new Error(E)}break}}}else switch(n.oneof&&(e=e[n.oneof.localName]={case:s},s="value"),n.kind){case"message":let o=n.T;if(t===null&&o.typeName!="google.protobuf.Value")return;let u=e[s];xo(u)?u.fromJson(t,r):(e[s]=u=o.fromJson(t,r),o.fieldWrapper&&!n.oneof&&(e[s]=o.fieldWrapper.unwrapField(u)));break;case"enum":let l=jF(n.T,t,r.ignoreUnknownFields,!1);switch(l){case s_:VF(n,e);break;case r_:break;default:e[s]=l;break}break;case"scalar":try{let d=jT(n.T,t,n.L,!1);switch(d){case s_:VF(n,e);break;default:e[s]=d;break}}catch(d){let p=`cannot decode field ${i.typeName}.${n.name} from JSON: ${_a(t)}`;throw d instanceof Error&&d.message.length>0&&(p+=`: ${d.message}`),new Error(p)}break}}function Cpe(e,t){if(e===ve.BOOL)switch(t){case"true":t=!0;break;case"false":t=!1;break}return jT(e,t,Uo.BIGINT,!0).toString()}function jT(e,t,n,r){if(t===null)return r?ko(e,n):s_;switch(e){case ve.DOUBLE:case ve.FLOAT:if(t==="NaN")return Number.NaN;if(t==="Infinity")return Number.POSITIVE_INFINITY;if(t==="-Infinity")return Number.NEGATIVE_INFINITY;if(t===""||typeof t=="string"&&t.trim().length!==t.length||typeof t!="string"&&typeof t!="number")break;let i=Number(t);if(Number.isNaN(i)||!Number.isFinite(i))break;return e==ve.FLOAT&&Yg(i),i;case ve.INT32:case ve.FIXED32:case ve.SFIXED32:case ve.SINT32:case ve.UINT32:let s;if(typeof t=="number"?s=t:typeof t=="string"&&t.length>0&&t.trim().length===t.length&&(s=Number(t)),s===void 0)break;return e==ve.UINT32||e==ve.FIXED32?VT(s):Of(s),s;case ve.INT64:case ve.SFIXED64:case ve.SINT64:if(typeof t!="number"&&typeof t!="string")break;let o=br.parse(t);return n?o.toString():o;case ve.FIXED64:case ve.UINT64:if(typeof t!="number"&&typeof t!="string")break;let u=br.uParse(t);return n?u.toString():u;case ve.BOOL:if(typeof t!="boolean")break;return t;case ve.STRING:if(typeof t!="string")break;try{encodeURIComponent(t)}catch(l){throw new Error("invalid UTF8")}return t;case ve.BYTES:if(t==="")return new Uint8Array(0);if(typeof t!="string")break;return MF.dec(t)}throw new Error}function jF(e,t,n,r){if(t===null)return e.typeName=="google.protobuf.NullValue"?0:r?e.values[0].no:s_;switch(typeof t){case"number":if(Number.isInteger(t))return t;break;case"string":let i=e.findName(t);if(i!==void 0)return i.no;if(n)return r_;break}throw new Error(`cannot decode enum ${e.typeName} from JSON: ${_a(t)}`)}function Upe(e){return e.repeated||e.kind=="map"?!0:!(e.oneof||e.kind=="message"||e.opt||e.req)}function xK(e,t,n){if(e.kind=="map"){Cn(typeof t=="object"&&t!=null);let r={},i=Object.entries(t);switch(e.V.kind){case"scalar":for(let[o,u]of i)r[o.toString()]=i_(e.V.T,u);break;case"message":for(let[o,u]of i)r[o.toString()]=u.toJson(n);break;case"enum":let s=e.V.T;for(let[o,u]of i)r[o.toString()]=qF(s,u,n.enumAsInteger);break}return n.emitDefaultValues||i.length>0?r:void 0}if(e.repeated){Cn(Array.isArray(t));let r=[];switch(e.kind){case"scalar":for(let i=0;i<t.length;i++)r.push(i_(e.T,t[i]));break;case"enum":for(let i=0;i<t.length;i++)r.push(qF(e.T,t[i],n.enumAsInteger));break;case"message":for(let i=0;i<t.length;i++)r.push(t[i].toJson(n));break}return n.emitDefaultValues||r.length>0?r:void 0}switch(e.kind){case"scalar":return i_(e.T,t);case"enum":return qF(e.T,t,n.enumAsInteger);case"message":return n_(e.T,t).toJson(n)}}function qF(e,t,n){var r;if(Cn(typeof t=="number"),e.typeName=="google.protobuf.NullValue")return null;if(n)return t;let i=e.findNumber(t);return(r=i==null?void 0:i.name)!==null&&r!==void 0?r:t}function i_(e,t){switch(e){case ve.INT32:case ve.SFIXED32:case ve.SINT32:case ve.FIXED32:case ve.UINT32:return Cn(typeof t=="number"),t;case ve.FLOAT:case ve.DOUBLE:return Cn(typeof t=="number"),Number.isNaN(t)?"NaN":t===Number.POSITIVE_INFINITY?"Infinity":t===Number.NEGATIVE_INFINITY?"-Infinity":t;case ve.STRING:return Cn(typeof t=="string"),t;case ve.BOOL:return Cn(typeof t=="boolean"),t;case ve.UINT64:case ve.FIXED64:case ve.INT64:case ve.SFIXED64:case ve.SINT64:return Cn(typeof t=="bigint"||typeof t=="string"||typeof t=="number"),t.toString();case ve.BYTES:return Cn(t instanceof Uint8Array),MF.enc(t)}}m();T();h();var Sf=Symbol("@bufbuild/protobuf/unknown-fields"),BK={readUnknownFields:!0,readerFactory:e=>new Xg(e)},VK={writeUnknownFields:!0,writerFactory:()=>new Wg};function kpe(e){return e?Object.assign(Object.assign({},BK),e):BK}function xpe(e){return e?Object.assign(Object.assign({},VK),e):VK}function KK(){return{makeReadOptions:kpe,makeWriteOptions:xpe,listUnknownFields(e){var t;return(t=e[Sf])!==null&&t!==void 0?t:[]},discardUnknownFields(e){delete e[Sf]},writeUnknownFields(e,t){let r=e[Sf];if(r)for(let i of r)t.tag(i.no,i.wireType).raw(i.data)},onUnknownField(e,t,n,r){let i=e;Array.isArray(i[Sf])||(i[Sf]=[]),i[Sf].push({no:t,wireType:n,data:r})},readMessage(e,t,n,r,i){let s=e.getType(),o=i?t.len:t.pos+n,u,l;for(;t.pos<o&&([u,l]=t.tag(),l!=Nr.EndGroup);){let d=s.fields.find(u);if(!d){let p=t.skip(l);r.readUnknownFields&&this.onUnknownField(e,u,l,p);continue}jK(e,t,d,l,r)}if(i&&(l!=Nr.EndGroup||u!==n))throw new Error("invalid end group tag")},readField:jK,writeMessage(e,t,n){let r=e.getType();for(let i of r.fields.byNumber()){if(!t_(i,e)){if(i.req)throw new Error(`cannot encode field ${r.typeName}.${i.name} to binary: required field not set`);continue}let s=i.oneof?e[i.oneof.localName].value:e[i.localName];qK(i,s,t,n)}return n.writeUnknownFields&&this.writeUnknownFields(e,t),t},writeField(e,t,n,r){t!==void 0&&qK(e,t,n,r)}}}function jK(e,t,n,r,i){let{repeated:s,localName:o}=n;switch(n.oneof&&(e=e[n.oneof.localName],e.case!=o&&delete e.value,e.case=o,o="value"),n.kind){case"scalar":case"enum":let u=n.kind=="enum"?ve.INT32:n.T,l=a_;if(n.kind=="scalar"&&n.L>0&&(l=Bpe),s){let E=e[o];if(r==Nr.LengthDelimited&&u!=ve.STRING&&u!=ve.BYTES){let A=t.uint32()+t.pos;for(;t.pos<A;)E.push(l(t,u))}else E.push(l(t,u))}else e[o]=l(t,u);break;case"message":let d=n.T;s?e[o].push(o_(t,new d,i,n)):xo(e[o])?o_(t,e[o],i,n):(e[o]=o_(t,new d,i,n),d.fieldWrapper&&!n.oneof&&!n.repeated&&(e[o]=d.fieldWrapper.unwrapField(e[o])));break;case"map":let[p,N]=Mpe(n,t,i);e[o][p]=N;break}}function o_(e,t,n,r){let i=t.getType().runtime.bin,s=r==null?void 0:r.delimited;return i.readMessage(t,e,s?r.no:e.uint32(),n,s),t}function Mpe(e,t,n){let r=t.uint32(),i=t.pos+r,s,o;for(;t.pos<i;){let[u]=t.tag();switch(u){case 1:s=a_(t,e.K);break;case 2:switch(e.V.kind){case"scalar":o=a_(t,e.V.T);break;case"enum":o=t.int32();break;case"message":o=o_(t,new e.V.T,n,void 0);break}break}}if(s===void 0&&(s=ko(e.K,Uo.BIGINT)),typeof s!="string"&&typeof s!="number"&&(s=s.toString()),o===void 0)switch(e.V.kind){case"scalar":o=ko(e.V.T,Uo.BIGINT);break;case"enum":o=e.V.T.values[0].no;break;case"message":o=new e.V.T;break}return[s,o]}function Bpe(e,t){let n=a_(e,t);return typeof n=="bigint"?n.toString():n}function a_(e,t){switch(t){case ve.STRING:return e.string();case ve.BOOL:return e.bool();case ve.DOUBLE:return e.double();case ve.FLOAT:return e.float();case ve.INT32:return e.int32();case ve.INT64:return e.int64();case ve.UINT64:return e.uint64();case ve.FIXED64:return e.fixed64();case ve.BYTES:return e.bytes();case ve.FIXED32:return e.fixed32();case ve.SFIXED32:return e.sfixed32();case ve.SFIXED64:return e.sfixed64();case ve.SINT64:return e.sint64();case ve.UINT32:return e.uint32();case ve.SINT32:return e.sint32()}}function qK(e,t,n,r){Cn(t!==void 0);let i=e.repeated;switch(e.kind){case"scalar":case"enum":let s=e.kind=="enum"?ve.INT32:e.T;if(i)if(Cn(Array.isArray(t)),e.packed)jpe(n,s,e.no,t);else for(let o of t)qT(n,s,e.no,o);else qT(n,s,e.no,t);break;case"message":if(i){Cn(Array.isArray(t));for(let o of t)$K(n,r,e,o)}else $K(n,r,e,t);break;case"map":Cn(typeof t=="object"&&t!=null);for(let[o,u]of Object.entries(t))Vpe(n,r,e,o,u);break}}function Vpe(e,t,n,r,i){e.tag(n.no,Nr.LengthDelimited),e.fork();let s=r;switch(n.K){case ve.INT32:case ve.FIXED32:case ve.UINT32:case ve.SFIXED32:case ve.SINT32:s=Number.parseInt(r);break;case ve.BOOL:Cn(r=="true"||r=="false"),s=r=="true";break}switch(qT(e,n.K,1,s),n.V.kind){case"scalar":qT(e,n.V.T,2,i);break;case"enum":qT(e,ve.INT32,2,i);break;case"message":Cn(i!==void 0),e.tag(2,Nr.LengthDelimited).bytes(i.toBinary(t));break}e.join()}function $K(e,t,n,r){let i=n_(n.T,r);n.delimited?e.tag(n.no,Nr.StartGroup).raw(i.toBinary(t)).tag(n.no,Nr.EndGroup):e.tag(n.no,Nr.LengthDelimited).bytes(i.toBinary(t))}function qT(e,t,n,r){Cn(r!==void 0);let[i,s]=GK(t);e.tag(n,i)[s](r)}function jpe(e,t,n,r){if(!r.length)return;e.tag(n,Nr.LengthDelimited).fork();let[,i]=GK(t);for(let s=0;s<r.length;s++)e[i](r[s]);e.join()}function GK(e){let t=Nr.Varint;switch(e){case ve.BYTES:case ve.STRING:t=Nr.LengthDelimited;break;case ve.DOUBLE:case ve.FIXED64:case ve.SFIXED64:t=Nr.Bit64;break;case ve.FIXED32:case ve.SFIXED32:case ve.FLOAT:t=Nr.Bit32;break}let n=ve[e].toLowerCase();return[t,n]}m();T();h();function QK(){return{setEnumType:PF,initPartial(e,t){if(e===void 0)return;let n=t.getType();for(let r of n.fields.byMember()){let i=r.localName,s=t,o=e;if(o[i]!==void 0)switch(r.kind){case"oneof":let u=o[i].case;if(u===void 0)continue;let l=r.findField(u),d=o[i].value;l&&l.kind=="message"&&!xo(d,l.T)?d=new l.T(d):l&&l.kind==="scalar"&&l.T===ve.BYTES&&(d=$T(d)),s[i]={case:u,value:d};break;case"scalar":case"enum":let p=o[i];r.T===ve.BYTES&&(p=r.repeated?p.map($T):$T(p)),s[i]=p;break;case"map":switch(r.V.kind){case"scalar":case"enum":if(r.V.T===ve.BYTES)for(let[g,A]of Object.entries(o[i]))s[i][g]=$T(A);else Object.assign(s[i],o[i]);break;case"message":let E=r.V.T;for(let g of Object.keys(o[i])){let A=o[i][g];E.fieldWrapper||(A=new E(A)),s[i][g]=A}break}break;case"message":let N=r.T;if(r.repeated)s[i]=o[i].map(E=>xo(E,N)?E:new N(E));else{let E=o[i];N.fieldWrapper?N.typeName==="google.protobuf.BytesValue"?s[i]=$T(E):s[i]=E:s[i]=xo(E,N)?E:new N(E)}break}}},equals(e,t,n){return t===n?!0:!t||!n?!1:e.fields.byMember().every(r=>{let i=t[r.localName],s=n[r.localName];if(r.repeated){if(i.length!==s.length)return!1;switch(r.kind){case"message":return i.every((o,u)=>r.T.equals(o,s[u]));case"scalar":return i.every((o,u)=>uu(r.T,o,s[u]));case"enum":return i.every((o,u)=>uu(ve.INT32,o,s[u]))}throw new Error(`repeated cannot contain ${r.kind}`)}switch(r.kind){case"message":return r.T.equals(i,s);case"enum":return uu(ve.INT32,i,s);case"scalar":return uu(r.T,i,s);case"oneof":if(i.case!==s.case)return!1;let o=r.findField(i.case);if(o===void 0)return!0;switch(o.kind){case"message":return o.T.equals(i.value,s.value);case"enum":return uu(ve.INT32,i.value,s.value);case"scalar":return uu(o.T,i.value,s.value)}throw new Error(`oneof cannot contain ${o.kind}`);case"map":let u=Object.keys(i).concat(Object.keys(s));switch(r.V.kind){case"message":let l=r.V.T;return u.every(p=>l.equals(i[p],s[p]));case"enum":return u.every(p=>uu(ve.INT32,i[p],s[p]));case"scalar":let d=r.V.T;return u.every(p=>uu(d,i[p],s[p]))}break}})},clone(e){let t=e.getType(),n=new t,r=n;for(let i of t.fields.byMember()){let s=e[i.localName],o;if(i.repeated)o=s.map(u_);else if(i.kind=="map"){o=r[i.localName];for(let[u,l]of Object.entries(s))o[u]=u_(l)}else i.kind=="oneof"?o=i.findField(s.case)?{case:s.case,value:u_(s.value)}:{case:void 0}:o=u_(s);r[i.localName]=o}for(let i of t.runtime.bin.listUnknownFields(e))t.runtime.bin.onUnknownField(r,i.no,i.wireType,i.data);return n}}}function u_(e){if(e===void 0)return e;if(xo(e))return e.clone();if(e instanceof Uint8Array){let t=new Uint8Array(e.byteLength);return t.set(e),t}return e}function $T(e){return e instanceof Uint8Array?e:new Uint8Array(e)}function YK(e,t,n){return{syntax:e,json:MK(),bin:KK(),util:Object.assign(Object.assign({},QK()),{newFieldList:t,initFields:n}),makeMessageType(r,i,s){return vK(this,r,i,s)},makeEnum:gK,makeEnumType:LF,getEnumType:IK,makeExtension(r,i,s){return RK(this,r,i,s)}}}m();T();h();var c_=class{constructor(t,n){this._fields=t,this._normalizer=n}findJsonName(t){if(!this.jsonNames){let n={};for(let r of this.list())n[r.jsonName]=n[r.name]=r;this.jsonNames=n}return this.jsonNames[t]}find(t){if(!this.numbers){let n={};for(let r of this.list())n[r.no]=r;this.numbers=n}return this.numbers[t]}list(){return this.all||(this.all=this._normalizer(this._fields)),this.all}byNumber(){return this.numbersAsc||(this.numbersAsc=this.list().concat().sort((t,n)=>t.no-n.no)),this.numbersAsc}byMember(){if(!this.members){this.members=[];let t=this.members,n;for(let r of this.list())r.oneof?r.oneof!==n&&(n=r.oneof,t.push(n)):t.push(r)}return this.members}};m();T();h();m();T();h();m();T();h();function $F(e,t){let n=HK(e);return t?n:Gpe(Kpe(n))}function JK(e){return $F(e,!1)}var zK=HK;function HK(e){let t=!1,n=[];for(let r=0;r<e.length;r++){let i=e.charAt(r);switch(i){case"_":t=!0;break;case"0":case"1":case"2":case"3":case"4":case"5":case"6":case"7":case"8":case"9":n.push(i),t=!1;break;default:t&&(t=!1,i=i.toUpperCase()),n.push(i);break}}return n.join("")}var qpe=new Set(["constructor","toString","toJSON","valueOf"]),$pe=new Set(["getType","clone","equals","fromBinary","fromJson","fromJsonString","toBinary","toJson","toJsonString","toObject"]),WK=e=>`${e}$`,Kpe=e=>$pe.has(e)?WK(e):e,Gpe=e=>qpe.has(e)?WK(e):e;var l_=class{constructor(t){this.kind="oneof",this.repeated=!1,this.packed=!1,this.opt=!1,this.req=!1,this.default=void 0,this.fields=[],this.name=t,this.localName=JK(t)}addField(t){Cn(t.oneof===this,`field ${t.name} not one of ${this.name}`),this.fields.push(t)}findField(t){if(!this._lookup){this._lookup=Object.create(null);for(let n=0;n<this.fields.length;n++)this._lookup[this.fields[n].localName]=this.fields[n]}return this._lookup[t]}};function XK(e,t){var n,r,i,s,o,u;let l=[],d;for(let p of typeof e=="function"?e():e){let N=p;if(N.localName=$F(p.name,p.oneof!==void 0),N.jsonName=(n=p.jsonName)!==null&&n!==void 0?n:zK(p.name),N.repeated=(r=p.repeated)!==null&&r!==void 0?r:!1,p.kind=="scalar"&&(N.L=(i=p.L)!==null&&i!==void 0?i:Uo.BIGINT),N.delimited=(s=p.delimited)!==null&&s!==void 0?s:!1,N.req=(o=p.req)!==null&&o!==void 0?o:!1,N.opt=(u=p.opt)!==null&&u!==void 0?u:!1,p.packed===void 0&&(t?N.packed=p.kind=="enum"||p.kind=="scalar"&&p.T!=ve.BYTES&&p.T!=ve.STRING:N.packed=!1),p.oneof!==void 0){let E=typeof p.oneof=="string"?p.oneof:p.oneof.name;(!d||d.name!=E)&&(d=new l_(E)),N.oneof=d,d.addField(N)}l.push(N)}return l}var K=YK("proto3",e=>new c_(e,t=>XK(t,!0)),e=>{for(let t of e.getType().fields.byMember()){if(t.opt)continue;let n=t.localName,r=e;if(t.repeated){r[n]=[];continue}switch(t.kind){case"oneof":r[n]={case:void 0};break;case"enum":r[n]=0;break;case"map":r[n]={};break;case"scalar":r[n]=ko(t.T,t.L);break;case"message":break}}});var Df;(function(e){e[e.OK=0]="OK",e[e.ERR=1]="ERR",e[e.ERR_NOT_FOUND=2]="ERR_NOT_FOUND",e[e.ERR_ALREADY_EXISTS=3]="ERR_ALREADY_EXISTS",e[e.ERR_INVALID_SUBGRAPH_SCHEMA=4]="ERR_INVALID_SUBGRAPH_SCHEMA",e[e.ERR_SUBGRAPH_COMPOSITION_FAILED=5]="ERR_SUBGRAPH_COMPOSITION_FAILED",e[e.ERR_SUBGRAPH_CHECK_FAILED=6]="ERR_SUBGRAPH_CHECK_FAILED",e[e.ERR_INVALID_LABELS=7]="ERR_INVALID_LABELS",e[e.ERR_ANALYTICS_DISABLED=8]="ERR_ANALYTICS_DISABLED",e[e.ERROR_NOT_AUTHENTICATED=9]="ERROR_NOT_AUTHENTICATED",e[e.ERR_OPENAI_DISABLED=10]="ERR_OPENAI_DISABLED",e[e.ERR_FREE_TRIAL_EXPIRED=11]="ERR_FREE_TRIAL_EXPIRED",e[e.ERROR_NOT_AUTHORIZED=12]="ERROR_NOT_AUTHORIZED",e[e.ERR_LIMIT_REACHED=13]="ERR_LIMIT_REACHED",e[e.ERR_DEPLOYMENT_FAILED=14]="ERR_DEPLOYMENT_FAILED",e[e.ERR_INVALID_NAME=15]="ERR_INVALID_NAME",e[e.ERR_UPGRADE_PLAN=16]="ERR_UPGRADE_PLAN",e[e.ERR_BAD_REQUEST=17]="ERR_BAD_REQUEST"})(Df||(Df={}));K.util.setEnumType(Df,"wg.cosmo.common.EnumStatusCode",[{no:0,name:"OK"},{no:1,name:"ERR"},{no:2,name:"ERR_NOT_FOUND"},{no:3,name:"ERR_ALREADY_EXISTS"},{no:4,name:"ERR_INVALID_SUBGRAPH_SCHEMA"},{no:5,name:"ERR_SUBGRAPH_COMPOSITION_FAILED"},{no:6,name:"ERR_SUBGRAPH_CHECK_FAILED"},{no:7,name:"ERR_INVALID_LABELS"},{no:8,name:"ERR_ANALYTICS_DISABLED"},{no:9,name:"ERROR_NOT_AUTHENTICATED"},{no:10,name:"ERR_OPENAI_DISABLED"},{no:11,name:"ERR_FREE_TRIAL_EXPIRED"},{no:12,name:"ERROR_NOT_AUTHORIZED"},{no:13,name:"ERR_LIMIT_REACHED"},{no:14,name:"ERR_DEPLOYMENT_FAILED"},{no:15,name:"ERR_INVALID_NAME"},{no:16,name:"ERR_UPGRADE_PLAN"},{no:17,name:"ERR_BAD_REQUEST"}]);var lu;(function(e){e[e.GRAPHQL_SUBSCRIPTION_PROTOCOL_WS=0]="GRAPHQL_SUBSCRIPTION_PROTOCOL_WS",e[e.GRAPHQL_SUBSCRIPTION_PROTOCOL_SSE=1]="GRAPHQL_SUBSCRIPTION_PROTOCOL_SSE",e[e.GRAPHQL_SUBSCRIPTION_PROTOCOL_SSE_POST=2]="GRAPHQL_SUBSCRIPTION_PROTOCOL_SSE_POST"})(lu||(lu={}));K.util.setEnumType(lu,"wg.cosmo.common.GraphQLSubscriptionProtocol",[{no:0,name:"GRAPHQL_SUBSCRIPTION_PROTOCOL_WS"},{no:1,name:"GRAPHQL_SUBSCRIPTION_PROTOCOL_SSE"},{no:2,name:"GRAPHQL_SUBSCRIPTION_PROTOCOL_SSE_POST"}]);var du;(function(e){e[e.GRAPHQL_WEBSOCKET_SUBPROTOCOL_AUTO=0]="GRAPHQL_WEBSOCKET_SUBPROTOCOL_AUTO",e[e.GRAPHQL_WEBSOCKET_SUBPROTOCOL_WS=1]="GRAPHQL_WEBSOCKET_SUBPROTOCOL_WS",e[e.GRAPHQL_WEBSOCKET_SUBPROTOCOL_TRANSPORT_WS=2]="GRAPHQL_WEBSOCKET_SUBPROTOCOL_TRANSPORT_WS"})(du||(du={}));K.util.setEnumType(du,"wg.cosmo.common.GraphQLWebsocketSubprotocol",[{no:0,name:"GRAPHQL_WEBSOCKET_SUBPROTOCOL_AUTO"},{no:1,name:"GRAPHQL_WEBSOCKET_SUBPROTOCOL_WS"},{no:2,name:"GRAPHQL_WEBSOCKET_SUBPROTOCOL_TRANSPORT_WS"}]);m();T();h();var KF;(function(e){e[e.RENDER_ARGUMENT_DEFAULT=0]="RENDER_ARGUMENT_DEFAULT",e[e.RENDER_ARGUMENT_AS_GRAPHQL_VALUE=1]="RENDER_ARGUMENT_AS_GRAPHQL_VALUE",e[e.RENDER_ARGUMENT_AS_ARRAY_CSV=2]="RENDER_ARGUMENT_AS_ARRAY_CSV"})(KF||(KF={}));K.util.setEnumType(KF,"wg.cosmo.node.v1.ArgumentRenderConfiguration",[{no:0,name:"RENDER_ARGUMENT_DEFAULT"},{no:1,name:"RENDER_ARGUMENT_AS_GRAPHQL_VALUE"},{no:2,name:"RENDER_ARGUMENT_AS_ARRAY_CSV"}]);var _d;(function(e){e[e.OBJECT_FIELD=0]="OBJECT_FIELD",e[e.FIELD_ARGUMENT=1]="FIELD_ARGUMENT"})(_d||(_d={}));K.util.setEnumType(_d,"wg.cosmo.node.v1.ArgumentSource",[{no:0,name:"OBJECT_FIELD"},{no:1,name:"FIELD_ARGUMENT"}]);var Xc;(function(e){e[e.STATIC=0]="STATIC",e[e.GRAPHQL=1]="GRAPHQL",e[e.PUBSUB=2]="PUBSUB"})(Xc||(Xc={}));K.util.setEnumType(Xc,"wg.cosmo.node.v1.DataSourceKind",[{no:0,name:"STATIC"},{no:1,name:"GRAPHQL"},{no:2,name:"PUBSUB"}]);var sc;(function(e){e[e.PUBLISH=0]="PUBLISH",e[e.REQUEST=1]="REQUEST",e[e.SUBSCRIBE=2]="SUBSCRIBE"})(sc||(sc={}));K.util.setEnumType(sc,"wg.cosmo.node.v1.EventType",[{no:0,name:"PUBLISH"},{no:1,name:"REQUEST"},{no:2,name:"SUBSCRIBE"}]);var Zc;(function(e){e[e.STATIC_CONFIGURATION_VARIABLE=0]="STATIC_CONFIGURATION_VARIABLE",e[e.ENV_CONFIGURATION_VARIABLE=1]="ENV_CONFIGURATION_VARIABLE",e[e.PLACEHOLDER_CONFIGURATION_VARIABLE=2]="PLACEHOLDER_CONFIGURATION_VARIABLE"})(Zc||(Zc={}));K.util.setEnumType(Zc,"wg.cosmo.node.v1.ConfigurationVariableKind",[{no:0,name:"STATIC_CONFIGURATION_VARIABLE"},{no:1,name:"ENV_CONFIGURATION_VARIABLE"},{no:2,name:"PLACEHOLDER_CONFIGURATION_VARIABLE"}]);var vd;(function(e){e[e.GET=0]="GET",e[e.POST=1]="POST",e[e.PUT=2]="PUT",e[e.DELETE=3]="DELETE",e[e.OPTIONS=4]="OPTIONS"})(vd||(vd={}));K.util.setEnumType(vd,"wg.cosmo.node.v1.HTTPMethod",[{no:0,name:"GET"},{no:1,name:"POST"},{no:2,name:"PUT"},{no:3,name:"DELETE"},{no:4,name:"OPTIONS"}]);var pu=class pu extends tt{constructor(n){super();O(this,"id","");O(this,"name","");O(this,"routingUrl","");K.util.initPartial(n,this)}static fromBinary(n,r){return new pu().fromBinary(n,r)}static fromJson(n,r){return new pu().fromJson(n,r)}static fromJsonString(n,r){return new pu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(pu,n,r)}};O(pu,"runtime",K),O(pu,"typeName","wg.cosmo.node.v1.Subgraph"),O(pu,"fields",K.util.newFieldList(()=>[{no:1,name:"id",kind:"scalar",T:9},{no:2,name:"name",kind:"scalar",T:9},{no:3,name:"routing_url",kind:"scalar",T:9}]));var d_=pu,fu=class fu extends tt{constructor(n){super();O(this,"configByFeatureFlagName",{});K.util.initPartial(n,this)}static fromBinary(n,r){return new fu().fromBinary(n,r)}static fromJson(n,r){return new fu().fromJson(n,r)}static fromJsonString(n,r){return new fu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(fu,n,r)}};O(fu,"runtime",K),O(fu,"typeName","wg.cosmo.node.v1.FeatureFlagRouterExecutionConfigs"),O(fu,"fields",K.util.newFieldList(()=>[{no:1,name:"config_by_feature_flag_name",kind:"map",K:9,V:{kind:"message",T:QF}}]));var GF=fu,mu=class mu extends tt{constructor(n){super();O(this,"engineConfig");O(this,"version","");O(this,"subgraphs",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new mu().fromBinary(n,r)}static fromJson(n,r){return new mu().fromJson(n,r)}static fromJsonString(n,r){return new mu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(mu,n,r)}};O(mu,"runtime",K),O(mu,"typeName","wg.cosmo.node.v1.FeatureFlagRouterExecutionConfig"),O(mu,"fields",K.util.newFieldList(()=>[{no:1,name:"engine_config",kind:"message",T:bf},{no:2,name:"version",kind:"scalar",T:9},{no:3,name:"subgraphs",kind:"message",T:d_,repeated:!0}]));var QF=mu,hu=class hu extends tt{constructor(n){super();O(this,"engineConfig");O(this,"version","");O(this,"subgraphs",[]);O(this,"featureFlagConfigs");O(this,"compatibilityVersion","");K.util.initPartial(n,this)}static fromBinary(n,r){return new hu().fromBinary(n,r)}static fromJson(n,r){return new hu().fromJson(n,r)}static fromJsonString(n,r){return new hu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(hu,n,r)}};O(hu,"runtime",K),O(hu,"typeName","wg.cosmo.node.v1.RouterConfig"),O(hu,"fields",K.util.newFieldList(()=>[{no:1,name:"engine_config",kind:"message",T:bf},{no:2,name:"version",kind:"scalar",T:9},{no:3,name:"subgraphs",kind:"message",T:d_,repeated:!0},{no:4,name:"feature_flag_configs",kind:"message",T:GF,opt:!0},{no:5,name:"compatibility_version",kind:"scalar",T:9}]));var KT=hu,Tu=class Tu extends tt{constructor(n){super();O(this,"code",Df.OK);O(this,"details");K.util.initPartial(n,this)}static fromBinary(n,r){return new Tu().fromBinary(n,r)}static fromJson(n,r){return new Tu().fromJson(n,r)}static fromJsonString(n,r){return new Tu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Tu,n,r)}};O(Tu,"runtime",K),O(Tu,"typeName","wg.cosmo.node.v1.Response"),O(Tu,"fields",K.util.newFieldList(()=>[{no:1,name:"code",kind:"enum",T:K.getEnumType(Df)},{no:2,name:"details",kind:"scalar",T:9,opt:!0}]));var YF=Tu,Nu=class Nu extends tt{constructor(n){super();O(this,"code",0);O(this,"message","");K.util.initPartial(n,this)}static fromBinary(n,r){return new Nu().fromBinary(n,r)}static fromJson(n,r){return new Nu().fromJson(n,r)}static fromJsonString(n,r){return new Nu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Nu,n,r)}};O(Nu,"runtime",K),O(Nu,"typeName","wg.cosmo.node.v1.ResponseStatus"),O(Nu,"fields",K.util.newFieldList(()=>[{no:1,name:"code",kind:"scalar",T:5},{no:2,name:"message",kind:"scalar",T:9}]));var ZK=Nu,Eu=class Eu extends tt{constructor(n){super();O(this,"accountLimits");O(this,"graphPublicKey","");K.util.initPartial(n,this)}static fromBinary(n,r){return new Eu().fromBinary(n,r)}static fromJson(n,r){return new Eu().fromJson(n,r)}static fromJsonString(n,r){return new Eu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Eu,n,r)}};O(Eu,"runtime",K),O(Eu,"typeName","wg.cosmo.node.v1.RegistrationInfo"),O(Eu,"fields",K.util.newFieldList(()=>[{no:1,name:"account_limits",kind:"message",T:zF},{no:2,name:"graph_public_key",kind:"scalar",T:9}]));var JF=Eu,yu=class yu extends tt{constructor(n){super();O(this,"traceSamplingRate",0);K.util.initPartial(n,this)}static fromBinary(n,r){return new yu().fromBinary(n,r)}static fromJson(n,r){return new yu().fromJson(n,r)}static fromJsonString(n,r){return new yu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(yu,n,r)}};O(yu,"runtime",K),O(yu,"typeName","wg.cosmo.node.v1.AccountLimits"),O(yu,"fields",K.util.newFieldList(()=>[{no:1,name:"trace_sampling_rate",kind:"scalar",T:2}]));var zF=yu,Iu=class Iu extends tt{constructor(t){super(),K.util.initPartial(t,this)}static fromBinary(t,n){return new Iu().fromBinary(t,n)}static fromJson(t,n){return new Iu().fromJson(t,n)}static fromJsonString(t,n){return new Iu().fromJsonString(t,n)}static equals(t,n){return K.util.equals(Iu,t,n)}};O(Iu,"runtime",K),O(Iu,"typeName","wg.cosmo.node.v1.SelfRegisterRequest"),O(Iu,"fields",K.util.newFieldList(()=>[]));var eG=Iu,gu=class gu extends tt{constructor(n){super();O(this,"response");O(this,"registrationInfo");K.util.initPartial(n,this)}static fromBinary(n,r){return new gu().fromBinary(n,r)}static fromJson(n,r){return new gu().fromJson(n,r)}static fromJsonString(n,r){return new gu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(gu,n,r)}};O(gu,"runtime",K),O(gu,"typeName","wg.cosmo.node.v1.SelfRegisterResponse"),O(gu,"fields",K.util.newFieldList(()=>[{no:1,name:"response",kind:"message",T:YF},{no:2,name:"registrationInfo",kind:"message",T:JF,opt:!0}]));var tG=gu,_u=class _u extends tt{constructor(n){super();O(this,"defaultFlushInterval",br.zero);O(this,"datasourceConfigurations",[]);O(this,"fieldConfigurations",[]);O(this,"graphqlSchema","");O(this,"typeConfigurations",[]);O(this,"stringStorage",{});O(this,"graphqlClientSchema");K.util.initPartial(n,this)}static fromBinary(n,r){return new _u().fromBinary(n,r)}static fromJson(n,r){return new _u().fromJson(n,r)}static fromJsonString(n,r){return new _u().fromJsonString(n,r)}static equals(n,r){return K.util.equals(_u,n,r)}};O(_u,"runtime",K),O(_u,"typeName","wg.cosmo.node.v1.EngineConfiguration"),O(_u,"fields",K.util.newFieldList(()=>[{no:1,name:"defaultFlushInterval",kind:"scalar",T:3},{no:2,name:"datasource_configurations",kind:"message",T:GT,repeated:!0},{no:3,name:"field_configurations",kind:"message",T:zT,repeated:!0},{no:4,name:"graphqlSchema",kind:"scalar",T:9},{no:5,name:"type_configurations",kind:"message",T:HF,repeated:!0},{no:6,name:"string_storage",kind:"map",K:9,V:{kind:"scalar",T:9}},{no:7,name:"graphql_client_schema",kind:"scalar",T:9,opt:!0}]));var bf=_u,vu=class vu extends tt{constructor(n){super();O(this,"kind",Xc.STATIC);O(this,"rootNodes",[]);O(this,"childNodes",[]);O(this,"overrideFieldPathFromAlias",!1);O(this,"customGraphql");O(this,"customStatic");O(this,"directives",[]);O(this,"requestTimeoutSeconds",br.zero);O(this,"id","");O(this,"keys",[]);O(this,"provides",[]);O(this,"requires",[]);O(this,"customEvents");O(this,"entityInterfaces",[]);O(this,"interfaceObjects",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new vu().fromBinary(n,r)}static fromJson(n,r){return new vu().fromJson(n,r)}static fromJsonString(n,r){return new vu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(vu,n,r)}};O(vu,"runtime",K),O(vu,"typeName","wg.cosmo.node.v1.DataSourceConfiguration"),O(vu,"fields",K.util.newFieldList(()=>[{no:1,name:"kind",kind:"enum",T:K.getEnumType(Xc)},{no:2,name:"root_nodes",kind:"message",T:Af,repeated:!0},{no:3,name:"child_nodes",kind:"message",T:Af,repeated:!0},{no:4,name:"override_field_path_from_alias",kind:"scalar",T:8},{no:5,name:"custom_graphql",kind:"message",T:XT},{no:6,name:"custom_static",kind:"message",T:XF},{no:7,name:"directives",kind:"message",T:ZF,repeated:!0},{no:8,name:"request_timeout_seconds",kind:"scalar",T:3},{no:9,name:"id",kind:"scalar",T:9},{no:10,name:"keys",kind:"message",T:gd,repeated:!0},{no:11,name:"provides",kind:"message",T:gd,repeated:!0},{no:12,name:"requires",kind:"message",T:gd,repeated:!0},{no:13,name:"custom_events",kind:"message",T:Sd},{no:14,name:"entity_interfaces",kind:"message",T:Rf,repeated:!0},{no:15,name:"interface_objects",kind:"message",T:Rf,repeated:!0}]));var GT=vu,Ou=class Ou extends tt{constructor(n){super();O(this,"name","");O(this,"sourceType",_d.OBJECT_FIELD);K.util.initPartial(n,this)}static fromBinary(n,r){return new Ou().fromBinary(n,r)}static fromJson(n,r){return new Ou().fromJson(n,r)}static fromJsonString(n,r){return new Ou().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Ou,n,r)}};O(Ou,"runtime",K),O(Ou,"typeName","wg.cosmo.node.v1.ArgumentConfiguration"),O(Ou,"fields",K.util.newFieldList(()=>[{no:1,name:"name",kind:"scalar",T:9},{no:2,name:"source_type",kind:"enum",T:K.getEnumType(_d)}]));var QT=Ou,Su=class Su extends tt{constructor(n){super();O(this,"requiredAndScopes",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new Su().fromBinary(n,r)}static fromJson(n,r){return new Su().fromJson(n,r)}static fromJsonString(n,r){return new Su().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Su,n,r)}};O(Su,"runtime",K),O(Su,"typeName","wg.cosmo.node.v1.Scopes"),O(Su,"fields",K.util.newFieldList(()=>[{no:1,name:"required_and_scopes",kind:"scalar",T:9,repeated:!0}]));var YT=Su,Du=class Du extends tt{constructor(n){super();O(this,"requiresAuthentication",!1);O(this,"requiredOrScopes",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new Du().fromBinary(n,r)}static fromJson(n,r){return new Du().fromJson(n,r)}static fromJsonString(n,r){return new Du().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Du,n,r)}};O(Du,"runtime",K),O(Du,"typeName","wg.cosmo.node.v1.AuthorizationConfiguration"),O(Du,"fields",K.util.newFieldList(()=>[{no:1,name:"requires_authentication",kind:"scalar",T:8},{no:2,name:"required_or_scopes",kind:"message",T:YT,repeated:!0}]));var JT=Du,bu=class bu extends tt{constructor(n){super();O(this,"typeName","");O(this,"fieldName","");O(this,"argumentsConfiguration",[]);O(this,"authorizationConfiguration");O(this,"subscriptionFilterCondition");K.util.initPartial(n,this)}static fromBinary(n,r){return new bu().fromBinary(n,r)}static fromJson(n,r){return new bu().fromJson(n,r)}static fromJsonString(n,r){return new bu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(bu,n,r)}};O(bu,"runtime",K),O(bu,"typeName","wg.cosmo.node.v1.FieldConfiguration"),O(bu,"fields",K.util.newFieldList(()=>[{no:1,name:"type_name",kind:"scalar",T:9},{no:2,name:"field_name",kind:"scalar",T:9},{no:3,name:"arguments_configuration",kind:"message",T:QT,repeated:!0},{no:4,name:"authorization_configuration",kind:"message",T:JT},{no:5,name:"subscription_filter_condition",kind:"message",T:el,opt:!0}]));var zT=bu,Au=class Au extends tt{constructor(n){super();O(this,"typeName","");O(this,"renameTo","");K.util.initPartial(n,this)}static fromBinary(n,r){return new Au().fromBinary(n,r)}static fromJson(n,r){return new Au().fromJson(n,r)}static fromJsonString(n,r){return new Au().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Au,n,r)}};O(Au,"runtime",K),O(Au,"typeName","wg.cosmo.node.v1.TypeConfiguration"),O(Au,"fields",K.util.newFieldList(()=>[{no:1,name:"type_name",kind:"scalar",T:9},{no:2,name:"rename_to",kind:"scalar",T:9}]));var HF=Au,Ru=class Ru extends tt{constructor(n){super();O(this,"typeName","");O(this,"fieldNames",[]);O(this,"externalFieldNames",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new Ru().fromBinary(n,r)}static fromJson(n,r){return new Ru().fromJson(n,r)}static fromJsonString(n,r){return new Ru().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Ru,n,r)}};O(Ru,"runtime",K),O(Ru,"typeName","wg.cosmo.node.v1.TypeField"),O(Ru,"fields",K.util.newFieldList(()=>[{no:1,name:"type_name",kind:"scalar",T:9},{no:2,name:"field_names",kind:"scalar",T:9,repeated:!0},{no:3,name:"external_field_names",kind:"scalar",T:9,repeated:!0}]));var Af=Ru,Fu=class Fu extends tt{constructor(n){super();O(this,"fieldName","");O(this,"typeName","");K.util.initPartial(n,this)}static fromBinary(n,r){return new Fu().fromBinary(n,r)}static fromJson(n,r){return new Fu().fromJson(n,r)}static fromJsonString(n,r){return new Fu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Fu,n,r)}};O(Fu,"runtime",K),O(Fu,"typeName","wg.cosmo.node.v1.FieldCoordinates"),O(Fu,"fields",K.util.newFieldList(()=>[{no:1,name:"field_name",kind:"scalar",T:9},{no:2,name:"type_name",kind:"scalar",T:9}]));var HT=Fu,wu=class wu extends tt{constructor(n){super();O(this,"fieldCoordinatesPath",[]);O(this,"fieldPath",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new wu().fromBinary(n,r)}static fromJson(n,r){return new wu().fromJson(n,r)}static fromJsonString(n,r){return new wu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(wu,n,r)}};O(wu,"runtime",K),O(wu,"typeName","wg.cosmo.node.v1.FieldSetCondition"),O(wu,"fields",K.util.newFieldList(()=>[{no:1,name:"field_coordinates_path",kind:"message",T:HT,repeated:!0},{no:2,name:"field_path",kind:"scalar",T:9,repeated:!0}]));var WT=wu,Pu=class Pu extends tt{constructor(n){super();O(this,"typeName","");O(this,"fieldName","");O(this,"selectionSet","");O(this,"disableEntityResolver",!1);O(this,"conditions",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new Pu().fromBinary(n,r)}static fromJson(n,r){return new Pu().fromJson(n,r)}static fromJsonString(n,r){return new Pu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Pu,n,r)}};O(Pu,"runtime",K),O(Pu,"typeName","wg.cosmo.node.v1.RequiredField"),O(Pu,"fields",K.util.newFieldList(()=>[{no:1,name:"type_name",kind:"scalar",T:9},{no:2,name:"field_name",kind:"scalar",T:9},{no:3,name:"selection_set",kind:"scalar",T:9},{no:4,name:"disable_entity_resolver",kind:"scalar",T:8},{no:5,name:"conditions",kind:"message",T:WT,repeated:!0}]));var gd=Pu,Lu=class Lu extends tt{constructor(n){super();O(this,"interfaceTypeName","");O(this,"concreteTypeNames",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new Lu().fromBinary(n,r)}static fromJson(n,r){return new Lu().fromJson(n,r)}static fromJsonString(n,r){return new Lu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Lu,n,r)}};O(Lu,"runtime",K),O(Lu,"typeName","wg.cosmo.node.v1.EntityInterfaceConfiguration"),O(Lu,"fields",K.util.newFieldList(()=>[{no:1,name:"interface_type_name",kind:"scalar",T:9},{no:2,name:"concrete_type_names",kind:"scalar",T:9,repeated:!0}]));var Rf=Lu,Cu=class Cu extends tt{constructor(n){super();O(this,"url");O(this,"method",vd.GET);O(this,"header",{});O(this,"body");O(this,"query",[]);O(this,"urlEncodeBody",!1);O(this,"mtls");O(this,"baseUrl");O(this,"path");O(this,"httpProxyUrl");K.util.initPartial(n,this)}static fromBinary(n,r){return new Cu().fromBinary(n,r)}static fromJson(n,r){return new Cu().fromJson(n,r)}static fromJsonString(n,r){return new Cu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Cu,n,r)}};O(Cu,"runtime",K),O(Cu,"typeName","wg.cosmo.node.v1.FetchConfiguration"),O(Cu,"fields",K.util.newFieldList(()=>[{no:1,name:"url",kind:"message",T:$i},{no:2,name:"method",kind:"enum",T:K.getEnumType(vd)},{no:3,name:"header",kind:"map",K:9,V:{kind:"message",T:tw}},{no:4,name:"body",kind:"message",T:$i},{no:5,name:"query",kind:"message",T:ew,repeated:!0},{no:7,name:"url_encode_body",kind:"scalar",T:8},{no:8,name:"mtls",kind:"message",T:nw},{no:9,name:"base_url",kind:"message",T:$i},{no:10,name:"path",kind:"message",T:$i},{no:11,name:"http_proxy_url",kind:"message",T:$i,opt:!0}]));var WF=Cu,Uu=class Uu extends tt{constructor(n){super();O(this,"statusCode",br.zero);O(this,"typeName","");O(this,"injectStatusCodeIntoBody",!1);K.util.initPartial(n,this)}static fromBinary(n,r){return new Uu().fromBinary(n,r)}static fromJson(n,r){return new Uu().fromJson(n,r)}static fromJsonString(n,r){return new Uu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Uu,n,r)}};O(Uu,"runtime",K),O(Uu,"typeName","wg.cosmo.node.v1.StatusCodeTypeMapping"),O(Uu,"fields",K.util.newFieldList(()=>[{no:1,name:"status_code",kind:"scalar",T:3},{no:2,name:"type_name",kind:"scalar",T:9},{no:3,name:"inject_status_code_into_body",kind:"scalar",T:8}]));var nG=Uu,ku=class ku extends tt{constructor(n){super();O(this,"fetch");O(this,"subscription");O(this,"federation");O(this,"upstreamSchema");O(this,"customScalarTypeFields",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new ku().fromBinary(n,r)}static fromJson(n,r){return new ku().fromJson(n,r)}static fromJsonString(n,r){return new ku().fromJsonString(n,r)}static equals(n,r){return K.util.equals(ku,n,r)}};O(ku,"runtime",K),O(ku,"typeName","wg.cosmo.node.v1.DataSourceCustom_GraphQL"),O(ku,"fields",K.util.newFieldList(()=>[{no:1,name:"fetch",kind:"message",T:WF},{no:2,name:"subscription",kind:"message",T:rw},{no:3,name:"federation",kind:"message",T:iw},{no:4,name:"upstream_schema",kind:"message",T:nN},{no:6,name:"custom_scalar_type_fields",kind:"message",T:sw,repeated:!0}]));var XT=ku,xu=class xu extends tt{constructor(n){super();O(this,"consumerName","");O(this,"streamName","");O(this,"consumerInactiveThreshold",0);K.util.initPartial(n,this)}static fromBinary(n,r){return new xu().fromBinary(n,r)}static fromJson(n,r){return new xu().fromJson(n,r)}static fromJsonString(n,r){return new xu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(xu,n,r)}};O(xu,"runtime",K),O(xu,"typeName","wg.cosmo.node.v1.NatsStreamConfiguration"),O(xu,"fields",K.util.newFieldList(()=>[{no:1,name:"consumer_name",kind:"scalar",T:9},{no:2,name:"stream_name",kind:"scalar",T:9},{no:3,name:"consumer_inactive_threshold",kind:"scalar",T:5}]));var ZT=xu,Mu=class Mu extends tt{constructor(n){super();O(this,"engineEventConfiguration");O(this,"subjects",[]);O(this,"streamConfiguration");K.util.initPartial(n,this)}static fromBinary(n,r){return new Mu().fromBinary(n,r)}static fromJson(n,r){return new Mu().fromJson(n,r)}static fromJsonString(n,r){return new Mu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Mu,n,r)}};O(Mu,"runtime",K),O(Mu,"typeName","wg.cosmo.node.v1.NatsEventConfiguration"),O(Mu,"fields",K.util.newFieldList(()=>[{no:1,name:"engine_event_configuration",kind:"message",T:Od},{no:2,name:"subjects",kind:"scalar",T:9,repeated:!0},{no:3,name:"stream_configuration",kind:"message",T:ZT}]));var eN=Mu,Bu=class Bu extends tt{constructor(n){super();O(this,"engineEventConfiguration");O(this,"topics",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new Bu().fromBinary(n,r)}static fromJson(n,r){return new Bu().fromJson(n,r)}static fromJsonString(n,r){return new Bu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Bu,n,r)}};O(Bu,"runtime",K),O(Bu,"typeName","wg.cosmo.node.v1.KafkaEventConfiguration"),O(Bu,"fields",K.util.newFieldList(()=>[{no:1,name:"engine_event_configuration",kind:"message",T:Od},{no:2,name:"topics",kind:"scalar",T:9,repeated:!0}]));var tN=Bu,Vu=class Vu extends tt{constructor(n){super();O(this,"providerId","");O(this,"type",sc.PUBLISH);O(this,"typeName","");O(this,"fieldName","");K.util.initPartial(n,this)}static fromBinary(n,r){return new Vu().fromBinary(n,r)}static fromJson(n,r){return new Vu().fromJson(n,r)}static fromJsonString(n,r){return new Vu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Vu,n,r)}};O(Vu,"runtime",K),O(Vu,"typeName","wg.cosmo.node.v1.EngineEventConfiguration"),O(Vu,"fields",K.util.newFieldList(()=>[{no:1,name:"provider_id",kind:"scalar",T:9},{no:2,name:"type",kind:"enum",T:K.getEnumType(sc)},{no:3,name:"type_name",kind:"scalar",T:9},{no:4,name:"field_name",kind:"scalar",T:9}]));var Od=Vu,ju=class ju extends tt{constructor(n){super();O(this,"nats",[]);O(this,"kafka",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new ju().fromBinary(n,r)}static fromJson(n,r){return new ju().fromJson(n,r)}static fromJsonString(n,r){return new ju().fromJsonString(n,r)}static equals(n,r){return K.util.equals(ju,n,r)}};O(ju,"runtime",K),O(ju,"typeName","wg.cosmo.node.v1.DataSourceCustomEvents"),O(ju,"fields",K.util.newFieldList(()=>[{no:1,name:"nats",kind:"message",T:eN,repeated:!0},{no:2,name:"kafka",kind:"message",T:tN,repeated:!0}]));var Sd=ju,qu=class qu extends tt{constructor(n){super();O(this,"data");K.util.initPartial(n,this)}static fromBinary(n,r){return new qu().fromBinary(n,r)}static fromJson(n,r){return new qu().fromJson(n,r)}static fromJsonString(n,r){return new qu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(qu,n,r)}};O(qu,"runtime",K),O(qu,"typeName","wg.cosmo.node.v1.DataSourceCustom_Static"),O(qu,"fields",K.util.newFieldList(()=>[{no:1,name:"data",kind:"message",T:$i}]));var XF=qu,$u=class $u extends tt{constructor(n){super();O(this,"kind",Zc.STATIC_CONFIGURATION_VARIABLE);O(this,"staticVariableContent","");O(this,"environmentVariableName","");O(this,"environmentVariableDefaultValue","");O(this,"placeholderVariableName","");K.util.initPartial(n,this)}static fromBinary(n,r){return new $u().fromBinary(n,r)}static fromJson(n,r){return new $u().fromJson(n,r)}static fromJsonString(n,r){return new $u().fromJsonString(n,r)}static equals(n,r){return K.util.equals($u,n,r)}};O($u,"runtime",K),O($u,"typeName","wg.cosmo.node.v1.ConfigurationVariable"),O($u,"fields",K.util.newFieldList(()=>[{no:1,name:"kind",kind:"enum",T:K.getEnumType(Zc)},{no:2,name:"static_variable_content",kind:"scalar",T:9},{no:3,name:"environment_variable_name",kind:"scalar",T:9},{no:4,name:"environment_variable_default_value",kind:"scalar",T:9},{no:5,name:"placeholder_variable_name",kind:"scalar",T:9}]));var $i=$u,Ku=class Ku extends tt{constructor(n){super();O(this,"directiveName","");O(this,"renameTo","");K.util.initPartial(n,this)}static fromBinary(n,r){return new Ku().fromBinary(n,r)}static fromJson(n,r){return new Ku().fromJson(n,r)}static fromJsonString(n,r){return new Ku().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Ku,n,r)}};O(Ku,"runtime",K),O(Ku,"typeName","wg.cosmo.node.v1.DirectiveConfiguration"),O(Ku,"fields",K.util.newFieldList(()=>[{no:1,name:"directive_name",kind:"scalar",T:9},{no:2,name:"rename_to",kind:"scalar",T:9}]));var ZF=Ku,Gu=class Gu extends tt{constructor(n){super();O(this,"name","");O(this,"value","");K.util.initPartial(n,this)}static fromBinary(n,r){return new Gu().fromBinary(n,r)}static fromJson(n,r){return new Gu().fromJson(n,r)}static fromJsonString(n,r){return new Gu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Gu,n,r)}};O(Gu,"runtime",K),O(Gu,"typeName","wg.cosmo.node.v1.URLQueryConfiguration"),O(Gu,"fields",K.util.newFieldList(()=>[{no:1,name:"name",kind:"scalar",T:9},{no:2,name:"value",kind:"scalar",T:9}]));var ew=Gu,Qu=class Qu extends tt{constructor(n){super();O(this,"values",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new Qu().fromBinary(n,r)}static fromJson(n,r){return new Qu().fromJson(n,r)}static fromJsonString(n,r){return new Qu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Qu,n,r)}};O(Qu,"runtime",K),O(Qu,"typeName","wg.cosmo.node.v1.HTTPHeader"),O(Qu,"fields",K.util.newFieldList(()=>[{no:1,name:"values",kind:"message",T:$i,repeated:!0}]));var tw=Qu,Yu=class Yu extends tt{constructor(n){super();O(this,"key");O(this,"cert");O(this,"insecureSkipVerify",!1);K.util.initPartial(n,this)}static fromBinary(n,r){return new Yu().fromBinary(n,r)}static fromJson(n,r){return new Yu().fromJson(n,r)}static fromJsonString(n,r){return new Yu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Yu,n,r)}};O(Yu,"runtime",K),O(Yu,"typeName","wg.cosmo.node.v1.MTLSConfiguration"),O(Yu,"fields",K.util.newFieldList(()=>[{no:1,name:"key",kind:"message",T:$i},{no:2,name:"cert",kind:"message",T:$i},{no:3,name:"insecureSkipVerify",kind:"scalar",T:8}]));var nw=Yu,Ju=class Ju extends tt{constructor(n){super();O(this,"enabled",!1);O(this,"url");O(this,"useSSE");O(this,"protocol");O(this,"websocketSubprotocol");K.util.initPartial(n,this)}static fromBinary(n,r){return new Ju().fromBinary(n,r)}static fromJson(n,r){return new Ju().fromJson(n,r)}static fromJsonString(n,r){return new Ju().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Ju,n,r)}};O(Ju,"runtime",K),O(Ju,"typeName","wg.cosmo.node.v1.GraphQLSubscriptionConfiguration"),O(Ju,"fields",K.util.newFieldList(()=>[{no:1,name:"enabled",kind:"scalar",T:8},{no:2,name:"url",kind:"message",T:$i},{no:3,name:"useSSE",kind:"scalar",T:8,opt:!0},{no:4,name:"protocol",kind:"enum",T:K.getEnumType(lu),opt:!0},{no:5,name:"websocketSubprotocol",kind:"enum",T:K.getEnumType(du),opt:!0}]));var rw=Ju,zu=class zu extends tt{constructor(n){super();O(this,"enabled",!1);O(this,"serviceSdl","");K.util.initPartial(n,this)}static fromBinary(n,r){return new zu().fromBinary(n,r)}static fromJson(n,r){return new zu().fromJson(n,r)}static fromJsonString(n,r){return new zu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(zu,n,r)}};O(zu,"runtime",K),O(zu,"typeName","wg.cosmo.node.v1.GraphQLFederationConfiguration"),O(zu,"fields",K.util.newFieldList(()=>[{no:1,name:"enabled",kind:"scalar",T:8},{no:2,name:"serviceSdl",kind:"scalar",T:9}]));var iw=zu,Hu=class Hu extends tt{constructor(n){super();O(this,"key","");K.util.initPartial(n,this)}static fromBinary(n,r){return new Hu().fromBinary(n,r)}static fromJson(n,r){return new Hu().fromJson(n,r)}static fromJsonString(n,r){return new Hu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Hu,n,r)}};O(Hu,"runtime",K),O(Hu,"typeName","wg.cosmo.node.v1.InternedString"),O(Hu,"fields",K.util.newFieldList(()=>[{no:1,name:"key",kind:"scalar",T:9}]));var nN=Hu,Wu=class Wu extends tt{constructor(n){super();O(this,"typeName","");O(this,"fieldName","");K.util.initPartial(n,this)}static fromBinary(n,r){return new Wu().fromBinary(n,r)}static fromJson(n,r){return new Wu().fromJson(n,r)}static fromJsonString(n,r){return new Wu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Wu,n,r)}};O(Wu,"runtime",K),O(Wu,"typeName","wg.cosmo.node.v1.SingleTypeField"),O(Wu,"fields",K.util.newFieldList(()=>[{no:1,name:"type_name",kind:"scalar",T:9},{no:2,name:"field_name",kind:"scalar",T:9}]));var sw=Wu,Xu=class Xu extends tt{constructor(n){super();O(this,"fieldPath",[]);O(this,"json","");K.util.initPartial(n,this)}static fromBinary(n,r){return new Xu().fromBinary(n,r)}static fromJson(n,r){return new Xu().fromJson(n,r)}static fromJsonString(n,r){return new Xu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Xu,n,r)}};O(Xu,"runtime",K),O(Xu,"typeName","wg.cosmo.node.v1.SubscriptionFieldCondition"),O(Xu,"fields",K.util.newFieldList(()=>[{no:1,name:"field_path",kind:"scalar",T:9,repeated:!0},{no:2,name:"json",kind:"scalar",T:9}]));var rN=Xu,co=class co extends tt{constructor(n){super();O(this,"and",[]);O(this,"in");O(this,"not");O(this,"or",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new co().fromBinary(n,r)}static fromJson(n,r){return new co().fromJson(n,r)}static fromJsonString(n,r){return new co().fromJsonString(n,r)}static equals(n,r){return K.util.equals(co,n,r)}};O(co,"runtime",K),O(co,"typeName","wg.cosmo.node.v1.SubscriptionFilterCondition"),O(co,"fields",K.util.newFieldList(()=>[{no:1,name:"and",kind:"message",T:co,repeated:!0},{no:2,name:"in",kind:"message",T:rN,opt:!0},{no:3,name:"not",kind:"message",T:co,opt:!0},{no:4,name:"or",kind:"message",T:co,repeated:!0}]));var el=co,Zu=class Zu extends tt{constructor(n){super();O(this,"operations",[]);K.util.initPartial(n,this)}static fromBinary(n,r){return new Zu().fromBinary(n,r)}static fromJson(n,r){return new Zu().fromJson(n,r)}static fromJsonString(n,r){return new Zu().fromJsonString(n,r)}static equals(n,r){return K.util.equals(Zu,n,r)}};O(Zu,"runtime",K),O(Zu,"typeName","wg.cosmo.node.v1.CacheWarmerOperations"),O(Zu,"fields",K.util.newFieldList(()=>[{no:1,name:"operations",kind:"message",T:ow,repeated:!0}]));var rG=Zu,ec=class ec extends tt{constructor(n){super();O(this,"request");O(this,"client");K.util.initPartial(n,this)}static fromBinary(n,r){return new ec().fromBinary(n,r)}static fromJson(n,r){return new ec().fromJson(n,r)}static fromJsonString(n,r){return new ec().fromJsonString(n,r)}static equals(n,r){return K.util.equals(ec,n,r)}};O(ec,"runtime",K),O(ec,"typeName","wg.cosmo.node.v1.Operation"),O(ec,"fields",K.util.newFieldList(()=>[{no:1,name:"request",kind:"message",T:aw},{no:2,name:"client",kind:"message",T:lw}]));var ow=ec,tc=class tc extends tt{constructor(n){super();O(this,"operationName","");O(this,"query","");O(this,"extensions");K.util.initPartial(n,this)}static fromBinary(n,r){return new tc().fromBinary(n,r)}static fromJson(n,r){return new tc().fromJson(n,r)}static fromJsonString(n,r){return new tc().fromJsonString(n,r)}static equals(n,r){return K.util.equals(tc,n,r)}};O(tc,"runtime",K),O(tc,"typeName","wg.cosmo.node.v1.OperationRequest"),O(tc,"fields",K.util.newFieldList(()=>[{no:1,name:"operation_name",kind:"scalar",T:9},{no:2,name:"query",kind:"scalar",T:9},{no:3,name:"extensions",kind:"message",T:uw}]));var aw=tc,nc=class nc extends tt{constructor(n){super();O(this,"persistedQuery");K.util.initPartial(n,this)}static fromBinary(n,r){return new nc().fromBinary(n,r)}static fromJson(n,r){return new nc().fromJson(n,r)}static fromJsonString(n,r){return new nc().fromJsonString(n,r)}static equals(n,r){return K.util.equals(nc,n,r)}};O(nc,"runtime",K),O(nc,"typeName","wg.cosmo.node.v1.Extension"),O(nc,"fields",K.util.newFieldList(()=>[{no:1,name:"persisted_query",kind:"message",T:cw}]));var uw=nc,rc=class rc extends tt{constructor(n){super();O(this,"sha256Hash","");O(this,"version",0);K.util.initPartial(n,this)}static fromBinary(n,r){return new rc().fromBinary(n,r)}static fromJson(n,r){return new rc().fromJson(n,r)}static fromJsonString(n,r){return new rc().fromJsonString(n,r)}static equals(n,r){return K.util.equals(rc,n,r)}};O(rc,"runtime",K),O(rc,"typeName","wg.cosmo.node.v1.PersistedQuery"),O(rc,"fields",K.util.newFieldList(()=>[{no:1,name:"sha256_hash",kind:"scalar",T:9},{no:2,name:"version",kind:"scalar",T:5}]));var cw=rc,ic=class ic extends tt{constructor(n){super();O(this,"name","");O(this,"version","");K.util.initPartial(n,this)}static fromBinary(n,r){return new ic().fromBinary(n,r)}static fromJson(n,r){return new ic().fromJson(n,r)}static fromJsonString(n,r){return new ic().fromJsonString(n,r)}static equals(n,r){return K.util.equals(ic,n,r)}};O(ic,"runtime",K),O(ic,"typeName","wg.cosmo.node.v1.ClientInfo"),O(ic,"fields",K.util.newFieldList(()=>[{no:1,name:"name",kind:"scalar",T:9},{no:2,name:"version",kind:"scalar",T:9}]));var lw=ic;m();T();h();var f_=bv(Vg(),1);function Qpe(e){if(!e.conditions)return;let t=[];for(let n of e.conditions){let r=[];for(let i of n.fieldCoordinatesPath){let s=i.split(".");if(s.length!==2)throw new Error(`fatal: malformed conditional field coordinates "${i}" for field set "${e.selectionSet}".`);r.push(new HT({fieldName:s[1],typeName:s[0]}))}t.push(new WT({fieldCoordinatesPath:r,fieldPath:n.fieldPath}))}return t}function dw(e,t,n){if(e)for(let r of e){let i=Qpe(r);t.push(new gd(C(C({typeName:n,fieldName:r.fieldName,selectionSet:r.selectionSet},r.disableEntityResolver?{disableEntityResolver:!0}:{}),i?{conditions:i}:{})))}}function iG(e){switch(e){case"publish":return sc.PUBLISH;case"request":return sc.REQUEST;case"subscribe":return sc.SUBSCRIBE}}function sG(e){var n;let t={rootNodes:[],childNodes:[],keys:[],provides:[],events:new Sd({nats:[],kafka:[]}),requires:[],entityInterfaces:[],interfaceObjects:[]};for(let r of e.values()){let i=r.typeName,s=[...r.fieldNames],o=new Af({typeName:i,fieldNames:s});if(r.externalFieldNames&&r.externalFieldNames.size>0&&(o.externalFieldNames=[...r.externalFieldNames]),r.isRootNode?t.rootNodes.push(o):t.childNodes.push(o),r.entityInterfaceConcreteTypeNames){let d=new Rf({interfaceTypeName:i,concreteTypeNames:[...r.entityInterfaceConcreteTypeNames]});r.isInterfaceObject?t.interfaceObjects.push(d):t.entityInterfaces.push(d)}dw(r.keys,t.keys,i),dw(r.provides,t.provides,i),dw(r.requires,t.requires,i);let u=[],l=[];for(let d of(n=r.events)!=null?n:[])switch(d.providerType){case f_.PROVIDER_TYPE_KAFKA:{l.push(new tN({engineEventConfiguration:new Od({fieldName:d.fieldName,providerId:d.providerId,type:iG(d.type),typeName:i}),topics:d.topics}));break}case f_.PROVIDER_TYPE_NATS:{u.push(new eN(C({engineEventConfiguration:new Od({fieldName:d.fieldName,providerId:d.providerId,type:iG(d.type),typeName:i}),subjects:d.subjects},d.streamConfiguration?{streamConfiguration:new ZT({consumerInactiveThreshold:d.streamConfiguration.consumerInactiveThreshold,consumerName:d.streamConfiguration.consumerName,streamName:d.streamConfiguration.streamName})}:{})));break}default:throw new Error("Fatal: Unknown event provider.")}t.events.nats.push(...u),t.events.kafka.push(...l)}return t}function oG(e){var n;let t=[];for(let r of e){let i=r.argumentNames.map(l=>new QT({name:l,sourceType:_d.FIELD_ARGUMENT})),s=new zT({argumentsConfiguration:i,fieldName:r.fieldName,typeName:r.typeName}),o=((n=r.requiredScopes)==null?void 0:n.map(l=>new YT({requiredAndScopes:l})))||[],u=o.length>0;if((r.requiresAuthentication||u)&&(s.authorizationConfiguration=new JT({requiresAuthentication:r.requiresAuthentication||u,requiredOrScopes:o})),r.subscriptionFilterCondition){let l=new el;p_(l,r.subscriptionFilterCondition),s.subscriptionFilterCondition=l}t.push(s)}return t}function p_(e,t){if(t.and!==void 0){let n=[];for(let r of t.and){let i=new el;p_(i,r),n.push(i)}e.and=n;return}if(t.in!==void 0){e.in=new rN({fieldPath:t.in.fieldPath,json:JSON.stringify(t.in.values)});return}if(t.not!==void 0){e.not=new el,p_(e.not,t.not);return}if(t.or!==void 0){let n=[];for(let r of t.or){let i=new el;p_(i,r),n.push(i)}e.or=n;return}throw new Error("Fatal: Incoming SubscriptionCondition object was malformed.")}m();T();h();function pw(e){return new Error(`Normalization failed to return a ${e}.`)}function aG(e){return new Error(`Invalid router compatibility version "${e}".`)}var Ype=(e,t)=>{let n=stringHash(t);return e.stringStorage[n]=t,new nN({key:n})},Jpe=e=>{switch(e){case"ws":return lu.GRAPHQL_SUBSCRIPTION_PROTOCOL_WS;case"sse":return lu.GRAPHQL_SUBSCRIPTION_PROTOCOL_SSE;case"sse_post":return lu.GRAPHQL_SUBSCRIPTION_PROTOCOL_SSE_POST}throw new Error(`Unsupported subscription protocol '${e}'`)},zpe=e=>{switch(e){case"auto":return du.GRAPHQL_WEBSOCKET_SUBPROTOCOL_AUTO;case"graphql-ws":return du.GRAPHQL_WEBSOCKET_SUBPROTOCOL_WS;case"graphql-transport-ws":return du.GRAPHQL_WEBSOCKET_SUBPROTOCOL_TRANSPORT_WS}throw new Error(`Unsupported  websocket subprotocol '${e}'`)},uG=function(e){if(!Ff.ROUTER_COMPATIBILITY_VERSIONS.has(e.routerCompatibilityVersion))throw aG(e.routerCompatibilityVersion);let t=new bf({defaultFlushInterval:BigInt(500),datasourceConfigurations:[],fieldConfigurations:[],graphqlSchema:"",stringStorage:{},typeConfigurations:[]});for(let n of e.subgraphs){if(!n.configurationDataByTypeName)throw pw("ConfigurationDataByTypeName");if(!n.schema)throw pw("GraphQLSchema");let r=Ype(t,TK(fh(n.schema))),{childNodes:i,entityInterfaces:s,events:o,interfaceObjects:u,keys:l,provides:d,requires:p,rootNodes:N}=sG(n.configurationDataByTypeName),E=Jpe(n.subscriptionProtocol||"ws"),g=zpe(n.websocketSubprotocol||"auto"),A,k,j;if(o.kafka.length>0||o.nats.length>0){A=Xc.PUBSUB,j=new Sd({kafka:o.kafka,nats:o.nats});let ie=Oe=>Ff.ROOT_TYPE_NAMES.has(Oe.typeName),re=0,oe=0;for(;re<N.length;){let Oe=N[re];ie(Oe)?N[oe++]=Oe:i.push(Oe),re++}N.length=oe}else A=Xc.GRAPHQL,k=new XT({customScalarTypeFields:[],federation:{enabled:!0,serviceSdl:n.sdl},upstreamSchema:r,fetch:{url:new $i({kind:Zc.STATIC_CONFIGURATION_VARIABLE,staticVariableContent:n.url}),method:vd.POST,header:{},body:{},baseUrl:{},path:{}},subscription:{enabled:!0,url:new $i({kind:Zc.STATIC_CONFIGURATION_VARIABLE,staticVariableContent:n.subscriptionUrl||n.url}),protocol:E,websocketSubprotocol:g}});let Y=new GT({id:n.id,childNodes:i,customEvents:j,customGraphql:k,directives:[],entityInterfaces:s,interfaceObjects:u,keys:l,kind:A,overrideFieldPathFromAlias:!0,provides:d,requestTimeoutSeconds:BigInt(10),requires:p,rootNodes:N});t.datasourceConfigurations.push(Y)}return t.fieldConfigurations=oG(e.fieldConfigurations),t.graphqlSchema=e.federatedSDL,e.federatedClientSDL!==""&&(t.graphqlClientSchema=e.federatedClientSDL),new KT({engineConfig:t,version:e.schemaVersionId,subgraphs:e.subgraphs.map(n=>({id:n.id,name:n.name,routingUrl:n.url})),compatibilityVersion:`${e.routerCompatibilityVersion}:${Ff.COMPOSITION_VERSION}`})};m();T();h();Ue();function cG(e){let t;try{t=ho(e.schema)}catch(n){let r={code:"INVALID_SUBGRAPH_SCHEMA",message:`could not parse schema for Graph ${e.name}: ${n}`,subgraph:e.name,coordinate:"",line:0,column:0};return n&&n.locations&&n.locations.length>0&&(r.line=n.locations[0].line,r.column=n.locations[0].column),{error:r}}return{subgraph:{definitions:t,name:e.name,url:e.url}}}function cmL(e,t){let[n,r]=t.split("."),i=r==null?void 0:r.split("(")[0];for(let s of e.definitions){if(!("name"in s)||(s.name==null?void 0:s.name.value)!==n)continue;let o=s;if(i&&(o=("fields"in s&&s.fields||"values"in s&&s.values||[]).find(u=>"name"in u&&(u.name==null?void 0:u.name.value)===i)),o!=null&&o.loc)return{line:o.loc.startToken.line,column:o.loc.startToken.column}}}function cmG(e,t,n,r,i){let s=i&&r?cmL(i,r):void 0;return{code:e,message:t,subgraph:n,coordinate:r!=null?r:"",line:s?s.line:0,column:s?s.column:0}}function cmM(e,t,n){return cmG(e,t.message,n?n.name:"",t.coordinate,n==null?void 0:n.definitions)}function Zpe(e){let t=[],n=[];for(let o of e){let{subgraph:u,error:l}=cG(o);l?n.push(l):t.push(u)}if(n.length>0)return{errors:n,warnings:[]};let a=[];for(let o of t){let u=(0,Dd.normalizeSubgraph)(o.definitions,o.name,void 0,Dd.LATEST_ROUTER_COMPATIBILITY_VERSION);if(!u.success)for(let l of u.errors)a.push(cmM("SUBGRAPH_VALIDATION_ERROR",l,o))}if(a.length>0)return{errors:a,warnings:[]};let r=(0,Dd.federateSubgraphs)(t,Dd.LATEST_ROUTER_COMPATIBILITY_VERSION),i=(r.warnings||[]).map(o=>{let u=t.find(l=>{var d;return l.name===((d=o.subgraph)==null?void 0:d.name)});return cmG("COMPOSITION_WARNING",o.message,o.subgraph?o.subgraph.name:"",o.coordinate,u==null?void 0:u.definitions)});return r.success?{result:r,errors:[],warnings:i}:{errors:r.errors.map(o=>cmM("COMPOSITION_ERROR",o,t.find(u=>u.name===o.subgraphName))),warnings:i}}function Hpe(e){let{result:t,errors:n,warnings:r}=Zpe(e);return t?{fieldConfigurations:t.fieldConfigurations,sdl:Rt(t.federatedGraphAST),errors:[],warnings:r}:{fieldConfigurations:[],sdl:"",errors:n,warnings:r}}function Wpe(e){let{result:t,errors:n,warnings:r}=Zpe(e);if(!t)return{config:"",errors:n,warnings:r};return{config:uG({federatedClientSDL:yp(t.federatedGraphClientSchema),federatedSDL:yp(t.federatedGraphSchema),fieldConfigurations:t.fieldConfigurations,routerCompatibilityVersion:Dd.LATEST_ROUTER_COMPATIBILITY_VERSION,schemaVersionId:"",subgraphs:e.map((r,i)=>{var l,d;let s=t.subgraphConfigBySubgraphName.get(r.name),o=s==null?void 0:s.schema,u=s==null?void 0:s.configurationDataByTypeName;return{id:`${i}`,name:r.name,url:RF(r.url),sdl:r.schema,subscriptionUrl:RF((l=r.subscription_url)!=null?l:r.url),subscriptionProtocol:(d=r.subscription_protocol)!=null?d:"ws",websocketSubprotocol:r.subscription_protocol==="ws"?r.websocketSubprotocol||"auto":void 0,schema:o,configurationDataByTypeName:u}})}).toJsonString(),errors:[],warnings:r}}return Be(Xpe);})();
 /*! Bundled license information:
 
 @jspm/core/nodelibs/browser/buffer.js:
@@ -1,10 +1,13 @@
 import {
+  CompositionError,
   FieldConfiguration,
   federateSubgraphs as realFederateSubgraphs,
-  LATEST_ROUTER_COMPATIBILITY_VERSION
+  LATEST_ROUTER_COMPATIBILITY_VERSION,
+  normalizeSubgraph,
+  Warning,
 } from '@wundergraph/composition';
 import { buildRouterConfig, normalizeURL, SubscriptionProtocol, WebsocketSubprotocol } from '@wundergraph/cosmo-shared';
-import { DocumentNode, parse, print, printSchema } from 'graphql';
+import { ASTNode, DocumentNode, parse, print, printSchema } from 'graphql';
 
 export type Subgraph = {
   schema: string;
@@ -15,40 +18,168 @@ export type Subgraph = {
   websocketSubprotocol?: WebsocketSubprotocol;
 };
 
+// CompositionMessage is a composition error or warning. The subgraph and the schema coordinate are empty if
+// the message doesn't refer to a single subgraph or coordinate.
+export type CompositionMessage = {
+  code: string;
+  message: string;
+  subgraph: string;
+  coordinate: string;
+  line: number;
+  column: number;
+};
+
 export type FederatedGraph = {
   fieldConfigurations: FieldConfiguration[];
   sdl: string;
+  errors: CompositionMessage[];
+  warnings: CompositionMessage[];
+};
+
+export type RouterConfiguration = {
+  config: string;
+  errors: CompositionMessage[];
+  warnings: CompositionMessage[];
+};
+
+type FederableSubgraph = {
+  definitions: DocumentNode;
+  name: string;
+  url: string;
 };
 
-function createFederableSubgraph(subgraph: Subgraph) {
+function createFederableSubgraph(subgraph: Subgraph): { subgraph?: FederableSubgraph; error?: CompositionMessage } {
   let definitions: DocumentNode;
   try {
     definitions = parse(subgraph.schema);
   } catch (e: any) {
-    throw new Error(`could not parse schema for Graph ${subgraph.name}: ${e}`);
+    const error: CompositionMessage = {
+      code: 'INVALID_SUBGRAPH_SCHEMA',
+      message: `could not parse schema for Graph ${subgraph.name}: ${e}`,
+      subgraph: subgraph.name,
+      coordinate: '',
+      line: 0,
+      column: 0,
+    };
+    if (e && e.locations && e.locations.length > 0) {
+      error.line = e.locations[0].line;
+      error.column = e.locations[0].column;
+    }
+    return { error };
   }
   return {
-    definitions,
-    name: subgraph.name,
-    url: subgraph.url,
+    subgraph: {
+      definitions,
+      name: subgraph.name,
+      url: subgraph.url,
+    },
   };
 }
 
-export function federateSubgraphs(subgraphs: Subgraph[]): FederatedGraph {
-  const result = realFederateSubgraphs(subgraphs.map(createFederableSubgraph), LATEST_ROUTER_COMPATIBILITY_VERSION);
+// locateCoordinate returns the position of the type or the field of the schema coordinate in the subgraph SDL
+function locateCoordinate(definitions: DocumentNode, coordinate: string): { line: number; column: number } | undefined {
+  const [typeName, childPath] = coordinate.split('.');
+  const childName = childPath?.split('(')[0];
+  for (const definition of definitions.definitions) {
+    if (!('name' in definition) || definition.name?.value !== typeName) {
+      continue;
+    }
+    let node: ASTNode | undefined = definition;
+    if (childName) {
+      const children: readonly ASTNode[] =
+        ('fields' in definition && definition.fields) || ('values' in definition && definition.values) || [];
+      node = children.find((child) => 'name' in child && child.name?.value === childName);
+    }
+    if (node?.loc) {
+      return { line: node.loc.startToken.line, column: node.loc.startToken.column };
+    }
+  }
+  return undefined;
+}
+
+function compositionMessage(
+  code: string,
+  message: string,
+  subgraph: string,
+  coordinate?: string,
+  definitions?: DocumentNode,
+): CompositionMessage {
+  const location = definitions && coordinate ? locateCoordinate(definitions, coordinate) : undefined;
+  return {
+    code,
+    message,
+    subgraph,
+    coordinate: coordinate ?? '',
+    line: location?.line ?? 0,
+    column: location?.column ?? 0,
+  };
+}
+
+// errorMessage maps an error of the composition library that refers to the given subgraph, if any. The location
+// is only known if the error refers to a single subgraph and a coordinate.
+function errorMessage(code: string, e: CompositionError, subgraph?: FederableSubgraph): CompositionMessage {
+  return compositionMessage(code, e.message, subgraph?.name ?? '', e.coordinate, subgraph?.definitions);
+}
+
+function federate(subgraphs: Subgraph[]) {
+  const federableSubgraphs: FederableSubgraph[] = [];
+  const parseErrors: CompositionMessage[] = [];
+  for (const subgraph of subgraphs) {
+    const { subgraph: federableSubgraph, error } = createFederableSubgraph(subgraph);
+    if (error) {
+      parseErrors.push(error);
+    } else {
+      federableSubgraphs.push(federableSubgraph!);
+    }
+  }
+  if (parseErrors.length > 0) {
+    return { errors: parseErrors, warnings: [] };
+  }
+  // Federation reports the errors of all subgraphs as a single message per subgraph. The subgraphs are
+  // validated separately first, so that every error refers to the subgraph it belongs to.
+  const validationErrors: CompositionMessage[] = [];
+  for (const subgraph of federableSubgraphs) {
+    const result = normalizeSubgraph(subgraph.definitions, subgraph.name, undefined, LATEST_ROUTER_COMPATIBILITY_VERSION);
+    if (!result.success) {
+      for (const e of result.errors) {
+        validationErrors.push(errorMessage('SUBGRAPH_VALIDATION_ERROR', e, subgraph));
+      }
+    }
+  }
+  if (validationErrors.length > 0) {
+    return { errors: validationErrors, warnings: [] };
+  }
+  const result = realFederateSubgraphs(federableSubgraphs, LATEST_ROUTER_COMPATIBILITY_VERSION);
+  const warnings: CompositionMessage[] = (result.warnings || []).map((w: Warning) => {
+    const subgraph = federableSubgraphs.find((s) => s.name === w.subgraph?.name);
+    return compositionMessage('COMPOSITION_WARNING', w.message, w.subgraph ? w.subgraph.name : '', w.coordinate, subgraph?.definitions);
+  });
   if (!result.success) {
-    throw new Error(`could not federate schema: ${result.errors.map((e: Error) => e.message).join(', ')}`);
+    const errors: CompositionMessage[] = result.errors.map((e: CompositionError) =>
+      errorMessage('COMPOSITION_ERROR', e, federableSubgraphs.find((s) => s.name === e.subgraphName)),
+    );
+    return { errors, warnings };
+  }
+  return { result, errors: [], warnings };
+}
+
+export function federateSubgraphs(subgraphs: Subgraph[]): FederatedGraph {
+  const { result, errors, warnings } = federate(subgraphs);
+  if (!result) {
+    return { fieldConfigurations: [], sdl: '', errors, warnings };
   }
   return {
     fieldConfigurations: result.fieldConfigurations,
     sdl: print(result.federatedGraphAST),
+    errors: [],
+    warnings,
   };
 }
 
-export function buildRouterConfiguration(subgraphs: Subgraph[]): string {
-  const result = realFederateSubgraphs(subgraphs.map(createFederableSubgraph), LATEST_ROUTER_COMPATIBILITY_VERSION);
-  if (!result.success) {
-    throw new Error(`could not federate schema: ${result.errors.map((e: Error) => e.message).join(', ')}`);
+export function buildRouterConfiguration(subgraphs: Subgraph[]): RouterConfiguration {
+  const { result, errors, warnings } = federate(subgraphs);
+  if (!result) {
+    return { config: '', errors, warnings };
   }
   const config = buildRouterConfig({
     federatedClientSDL: printSchema(result.federatedGraphClientSchema),
@@ -73,5 +204,5 @@ export function buildRouterConfiguration(subgraphs: Subgraph[]): string {
       };
     }),
   });
-  return config.toJsonString();
+  return { config: config.toJsonString(), errors: [], warnings };
 }
@@ -18,25 +18,35 @@ type gojaVm struct {
 
 func (m *gojaVm) Dispose() {}
 
-func (m *gojaVm) FederateSubgraphs(subgraphs []*Subgraph) (*FederatedGraph, error) {
+// Interrupt aborts the running composition. Safe to call from another goroutine.
+func (m *gojaVm) Interrupt() {
+	m.runtime.Interrupt(errCompositionInterrupted)
+}
+
+func (m *gojaVm) FederateSubgraphs(subgraphs []*Subgraph) (*federationResult, error) {
 	result, err := m.federateSubgraphs(goja.Undefined(), m.runtime.ToValue(subgraphs))
 	if err != nil {
 		return nil, err
 	}
 
-	var federated FederatedGraph
+	var federated federationResult
 	if err := m.runtime.ExportTo(result, &federated); err != nil {
 		return nil, err
 	}
 	return &federated, nil
 }
 
-func (m *gojaVm) BuildRouterConfiguration(subgraphs []*Subgraph) (string, error) {
+func (m *gojaVm) BuildRouterConfiguration(subgraphs []*Subgraph) (*routerConfigurationResult, error) {
 	result, err := m.buildRouterConfiguration(goja.Undefined(), m.runtime.ToValue(subgraphs))
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	return result.String(), nil
+
+	var config routerConfigurationResult
+	if err := m.runtime.ExportTo(result, &config); err != nil {
+		return nil, err
+	}
+	return &config, nil
 }
 
 func newVM() (*gojaVm, error) {
@@ -83,4 +93,6 @@ func newVM() (*gojaVm, error) {
 	}, nil
 }
 
+var errCompositionInterrupted = errors.New("composition interrupted")
+
 type vm = gojaVm
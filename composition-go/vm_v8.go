@@ -80,6 +80,11 @@ func (m *v8Vm) Dispose() {
 	m.isolate.Dispose()
 }
 
+// Interrupt aborts the running composition. Safe to call from another goroutine.
+func (m *v8Vm) Interrupt() {
+	m.isolate.TerminateExecution()
+}
+
 func (m *v8Vm) subgraphsToJS(subgraphs []*Subgraph) (*v8.Object, error) {
 	tmpl := v8.NewObjectTemplate(m.ctx.Isolate())
 	arrayValue, err := m.ctx.RunScript("[]", "array")
@@ -101,7 +106,47 @@ func (m *v8Vm) subgraphsToJS(subgraphs []*Subgraph) (*v8.Object, error) {
 	return arrayObject, nil
 }
 
-func (m *v8Vm) FederateSubgraphs(subgraphs []*Subgraph) (*FederatedGraph, error) {
+func deserializeCompositionMessages(resultObject *v8.Object, key string) ([]*compositionMessage, error) {
+	messagesValue, err := resultObject.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if !messagesValue.IsArray() {
+		return nil, nil
+	}
+	return deserializeArray(messagesValue.Object(), func(o *v8.Object) (*compositionMessage, error) {
+		message := &compositionMessage{}
+		for key, target := range map[string]*string{
+			"code":       &message.Code,
+			"message":    &message.Message,
+			"subgraph":   &message.Subgraph,
+			"coordinate": &message.Coordinate,
+		} {
+			value, err := o.Get(key)
+			if err != nil {
+				return nil, err
+			}
+			if value.IsString() {
+				*target = value.String()
+			}
+		}
+		for key, target := range map[string]*int{
+			"line":   &message.Line,
+			"column": &message.Column,
+		} {
+			value, err := o.Get(key)
+			if err != nil {
+				return nil, err
+			}
+			if value.IsNumber() {
+				*target = int(value.Integer())
+			}
+		}
+		return message, nil
+	})
+}
+
+func (m *v8Vm) FederateSubgraphs(subgraphs []*Subgraph) (*federationResult, error) {
 	arrayObject, err := m.subgraphsToJS(subgraphs)
 	if err != nil {
 		return nil, err
@@ -111,6 +156,14 @@ func (m *v8Vm) FederateSubgraphs(subgraphs []*Subgraph) (*FederatedGraph, error)
 		return nil, err
 	}
 	resultObject := result.Object()
+	errors, err := deserializeCompositionMessages(resultObject, "errors")
+	if err != nil {
+		return nil, err
+	}
+	warnings, err := deserializeCompositionMessages(resultObject, "warnings")
+	if err != nil {
+		return nil, err
+	}
 	fieldConfigurationsValue, err := resultObject.Get("fieldConfigurations")
 	if err != nil {
 		return nil, err
@@ -169,22 +222,41 @@ func (m *v8Vm) FederateSubgraphs(subgraphs []*Subgraph) (*FederatedGraph, error)
 	if err != nil {
 		return nil, err
 	}
-	return &FederatedGraph{
+	return &federationResult{
 		FieldConfigurations: fieldConfigurations,
 		SDL:                 sdl.String(),
+		Errors:              errors,
+		Warnings:            warnings,
 	}, nil
 }
 
-func (m *v8Vm) BuildRouterConfiguration(subgraphs []*Subgraph) (string, error) {
+func (m *v8Vm) BuildRouterConfiguration(subgraphs []*Subgraph) (*routerConfigurationResult, error) {
 	arrayObject, err := m.subgraphsToJS(subgraphs)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	result, err := m.buildRouterConfiguration.Call(m.null, arrayObject)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	return result.String(), nil
+	resultObject := result.Object()
+	errors, err := deserializeCompositionMessages(resultObject, "errors")
+	if err != nil {
+		return nil, err
+	}
+	warnings, err := deserializeCompositionMessages(resultObject, "warnings")
+	if err != nil {
+		return nil, err
+	}
+	config, err := resultObject.Get("config")
+	if err != nil {
+		return nil, err
+	}
+	return &routerConfigurationResult{
+		Config:   config.String(),
+		Errors:   errors,
+		Warnings: warnings,
+	}, nil
 }
 
 func debugErr(err error) error {
@@ -1,6 +1,6 @@
 import { Kind, OperationTypeNode } from 'graphql';
 import { ObjectDefinitionData } from '../schema-building/types';
-import { InvalidRootTypeFieldEventsDirectiveData } from './utils';
+import { CompositionError, CompositionErrorData, InvalidRootTypeFieldEventsDirectiveData } from './utils';
 import { UnresolvableFieldData } from '../resolvability-graph/utils';
 import { FieldSetDirective } from '../schema-building/utils';
 import {
@@ -34,6 +34,17 @@ import {
   numberToOrdinal,
 } from '../utils/utils';
 
+// compositionError returns an error that identifies the subgraph and the schema coordinate it refers to
+function compositionError(message: string, data: CompositionErrorData): CompositionError {
+  return Object.assign(new Error(message), data);
+}
+
+// childCoordinate returns the coordinate of the child if it's the only one, otherwise the coordinate of the parent
+function childCoordinate(parentTypeName: string, childNames: Iterable<string>): string {
+  const names = [...childNames];
+  return names.length === 1 ? `${parentTypeName}.${names[0]}` : parentTypeName;
+}
+
 export const minimumSubgraphRequirementError = new Error('At least one subgraph is required for federation.');
 
 export function multipleNamedTypeDefinitionError(
@@ -53,9 +64,10 @@ export function incompatibleArgumentTypesError(
   expectedType: string,
   actualType: string,
 ): Error {
-  return new Error(
+  return compositionError(
     `Incompatible types when merging two instances of argument "${argName}" on path "${hostPath}":\n` +
       ` Expected type "${expectedType}" but received "${actualType}"`,
+    { coordinate: hostPath },
   );
 }
 
@@ -72,9 +84,10 @@ export function incompatibleInputValueDefaultValueTypeError(
 }
 
 export function incompatibleChildTypesError(childPath: string, expectedType: string, actualType: string): Error {
-  return new Error(
+  return compositionError(
     `Incompatible types when merging two instances of "${childPath}":\n` +
       ` Expected type "${expectedType}" but received "${actualType}"`,
+    { coordinate: childPath },
   );
 }
 
@@ -97,8 +110,9 @@ export function incompatibleInputValueDefaultValuesError(
 }
 
 export function incompatibleSharedEnumError(parentName: string): Error {
-  return new Error(
+  return compositionError(
     `Enum "${parentName}" was used as both an input and output but was inconsistently defined across inclusive subgraphs.`,
+    { coordinate: parentName },
   );
 }
 
@@ -155,9 +169,10 @@ export function duplicateOperationTypeDefinitionError(
 }
 
 export function noBaseDefinitionForExtensionError(typeString: string, typeName: string): Error {
-  return new Error(
+  return compositionError(
     `The ${typeString} "${typeName}" is an extension,` +
       ` but no base ${typeString} definition of "${typeName}" is defined in any subgraph.`,
+    { coordinate: typeName },
   );
 }
 
@@ -213,9 +228,10 @@ export function invalidFieldShareabilityError(objectData: ObjectDefinitionData,
       );
     }
   }
-  return new Error(
+  return compositionError(
     `The object "${parentTypeName}" defines the same fields in multiple subgraphs without the "@shareable" directive:` +
       `${errorMessages.join('\n')}`,
+    { coordinate: childCoordinate(parentTypeName, invalidFieldNames) },
   );
 }
 
@@ -225,7 +241,9 @@ export function undefinedDirectiveError(directiveName: string, directiveCoords:
   );
 }
 export function undefinedTypeError(typeName: string): Error {
-  return new Error(` The type "${typeName}" was referenced in the schema, but it was never defined.`);
+  return compositionError(` The type "${typeName}" was referenced in the schema, but it was never defined.`, {
+    coordinate: typeName,
+  });
 }
 
 export function invalidRepeatedDirectiveErrorMessage(directiveName: string): string {
@@ -246,9 +264,10 @@ export function invalidDirectiveError(
 }
 
 export function invalidRepeatedFederatedDirectiveErrorMessage(directiveName: string, directiveCoords: string): Error {
-  return new Error(
+  return compositionError(
     `The definition for the directive "@${directiveName}" does not define it as repeatable,` +
       ` but the directive has been declared on more than one instance of the type "${directiveCoords}".`,
+    { coordinate: directiveCoords },
   );
 }
 
@@ -334,9 +353,10 @@ export function invalidKeyDirectivesError(parentTypeName: string, errorMessages:
 }
 
 export function maximumTypeNestingExceededError(path: string): Error {
-  return new Error(
+  return compositionError(
     ` The type defined at path "${path}" has more than ${MAXIMUM_TYPE_NESTING} layers of nesting,` +
       ` or there is a cyclical error.`,
+    { coordinate: path },
   );
 }
 
@@ -364,8 +384,9 @@ export function incompatibleParentKindMergeError(
   expectedTypeString: string,
   actualTypeString: string,
 ): Error {
-  return new Error(
+  return compositionError(
     ` When merging types, expected "${parentTypeName}" to be type "${expectedTypeString}" but received "${actualTypeString}".`,
+    { coordinate: parentTypeName },
   );
 }
 
@@ -528,9 +549,10 @@ export function invalidInterfaceImplementationError(
     }
     messages.push(message);
   }
-  return new Error(
+  return compositionError(
     `The ${parentTypeString} "${parentTypeName}" has the following Interface implementation errors:\n` +
       messages.join('\n'),
+    { coordinate: parentTypeName },
   );
 }
 
@@ -557,7 +579,7 @@ export function invalidRequiredInputValueError(
       ` If an ${inputValueTypeString} is required on a ${typeString} in any one subgraph, it must be at least defined` +
       ` as optional on all other definitions of that ${typeString} in all other subgraphs.\n`;
   }
-  return new Error(message);
+  return compositionError(message, { coordinate: path });
 }
 
 export function duplicateArgumentsError(fieldPath: string, duplicatedArguments: string[]): Error {
@@ -890,8 +912,9 @@ export function allChildDefinitionsAreInaccessibleError(
   typeName: string,
   childType: string,
 ): Error {
-  return new Error(
+  return compositionError(
     `The ${typeString} "${typeName}" is invalid because all its ${childType} definitions are declared "@inaccessible".`,
+    { coordinate: typeName },
   );
 }
 
@@ -1153,7 +1176,7 @@ export function invalidImplementedTypeError(
   for (const [typeName, typeString] of invalidImplementationTypeStringByTypeName) {
     message += `  "${typeName}", which is type "${typeString}"\n`;
   }
-  return new Error(message);
+  return compositionError(message, { coordinate: typeName });
 }
 
 export function selfImplementationError(typeName: string): Error {
@@ -1200,11 +1223,12 @@ export function invalidReferencesOfInaccessibleTypeError(
   typeName: string,
   invalidPaths: string[],
 ): Error {
-  return new Error(
+  return compositionError(
     `The ${typeString} "${typeName}" is declared @inaccessible; however, the ${typeString} is still referenced at` +
       ` the following paths:\n "` +
       invalidPaths.join(QUOTATION_JOIN) +
       `"\n`,
+    { coordinate: typeName },
   );
 }
 
@@ -1213,9 +1237,10 @@ export function inaccessibleRequiredArgumentError(
   argumentPath: string,
   fieldName: string,
 ): Error {
-  return new Error(
+  return compositionError(
     `The argument "${argumentName}" on path "${argumentPath}" is declared @inaccessible;` +
       ` however, it is a required argument for field "${fieldName}".`,
+    { coordinate: argumentPath },
   );
 }
 
@@ -1242,12 +1267,13 @@ export function invalidSubscriptionFilterLocationError(path: string): Error {
 }
 
 export function invalidSubscriptionFilterDirectiveError(fieldPath: string, errorMessages: string[]): Error {
-  return new Error(
+  return compositionError(
     `The "@${SUBSCRIPTION_FILTER}" directive defined on path "${fieldPath}" is invalid for the` +
       ` following reason` +
       (errorMessages.length > 1 ? 's' : '') +
       `:\n` +
       errorMessages.join(`\n`),
+    { coordinate: fieldPath },
   );
 }
 
@@ -1477,14 +1503,17 @@ export function nonLeafSubscriptionFieldConditionFieldPathFinalFieldErrorMessage
 }
 
 export function unresolvablePathError(
-  { fieldName, selectionSet }: UnresolvableFieldData,
+  { fieldName, selectionSet, subgraphNames, typeName }: UnresolvableFieldData,
   reasons: Array<string>,
 ): Error {
   const message =
     `The field "${fieldName}" is unresolvable at the following path:\n${selectionSet}` +
     `\nThis is because:\n - ` +
     reasons.join(`\n - `);
-  return new Error(message);
+  return compositionError(message, {
+    subgraphName: subgraphNames.size === 1 ? [...subgraphNames][0] : undefined,
+    coordinate: `${typeName}.${fieldName}`,
+  });
 }
 
 export function allExternalFieldInstancesError(
@@ -1504,7 +1533,7 @@ export function allExternalFieldInstancesError(
       `"\n`;
   }
   message += `At least one instance of a Field definition must always be resolvable (and therefore not declared "@external").`;
-  return new Error(message);
+  return compositionError(message, { coordinate: childCoordinate(typeName, subgraphNamesByFieldName.keys()) });
 }
 
 export function externalInterfaceFieldsError(typeName: string, fieldNames: Array<string>): Error {
@@ -1551,11 +1580,12 @@ export function incompatibleFederatedFieldNamedTypeError(
         `".`,
     );
   }
-  return new Error(
+  return compositionError(
     `Each instance of a shared Field must resolve identically across subgraphs.\n` +
       `The Field "${fieldCoordinates}" could not be federated due to incompatible types across subgraphs.\n` +
       `The discrepancies are as follows:\n` +
       instances.join(`\n`),
+    { coordinate: fieldCoordinates },
   );
 }
 
@@ -1564,7 +1594,9 @@ export function unknownNamedTypeErrorMessage(fieldCoordinates: string, namedType
 }
 
 export function unknownNamedTypeError(fieldCoordinates: string, namedTypeName: string): Error {
-  return new Error(unknownNamedTypeErrorMessage(fieldCoordinates, namedTypeName));
+  return compositionError(unknownNamedTypeErrorMessage(fieldCoordinates, namedTypeName), {
+    coordinate: fieldCoordinates,
+  });
 }
 
 export function unknownFieldDataError(fieldCoordinates: string): Error {
@@ -1598,11 +1630,12 @@ export function configureDescriptionNoDescriptionError(typeString: string, typeN
 }
 
 export function configureDescriptionPropagationError(coords: string, subgraphNames: Array<string>): Error {
-  return new Error(
+  return compositionError(
     `The coordinates "${coords}" declare "@openfed__configureDescription(propagate: true)" in the following subgraphs:\n "` +
       subgraphNames.join(QUOTATION_JOIN) +
       '"\n' +
       `A federated graph only supports a single description; consequently, only one subgraph may define argument "propagate" as true (this is the default value).`,
+    { coordinate: coords },
   );
 }
 
@@ -2,3 +2,13 @@ export type InvalidRootTypeFieldEventsDirectiveData = {
   definesDirectives: boolean;
   invalidDirectiveNames: string[];
 };
+
+// CompositionErrorData identifies the subgraph and the schema coordinate a composition error refers to
+export type CompositionErrorData = {
+  // subgraphName is set if the error refers to a single subgraph
+  subgraphName?: string;
+  // coordinate is the schema coordinate the error refers to, e.g. "Query.employees"
+  coordinate?: string;
+};
+
+export type CompositionError = Error & CompositionErrorData;
@@ -18,6 +18,7 @@ export function invalidOverrideTargetSubgraphNameWarning(
       `The required "from" argument of type "String!" should be provided with an existing subgraph name.\n` +
       `However, a subgraph by the name of "${targetSubgraphName}" does not exist.\n` +
       `If this subgraph has been recently deleted, remember to clean up unused "@override" directives that reference this subgraph.`,
+    coordinate: fieldNames.length === 1 ? `${parentTypeName}.${fieldNames[0]}` : parentTypeName,
     subgraph: {
       name: originSubgraphName,
     },
@@ -48,6 +49,7 @@ export function externalInterfaceFieldsWarning(
       `Interface Fields should not be declared "@external". This is because Interface Fields do not resolve directly,` +
       ` but the "@external" directive relates to whether a Field instance can be resolved` +
       ` by the subgraph in which it is defined.`,
+    coordinate: typeName,
     subgraph: {
       name: subgraphName,
     },
@@ -69,6 +71,7 @@ export function nonExternalConditionalFieldWarning(
       `\nHowever, neither the Field "${targetCoords}" nor any of its field set ancestors are declared @external.` +
       `\nConsequently, "${targetCoords}" is already provided by subgraph "${subgraphName}" and should not form part of` +
       ` a "@${fieldSetDirective}" directive field set.`,
+    coordinate: originCoords,
     subgraph: {
       name: subgraphName,
     },
@@ -81,6 +84,7 @@ export function unimplementedInterfaceOutputTypeWarning(subgraphName: string, in
     message:
       `Subgraph "${subgraphName}": The Interface "${interfaceTypeName}" is used as an output type` +
       ` without at least one Object type implementation defined in the schema.`,
+    coordinate: interfaceTypeName,
     subgraph: {
       name: subgraphName,
     },
@@ -95,6 +99,7 @@ export function invalidExternalFieldWarning(fieldCoords: string, subgraphName: s
       ` be declared "@external" if it is part of a "@key", "@provides", or "@requires" FieldSet, or the Field is` +
       ` necessary to satisfy an Interface implementation. In the case that none of these conditions is true, the` +
       ` "@external" directive should be removed.`,
+    coordinate: fieldCoords,
     subgraph: {
       name: subgraphName,
     },
@@ -107,6 +112,7 @@ export function requiresDefinedOnNonEntityFieldWarning(fieldCoords: string, subg
       ` The Object Field "${fieldCoords}" defines a "@requires" directive, but the Object is not an entity.` +
       ' Consequently, the "@requires" FieldSet cannot be satisfied because there is no entity resolver with which to' +
       ' provide the required Fields.',
+    coordinate: fieldCoords,
     subgraph: {
       name: subgraphName,
     },
@@ -4,14 +4,18 @@ export type WarningSubgraphData = {
 export type WarningOptions = {
   message: string;
   subgraph: WarningSubgraphData;
+  // coordinate is the schema coordinate the warning refers to, e.g. "Query.employees"
+  coordinate?: string;
 };
 
 export class Warning extends Error {
   subgraph: WarningSubgraphData;
+  coordinate?: string;
 
   constructor(options: WarningOptions) {
     super(options.message);
     this.name = 'Warning';
     this.subgraph = options.subgraph;
+    this.coordinate = options.coordinate;
   }
 }
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/wundergraph/cosmo/composition-go"
+	"github.com/wundergraph/cosmo/router/core"
+	"github.com/wundergraph/cosmo/router/pkg/config"
+	"github.com/wundergraph/cosmo/router/pkg/execution_config"
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/graphqlerrors"
+)
+
+// devComposer composes the execution config of the dev composition mode with composition-go
+type devComposer struct {
+	once     sync.Once
+	composer *composition.Composer
+}
+
+// init creates the composer on the first composition. The dev composition config can't change at runtime.
+func (c *devComposer) init(cfg *config.DevComposition) {
+	c.once.Do(func() {
+		opts := []composition.Option{
+			// Compositions are serialized by the router, a single VM is enough
+			composition.WithPoolSize(1),
+			composition.WithHTTPClient(&http.Client{Timeout: cfg.IntrospectionTimeout}),
+		}
+
+		for _, sg := range cfg.Subgraphs {
+			if len(sg.IntrospectionHeaders) == 0 {
+				continue
+			}
+			header := make(http.Header, len(sg.IntrospectionHeaders))
+			for name, value := range sg.IntrospectionHeaders {
+				header.Set(name, value)
+			}
+			opts = append(opts, composition.WithIntrospectionHeaders(sg.Name, header))
+		}
+
+		c.composer = composition.NewComposer(opts...)
+	})
+}
+
+// Compose reads the schema files, introspects the subgraphs without a schema file and composes the execution config.
+func (c *devComposer) Compose(ctx context.Context, cfg *config.DevComposition) (*core.DevCompositionResult, error) {
+	c.init(cfg)
+
+	subgraphs := make([]*composition.Subgraph, 0, len(cfg.Subgraphs))
+
+	for _, sg := range cfg.Subgraphs {
+		subgraph := &composition.Subgraph{
+			Name:                 sg.Name,
+			URL:                  sg.RoutingURL,
+			SubscriptionURL:      sg.SubscriptionURL,
+			SubscriptionProtocol: sg.SubscriptionProtocol,
+		}
+
+		switch {
+		case sg.SchemaFile != "":
+			schema, err := os.ReadFile(sg.SchemaFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read schema of subgraph %s: %w", sg.Name, err)
+			}
+			subgraph.Schema = string(schema)
+		case sg.IntrospectionURL != "":
+			schema, err := c.composer.IntrospectSubgraph(ctx, &composition.Subgraph{Name: sg.Name, URL: sg.IntrospectionURL})
+			if err != nil {
+				return nil, fmt.Errorf("error introspecting subgraph %s: %w", sg.Name, err)
+			}
+			subgraph.Schema = schema
+		}
+
+		// Subgraphs without a schema are introspected through the routing URL by the composer
+		subgraphs = append(subgraphs, subgraph)
+	}
+
+	routerConfig, err := c.composer.BuildRouterConfiguration(ctx, subgraphs...)
+	if err != nil {
+		return nil, compositionRequestErrors(err)
+	}
+
+	executionConfig, err := execution_config.UnmarshalConfig([]byte(routerConfig.Config))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse composed execution config: %w", err)
+	}
+
+	// The composition doesn't assign a version. Deriving it from the content allows to skip unchanged compositions.
+	executionConfig.Version = fmt.Sprintf("dev-%x", xxhash.Sum64String(routerConfig.Config))
+
+	warnings := make([]string, 0, len(routerConfig.Warnings))
+	for _, warning := range routerConfig.Warnings {
+		warnings = append(warnings, compositionMessage(warning.SubgraphName, warning.Message))
+	}
+
+	return &core.DevCompositionResult{
+		RouterConfig: executionConfig,
+		Warnings:     warnings,
+	}, nil
+}
+
+func (c *devComposer) Close() {
+	if c.composer != nil {
+		c.composer.Close()
+	}
+}
+
+// compositionRequestErrors converts the errors of a failed composition to GraphQL errors, so that the router answers
+// requests with every error of the composition. Other errors are returned as is.
+func compositionRequestErrors(err error) error {
+	var compositionErrs composition.CompositionErrors
+	if !errors.As(err, &compositionErrs) {
+		return err
+	}
+
+	requestErrors := make(graphqlerrors.RequestErrors, 0, len(compositionErrs))
+	for _, compositionErr := range compositionErrs {
+		requestErrors = append(requestErrors, graphqlerrors.RequestError{
+			Message:    compositionMessage(compositionErr.SubgraphName, compositionErr.Message),
+			Extensions: &graphqlerrors.Extensions{Code: compositionErr.Code},
+		})
+	}
+
+	return requestErrors
+}
+
+func compositionMessage(subgraphName, message string) string {
+	if subgraphName == "" {
+		return message
+	}
+	return fmt.Sprintf("[%s] %s", subgraphName, message)
+}
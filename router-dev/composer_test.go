@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wundergraph/cosmo/composition-go"
+	"github.com/wundergraph/cosmo/router/pkg/config"
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/graphqlerrors"
+)
+
+func TestDevComposer(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	employeesSchema := filepath.Join(dir, "employees.graphql")
+	require.NoError(t, os.WriteFile(employeesSchema, []byte(`type Query { employees: [String] }`), 0600))
+
+	cfg := &config.DevComposition{
+		Enabled:              true,
+		IntrospectionTimeout: time.Second,
+		Subgraphs: []config.DevCompositionSubgraph{
+			{Name: "employees", RoutingURL: "http://localhost:4001/graphql", SchemaFile: employeesSchema},
+		},
+	}
+
+	composer := &devComposer{}
+	defer composer.Close()
+
+	result, err := composer.Compose(context.Background(), cfg)
+	require.NoError(t, err)
+	require.Contains(t, result.RouterConfig.GetEngineConfig().GetGraphqlSchema(), "employees: [String]")
+	require.Len(t, result.RouterConfig.GetSubgraphs(), 1)
+	require.Equal(t, "employees", result.RouterConfig.GetSubgraphs()[0].GetName())
+
+	// The version only changes with the composition
+	unchanged, err := composer.Compose(context.Background(), cfg)
+	require.NoError(t, err)
+	require.Equal(t, result.RouterConfig.GetVersion(), unchanged.RouterConfig.GetVersion())
+
+	require.NoError(t, os.WriteFile(employeesSchema, []byte(`type Query { employees: [String!] }`), 0600))
+	changed, err := composer.Compose(context.Background(), cfg)
+	require.NoError(t, err)
+	require.NotEqual(t, result.RouterConfig.GetVersion(), changed.RouterConfig.GetVersion())
+
+	require.NoError(t, os.WriteFile(employeesSchema, []byte(`type Query { employees: [String }`), 0600))
+	_, err = composer.Compose(context.Background(), cfg)
+	var requestErrors graphqlerrors.RequestErrors
+	require.ErrorAs(t, err, &requestErrors)
+	require.Len(t, requestErrors, 1)
+	require.Equal(t, composition.ErrorCodeInvalidSubgraphSchema, requestErrors[0].Extensions.Code)
+}
+
+func TestCompositionRequestErrors(t *testing.T) {
+	t.Parallel()
+
+	err := compositionRequestErrors(composition.CompositionErrors{
+		{Code: composition.ErrorCodeInvalidSubgraphSchema, Message: "Unexpected token", SubgraphName: "employees"},
+		{Code: composition.ErrorCodeComposition, Message: "Composition failed"},
+	})
+	var requestErrors graphqlerrors.RequestErrors
+	require.ErrorAs(t, err, &requestErrors)
+	require.Len(t, requestErrors, 2)
+	require.Equal(t, "[employees] Unexpected token", requestErrors[0].Message)
+	require.Equal(t, composition.ErrorCodeInvalidSubgraphSchema, requestErrors[0].Extensions.Code)
+	require.Equal(t, "Composition failed", requestErrors[1].Message)
+
+	// Other errors are returned as is
+	readErr := errors.New("failed to read schema of subgraph employees")
+	require.Equal(t, readErr, compositionRequestErrors(readErr))
+}
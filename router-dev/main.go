@@ -0,0 +1,13 @@
+// The dev router composes the execution config from local subgraphs with composition-go. It is built separately from
+// the router, so that the router binary doesn't include the composition library and its JavaScript runtime.
+package main
+
+import (
+	routercmd "github.com/wundergraph/cosmo/router/cmd"
+	"github.com/wundergraph/cosmo/router/core"
+)
+
+func main() {
+	// The composer is closed by the router on shutdown
+	routercmd.Main(core.WithDevComposer(&devComposer{}))
+}
@@ -0,0 +1,129 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/wundergraph/cosmo/router-tests/testenv"
+	"github.com/wundergraph/cosmo/router/core"
+	"github.com/wundergraph/cosmo/router/pkg/config"
+)
+
+func TestBulkhead(t *testing.T) {
+	t.Parallel()
+
+	// blockingSubgraph holds the first request until unblock is closed
+	blockingSubgraph := func(received chan<- struct{}, unblock <-chan struct{}) func(handler http.Handler) http.Handler {
+		return func(handler http.Handler) http.Handler {
+			var once sync.Once
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				once.Do(func() {
+					close(received)
+					<-unblock
+				})
+				handler.ServeHTTP(w, r)
+			})
+		}
+	}
+
+	employee := func(id int) testenv.GraphQLRequest {
+		// Different variables per request, so the requests are not deduplicated
+		variables, _ := json.Marshal(map[string]int{"id": id})
+		return testenv.GraphQLRequest{
+			Query:     `query ($id: Int!) { employee(id: $id) { id } }`,
+			Variables: variables,
+		}
+	}
+
+	t.Run("requests exceeding the limit are rejected", func(t *testing.T) {
+		t.Parallel()
+
+		received, unblock := make(chan struct{}), make(chan struct{})
+
+		trafficConfig := config.TrafficShapingRules{
+			Subgraphs: map[string]*config.GlobalSubgraphRequestRule{
+				"employees": {
+					Bulkhead: config.Bulkhead{
+						Enabled:               true,
+						MaxConcurrentRequests: 1,
+						MaxQueueSize:          0,
+					},
+				},
+			},
+		}
+
+		testenv.Run(t, &testenv.Config{
+			Subgraphs: testenv.SubgraphsConfig{
+				Employees: testenv.SubgraphConfig{
+					Middleware: blockingSubgraph(received, unblock),
+				},
+			},
+			RouterOptions: []core.Option{
+				core.WithSubgraphTransportOptions(core.NewSubgraphTransportOptions(trafficConfig)),
+			},
+		}, func(t *testing.T, xEnv *testenv.Environment) {
+			done := make(chan string)
+			go func() {
+				res, _ := xEnv.MakeGraphQLRequest(employee(1))
+				done <- res.Body
+			}()
+			<-received
+
+			res := xEnv.MakeGraphQLRequestOK(employee(2))
+			require.Contains(t, res.Body, `{"message":"Too many concurrent requests to Subgraph 'employees'.","extensions":{"code":"BULKHEAD_REJECTED"}}`)
+
+			close(unblock)
+			require.Equal(t, `{"data":{"employee":{"id":1}}}`, <-done)
+
+			// The slot is released once the request is done
+			res = xEnv.MakeGraphQLRequestOK(employee(2))
+			require.Equal(t, `{"data":{"employee":{"id":2}}}`, res.Body)
+		})
+	})
+
+	t.Run("queued requests time out", func(t *testing.T) {
+		t.Parallel()
+
+		received, unblock := make(chan struct{}), make(chan struct{})
+
+		trafficConfig := config.TrafficShapingRules{
+			All: config.GlobalSubgraphRequestRule{
+				Bulkhead: config.Bulkhead{
+					Enabled:               true,
+					MaxConcurrentRequests: 1,
+					MaxQueueSize:          1,
+					QueueTimeout:          50 * time.Millisecond,
+				},
+			},
+		}
+
+		testenv.Run(t, &testenv.Config{
+			Subgraphs: testenv.SubgraphsConfig{
+				Employees: testenv.SubgraphConfig{
+					Middleware: blockingSubgraph(received, unblock),
+				},
+			},
+			RouterOptions: []core.Option{
+				core.WithSubgraphTransportOptions(core.NewSubgraphTransportOptions(trafficConfig)),
+			},
+		}, func(t *testing.T, xEnv *testenv.Environment) {
+			done := make(chan string)
+			go func() {
+				res, _ := xEnv.MakeGraphQLRequest(employee(1))
+				done <- res.Body
+			}()
+			<-received
+
+			res := xEnv.MakeGraphQLRequestOK(employee(2))
+			require.Contains(t, res.Body, `{"message":"Timed out waiting for a free request slot of Subgraph 'employees'.","extensions":{"code":"BULKHEAD_REJECTED"}}`)
+
+			close(unblock)
+			require.Equal(t, `{"data":{"employee":{"id":1}}}`, <-done)
+		})
+	})
+}
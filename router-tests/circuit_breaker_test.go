@@ -0,0 +1,184 @@
+package integration
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/wundergraph/cosmo/router-tests/testenv"
+	"github.com/wundergraph/cosmo/router/core"
+	nodev1 "github.com/wundergraph/cosmo/router/gen/proto/wg/cosmo/node/v1"
+	"github.com/wundergraph/cosmo/router/pkg/config"
+	"github.com/wundergraph/cosmo/router/pkg/controlplane/configpoller"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	const openCircuitError = `{"message":"Circuit breaker of Subgraph 'employees' is open.","extensions":{"code":"CIRCUIT_BREAKER_OPEN"}}`
+
+	t.Run("open circuit rejects requests until the subgraph recovers", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			failing  atomic.Bool
+			requests atomic.Int64
+		)
+		failing.Store(true)
+
+		trafficConfig := config.TrafficShapingRules{
+			Subgraphs: map[string]*config.GlobalSubgraphRequestRule{
+				"employees": {
+					CircuitBreaker: config.CircuitBreaker{
+						Enabled:             true,
+						ConsecutiveFailures: 2,
+						SleepWindow:         200 * time.Millisecond,
+						HalfOpenRequests:    1,
+					},
+				},
+			},
+		}
+
+		testenv.Run(t, &testenv.Config{
+			Subgraphs: testenv.SubgraphsConfig{
+				Employees: testenv.SubgraphConfig{
+					Middleware: func(handler http.Handler) http.Handler {
+						return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+							requests.Add(1)
+							if failing.Load() {
+								w.WriteHeader(http.StatusInternalServerError)
+								_, _ = w.Write([]byte(`{"errors":[{"message":"unavailable"}]}`))
+								return
+							}
+							handler.ServeHTTP(w, r)
+						})
+					},
+				},
+			},
+			RouterOptions: []core.Option{
+				core.WithSubgraphTransportOptions(core.NewSubgraphTransportOptions(trafficConfig)),
+			},
+		}, func(t *testing.T, xEnv *testenv.Environment) {
+			query := testenv.GraphQLRequest{Query: `{ employee(id: 1) { id } }`}
+
+			for i := 0; i < 2; i++ {
+				res := xEnv.MakeGraphQLRequestOK(query)
+				require.Contains(t, res.Body, `"message":"unavailable"`)
+			}
+			require.Equal(t, int64(2), requests.Load())
+
+			// The circuit is open, the subgraph is not called
+			res := xEnv.MakeGraphQLRequestOK(query)
+			require.Contains(t, res.Body, openCircuitError)
+			require.Equal(t, int64(2), requests.Load())
+
+			failing.Store(false)
+			time.Sleep(250 * time.Millisecond)
+
+			// The probe request succeeds and closes the circuit
+			res = xEnv.MakeGraphQLRequestOK(query)
+			require.Equal(t, `{"data":{"employee":{"id":1}}}`, res.Body)
+			res = xEnv.MakeGraphQLRequestOK(query)
+			require.Equal(t, `{"data":{"employee":{"id":1}}}`, res.Body)
+			require.Equal(t, int64(4), requests.Load())
+		})
+	})
+
+	t.Run("open circuit stops retries", func(t *testing.T) {
+		t.Parallel()
+
+		var requests atomic.Int64
+
+		trafficConfig := config.TrafficShapingRules{
+			All: config.GlobalSubgraphRequestRule{
+				CircuitBreaker: config.CircuitBreaker{
+					Enabled:             true,
+					ConsecutiveFailures: 2,
+					SleepWindow:         time.Minute,
+				},
+			},
+		}
+
+		testenv.Run(t, &testenv.Config{
+			Subgraphs: testenv.SubgraphsConfig{
+				Employees: testenv.SubgraphConfig{
+					Middleware: func(handler http.Handler) http.Handler {
+						return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+							requests.Add(1)
+							w.WriteHeader(http.StatusServiceUnavailable)
+						})
+					},
+				},
+			},
+			RouterOptions: []core.Option{
+				core.WithSubgraphTransportOptions(core.NewSubgraphTransportOptions(trafficConfig)),
+				core.WithSubgraphRetryOptions(true, 5, 10*time.Millisecond, time.Millisecond),
+			},
+		}, func(t *testing.T, xEnv *testenv.Environment) {
+			res := xEnv.MakeGraphQLRequestOK(testenv.GraphQLRequest{Query: `{ employee(id: 1) { id } }`})
+			require.Contains(t, res.Body, openCircuitError)
+			require.Equal(t, int64(2), requests.Load())
+		})
+	})
+
+	t.Run("open circuit survives a config update", func(t *testing.T) {
+		t.Parallel()
+
+		var requests atomic.Int64
+
+		pm := ConfigPollerMock{
+			ready: make(chan struct{}),
+		}
+
+		trafficConfig := config.TrafficShapingRules{
+			Subgraphs: map[string]*config.GlobalSubgraphRequestRule{
+				"employees": {
+					CircuitBreaker: config.CircuitBreaker{
+						Enabled:             true,
+						ConsecutiveFailures: 1,
+						SleepWindow:         time.Minute,
+					},
+				},
+			},
+		}
+
+		testenv.Run(t, &testenv.Config{
+			Subgraphs: testenv.SubgraphsConfig{
+				Employees: testenv.SubgraphConfig{
+					Middleware: func(handler http.Handler) http.Handler {
+						return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+							requests.Add(1)
+							w.WriteHeader(http.StatusInternalServerError)
+						})
+					},
+				},
+			},
+			RouterOptions: []core.Option{
+				core.WithSubgraphTransportOptions(core.NewSubgraphTransportOptions(trafficConfig)),
+			},
+			RouterConfig: &testenv.RouterConfig{
+				ConfigPollerFactory: func(config *nodev1.RouterConfig) configpoller.ConfigPoller {
+					pm.initConfig = config
+					return &pm
+				},
+			},
+		}, func(t *testing.T, xEnv *testenv.Environment) {
+			query := testenv.GraphQLRequest{Query: `{ employee(id: 1) { id } }`}
+
+			xEnv.MakeGraphQLRequestOK(query)
+			require.Equal(t, int64(1), requests.Load())
+
+			<-pm.ready
+			pm.initConfig.Version = "updated"
+			require.NoError(t, pm.updateConfig(pm.initConfig, "old-1"))
+
+			// The new graph server uses the open circuit of the previous one
+			res := xEnv.MakeGraphQLRequestOK(query)
+			require.Contains(t, res.Body, openCircuitError)
+			require.Equal(t, int64(1), requests.Load())
+		})
+	})
+}
@@ -393,4 +393,78 @@ func TestComplexityLimits(t *testing.T) {
 			})
 		})
 	})
+
+	t.Run("cost limit", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("cost limit blocks queries over the limit", func(t *testing.T) {
+			t.Parallel()
+			testenv.Run(t, &testenv.Config{
+				ModifySecurityConfiguration: func(securityConfiguration *config.SecurityConfiguration) {
+					securityConfiguration.ComplexityLimits = &config.ComplexityLimits{
+						Cost: &config.CostAnalysis{
+							Enabled:         true,
+							Limit:           5,
+							DefaultListSize: 10,
+						},
+					}
+				},
+			}, func(t *testing.T, xEnv *testenv.Environment) {
+				res, _ := xEnv.MakeGraphQLRequest(testenv.GraphQLRequest{
+					Query: `{ employees { id } }`,
+				})
+				require.Equal(t, 400, res.Response.StatusCode)
+				require.Equal(t, `{"errors":[{"message":"The estimated query cost 10 exceeds the maximum cost allowed (5)"}]}`, res.Body)
+			})
+		})
+
+		t.Run("cost limit uses configured weights and list sizes", func(t *testing.T) {
+			t.Parallel()
+			testenv.Run(t, &testenv.Config{
+				ModifySecurityConfiguration: func(securityConfiguration *config.SecurityConfiguration) {
+					securityConfiguration.ComplexityLimits = &config.ComplexityLimits{
+						Cost: &config.CostAnalysis{
+							Enabled:         true,
+							Limit:           5,
+							DefaultListSize: 10,
+							FieldWeights:    map[string]int{"Query.employee": 5},
+							ListSizes:       map[string]int{"Query.employees": 2},
+						},
+					}
+				},
+			}, func(t *testing.T, xEnv *testenv.Environment) {
+				res := xEnv.MakeGraphQLRequestOK(testenv.GraphQLRequest{
+					Query: `{ employees { id } }`,
+				})
+				require.Equal(t, 200, res.Response.StatusCode)
+
+				res, _ = xEnv.MakeGraphQLRequest(testenv.GraphQLRequest{
+					Query: `{ employee(id:1) { id details { forename surname } } }`,
+				})
+				require.Equal(t, 400, res.Response.StatusCode)
+				require.Equal(t, `{"errors":[{"message":"The estimated query cost 6 exceeds the maximum cost allowed (5)"}]}`, res.Body)
+			})
+		})
+
+		t.Run("cost is exposed in the response extensions", func(t *testing.T) {
+			t.Parallel()
+			testenv.Run(t, &testenv.Config{
+				ModifySecurityConfiguration: func(securityConfiguration *config.SecurityConfiguration) {
+					securityConfiguration.ComplexityLimits = &config.ComplexityLimits{
+						Cost: &config.CostAnalysis{
+							Enabled:          true,
+							Limit:            100,
+							ExposeInResponse: true,
+							DefaultListSize:  10,
+						},
+					}
+				},
+			}, func(t *testing.T, xEnv *testenv.Environment) {
+				res := xEnv.MakeGraphQLRequestOK(testenv.GraphQLRequest{
+					Query: `{ employee(id:1) { id details { forename surname } } }`,
+				})
+				require.JSONEq(t, `{"data":{"employee":{"id":1,"details":{"forename":"Jens","surname":"Neuse"}}},"extensions":{"cost":2}}`, res.Body)
+			})
+		})
+	})
 }
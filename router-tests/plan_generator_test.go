@@ -0,0 +1,76 @@
+package integration
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wundergraph/cosmo/router/core"
+)
+
+func TestPlanGenerator(t *testing.T) {
+	t.Parallel()
+
+	operationsPath := t.TempDir()
+	plansPath := filepath.Join(t.TempDir(), "plans")
+
+	operations := map[string]string{
+		"Employees.graphql":        `query Employees { employees { id details { forename } } }`,
+		"EmployeeProducts.graphql": `query EmployeeProducts { employees { id products } }`,
+		"Unknown.graphql":          `query Unknown { unknown }`,
+		"Ignored.txt":              `query Ignored { employees { id } }`,
+	}
+	for name, content := range operations {
+		require.NoError(t, os.WriteFile(filepath.Join(operationsPath, name), []byte(content), 0600))
+	}
+
+	pg, err := core.NewPlanGenerator(filepath.Join("testenv", "testdata", "config.json"))
+	require.NoError(t, err)
+
+	report, err := pg.PlanOperations(context.Background(), core.PlanOperationsOptions{
+		OperationsPath: operationsPath,
+		OutputPath:     plansPath,
+		Concurrency:    2,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, report.Operations, 3)
+	require.Equal(t, 2, report.Succeeded)
+	require.Equal(t, 1, report.Failed)
+
+	require.Equal(t, "EmployeeProducts.graphql", report.Operations[0].Name)
+	require.Equal(t, core.PlanStatusSuccess, report.Operations[0].Status)
+	require.Equal(t, 2, report.Operations[0].Fetches)
+
+	require.Equal(t, "Employees.graphql", report.Operations[1].Name)
+	require.Equal(t, 1, report.Operations[1].Fetches)
+
+	require.Equal(t, "Unknown.graphql", report.Operations[2].Name)
+	require.Equal(t, core.PlanStatusError, report.Operations[2].Status)
+	require.NotEmpty(t, report.Operations[2].Error)
+
+	plan, err := os.ReadFile(filepath.Join(plansPath, "Employees.graphql"))
+	require.NoError(t, err)
+	require.Contains(t, string(plan), "employees")
+
+	report, err = pg.PlanOperations(context.Background(), core.PlanOperationsOptions{
+		OperationsPath: operationsPath,
+		Filter:         []string{"Employees.graphql"},
+	})
+	require.NoError(t, err)
+	require.Len(t, report.Operations, 1)
+
+	// Planning against the same execution config doesn't change any fetch tree
+	regressions, err := pg.ComparePlans(context.Background(), pg, core.PlanOperationsOptions{
+		OperationsPath: operationsPath,
+		Concurrency:    2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, regressions.Compared)
+	require.Equal(t, 0, regressions.Changed)
+	require.Equal(t, 1, regressions.Failed)
+	require.Len(t, regressions.Operations, 1)
+	require.Equal(t, "Unknown.graphql", regressions.Operations[0].Name)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/sebdah/goldie/v2"
@@ -87,6 +88,59 @@ func TestQueryPlans(t *testing.T) {
 			g.Assert(t, "response_with_query_plan", indentedJSON(res.Body))
 		})
 	})
+	t.Run("include query plan in requested format", func(t *testing.T) {
+		t.Parallel()
+
+		testenv.Run(t, &testenv.Config{}, func(t *testing.T, xEnv *testenv.Environment) {
+			res := xEnv.MakeGraphQLRequestOK(testenv.GraphQLRequest{
+				Header: http.Header{
+					"X-WG-Include-Query-Plan": []string{"true"},
+					"X-WG-Query-Plan-Format":  []string{"mermaid"},
+				},
+				Query: `query Requires {
+					  products {
+						__typename
+						... on Consultancy {
+						  lead {
+							__typename
+							id
+							derivedMood
+						  }
+						  isLeadAvailable
+						}
+					  }
+					}`,
+			})
+
+			var response struct {
+				Data       json.RawMessage `json:"data"`
+				Extensions struct {
+					QueryPlan string `json:"queryPlan"`
+				} `json:"extensions"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(res.Body), &response))
+			require.NotEmpty(t, response.Data)
+			require.True(t, strings.HasPrefix(response.Extensions.QueryPlan, "flowchart TD\n"))
+			require.Contains(t, response.Extensions.QueryPlan, "employees<br/>Single")
+			require.Contains(t, response.Extensions.QueryPlan, "mood<br/>BatchEntity")
+		})
+	})
+	t.Run("invalid query plan format", func(t *testing.T) {
+		t.Parallel()
+
+		testenv.Run(t, &testenv.Config{}, func(t *testing.T, xEnv *testenv.Environment) {
+			res, err := xEnv.MakeGraphQLRequest(testenv.GraphQLRequest{
+				Header: http.Header{
+					"X-WG-Include-Query-Plan": []string{"true"},
+					"X-WG-Query-Plan-Format":  []string{"svg"},
+				},
+				Query: `{ employees { id } }`,
+			})
+			require.NoError(t, err)
+			require.Equal(t, http.StatusBadRequest, res.Response.StatusCode)
+			require.Contains(t, res.Body, "unsupported query plan format 'svg'")
+		})
+	})
 	t.Run("query plans disabled", func(t *testing.T) {
 		t.Parallel()
 
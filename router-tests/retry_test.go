@@ -0,0 +1,201 @@
+package integration
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/wundergraph/cosmo/router-tests/testenv"
+	"github.com/wundergraph/cosmo/router/core"
+	"github.com/wundergraph/cosmo/router/pkg/config"
+	"github.com/wundergraph/cosmo/router/pkg/otel"
+	"github.com/wundergraph/cosmo/router/pkg/trace/tracetest"
+)
+
+func TestSubgraphRetry(t *testing.T) {
+	t.Parallel()
+
+	// failingSubgraph responds with the status code and headers until it received as many requests as failures
+	failingSubgraph := func(requests *atomic.Int64, failures int64, statusCode int, header http.Header) func(handler http.Handler) http.Handler {
+		return func(handler http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if requests.Add(1) > failures {
+					handler.ServeHTTP(w, r)
+					return
+				}
+				for name, values := range header {
+					w.Header()[name] = values
+				}
+				w.WriteHeader(statusCode)
+			})
+		}
+	}
+
+	employeesRetry := func(retry config.BackoffJitterRetry) config.TrafficShapingRules {
+		return config.TrafficShapingRules{
+			Subgraphs: map[string]*config.GlobalSubgraphRequestRule{
+				"employees": {
+					BackoffJitterRetry: retry,
+				},
+			},
+		}
+	}
+
+	t.Run("retries with the policy of the subgraph", func(t *testing.T) {
+		t.Parallel()
+
+		var requests atomic.Int64
+		trafficConfig := employeesRetry(config.BackoffJitterRetry{
+			Enabled:     ToPtr(true),
+			MaxAttempts: 2,
+			Interval:    time.Millisecond,
+			MaxDuration: 10 * time.Millisecond,
+		})
+
+		testenv.Run(t, &testenv.Config{
+			Subgraphs: testenv.SubgraphsConfig{
+				Employees: testenv.SubgraphConfig{
+					Middleware: failingSubgraph(&requests, 2, http.StatusBadGateway, nil),
+				},
+			},
+			RouterOptions: []core.Option{
+				core.WithSubgraphTransportOptions(core.NewSubgraphTransportOptions(trafficConfig)),
+				core.WithSubgraphRetryOptions(false, 0, 0, 0),
+			},
+		}, func(t *testing.T, xEnv *testenv.Environment) {
+			res := xEnv.MakeGraphQLRequestOK(testenv.GraphQLRequest{Query: `{ employee(id: 1) { id } }`})
+			require.Equal(t, `{"data":{"employee":{"id":1}}}`, res.Body)
+			require.Equal(t, int64(3), requests.Load())
+		})
+	})
+
+	t.Run("only retries the configured status codes", func(t *testing.T) {
+		t.Parallel()
+
+		var requests atomic.Int64
+		trafficConfig := employeesRetry(config.BackoffJitterRetry{
+			Enabled:     ToPtr(true),
+			MaxAttempts: 2,
+			Interval:    time.Millisecond,
+			MaxDuration: 10 * time.Millisecond,
+			StatusCodes: []int{http.StatusBadGateway},
+		})
+
+		testenv.Run(t, &testenv.Config{
+			Subgraphs: testenv.SubgraphsConfig{
+				Employees: testenv.SubgraphConfig{
+					Middleware: failingSubgraph(&requests, 1, http.StatusServiceUnavailable, nil),
+				},
+			},
+			RouterOptions: []core.Option{
+				core.WithSubgraphTransportOptions(core.NewSubgraphTransportOptions(trafficConfig)),
+			},
+		}, func(t *testing.T, xEnv *testenv.Environment) {
+			xEnv.MakeGraphQLRequestOK(testenv.GraphQLRequest{Query: `{ employee(id: 1) { id } }`})
+			require.Equal(t, int64(1), requests.Load())
+		})
+	})
+
+	t.Run("waits for the duration of the Retry-After header", func(t *testing.T) {
+		t.Parallel()
+
+		var requests atomic.Int64
+		trafficConfig := employeesRetry(config.BackoffJitterRetry{
+			Enabled:     ToPtr(true),
+			MaxAttempts: 2,
+			Interval:    time.Millisecond,
+			MaxDuration: 5 * time.Second,
+		})
+
+		testenv.Run(t, &testenv.Config{
+			Subgraphs: testenv.SubgraphsConfig{
+				Employees: testenv.SubgraphConfig{
+					Middleware: failingSubgraph(&requests, 1, http.StatusTooManyRequests, http.Header{"Retry-After": []string{"1"}}),
+				},
+			},
+			RouterOptions: []core.Option{
+				core.WithSubgraphTransportOptions(core.NewSubgraphTransportOptions(trafficConfig)),
+			},
+		}, func(t *testing.T, xEnv *testenv.Environment) {
+			start := time.Now()
+			res := xEnv.MakeGraphQLRequestOK(testenv.GraphQLRequest{Query: `{ employee(id: 1) { id } }`})
+			require.Equal(t, `{"data":{"employee":{"id":1}}}`, res.Body)
+			require.GreaterOrEqual(t, time.Since(start), time.Second)
+			require.Equal(t, int64(2), requests.Load())
+		})
+	})
+
+	t.Run("retries of a request are limited by the budget", func(t *testing.T) {
+		t.Parallel()
+
+		var requests atomic.Int64
+		trafficConfig := employeesRetry(config.BackoffJitterRetry{
+			Enabled:       ToPtr(true),
+			MaxAttempts:   5,
+			Interval:      time.Millisecond,
+			MaxDuration:   10 * time.Millisecond,
+			RequestBudget: 1,
+		})
+
+		testenv.Run(t, &testenv.Config{
+			Subgraphs: testenv.SubgraphsConfig{
+				Employees: testenv.SubgraphConfig{
+					Middleware: failingSubgraph(&requests, 5, http.StatusBadGateway, nil),
+				},
+			},
+			RouterOptions: []core.Option{
+				core.WithSubgraphTransportOptions(core.NewSubgraphTransportOptions(trafficConfig)),
+			},
+		}, func(t *testing.T, xEnv *testenv.Environment) {
+			xEnv.MakeGraphQLRequestOK(testenv.GraphQLRequest{Query: `{ employee(id: 1) { id } }`})
+			require.Equal(t, int64(2), requests.Load())
+
+			// Every client request has its own budget
+			xEnv.MakeGraphQLRequestOK(testenv.GraphQLRequest{Query: `{ employee(id: 1) { id } }`})
+			require.Equal(t, int64(4), requests.Load())
+		})
+	})
+
+	t.Run("retries are recorded as span events", func(t *testing.T) {
+		t.Parallel()
+
+		var requests atomic.Int64
+		exporter := tracetest.NewInMemoryExporter(t)
+		trafficConfig := employeesRetry(config.BackoffJitterRetry{
+			Enabled:     ToPtr(true),
+			MaxAttempts: 2,
+			Interval:    time.Millisecond,
+			MaxDuration: 10 * time.Millisecond,
+		})
+
+		testenv.Run(t, &testenv.Config{
+			TraceExporter: exporter,
+			Subgraphs: testenv.SubgraphsConfig{
+				Employees: testenv.SubgraphConfig{
+					Middleware: failingSubgraph(&requests, 1, http.StatusBadGateway, nil),
+				},
+			},
+			RouterOptions: []core.Option{
+				core.WithSubgraphTransportOptions(core.NewSubgraphTransportOptions(trafficConfig)),
+			},
+		}, func(t *testing.T, xEnv *testenv.Environment) {
+			xEnv.MakeGraphQLRequestOK(testenv.GraphQLRequest{Query: `{ employee(id: 1) { id } }`})
+
+			var retryEvents int
+			for _, span := range exporter.GetSpans().Snapshots() {
+				for _, event := range span.Events() {
+					if event.Name != "Subgraph request retry" {
+						continue
+					}
+					retryEvents++
+					require.Contains(t, event.Attributes, otel.WgSubgraphRetryAttempt.Int(1))
+					require.Contains(t, event.Attributes, otel.WgSubgraphName.String("employees"))
+				}
+			}
+			require.Equal(t, 1, retryEvents)
+		})
+	})
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/wundergraph/cosmo/router/core"
+	"github.com/wundergraph/cosmo/router/pkg/config"
+	"github.com/wundergraph/cosmo/router/pkg/logging"
+	"go.uber.org/zap"
+)
+
+// accessLogs is the access log config of the router together with the writers that were opened for it.
+// The writers are kept open until Close is called, so they can be shared by the graph servers of several reloads.
+type accessLogs struct {
+	config  *core.AccessLogsConfig
+	closers []io.Closer
+}
+
+// newAccessLogs opens the writers of the access logs. The config is nil if access logs are disabled.
+func newAccessLogs(cfg *config.Config) (*accessLogs, error) {
+	a := &accessLogs{}
+
+	if !cfg.AccessLogs.Enabled {
+		return a, nil
+	}
+
+	a.config = &core.AccessLogsConfig{
+		Attributes:         cfg.AccessLogs.Router.Fields,
+		SubgraphEnabled:    cfg.AccessLogs.Subgraphs.Enabled,
+		SubgraphAttributes: cfg.AccessLogs.Subgraphs.Fields,
+	}
+
+	var output *os.File
+	if cfg.AccessLogs.Output.File.Enabled {
+		f, err := logging.NewLogFile(cfg.AccessLogs.Output.File.Path)
+		if err != nil {
+			return nil, fmt.Errorf("could not create log file: %w", err)
+		}
+		a.closers = append(a.closers, f)
+		output = f
+	} else if cfg.AccessLogs.Output.Stdout.Enabled {
+		output = os.Stdout
+	} else {
+		return a, nil
+	}
+
+	if cfg.AccessLogs.Buffer.Enabled {
+		bl, err := logging.NewJSONZapBufferedLogger(logging.BufferedLoggerOptions{
+			WS:            output,
+			BufferSize:    int(cfg.AccessLogs.Buffer.Size.Uint64()),
+			FlushInterval: cfg.AccessLogs.Buffer.FlushInterval,
+			Development:   cfg.DevelopmentMode,
+			Level:         zap.InfoLevel,
+			Pretty:        !cfg.JSONLog,
+		})
+		if err != nil {
+			_ = a.Close()
+			return nil, fmt.Errorf("could not create buffered logger: %w", err)
+		}
+		// The buffer is flushed before the file is closed
+		a.closers = append([]io.Closer{bl}, a.closers...)
+		a.config.Logger = bl.Logger
+	} else {
+		a.config.Logger = logging.NewZapAccessLogger(output, cfg.DevelopmentMode, !cfg.JSONLog)
+	}
+
+	return a, nil
+}
+
+// Close flushes the buffered access logs and closes the log file
+func (a *accessLogs) Close() error {
+	var errs []error
+	for _, closer := range a.closers {
+		errs = append(errs, closer.Close())
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/wundergraph/cosmo/router/pkg/configdiff"
+	"github.com/wundergraph/cosmo/router/pkg/execution_config"
+	"github.com/wundergraph/cosmo/router/pkg/schemadiff"
+)
+
+var (
+	oldConfigFilePath      = flag.String("old", "", "execution config file location of the current version")
+	newConfigFilePath      = flag.String("new", "", "execution config file location of the new version")
+	operationsFolderPath   = flag.String("operations", "", "operations folder location of the plan generator. When set, only breaking changes affecting an operation fail the check")
+	outputFormat           = flag.String("format", "text", "output format, one of text or json")
+	failOnDangerousChanges = flag.Bool("fail-on-dangerous", false, "also fail the check on dangerous changes")
+)
+
+type output struct {
+	Changes            []configdiff.Change            `json:"changes"`
+	AffectedOperations []configdiff.AffectedOperation `json:"affectedOperations,omitempty"`
+	Failed             bool                           `json:"failed"`
+}
+
+func main() {
+	flag.Parse()
+
+	if *oldConfigFilePath == "" || *newConfigFilePath == "" {
+		log.Fatalf("both -old and -new execution config file locations are required")
+	}
+
+	oldConfig, err := execution_config.FromFile(*oldConfigFilePath)
+	if err != nil {
+		log.Fatalf("failed to read old execution config: %v", err)
+	}
+
+	newConfig, err := execution_config.FromFile(*newConfigFilePath)
+	if err != nil {
+		log.Fatalf("failed to read new execution config: %v", err)
+	}
+
+	report, err := configdiff.Compare(oldConfig, newConfig)
+	if err != nil {
+		log.Fatalf("failed to compare execution configs: %v", err)
+	}
+
+	failingCriticalities := []schemadiff.Criticality{schemadiff.CriticalityBreaking}
+	if *failOnDangerousChanges {
+		failingCriticalities = append(failingCriticalities, schemadiff.CriticalityDangerous)
+	}
+
+	out := output{Changes: report.Changes}
+
+	if *operationsFolderPath != "" {
+		operations, err := configdiff.LoadOperations(*operationsFolderPath)
+		if err != nil {
+			log.Fatalf("failed to load operations: %v", err)
+		}
+
+		out.AffectedOperations, err = report.AffectedOperations(oldConfig.GetEngineConfig().GetGraphqlSchema(), operations, failingCriticalities...)
+		if err != nil {
+			log.Fatalf("failed to check operations: %v", err)
+		}
+
+		out.Failed = len(out.AffectedOperations) > 0
+	} else {
+		for _, criticality := range failingCriticalities {
+			if report.Count(criticality) > 0 {
+				out.Failed = true
+			}
+		}
+	}
+
+	switch *outputFormat {
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(out); err != nil {
+			log.Fatalf("failed to write output: %v", err)
+		}
+	case "text":
+		printText(out)
+	default:
+		log.Fatalf("unsupported output format: %s", *outputFormat)
+	}
+
+	if out.Failed {
+		os.Exit(1)
+	}
+}
+
+func printText(out output) {
+	if len(out.Changes) == 0 {
+		fmt.Println("No changes detected")
+		return
+	}
+
+	fmt.Printf("Detected %d changes:\n", len(out.Changes))
+	for _, change := range out.Changes {
+		if change.Subgraph != "" {
+			fmt.Printf("  [%s] %s (subgraph %s)\n", change.Criticality, change.Message, change.Subgraph)
+			continue
+		}
+		fmt.Printf("  [%s] %s\n", change.Criticality, change.Message)
+	}
+
+	if len(out.AffectedOperations) > 0 {
+		fmt.Printf("\n%d operations are affected:\n", len(out.AffectedOperations))
+		for _, operation := range out.AffectedOperations {
+			fmt.Printf("  %s\n", operation.Name)
+			for _, change := range operation.Changes {
+				fmt.Printf("    [%s] %s\n", change.Criticality, change.Message)
+			}
+		}
+	}
+}
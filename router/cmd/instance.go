@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -13,7 +14,6 @@ import (
 	"github.com/wundergraph/cosmo/router/pkg/config"
 	"github.com/wundergraph/cosmo/router/pkg/controlplane/selfregister"
 	"github.com/wundergraph/cosmo/router/pkg/cors"
-	"github.com/wundergraph/cosmo/router/pkg/logging"
 	"go.uber.org/automaxprocs/maxprocs"
 	"go.uber.org/zap"
 )
@@ -28,6 +28,16 @@ type Params struct {
 //
 // additionalOptions can be used to override default options or options provided in the config.
 func NewRouter(ctx context.Context, params Params, additionalOptions ...core.Option) (*core.Router, error) {
+	accessLogs, err := newAccessLogs(params.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRouter(ctx, params, accessLogs, additionalOptions...)
+}
+
+// newRouter creates a new router instance that writes the access logs to the given writers.
+func newRouter(ctx context.Context, params Params, accessLogs *accessLogs, additionalOptions ...core.Option) (*core.Router, error) {
 	// Automatically set GOMAXPROCS to avoid CPU throttling on containerized environments
 	_, err := maxprocs.Set(maxprocs.Logger(params.Logger.Sugar().Debugf))
 	if err != nil {
@@ -52,14 +62,29 @@ func NewRouter(ctx context.Context, params Params, additionalOptions ...core.Opt
 		}
 	}
 
-	cfg := params.Config
-	logger := params.Logger
-
-	authenticators, err := setupAuthenticators(ctx, logger, cfg)
+	authenticators, err := setupAuthenticators(ctx, params.Logger, params.Config)
 	if err != nil {
 		return nil, fmt.Errorf("could not setup authenticators: %w", err)
 	}
 
+	options, err := routerOptions(params, accessLogs, additionalOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(authenticators) > 0 {
+		options = append(options, core.WithAccessController(core.NewAccessController(authenticators, params.Config.Authorization.RequireAuthentication)))
+	}
+
+	return core.NewRouter(options...)
+}
+
+// routerOptions maps the router config to router options. Authenticators are not part of the options
+// because they fetch the JWKS on creation.
+func routerOptions(params Params, accessLogs *accessLogs, additionalOptions ...core.Option) ([]core.Option, error) {
+	cfg := params.Config
+	logger := params.Logger
+
 	options := []core.Option{
 		core.WithListenerAddr(cfg.ListenAddr),
 		core.WithOverrideRoutingURL(cfg.OverrideRoutingURL),
@@ -92,24 +117,7 @@ func NewRouter(ctx context.Context, params Params, additionalOptions ...core.Opt
 		core.WithClusterName(cfg.Cluster.Name),
 		core.WithInstanceID(cfg.InstanceID),
 		core.WithReadinessCheckPath(cfg.ReadinessCheckPath),
-		core.WithHeaderRules(cfg.Headers),
-		core.WithRouterTrafficConfig(&cfg.TrafficShaping.Router),
 		core.WithFileUploadConfig(&cfg.FileUpload),
-		core.WithSubgraphTransportOptions(core.NewSubgraphTransportOptions(cfg.TrafficShaping)),
-		core.WithSubgraphRetryOptions(
-			cfg.TrafficShaping.All.BackoffJitterRetry.Enabled,
-			cfg.TrafficShaping.All.BackoffJitterRetry.MaxAttempts,
-			cfg.TrafficShaping.All.BackoffJitterRetry.MaxDuration,
-			cfg.TrafficShaping.All.BackoffJitterRetry.Interval,
-		),
-		core.WithCors(&cors.Config{
-			Enabled:          cfg.CORS.Enabled,
-			AllowOrigins:     cfg.CORS.AllowOrigins,
-			AllowMethods:     cfg.CORS.AllowMethods,
-			AllowCredentials: cfg.CORS.AllowCredentials,
-			AllowHeaders:     cfg.CORS.AllowHeaders,
-			MaxAge:           cfg.CORS.MaxAge,
-		}),
 		core.WithTLSConfig(&core.TlsConfig{
 			Enabled:  cfg.TLS.Server.Enabled,
 			CertFile: cfg.TLS.Server.CertFile,
@@ -125,16 +133,16 @@ func NewRouter(ctx context.Context, params Params, additionalOptions ...core.Opt
 		core.WithTelemetryAttributes(cfg.Telemetry.Attributes),
 		core.WithEngineExecutionConfig(cfg.EngineExecutionConfiguration),
 		core.WithCacheControlPolicy(cfg.CacheControl),
-		core.WithSecurityConfig(cfg.SecurityConfiguration),
 		core.WithAuthorizationConfig(&cfg.Authorization),
 		core.WithWebSocketConfiguration(&cfg.WebSocket),
 		core.WithSubgraphErrorPropagation(cfg.SubgraphErrorPropagation),
 		core.WithLocalhostFallbackInsideDocker(cfg.LocalhostFallbackInsideDocker),
 		core.WithCDN(cfg.CDN),
 		core.WithEvents(cfg.Events),
-		core.WithRateLimitConfig(&cfg.RateLimit),
 		core.WithClientHeader(cfg.ClientHeader),
 		core.WithCacheWarmupConfig(&cfg.CacheWarmup),
+		core.WithExecutionConfigHistory(&cfg.ExecutionConfigHistory),
+		core.WithExecutionConfigCanary(&cfg.ExecutionConfigCanary),
 	}
 
 	// HTTP_PROXY, HTTPS_PROXY and NO_PROXY
@@ -142,58 +150,9 @@ func NewRouter(ctx context.Context, params Params, additionalOptions ...core.Opt
 		core.WithProxy(http.ProxyFromEnvironment)
 	}
 
+	options = append(options, reloadableRouterOptions(cfg, accessLogs)...)
 	options = append(options, additionalOptions...)
 
-	if cfg.AccessLogs.Enabled {
-		c := &core.AccessLogsConfig{
-			Attributes:         cfg.AccessLogs.Router.Fields,
-			SubgraphEnabled:    cfg.AccessLogs.Subgraphs.Enabled,
-			SubgraphAttributes: cfg.AccessLogs.Subgraphs.Fields,
-		}
-
-		if cfg.AccessLogs.Output.File.Enabled {
-			f, err := logging.NewLogFile(cfg.AccessLogs.Output.File.Path)
-			if err != nil {
-				return nil, fmt.Errorf("could not create log file: %w", err)
-			}
-			if cfg.AccessLogs.Buffer.Enabled {
-				bl, err := logging.NewJSONZapBufferedLogger(logging.BufferedLoggerOptions{
-					WS:            f,
-					BufferSize:    int(cfg.AccessLogs.Buffer.Size.Uint64()),
-					FlushInterval: cfg.AccessLogs.Buffer.FlushInterval,
-					Development:   cfg.DevelopmentMode,
-					Level:         zap.InfoLevel,
-					Pretty:        !cfg.JSONLog,
-				})
-				if err != nil {
-					return nil, fmt.Errorf("could not create buffered logger: %w", err)
-				}
-				c.Logger = bl.Logger
-			} else {
-				c.Logger = logging.NewZapAccessLogger(f, cfg.DevelopmentMode, !cfg.JSONLog)
-			}
-		} else if cfg.AccessLogs.Output.Stdout.Enabled {
-			if cfg.AccessLogs.Buffer.Enabled {
-				bl, err := logging.NewJSONZapBufferedLogger(logging.BufferedLoggerOptions{
-					WS:            os.Stdout,
-					BufferSize:    int(cfg.AccessLogs.Buffer.Size.Uint64()),
-					FlushInterval: cfg.AccessLogs.Buffer.FlushInterval,
-					Development:   cfg.DevelopmentMode,
-					Level:         zap.InfoLevel,
-					Pretty:        !cfg.JSONLog,
-				})
-				if err != nil {
-					return nil, fmt.Errorf("could not create buffered logger: %w", err)
-				}
-				c.Logger = bl.Logger
-			} else {
-				c.Logger = logging.NewZapAccessLogger(os.Stdout, cfg.DevelopmentMode, !cfg.JSONLog)
-			}
-		}
-
-		options = append(options, core.WithAccessLogs(c))
-	}
-
 	if cfg.RouterRegistration && cfg.Graph.Token != "" {
 		selfRegister, err := selfregister.New(cfg.ControlplaneURL, cfg.Graph.Token,
 			selfregister.WithLogger(logger),
@@ -209,11 +168,21 @@ func NewRouter(ctx context.Context, params Params, additionalOptions ...core.Opt
 		executionConfigPath = cfg.RouterConfigPath
 	}
 
-	if executionConfigPath != "" {
-		options = append(options, core.WithExecutionConfig(&core.ExecutionConfig{
+	if cfg.DevComposition.Enabled {
+		// The execution config is composed from the local subgraphs, neither the file nor the CDN is used
+		options = append(options, core.WithDevComposition(&cfg.DevComposition))
+	} else if executionConfigPath != "" {
+		executionConfig := &core.ExecutionConfig{
 			Watch: cfg.ExecutionConfig.File.Watch,
 			Path:  executionConfigPath,
-		}))
+		}
+		if cfg.ExecutionConfig.File.VerifySignature {
+			if cfg.Graph.SignKey == "" {
+				return nil, errors.New("verifying the signature of the execution config file requires the graph sign key")
+			}
+			executionConfig.SignKey = cfg.Graph.SignKey
+		}
+		options = append(options, core.WithExecutionConfig(executionConfig))
 	} else {
 		options = append(options, core.WithConfigPollerConfig(&core.RouterConfigPollerConfig{
 			GraphSignKey:    cfg.Graph.SignKey,
@@ -223,11 +192,40 @@ func NewRouter(ctx context.Context, params Params, additionalOptions ...core.Opt
 		}))
 	}
 
-	if len(authenticators) > 0 {
-		options = append(options, core.WithAccessController(core.NewAccessController(authenticators, cfg.Authorization.RequireAuthentication)))
+	return options, nil
+}
+
+// reloadableRouterOptions maps the settings of the router config that can be applied by Router.Reload to
+// router options.
+func reloadableRouterOptions(cfg *config.Config, accessLogs *accessLogs) []core.Option {
+	options := []core.Option{
+		core.WithHeaderRules(cfg.Headers),
+		core.WithRouterTrafficConfig(&cfg.TrafficShaping.Router),
+		core.WithSubgraphTransportOptions(core.NewSubgraphTransportOptions(cfg.TrafficShaping)),
+		core.WithSubgraphRetryOptions(
+			cfg.TrafficShaping.All.BackoffJitterRetry.IsEnabled(),
+			cfg.TrafficShaping.All.BackoffJitterRetry.MaxAttempts,
+			cfg.TrafficShaping.All.BackoffJitterRetry.MaxDuration,
+			cfg.TrafficShaping.All.BackoffJitterRetry.Interval,
+		),
+		core.WithCors(&cors.Config{
+			Enabled:          cfg.CORS.Enabled,
+			AllowOrigins:     cfg.CORS.AllowOrigins,
+			AllowMethods:     cfg.CORS.AllowMethods,
+			AllowCredentials: cfg.CORS.AllowCredentials,
+			AllowHeaders:     cfg.CORS.AllowHeaders,
+			MaxAge:           cfg.CORS.MaxAge,
+		}),
+		core.WithRateLimitConfig(&cfg.RateLimit),
+		core.WithSecurityConfig(cfg.SecurityConfiguration),
+		core.WithAdminAPI(&cfg.AdminAPI),
 	}
 
-	return core.NewRouter(options...)
+	if accessLogs.config != nil {
+		options = append(options, core.WithAccessLogs(accessLogs.config))
+	}
+
+	return options
 }
 
 func setupAuthenticators(ctx context.Context, logger *zap.Logger, cfg *config.Config) ([]authentication.Authenticator, error) {
@@ -20,7 +20,8 @@ import (
 
 var (
 	overrideEnvFlag = flag.String("override-env", os.Getenv("OVERRIDE_ENV"), "Path to .env file to override environment variables")
-	configPathFlag  = flag.String("config", os.Getenv("CONFIG_PATH"), "Path to the router config file e.g. config.yaml")
+	configPathFlag  = flag.String("config", os.Getenv("CONFIG_PATH"), "Path to the router config file e.g. config.yaml. Multiple comma-separated files are merged in order e.g. base.yaml,production.yaml")
+	printConfig     = flag.Bool("print-config", false, "Prints the effective config with the file each value came from and exits. Secrets are redacted")
 	routerVersion   = flag.Bool("version", false, "Prints the version and dependency information")
 	pprofListenAddr = flag.String("pprof-addr", os.Getenv("PPROF_ADDR"), "Address to listen for pprof requests. e.g. :6060 for localhost:6060")
 	memProfilePath  = flag.String("memprofile", "", "Path to write memory profile. Memory is a snapshot taken at the time the program exits")
@@ -28,12 +29,23 @@ var (
 	help            = flag.Bool("help", false, "Prints the help message")
 )
 
-func Main() {
+// Main runs the router. The additional options are applied after the options of the router config, also when
+// the config is reloaded or validated.
+func Main(additionalOptions ...core.Option) {
+
+	// The validate subcommand performs a dry-run of the router startup e.g. router validate -config config.yaml
+	if len(os.Args) > 1 && os.Args[1] == validateCommand {
+		// Exits on invalid flags
+		_ = flag.CommandLine.Parse(os.Args[2:])
+		os.Exit(Validate(context.Background(), *configPathFlag, *overrideEnvFlag, additionalOptions...))
+	}
 
 	// Parse flags before calling profile.Start(), since it may add flags
 	flag.Parse()
 
 	if *help {
+		fmt.Printf("Usage: %s [%s] [flags]\n\n", os.Args[0], validateCommand)
+		fmt.Printf("Commands:\n  %s\tValidates the config and performs a dry-run of the router startup without serving traffic\n\n", validateCommand)
 		flag.PrintDefaults()
 		os.Exit(0)
 	} else if *routerVersion {
@@ -47,6 +59,15 @@ func Main() {
 		log.Fatalf("Could not load config: %s", err)
 	}
 
+	if *printConfig {
+		out, err := config.MarshalEffectiveConfig(result)
+		if err != nil {
+			log.Fatalf("Could not print config: %s", err)
+		}
+		fmt.Print(string(out))
+		os.Exit(0)
+	}
+
 	logLevel, err := logging.ZapLogLevelFromString(result.Config.LogLevel)
 	if err != nil {
 		log.Fatalf("Could not parse log level: %s", err)
@@ -82,7 +103,7 @@ func Main() {
 	if *configPathFlag != "" {
 		logger.Info(
 			"Config file path provided. Values in the config file have higher priority than environment variables",
-			zap.String("config_file", *configPathFlag),
+			zap.Strings("config_files", result.Files),
 		)
 	} else if result.DefaultLoaded {
 		logger.Info("Found default config file. Values in the config file have higher priority than environment variables",
@@ -95,10 +116,15 @@ func Main() {
 	routerCtx, routerCancel := context.WithCancel(context.Background())
 	defer routerCancel()
 
-	router, err := NewRouter(routerCtx, Params{
+	accessLogs, err := newAccessLogs(&result.Config)
+	if err != nil {
+		logger.Fatal("Could not create access logs", zap.Error(err))
+	}
+
+	router, err := newRouter(routerCtx, Params{
 		Config: &result.Config,
 		Logger: logger,
-	})
+	}, accessLogs, additionalOptions...)
 	if err != nil {
 		logger.Fatal("Could not create router", zap.Error(err))
 	}
@@ -107,6 +133,14 @@ func Main() {
 		logger.Fatal("Could not start router", zap.Error(err))
 	}
 
+	if result.Config.WatchConfig.Enabled {
+		if len(result.Files) == 0 {
+			logger.Warn("Watching the router config is enabled but no config file is used. Router config changes are only applied after a restart")
+		} else if err = watchRouterConfig(routerCtx, logger, router, &result.Config, accessLogs, result.Files, *overrideEnvFlag); err != nil {
+			logger.Fatal("Could not watch router config", zap.Error(err))
+		}
+	}
+
 	<-ctx.Done()
 
 	logger.Info("Graceful shutdown of router initiated", zap.String("shutdown_delay", result.Config.ShutdownDelay.String()))
@@ -1,27 +1,46 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"log"
 	"os"
+	"os/signal"
 	"path/filepath"
-	"slices"
+	"runtime"
 	"strings"
-	"time"
+	"syscall"
 
 	"github.com/wundergraph/cosmo/router/core"
+	"github.com/wundergraph/cosmo/router/pkg/queryplan"
 )
 
 var (
-	executionConfigFilePath    = flag.String("execution-config", "config.json", "execution config file location")
+	executionConfigFilePath    = flag.String("execution-config", "config.json", "execution config file location. With -base-execution-config, this is the new execution config")
 	sourceOperationFoldersPath = flag.String("operations", "operations", "source operations folder location")
 	plansOutPath               = flag.String("plans", "plans", "output plans folder location")
 	operationFilterFilePath    = flag.String("filter", "", "operation filter file location which should contain file names of operations to include")
+	concurrency                = flag.Int("concurrency", runtime.GOMAXPROCS(0), "number of operations planned in parallel")
+	reportFilePath             = flag.String("report", "", "report file location. The report contains the status, planning time, error and fetch count of every operation")
+	reportFormat               = flag.String("report-format", "json", "report format, one of json or junit")
+	planFormat                 = flag.String("format", "text", "format of the written plans, one of text, json, dot or mermaid")
+	failOnError                = flag.Bool("fail-on-error", false, "exit with a non-zero code if any operation fails to plan")
+	baseConfigFilePath         = flag.String("base-execution-config", "", "location of the old execution config used as baseline. When set, every operation is planned against the base and the new execution config of -execution-config, and operations with a changed fetch tree are reported instead of writing plans")
+	failOnChange               = flag.Bool("fail-on-change", false, "exit with a non-zero code if the fetch tree of any operation changed, requires -base-execution-config")
 )
 
 func main() {
 	flag.Parse()
 
+	if *reportFormat != "json" && *reportFormat != "junit" {
+		log.Fatalf("unsupported report format: %s", *reportFormat)
+	}
+
+	format, err := queryplan.ParseFormat(*planFormat)
+	if err != nil {
+		log.Fatalf("invalid plan format: %v", err)
+	}
+
 	queriesPath, err := filepath.Abs(*sourceOperationFoldersPath)
 	if err != nil {
 		log.Fatalf("failed to get absolute path for queries: %v", err)
@@ -31,9 +50,6 @@ func main() {
 	if err != nil {
 		log.Fatalf("failed to get absolute path for output: %v", err)
 	}
-	if err := os.MkdirAll(outPath, 0755); err != nil {
-		log.Fatalf("failed to create output directory: %v", err)
-	}
 
 	supergraphConfigPath, err := filepath.Abs(*executionConfigFilePath)
 	log.Println("supergraphPath:", supergraphConfigPath)
@@ -41,11 +57,6 @@ func main() {
 		log.Fatalf("failed to get absolute path for supergraph: %v", err)
 	}
 
-	queries, err := os.ReadDir(queriesPath)
-	if err != nil {
-		log.Fatalf("failed to read queries directory: %v", err)
-	}
-
 	var filter []string
 	if *operationFilterFilePath != "" {
 		filterContent, err := os.ReadFile(*operationFilterFilePath)
@@ -61,32 +72,100 @@ func main() {
 		log.Fatalf("failed to create plan generator: %v", err)
 	}
 
-	t := time.Now()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	for i, queryFile := range queries {
-		if filepath.Ext(queryFile.Name()) != ".graphql" {
-			continue
-		}
+	opts := core.PlanOperationsOptions{
+		OperationsPath: queriesPath,
+		OutputPath:     outPath,
+		Filter:         filter,
+		Concurrency:    *concurrency,
+		Format:         format,
+	}
 
-		if len(filter) > 0 && !slices.Contains(filter, queryFile.Name()) {
-			continue
+	if *baseConfigFilePath != "" {
+		comparePlans(ctx, pg, opts)
+		return
+	}
+
+	report, err := pg.PlanOperations(ctx, opts)
+	if err != nil {
+		log.Fatalf("failed to plan operations: %v", err)
+	}
+
+	for _, operation := range report.Operations {
+		if operation.Status != core.PlanStatusSuccess {
+			log.Printf("failed operation: %s err: %s\n", operation.Name, operation.Error)
 		}
+	}
 
-		log.Println("Running query #", i, " name:", queryFile.Name())
+	if *reportFilePath != "" {
+		writeReportFile(func(f *os.File) error {
+			return writeReport(f, *reportFormat, report)
+		})
+	}
 
-		queryFilePath := filepath.Join(queriesPath, queryFile.Name())
+	log.Printf("Planned %d operations, %d failed. Total planning time: %s\n", len(report.Operations), report.Failed, report.TotalTime)
 
-		outContent, err := pg.PlanOperation(queryFilePath)
-		if err != nil {
-			log.Printf("failed operation #%d: %s err: %v\n", i, queryFile.Name(), err.Error())
-		}
+	if *failOnError && report.Failed > 0 {
+		os.Exit(1)
+	}
+}
 
-		outFileName := filepath.Join(outPath, queryFile.Name())
-		err = os.WriteFile(outFileName, []byte(outContent), 0644)
-		if err != nil {
-			log.Fatalf("failed to write file: %v", err)
+// comparePlans plans the operations against the old execution config of -base-execution-config and the new one of
+// -execution-config and reports the operations whose fetch tree changed
+func comparePlans(ctx context.Context, pg *core.PlanGenerator, opts core.PlanOperationsOptions) {
+	baseConfigPath, err := filepath.Abs(*baseConfigFilePath)
+	if err != nil {
+		log.Fatalf("failed to get absolute path for base execution config: %v", err)
+	}
+	log.Println("base supergraphPath:", baseConfigPath)
+
+	basePg, err := core.NewPlanGenerator(baseConfigPath)
+	if err != nil {
+		log.Fatalf("failed to create plan generator for base execution config: %v", err)
+	}
+
+	report, err := basePg.ComparePlans(ctx, pg, opts)
+	if err != nil {
+		log.Fatalf("failed to compare plans: %v", err)
+	}
+
+	for _, operation := range report.Operations {
+		if operation.Status != core.PlanStatusSuccess {
+			log.Printf("failed operation: %s err: %s\n", operation.Name, operation.Error)
+			continue
+		}
+		log.Printf("changed operation: %s fetches: %d -> %d sequential steps: %d -> %d\n", operation.Name,
+			operation.OldFetches, operation.NewFetches, operation.OldSequentialSteps, operation.NewSequentialSteps)
+		for _, change := range operation.Changes {
+			log.Printf("  %s\n", change.Message)
 		}
 	}
 
-	log.Println("Total planning time:", time.Since(t))
+	if *reportFilePath != "" {
+		writeReportFile(func(f *os.File) error {
+			return writeRegressionReport(f, *reportFormat, report)
+		})
+	}
+
+	log.Printf("Compared %d operations, %d changed, %d failed. Total planning time: %s\n", report.Compared, report.Changed, report.Failed, report.TotalTime)
+
+	if (*failOnError && report.Failed > 0) || (*failOnChange && report.Changed > 0) {
+		os.Exit(1)
+	}
+}
+
+func writeReportFile(write func(f *os.File) error) {
+	f, err := os.Create(*reportFilePath)
+	if err != nil {
+		log.Fatalf("failed to create report file: %v", err)
+	}
+	err = write(f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		log.Fatalf("failed to write report: %v", err)
+	}
 }
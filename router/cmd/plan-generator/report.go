@@ -0,0 +1,153 @@
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/wundergraph/cosmo/router/core"
+)
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name       string           `xml:"name,attr"`
+	ClassName  string           `xml:"classname,attr"`
+	Time       string           `xml:"time,attr"`
+	Properties *junitProperties `xml:"properties,omitempty"`
+	Failure    *junitFailure    `xml:"failure,omitempty"`
+}
+
+type junitProperties struct {
+	Properties []junitProperty `xml:"property"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Content string `xml:",chardata"`
+}
+
+func writeReport(w io.Writer, format string, report *core.PlanOperationsReport) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	case "junit":
+		return writeJUnitReport(w, report)
+	default:
+		return fmt.Errorf("unsupported report format: %s", format)
+	}
+}
+
+func writeJUnitReport(w io.Writer, report *core.PlanOperationsReport) error {
+	suite := junitTestSuite{
+		Name:     "plan-generator",
+		Tests:    len(report.Operations),
+		Failures: report.Failed,
+		Time:     fmt.Sprintf("%.3f", report.TotalTime.Seconds()),
+		Cases:    make([]junitTestCase, 0, len(report.Operations)),
+	}
+
+	for _, operation := range report.Operations {
+		testCase := junitTestCase{
+			Name:      operation.Name,
+			ClassName: "plan-generator",
+			Time:      fmt.Sprintf("%.3f", operation.PlanningTime.Seconds()),
+		}
+
+		if operation.Status == core.PlanStatusSuccess {
+			testCase.Properties = &junitProperties{
+				Properties: []junitProperty{{Name: "fetches", Value: fmt.Sprint(operation.Fetches)}},
+			}
+		} else {
+			testCase.Failure = &junitFailure{Message: operation.Error, Content: operation.Error}
+		}
+
+		suite.Cases = append(suite.Cases, testCase)
+	}
+
+	return encodeJUnit(w, suite)
+}
+
+func writeRegressionReport(w io.Writer, format string, report *core.PlanRegressionReport) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	case "junit":
+		return writeJUnitRegressionReport(w, report)
+	default:
+		return fmt.Errorf("unsupported report format: %s", format)
+	}
+}
+
+// writeJUnitRegressionReport writes the changed and failed operations as failed test cases. Unchanged operations
+// are only part of the test count.
+func writeJUnitRegressionReport(w io.Writer, report *core.PlanRegressionReport) error {
+	suite := junitTestSuite{
+		Name:     "plan-generator-regression",
+		Tests:    report.Compared,
+		Failures: len(report.Operations),
+		Time:     fmt.Sprintf("%.3f", report.TotalTime.Seconds()),
+		Cases:    make([]junitTestCase, 0, len(report.Operations)),
+	}
+
+	for _, operation := range report.Operations {
+		testCase := junitTestCase{
+			Name:      operation.Name,
+			ClassName: "plan-generator-regression",
+		}
+
+		if operation.Status != core.PlanStatusSuccess {
+			testCase.Failure = &junitFailure{Message: operation.Error, Content: operation.Error}
+		} else {
+			messages := make([]string, 0, len(operation.Changes))
+			for _, change := range operation.Changes {
+				messages = append(messages, change.Message)
+			}
+			testCase.Failure = &junitFailure{
+				Message: fmt.Sprintf("fetch tree changed: %d -> %d fetches", operation.OldFetches, operation.NewFetches),
+				Content: strings.Join(messages, "\n"),
+			}
+		}
+
+		suite.Cases = append(suite.Cases, testCase)
+	}
+
+	return encodeJUnit(w, suite)
+}
+
+func encodeJUnit(w io.Writer, suite junitTestSuite) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(junitTestSuites{Suites: []junitTestSuite{suite}}); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
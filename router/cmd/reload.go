@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/wundergraph/cosmo/router/core"
+	"github.com/wundergraph/cosmo/router/pkg/config"
+	"github.com/wundergraph/cosmo/router/pkg/watcher"
+	"go.uber.org/zap"
+)
+
+// watchRouterConfig watches the router config files and reloads the router when one of the files changes.
+// The files are merged in the same order as on startup. Invalid configs are logged and the router keeps
+// running with the current config. The router must have been created from the active config and write its
+// access logs to the given writers.
+func watchRouterConfig(ctx context.Context, logger *zap.Logger, router *core.Router, activeConfig *config.Config, activeAccessLogs *accessLogs, configFiles []string, overrideEnvPath string) error {
+	configPath := strings.Join(configFiles, config.ConfigFileSeparator)
+
+	// Every config file has its own watcher, so reloads can be triggered concurrently
+	var mu sync.Mutex
+
+	reload := func(events []watcher.Event) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		logger.Info("Router config file changed. Reloading router config", zap.Strings("files", configFiles))
+
+		result, err := config.LoadConfig(configPath, overrideEnvPath)
+		if err != nil {
+			logger.Error("Failed to load router config. Keeping the current config", zap.Error(err))
+			return nil
+		}
+		nextConfig := &result.Config
+
+		changes := activeConfig.ReloadChanges(nextConfig)
+		if len(changes.Rejected) > 0 {
+			logger.Error("Router config contains changes that can't be applied at runtime. Restart the router to apply them. Keeping the current config",
+				zap.Strings("fields", changes.Rejected),
+			)
+			return nil
+		}
+
+		// The access log writers are only replaced when the access log config changed
+		nextAccessLogs := activeAccessLogs
+		if !reflect.DeepEqual(activeConfig.AccessLogs, nextConfig.AccessLogs) {
+			nextAccessLogs, err = newAccessLogs(nextConfig)
+			if err != nil {
+				logger.Error("Failed to apply router config. Keeping the current config", zap.Error(err))
+				return nil
+			}
+		}
+
+		if err := router.Reload(ctx, reloadableRouterOptions(nextConfig, nextAccessLogs)...); err != nil {
+			if nextAccessLogs != activeAccessLogs {
+				closeAccessLogs(logger, nextAccessLogs)
+			}
+			if errors.Is(err, core.ErrConfigNotReloadable) {
+				logger.Error("Router config contains changes that can't be applied at runtime. Restart the router to apply them. Keeping the current config", zap.Error(err))
+			} else {
+				logger.Error("Failed to reload router config. Keeping the current config", zap.Error(err))
+			}
+			return nil
+		}
+
+		if len(changes.Ignored) > 0 {
+			logger.Warn("Router config changes can't be applied at runtime and take effect after a restart",
+				zap.Strings("fields", changes.Ignored),
+			)
+		}
+
+		// Reload returns after the previous graph server has been drained, so the replaced writers are no longer used
+		if nextAccessLogs != activeAccessLogs {
+			closeAccessLogs(logger, activeAccessLogs)
+		}
+
+		activeConfig = nextConfig
+		activeAccessLogs = nextAccessLogs
+
+		return nil
+	}
+
+	// A watcher consumes all events of its underlying file system watcher, so every file needs its own watcher
+	for _, configFile := range configFiles {
+		w, err := watcher.NewWatcher(logger.With(zap.String("watcher", "router_config")))
+		if err != nil {
+			return fmt.Errorf("failed to start watcher for router config file: %w", err)
+		}
+
+		if err := w.Watch(ctx, configFile, reload); err != nil {
+			return fmt.Errorf("failed to watch router config file %s: %w", configFile, err)
+		}
+	}
+
+	logger.Info("Watching router config files for changes", zap.Strings("files", configFiles))
+
+	return nil
+}
+
+func closeAccessLogs(logger *zap.Logger, accessLogs *accessLogs) {
+	if err := accessLogs.Close(); err != nil {
+		logger.Error("Failed to close access log writers", zap.Error(err))
+	}
+}
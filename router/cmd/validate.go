@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/wundergraph/cosmo/router/core"
+	"github.com/wundergraph/cosmo/router/pkg/config"
+	"github.com/wundergraph/cosmo/router/pkg/logging"
+	"go.uber.org/zap"
+)
+
+// validateCommand is the name of the subcommand that performs a dry-run of the router startup
+const validateCommand = "validate"
+
+// Validate loads the router config and performs a dry-run of the router startup without opening a listener.
+// The structured report is written as JSON to stdout. Logs are written to stderr.
+// It returns the exit code of the command.
+func Validate(ctx context.Context, configPath, overrideEnvPath string, additionalOptions ...core.Option) int {
+	report := &core.ValidationReport{Valid: true}
+
+	result, err := config.LoadConfig(configPath, overrideEnvPath)
+	report.Add(core.ValidationCheckConfig, err)
+	if err != nil {
+		return printValidationReport(report)
+	}
+
+	logLevel, err := logging.ZapLogLevelFromString(result.Config.LogLevel)
+	if err != nil {
+		report.Add(core.ValidationCheckRouter, fmt.Errorf("could not parse log level: %w", err))
+		return printValidationReport(report)
+	}
+
+	logger := logging.NewZapLogger(os.Stderr, !result.Config.JSONLog, result.Config.DevelopmentMode, logLevel).
+		With(
+			zap.String("service", "@wundergraph/router"),
+			zap.String("service_version", core.Version),
+		)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	router, err := NewRouter(ctx, Params{
+		Config: &result.Config,
+		Logger: logger,
+	}, additionalOptions...)
+	report.Add(core.ValidationCheckRouter, err)
+	if err != nil {
+		return printValidationReport(report)
+	}
+
+	validation := router.Validate(ctx)
+	report.Checks = append(report.Checks, validation.Checks...)
+	report.Valid = report.Valid && validation.Valid
+
+	return printValidationReport(report)
+}
+
+func printValidationReport(report *core.ValidationReport) int {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		fmt.Fprintf(os.Stderr, "Could not write validation report: %s\n", err)
+		return 1
+	}
+
+	if !report.Valid {
+		return 1
+	}
+
+	return 0
+}
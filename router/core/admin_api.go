@@ -0,0 +1,221 @@
+package core
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	nodev1 "github.com/wundergraph/cosmo/router/gen/proto/wg/cosmo/node/v1"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrExecutionConfigHistoryDisabled = errors.New("execution config history is disabled")
+	ErrExecutionConfigVersionNotFound = errors.New("execution config version not found in history")
+)
+
+type adminAPIErrorResponse struct {
+	Error string `json:"error"`
+}
+
+type executionConfigHistoryResponse struct {
+	ActiveVersion string                        `json:"active_version"`
+	PinnedVersion string                        `json:"pinned_version,omitempty"`
+	Versions      []ExecutionConfigHistoryEntry `json:"versions"`
+}
+
+type executionConfigRollbackRequest struct {
+	Version string `json:"version"`
+}
+
+// ExecutionConfigHistory returns the execution configs of the history, most recently loaded first.
+func (r *Router) ExecutionConfigHistory() []ExecutionConfigHistoryEntry {
+	r.serverLock.Lock()
+	defer r.serverLock.Unlock()
+
+	if r.configHistory == nil {
+		return nil
+	}
+
+	entries := r.configHistory.Entries()
+	for i := range entries {
+		entries[i].Active = entries[i].Version == r.activeRouterConfig.GetVersion()
+		entries[i].Pinned = entries[i].Version == r.pinnedConfigVersion
+	}
+
+	return entries
+}
+
+// RollbackExecutionConfig swaps the active graph server with one that is built from the execution config
+// of the history with the given version. The version is pinned afterward: updates from the config poller
+// or the execution config file are not applied until ReleaseExecutionConfigPin is called.
+// The context must outlive the graph server e.g. the context passed to Start.
+func (r *Router) RollbackExecutionConfig(ctx context.Context, version string) error {
+	if r.shutdown.Load() {
+		return fmt.Errorf("router is shutdown. Create a new instance with router.NewRouter()")
+	}
+
+	r.serverLock.Lock()
+	defer r.serverLock.Unlock()
+
+	if r.configHistory == nil {
+		return ErrExecutionConfigHistoryDisabled
+	}
+
+	cfg, ok := r.configHistory.Get(version)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrExecutionConfigVersionNotFound, version)
+	}
+
+	// The execution config of a running canary is deferred like any other update until the pin is released
+	if r.canary != nil {
+		r.pendingExecutionConfig = r.canary.canaryCfg
+		r.stopCanary(ctx, "execution config was rolled back")
+	}
+
+	if r.activeRouterConfig.GetVersion() != version {
+		if err := r.swapGraphServer(ctx, cfg); err != nil {
+			return err
+		}
+	}
+
+	r.pinnedConfigVersion = version
+
+	r.logger.Info("Rolled back execution config. Updates are not applied until the pin is released",
+		zap.String("config_version", version),
+	)
+
+	return nil
+}
+
+// ReleaseExecutionConfigPin releases the version that was pinned by RollbackExecutionConfig. If updates were
+// skipped while the version was pinned, the latest skipped execution config is applied like a new update.
+func (r *Router) ReleaseExecutionConfigPin(ctx context.Context) error {
+	r.serverLock.Lock()
+	defer r.serverLock.Unlock()
+
+	if r.pinnedConfigVersion == "" {
+		return nil
+	}
+
+	r.logger.Info("Released pinned execution config", zap.String("config_version", r.pinnedConfigVersion))
+
+	pending := r.pendingExecutionConfig
+	r.pinnedConfigVersion = ""
+	r.pendingExecutionConfig = nil
+
+	if pending != nil && pending.GetVersion() != r.activeRouterConfig.GetVersion() {
+		r.logger.Info("Applying execution config update that was skipped while the version was pinned",
+			zap.String("config_version", pending.GetVersion()),
+		)
+		return r.applyExecutionConfig(ctx, pending)
+	}
+
+	return nil
+}
+
+// updateExecutionConfig applies an execution config update of the config poller or the execution config file watcher.
+// While a version is pinned, the update is deferred until the pin is released. With canary rollouts enabled,
+// the update only serves a share of the traffic until it is promoted.
+func (r *Router) updateExecutionConfig(ctx context.Context, cfg *nodev1.RouterConfig) error {
+	r.serverLock.Lock()
+	defer r.serverLock.Unlock()
+
+	if r.pinnedConfigVersion != "" {
+		r.pendingExecutionConfig = cfg
+		r.logger.Warn("Execution config is pinned after a rollback. Skipping update until the pin is released",
+			zap.String("pinned_version", r.pinnedConfigVersion),
+			zap.String("new_version", cfg.GetVersion()),
+		)
+		return nil
+	}
+
+	return r.applyExecutionConfig(ctx, cfg)
+}
+
+// applyExecutionConfig starts a canary rollout of the execution config if enabled or swaps the graph server
+// otherwise. Must be called with the serverLock held.
+func (r *Router) applyExecutionConfig(ctx context.Context, cfg *nodev1.RouterConfig) error {
+	if r.executionConfigCanary != nil && r.executionConfigCanary.Enabled && r.httpServer.graphServer != nil {
+		return r.startCanary(ctx, cfg)
+	}
+
+	return r.swapGraphServer(ctx, cfg)
+}
+
+// adminAPIHandler serves the admin API. Every request must send the token as bearer token. The graph servers
+// created by the admin API live as long as the given router context, like the graph servers of config updates.
+func (r *Router) adminAPIHandler(ctx context.Context, adminToken string) http.Handler {
+	mux := chi.NewRouter()
+
+	mux.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+			if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) != 1 {
+				writeAdminAPIResponse(w, http.StatusUnauthorized, adminAPIErrorResponse{Error: "unauthorized"})
+				return
+			}
+			next.ServeHTTP(w, req)
+		})
+	})
+
+	mux.Get("/execution-config/history", func(w http.ResponseWriter, req *http.Request) {
+		r.serverLock.Lock()
+		res := executionConfigHistoryResponse{
+			ActiveVersion: r.activeRouterConfig.GetVersion(),
+			PinnedVersion: r.pinnedConfigVersion,
+		}
+		r.serverLock.Unlock()
+
+		res.Versions = r.ExecutionConfigHistory()
+		if res.Versions == nil {
+			res.Versions = []ExecutionConfigHistoryEntry{}
+		}
+
+		writeAdminAPIResponse(w, http.StatusOK, res)
+	})
+
+	mux.Post("/execution-config/rollback", func(w http.ResponseWriter, req *http.Request) {
+		var body executionConfigRollbackRequest
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil || body.Version == "" {
+			writeAdminAPIResponse(w, http.StatusBadRequest, adminAPIErrorResponse{Error: "request body must contain the version to roll back to"})
+			return
+		}
+
+		// The new graph server must not be bound to the lifetime or the values of the request
+		if err := r.RollbackExecutionConfig(ctx, body.Version); err != nil {
+			status := http.StatusInternalServerError
+			if errors.Is(err, ErrExecutionConfigVersionNotFound) {
+				status = http.StatusNotFound
+			} else if errors.Is(err, ErrExecutionConfigHistoryDisabled) {
+				status = http.StatusConflict
+			}
+			writeAdminAPIResponse(w, status, adminAPIErrorResponse{Error: err.Error()})
+			return
+		}
+
+		writeAdminAPIResponse(w, http.StatusOK, executionConfigRollbackRequest{Version: body.Version})
+	})
+
+	mux.Delete("/execution-config/pin", func(w http.ResponseWriter, req *http.Request) {
+		if err := r.ReleaseExecutionConfigPin(ctx); err != nil {
+			writeAdminAPIResponse(w, http.StatusInternalServerError, adminAPIErrorResponse{Error: err.Error()})
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	return mux
+}
+
+func writeAdminAPIResponse(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
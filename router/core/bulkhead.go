@@ -0,0 +1,175 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"github.com/wundergraph/cosmo/router/internal/bulkhead"
+	"github.com/wundergraph/cosmo/router/pkg/config"
+	rmetric "github.com/wundergraph/cosmo/router/pkg/metric"
+)
+
+// BulkheadRejectedErrorCode is the extension code of the error that is returned for requests rejected by a bulkhead
+const BulkheadRejectedErrorCode = "BULKHEAD_REJECTED"
+
+// SubgraphBulkheads holds the bulkheads of the subgraphs that have one enabled
+type SubgraphBulkheads struct {
+	bulkheads map[string]*bulkhead.Bulkhead
+}
+
+// bulkheadRegistry holds the bulkhead of every subgraph for the lifetime of the router. The graph servers and their
+// feature flag muxes share the bulkheads, so the limit applies to all requests to a subgraph, also while a previous
+// graph server drains its requests.
+type bulkheadRegistry struct {
+	mu        sync.Mutex
+	bulkheads map[string]registeredBulkhead
+}
+
+type registeredBulkhead struct {
+	config   config.Bulkhead
+	bulkhead *bulkhead.Bulkhead
+	// rejected counts the rejected requests of the subgraph across the bulkheads that replaced each other, so that
+	// the rejected requests metric stays monotonic when a config change recreates the bulkhead
+	rejected *atomic.Int64
+}
+
+func newBulkheadRegistry() *bulkheadRegistry {
+	return &bulkheadRegistry{
+		bulkheads: make(map[string]registeredBulkhead),
+	}
+}
+
+// get returns the bulkhead of the subgraph. A new bulkhead is created if the subgraph has none yet or its
+// configuration changed. A new bulkhead continues the count of rejected requests of the bulkhead it replaces.
+func (r *bulkheadRegistry) get(name string, cfg config.Bulkhead) *bulkhead.Bulkhead {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	registered, ok := r.bulkheads[name]
+	if ok && registered.config == cfg {
+		return registered.bulkhead
+	}
+
+	rejected := registered.rejected
+	if rejected == nil {
+		rejected = new(atomic.Int64)
+	}
+
+	bh := bulkhead.New(bulkhead.Config{
+		MaxConcurrentRequests: cfg.MaxConcurrentRequests,
+		MaxQueueSize:          cfg.MaxQueueSize,
+		QueueTimeout:          cfg.QueueTimeout,
+		Rejected:              rejected,
+	})
+	r.bulkheads[name] = registeredBulkhead{config: cfg, bulkhead: bh, rejected: rejected}
+
+	return bh
+}
+
+// newSubgraphBulkheads returns the bulkhead of every subgraph with an enabled bulkhead from the registry of the
+// router. A subgraph without its own traffic shaping rules uses the bulkhead configuration of all subgraphs. Returns
+// nil if no subgraph has a bulkhead.
+func newSubgraphBulkheads(registry *bulkheadRegistry, opts *SubgraphTransportOptions, subgraphs []Subgraph) *SubgraphBulkheads {
+	if opts == nil {
+		return nil
+	}
+
+	bulkheads := make(map[string]*bulkhead.Bulkhead)
+	for _, subgraph := range subgraphs {
+		requestOpts := opts.forSubgraph(subgraph.Name)
+		if requestOpts == nil || !requestOpts.Bulkhead.Enabled {
+			continue
+		}
+
+		bulkheads[subgraph.Name] = registry.get(subgraph.Name, requestOpts.Bulkhead)
+	}
+
+	if len(bulkheads) == 0 {
+		return nil
+	}
+
+	return &SubgraphBulkheads{bulkheads: bulkheads}
+}
+
+// Stats returns the stats of every bulkhead by subgraph name as reported by the bulkhead metrics
+func (b *SubgraphBulkheads) Stats() map[string]rmetric.BulkheadStats {
+	stats := make(map[string]rmetric.BulkheadStats, len(b.bulkheads))
+	for name, bh := range b.bulkheads {
+		stats[name] = rmetric.BulkheadStats{
+			InFlight: bh.InFlight(),
+			Queued:   bh.Queued(),
+			Rejected: bh.Rejected(),
+		}
+	}
+	return stats
+}
+
+// bulkheadTransport limits the concurrent requests of a subgraph. The slot of a request is released once its response
+// body is closed or the request failed. It is wrapped by the retry transport, so a request gives up its slot while it
+// waits for the next attempt.
+type bulkheadTransport struct {
+	roundTripper http.RoundTripper
+	bulkheads    *SubgraphBulkheads
+}
+
+func (t *bulkheadTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqContext := getRequestContext(req.Context())
+	if reqContext == nil {
+		return t.roundTripper.RoundTrip(req)
+	}
+	subgraph := reqContext.ActiveSubgraph(req)
+	if subgraph == nil {
+		return t.roundTripper.RoundTrip(req)
+	}
+	bh, ok := t.bulkheads.bulkheads[subgraph.Name]
+	if !ok {
+		return t.roundTripper.RoundTrip(req)
+	}
+
+	release, err := bh.Acquire(req.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, bulkhead.ErrQueueFull):
+			return nil, &subgraphRequestRejectedError{
+				cause:   err,
+				message: fmt.Sprintf("Too many concurrent requests to Subgraph '%s'.", subgraph.Name),
+				code:    BulkheadRejectedErrorCode,
+			}
+		case errors.Is(err, bulkhead.ErrQueueTimeout):
+			return nil, &subgraphRequestRejectedError{
+				cause:   err,
+				message: fmt.Sprintf("Timed out waiting for a free request slot of Subgraph '%s'.", subgraph.Name),
+				code:    BulkheadRejectedErrorCode,
+			}
+		default:
+			return nil, err
+		}
+	}
+
+	resp, err := t.roundTripper.RoundTrip(req)
+	// The body of a protocol switch is the connection itself and must keep implementing io.Writer
+	if err != nil || resp.StatusCode == http.StatusSwitchingProtocols {
+		release()
+		return resp, err
+	}
+	resp.Body = &bulkheadReleasingBody{ReadCloser: resp.Body, release: release}
+
+	return resp, nil
+}
+
+// bulkheadReleasingBody releases the bulkhead slot of a request once the response body is closed
+type bulkheadReleasingBody struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+func (b *bulkheadReleasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
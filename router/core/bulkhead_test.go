@@ -0,0 +1,102 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/wundergraph/cosmo/router/pkg/config"
+)
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestBulkheadTransport(t *testing.T) {
+	t.Parallel()
+
+	const subgraphURL = "http://employees.local/graphql"
+
+	newTransport := func(roundTrip func(req *http.Request) (*http.Response, error)) (*bulkheadTransport, *SubgraphBulkheads) {
+		opts := NewSubgraphTransportOptions(config.TrafficShapingRules{
+			All: config.GlobalSubgraphRequestRule{
+				Bulkhead: config.Bulkhead{Enabled: true, MaxConcurrentRequests: 1},
+			},
+		})
+		bulkheads := newSubgraphBulkheads(newBulkheadRegistry(), opts, []Subgraph{{Name: "employees"}})
+		return &bulkheadTransport{roundTripper: roundTripperFunc(roundTrip), bulkheads: bulkheads}, bulkheads
+	}
+
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodPost, subgraphURL, nil)
+		return req.WithContext(withRequestContext(req.Context(), &requestContext{
+			subgraphResolver: NewSubgraphResolver([]Subgraph{{Name: "employees", UrlString: subgraphURL}}),
+		}))
+	}
+
+	t.Run("slot is held until the body is closed", func(t *testing.T) {
+		t.Parallel()
+
+		transport, bulkheads := newTransport(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+		})
+
+		resp, err := transport.RoundTrip(newRequest())
+		require.NoError(t, err)
+		require.Equal(t, int64(1), bulkheads.Stats()["employees"].InFlight)
+
+		_, err = transport.RoundTrip(newRequest())
+		var rejected *subgraphRequestRejectedError
+		require.ErrorAs(t, err, &rejected)
+
+		require.NoError(t, resp.Body.Close())
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, int64(0), bulkheads.Stats()["employees"].InFlight)
+	})
+
+	t.Run("slot is released if the request fails", func(t *testing.T) {
+		t.Parallel()
+
+		transport, bulkheads := newTransport(func(req *http.Request) (*http.Response, error) {
+			return nil, errors.New("connection refused")
+		})
+
+		_, err := transport.RoundTrip(newRequest())
+		require.Error(t, err)
+		require.Equal(t, int64(0), bulkheads.Stats()["employees"].InFlight)
+	})
+}
+
+func TestBulkheadRegistry(t *testing.T) {
+	t.Parallel()
+
+	registry := newBulkheadRegistry()
+	cfg := config.Bulkhead{Enabled: true, MaxConcurrentRequests: 1}
+
+	bh := registry.get("employees", cfg)
+	require.Same(t, bh, registry.get("employees", cfg))
+
+	release, err := bh.Acquire(context.Background())
+	require.NoError(t, err)
+	defer release()
+	_, err = bh.Acquire(context.Background())
+	require.Error(t, err)
+	require.Equal(t, int64(1), bh.Rejected())
+
+	// A changed configuration creates a new bulkhead that keeps the count of rejected requests
+	cfg.MaxConcurrentRequests = 2
+	replaced := registry.get("employees", cfg)
+	require.NotSame(t, bh, replaced)
+	require.Equal(t, int64(1), replaced.Rejected())
+
+	// Other subgraphs have their own count
+	require.Equal(t, int64(0), registry.get("products", cfg).Rejected())
+}
@@ -0,0 +1,302 @@
+package core
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	nodev1 "github.com/wundergraph/cosmo/router/gen/proto/wg/cosmo/node/v1"
+	"github.com/wundergraph/cosmo/router/pkg/config"
+	rmetric "github.com/wundergraph/cosmo/router/pkg/metric"
+	"github.com/wundergraph/cosmo/router/pkg/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// canaryBuckets is the resolution of the traffic share
+const canaryBuckets = 10000
+
+// requestStats counts the requests and request errors of a graph server. During a canary rollout,
+// the error rates of the current and the new execution config are compared.
+type requestStats struct {
+	requests atomic.Uint64
+	errors   atomic.Uint64
+}
+
+type requestStatsSnapshot struct {
+	requests uint64
+	errors   uint64
+}
+
+func (s *requestStats) snapshot() requestStatsSnapshot {
+	return requestStatsSnapshot{
+		requests: s.requests.Load(),
+		errors:   s.errors.Load(),
+	}
+}
+
+// since returns the requests and errors that were recorded after the given snapshot
+func (s requestStatsSnapshot) since(start requestStatsSnapshot) requestStatsSnapshot {
+	return requestStatsSnapshot{
+		requests: s.requests - start.requests,
+		errors:   s.errors - start.errors,
+	}
+}
+
+func (s requestStatsSnapshot) errorRate() float64 {
+	if s.requests == 0 {
+		return 0
+	}
+	return float64(s.errors) / float64(s.requests)
+}
+
+// statsMetricStore records router requests in the request stats of the graph server in addition to the wrapped store.
+// Subgraph requests are measured through the same store and are identified by the subgraph name attribute.
+type statsMetricStore struct {
+	rmetric.Store
+	stats *requestStats
+}
+
+func (m *statsMetricStore) MeasureRequestCount(ctx context.Context, sliceAttr []attribute.KeyValue, opt otelmetric.AddOption) {
+	if !isSubgraphMeasurement(opt) {
+		m.stats.requests.Add(1)
+	}
+	m.Store.MeasureRequestCount(ctx, sliceAttr, opt)
+}
+
+func (m *statsMetricStore) MeasureRequestError(ctx context.Context, sliceAttr []attribute.KeyValue, opt otelmetric.AddOption) {
+	if !isSubgraphMeasurement(opt) {
+		m.stats.errors.Add(1)
+	}
+	m.Store.MeasureRequestError(ctx, sliceAttr, opt)
+}
+
+func isSubgraphMeasurement(opt otelmetric.AddOption) bool {
+	if opt == nil {
+		return false
+	}
+	attrs := otelmetric.NewAddConfig([]otelmetric.AddOption{opt}).Attributes()
+	return attrs.HasValue(otel.WgSubgraphName)
+}
+
+// canaryRollout routes a share of the traffic to the graph server of a new execution config.
+// All fields are guarded by the serverLock of the router.
+type canaryRollout struct {
+	config      *config.ExecutionConfigCanary
+	stable      *graphServer
+	canary      *graphServer
+	canaryCfg   *nodev1.RouterConfig
+	stableStart requestStatsSnapshot
+	startedAt   time.Time
+	cancel      context.CancelFunc
+}
+
+// handler dispatches requests to the stable or the canary graph server. Requests with the same sticky key
+// are always routed to the same graph server.
+func (c *canaryRollout) handler() http.Handler {
+	threshold := uint64(c.config.TrafficShare * canaryBuckets)
+	stableMux := c.stable.mux
+	canaryMux := c.canary.mux
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if xxhash.Sum64String(c.stickyKey(r))%canaryBuckets < threshold {
+			canaryMux.ServeHTTP(w, r)
+			return
+		}
+		stableMux.ServeHTTP(w, r)
+	})
+}
+
+func (c *canaryRollout) stickyKey(r *http.Request) string {
+	if c.config.StickyHeader != "" {
+		if v := r.Header.Get(c.config.StickyHeader); v != "" {
+			return v
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+type canaryDecision int
+
+const (
+	canaryContinue canaryDecision = iota
+	canaryPromote
+	canaryAbandon
+)
+
+// evaluate compares the error rates of the canary and the stable graph server since the start of the rollout
+func (c *canaryRollout) evaluate(now time.Time) (canaryDecision, requestStatsSnapshot, requestStatsSnapshot) {
+	stable := c.stable.requestStats.snapshot().since(c.stableStart)
+	canary := c.canary.requestStats.snapshot()
+
+	if canary.requests >= uint64(c.config.MinRequests) && canary.errorRate() > stable.errorRate()+c.config.MaxErrorRateIncrease {
+		return canaryAbandon, stable, canary
+	}
+
+	if now.Sub(c.startedAt) >= c.config.Duration {
+		return canaryPromote, stable, canary
+	}
+
+	return canaryContinue, stable, canary
+}
+
+// startCanary builds the graph server of the new execution config next to the active one and routes a share
+// of the traffic to it. A running canary is abandoned in favor of the new execution config.
+// Must be called with the serverLock held.
+func (r *Router) startCanary(ctx context.Context, cfg *nodev1.RouterConfig) error {
+	r.stopCanary(ctx, "superseded by a newer execution config")
+
+	stable := r.httpServer.graphServer
+
+	canary, err := newGraphServer(ctx, r, r.graphServerConfig(), cfg, r.proxy)
+	if err != nil {
+		r.logger.Error("Failed to create graph server. Keeping the old server", zap.Error(err))
+		return err
+	}
+
+	evalCtx, cancel := context.WithCancel(ctx)
+
+	rollout := &canaryRollout{
+		config:      r.executionConfigCanary,
+		stable:      stable,
+		canary:      canary,
+		canaryCfg:   cfg,
+		stableStart: stable.requestStats.snapshot(),
+		startedAt:   time.Now(),
+		cancel:      cancel,
+	}
+
+	r.canary = rollout
+	r.httpServer.setHandler(rollout.handler())
+
+	r.logger.Info("Started canary rollout of new execution config",
+		zap.String("current_version", r.activeRouterConfig.GetVersion()),
+		zap.String("canary_version", cfg.GetVersion()),
+		zap.Float64("traffic_share", r.executionConfigCanary.TrafficShare),
+		zap.Duration("duration", r.executionConfigCanary.Duration),
+	)
+
+	go r.watchCanary(evalCtx, ctx, rollout)
+
+	return nil
+}
+
+// watchCanary evaluates the rollout in the configured interval until it is promoted, abandoned or stopped.
+// The swap context must outlive the graph server of the canary.
+func (r *Router) watchCanary(ctx context.Context, swapCtx context.Context, rollout *canaryRollout) {
+	ticker := time.NewTicker(rollout.config.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			r.serverLock.Lock()
+			// The rollout was stopped or replaced in the meantime
+			if r.canary != rollout {
+				r.serverLock.Unlock()
+				return
+			}
+
+			decision, stable, canary := rollout.evaluate(now)
+
+			fields := []zap.Field{
+				zap.String("canary_version", rollout.canaryCfg.GetVersion()),
+				zap.Uint64("canary_requests", canary.requests),
+				zap.Float64("canary_error_rate", canary.errorRate()),
+				zap.Uint64("current_requests", stable.requests),
+				zap.Float64("current_error_rate", stable.errorRate()),
+			}
+
+			// The graph server that is no longer used drains its requests after the lock is released, so that
+			// config updates aren't blocked in the meantime
+			var drained *graphServer
+
+			switch decision {
+			case canaryAbandon:
+				r.logger.Warn("Abandoning canary execution config because of an increased error rate. Keeping the current config", fields...)
+				drained = r.detachCanary("")
+			case canaryPromote:
+				r.logger.Info("Promoting canary execution config", fields...)
+				drained = r.promoteCanary(swapCtx)
+			default:
+				r.logger.Debug("Canary rollout in progress", fields...)
+			}
+
+			r.serverLock.Unlock()
+
+			if drained != nil {
+				if err := drained.Shutdown(swapCtx); err != nil {
+					r.logger.Error("Failed to shutdown graph server", zap.Error(err))
+				}
+			}
+
+			if decision != canaryContinue {
+				return
+			}
+		}
+	}
+}
+
+// promoteCanary serves all traffic with the canary graph server and returns the stable one, which must be shut down
+// by the caller. Must be called with the serverLock held.
+func (r *Router) promoteCanary(ctx context.Context) *graphServer {
+	rollout := r.canary
+	r.canary = nil
+	rollout.cancel()
+
+	stable := r.httpServer.replaceGraphServer(rollout.canary)
+
+	if r.configHistory != nil {
+		r.configHistory.Add(rollout.canaryCfg)
+	}
+	r.reportSchemaChanges(ctx, r.activeRouterConfig, rollout.canaryCfg)
+	r.activeRouterConfig = rollout.canaryCfg
+
+	return stable
+}
+
+// stopCanary routes all traffic back to the stable graph server and shuts down the canary graph server.
+// The reason is logged if set. Must be called with the serverLock held.
+func (r *Router) stopCanary(ctx context.Context, reason string) {
+	canary := r.detachCanary(reason)
+	if canary == nil {
+		return
+	}
+
+	if err := canary.Shutdown(ctx); err != nil {
+		r.logger.Error("Failed to shutdown canary graph server", zap.Error(err))
+	}
+}
+
+// detachCanary routes all traffic back to the stable graph server and returns the canary graph server, which must
+// be shut down by the caller. Returns nil if no canary is running. Must be called with the serverLock held.
+func (r *Router) detachCanary(reason string) *graphServer {
+	rollout := r.canary
+	if rollout == nil {
+		return nil
+	}
+
+	r.canary = nil
+	rollout.cancel()
+
+	if reason != "" {
+		r.logger.Info("Stopped canary rollout of execution config",
+			zap.String("canary_version", rollout.canaryCfg.GetVersion()),
+			zap.String("reason", reason),
+		)
+	}
+
+	r.httpServer.setHandler(rollout.stable.mux)
+
+	return rollout.canary
+}
@@ -0,0 +1,194 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	nodev1 "github.com/wundergraph/cosmo/router/gen/proto/wg/cosmo/node/v1"
+	"github.com/wundergraph/cosmo/router/pkg/config"
+	rmetric "github.com/wundergraph/cosmo/router/pkg/metric"
+	"github.com/wundergraph/cosmo/router/pkg/otel"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+func newCanaryTestServer(name string) *graphServer {
+	mux := chi.NewMux()
+	mux.HandleFunc("/*", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(name))
+	})
+	return &graphServer{mux: mux, requestStats: &requestStats{}}
+}
+
+func TestStatsMetricStore(t *testing.T) {
+	t.Parallel()
+
+	stats := &requestStats{}
+	store := &statsMetricStore{Store: rmetric.NewNoopMetrics(), stats: stats}
+	ctx := context.Background()
+
+	store.MeasureRequestCount(ctx, nil, otelmetric.WithAttributes(otel.WgOperationName.String("a")))
+	store.MeasureRequestCount(ctx, nil, otelmetric.WithAttributes(otel.WgOperationName.String("b")))
+	store.MeasureRequestError(ctx, nil, otelmetric.WithAttributes(otel.WgOperationName.String("b")))
+
+	// Subgraph requests are not counted
+	store.MeasureRequestCount(ctx, nil, otelmetric.WithAttributes(otel.WgSubgraphName.String("employees")))
+	store.MeasureRequestError(ctx, nil, otelmetric.WithAttributes(otel.WgSubgraphName.String("employees")))
+
+	snapshot := stats.snapshot()
+	require.Equal(t, uint64(2), snapshot.requests)
+	require.Equal(t, uint64(1), snapshot.errors)
+	require.Equal(t, 0.5, snapshot.errorRate())
+}
+
+func TestCanaryRollout(t *testing.T) {
+	t.Parallel()
+
+	t.Run("routes requests sticky by header", func(t *testing.T) {
+		t.Parallel()
+
+		rollout := &canaryRollout{
+			config: &config.ExecutionConfigCanary{TrafficShare: 0.3, StickyHeader: "X-User-ID"},
+			stable: newCanaryTestServer("stable"),
+			canary: newCanaryTestServer("canary"),
+		}
+		handler := rollout.handler()
+
+		serve := func(user string) string {
+			req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+			req.Header.Set("X-User-ID", user)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			return rec.Body.String()
+		}
+
+		canaryRequests := 0
+		for i := 0; i < 1000; i++ {
+			user := strconv.Itoa(i)
+			target := serve(user)
+			require.Equal(t, target, serve(user))
+			if target == "canary" {
+				canaryRequests++
+			}
+		}
+
+		require.InDelta(t, 300, canaryRequests, 60)
+	})
+
+	t.Run("falls back to the client address", func(t *testing.T) {
+		t.Parallel()
+
+		rollout := &canaryRollout{config: &config.ExecutionConfigCanary{StickyHeader: "X-User-ID"}}
+
+		req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+		req.RemoteAddr = "10.0.0.1:1234"
+		require.Equal(t, "10.0.0.1", rollout.stickyKey(req))
+
+		req.Header.Set("X-User-ID", "user")
+		require.Equal(t, "user", rollout.stickyKey(req))
+	})
+
+	t.Run("evaluates the error rates", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Now()
+		rollout := &canaryRollout{
+			config: &config.ExecutionConfigCanary{
+				Duration:             time.Minute,
+				MinRequests:          10,
+				MaxErrorRateIncrease: 0.05,
+			},
+			stable:    newCanaryTestServer("stable"),
+			canary:    newCanaryTestServer("canary"),
+			startedAt: start,
+		}
+
+		// Requests before the rollout are not compared
+		rollout.stable.requestStats.requests.Add(100)
+		rollout.stable.requestStats.errors.Add(100)
+		rollout.stableStart = rollout.stable.requestStats.snapshot()
+
+		rollout.stable.requestStats.requests.Add(100)
+		rollout.stable.requestStats.errors.Add(5)
+
+		// Not enough requests to abandon the canary
+		rollout.canary.requestStats.requests.Add(5)
+		rollout.canary.requestStats.errors.Add(5)
+		decision, _, _ := rollout.evaluate(start.Add(time.Second))
+		require.Equal(t, canaryContinue, decision)
+
+		rollout.canary.requestStats.requests.Add(95)
+		decision, _, _ = rollout.evaluate(start.Add(time.Second))
+		require.Equal(t, canaryContinue, decision)
+
+		decision, _, _ = rollout.evaluate(start.Add(time.Minute))
+		require.Equal(t, canaryPromote, decision)
+
+		rollout.canary.requestStats.errors.Add(10)
+		decision, _, _ = rollout.evaluate(start.Add(time.Second))
+		require.Equal(t, canaryAbandon, decision)
+	})
+}
+
+func TestCanaryPromotionAndStop(t *testing.T) {
+	t.Parallel()
+
+	newRouter := func() *Router {
+		stable := newCanaryTestServer("stable")
+		canary := newCanaryTestServer("canary")
+		r := &Router{
+			Config: Config{logger: zap.NewNop()},
+			httpServer: &server{
+				handler:     stable.mux,
+				graphServer: stable,
+			},
+			activeRouterConfig: &nodev1.RouterConfig{Version: "1"},
+		}
+		r.canary = &canaryRollout{
+			stable:    stable,
+			canary:    canary,
+			canaryCfg: &nodev1.RouterConfig{Version: "2"},
+			cancel:    func() {},
+		}
+		return r
+	}
+
+	serve := func(r *Router) string {
+		rec := httptest.NewRecorder()
+		r.httpServer.handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/graphql", nil))
+		return rec.Body.String()
+	}
+
+	t.Run("promotion returns the stable graph server to be drained by the caller", func(t *testing.T) {
+		t.Parallel()
+
+		r := newRouter()
+		stable := r.canary.stable
+
+		drained := r.promoteCanary(context.Background())
+		require.Same(t, stable, drained)
+		require.Nil(t, r.canary)
+		require.Equal(t, "2", r.activeRouterConfig.GetVersion())
+		require.Equal(t, "canary", serve(r))
+	})
+
+	t.Run("detaching returns the canary graph server to be drained by the caller", func(t *testing.T) {
+		t.Parallel()
+
+		r := newRouter()
+		canary := r.canary.canary
+
+		drained := r.detachCanary("test")
+		require.Same(t, canary, drained)
+		require.Nil(t, r.canary)
+		require.Equal(t, "1", r.activeRouterConfig.GetVersion())
+		require.Equal(t, "stable", serve(r))
+		require.Nil(t, r.detachCanary("test"))
+	})
+}
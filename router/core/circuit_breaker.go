@@ -0,0 +1,164 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/wundergraph/cosmo/router/internal/circuitbreaker"
+	"github.com/wundergraph/cosmo/router/pkg/config"
+	rmetric "github.com/wundergraph/cosmo/router/pkg/metric"
+	"github.com/wundergraph/cosmo/router/pkg/otel"
+	otrace "go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// CircuitBreakerOpenErrorCode is the extension code of the error that is returned for a subgraph with an open circuit
+const CircuitBreakerOpenErrorCode = "CIRCUIT_BREAKER_OPEN"
+
+// SubgraphCircuitBreakers holds the circuit breakers of the subgraphs that have one enabled
+type SubgraphCircuitBreakers struct {
+	breakers map[string]*circuitbreaker.Breaker
+	metrics  *rmetric.CircuitBreakerMetrics
+}
+
+// circuitBreakerRegistry holds the circuit breaker of every subgraph for the lifetime of the router. The graph servers
+// and their feature flag muxes share the circuit breakers, so the state of a circuit survives config updates.
+type circuitBreakerRegistry struct {
+	mu       sync.Mutex
+	breakers map[string]registeredCircuitBreaker
+}
+
+type registeredCircuitBreaker struct {
+	config  config.CircuitBreaker
+	breaker *circuitbreaker.Breaker
+}
+
+func newCircuitBreakerRegistry() *circuitBreakerRegistry {
+	return &circuitBreakerRegistry{
+		breakers: make(map[string]registeredCircuitBreaker),
+	}
+}
+
+// get returns the circuit breaker of the subgraph. A new circuit breaker is created if the subgraph has none yet or
+// its configuration changed.
+func (r *circuitBreakerRegistry) get(name string, cfg config.CircuitBreaker, logger *zap.Logger) *circuitbreaker.Breaker {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if registered, ok := r.breakers[name]; ok && registered.config == cfg {
+		return registered.breaker
+	}
+
+	breaker := circuitbreaker.New(name, circuitbreaker.Config{
+		ErrorThresholdPercentage: cfg.ErrorThresholdPercentage,
+		RequestThreshold:         cfg.RequestThreshold,
+		ConsecutiveFailures:      cfg.ConsecutiveFailures,
+		RollingDuration:          cfg.RollingDuration,
+		SleepWindow:              cfg.SleepWindow,
+		HalfOpenRequests:         cfg.HalfOpenRequests,
+		OnStateChange: func(name string, from, to circuitbreaker.State) {
+			logger.Warn("Subgraph circuit breaker changed state",
+				zap.String("subgraph_name", name),
+				zap.String("from", from.String()),
+				zap.String("to", to.String()),
+			)
+		},
+	})
+	r.breakers[name] = registeredCircuitBreaker{config: cfg, breaker: breaker}
+
+	return breaker
+}
+
+// newSubgraphCircuitBreakers returns the circuit breaker of every subgraph with an enabled circuit breaker from the
+// registry of the router. A subgraph without its own traffic shaping rules uses the circuit breaker configuration of
+// all subgraphs. Returns nil if no subgraph has a circuit breaker.
+func newSubgraphCircuitBreakers(registry *circuitBreakerRegistry, opts *SubgraphTransportOptions, subgraphs []Subgraph, metrics *rmetric.CircuitBreakerMetrics, logger *zap.Logger) *SubgraphCircuitBreakers {
+	if opts == nil {
+		return nil
+	}
+
+	breakers := make(map[string]*circuitbreaker.Breaker)
+	for _, subgraph := range subgraphs {
+		requestOpts := opts.forSubgraph(subgraph.Name)
+		if requestOpts == nil || !requestOpts.CircuitBreaker.Enabled {
+			continue
+		}
+
+		breakers[subgraph.Name] = registry.get(subgraph.Name, requestOpts.CircuitBreaker, logger)
+	}
+
+	if len(breakers) == 0 {
+		return nil
+	}
+
+	return &SubgraphCircuitBreakers{
+		breakers: breakers,
+		metrics:  metrics,
+	}
+}
+
+// States returns the state of every circuit breaker by subgraph name as reported by the state metric
+func (c *SubgraphCircuitBreakers) States() map[string]int64 {
+	states := make(map[string]int64, len(c.breakers))
+	for name, breaker := range c.breakers {
+		states[name] = int64(breaker.State())
+	}
+	return states
+}
+
+// circuitBreakerTransport sends the requests of a subgraph through its circuit breaker. It is wrapped by the retry
+// transport, so every attempt of a request is counted by the circuit breaker. Rejected requests aren't retried.
+type circuitBreakerTransport struct {
+	roundTripper http.RoundTripper
+	breakers     *SubgraphCircuitBreakers
+}
+
+func (t *circuitBreakerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqContext := getRequestContext(req.Context())
+	if reqContext == nil {
+		return t.roundTripper.RoundTrip(req)
+	}
+	subgraph := reqContext.ActiveSubgraph(req)
+	if subgraph == nil {
+		return t.roundTripper.RoundTrip(req)
+	}
+	breaker, ok := t.breakers.breakers[subgraph.Name]
+	if !ok {
+		return t.roundTripper.RoundTrip(req)
+	}
+
+	done, err := breaker.Allow()
+	span := otrace.SpanFromContext(req.Context())
+	span.SetAttributes(otel.WgSubgraphCircuitBreakerState.String(breaker.State().String()))
+	if err != nil {
+		if t.breakers.metrics != nil {
+			t.breakers.metrics.MeasureRejectedRequest(req.Context(), subgraph.Name)
+		}
+		return nil, &subgraphRequestRejectedError{
+			cause:   err,
+			message: fmt.Sprintf("Circuit breaker of Subgraph '%s' is open.", subgraph.Name),
+			code:    CircuitBreakerOpenErrorCode,
+		}
+	}
+
+	resp, err := t.roundTripper.RoundTrip(req)
+	done(subgraphRequestOutcome(resp, err))
+
+	return resp, err
+}
+
+// subgraphRequestOutcome reports whether the subgraph could be reached and didn't respond with a server error.
+// Requests canceled by the client don't say anything about the health of the subgraph and aren't counted.
+func subgraphRequestOutcome(resp *http.Response, err error) circuitbreaker.Outcome {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return circuitbreaker.Ignored
+	case err != nil || resp.StatusCode >= http.StatusInternalServerError:
+		return circuitbreaker.Failure
+	default:
+		return circuitbreaker.Success
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/wundergraph/cosmo/router/internal/expr"
 	"github.com/wundergraph/cosmo/router/pkg/authentication"
 	"github.com/wundergraph/cosmo/router/pkg/config"
+	"github.com/wundergraph/cosmo/router/pkg/queryplan"
 	ctrace "github.com/wundergraph/cosmo/router/pkg/trace"
 )
 
@@ -259,6 +260,8 @@ type requestContext struct {
 	telemetry *requestTelemetryAttributes
 	// expressionContext is the context that will be provided to a compiled expression in order to retrieve data via dynamic expressions
 	expressionContext expr.Context
+	// subgraphRetries counts the retries of the subgraph requests against the retry budgets of the subgraphs
+	subgraphRetries subgraphRetryBudget
 }
 
 func (c *requestContext) ResolveStringExpression(expression *vm.Program) (string, error) {
@@ -504,10 +507,14 @@ type operationContext struct {
 	preparedPlan     *planWithMetaData
 	traceOptions     resolve.TraceOptions
 	executionOptions resolve.ExecutionOptions
-	planCacheHit     bool
-	initialPayload   []byte
-	extensions       []byte
-	persistedID      string
+	// queryPlanFormat is the format the client requested the query plan in. Empty for the default JSON plan.
+	queryPlanFormat queryplan.Format
+	// complexity of the operation. Only calculated if complexity limits or the cost based rate limit are configured.
+	complexity     ComplexityCacheEntry
+	planCacheHit   bool
+	initialPayload []byte
+	extensions     []byte
+	persistedID    string
 	// Hash on the original operation
 	sha256Hash string
 	protocol   OperationProtocol
@@ -0,0 +1,210 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	nodev1 "github.com/wundergraph/cosmo/router/gen/proto/wg/cosmo/node/v1"
+	"github.com/wundergraph/cosmo/router/pkg/config"
+	"github.com/wundergraph/cosmo/router/pkg/watcher"
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/graphqlerrors"
+	"go.uber.org/zap"
+)
+
+// DevComposer composes the execution config from the subgraphs of the dev composition config. The router doesn't
+// include a composer, because the composition library adds a JavaScript runtime to the binary. The dev router of
+// the router-dev module provides one with WithDevComposer.
+//
+// When the composition fails, Compose should return graphqlerrors.RequestErrors, so that GraphQL requests are
+// answered with every error of the composition.
+type DevComposer interface {
+	Compose(ctx context.Context, cfg *config.DevComposition) (*DevCompositionResult, error)
+	Close()
+}
+
+// DevCompositionResult is the result of a successful composition
+type DevCompositionResult struct {
+	// RouterConfig is the composed execution config. Its version must only change with the composition, so that
+	// unchanged compositions don't replace the graph server.
+	RouterConfig *nodev1.RouterConfig
+	// Warnings are issues that didn't prevent the composition. They are logged by the router.
+	Warnings []string
+}
+
+// devCompositionErrorCode is the code of the GraphQL error for compositions that failed without request errors
+const devCompositionErrorCode = "COMPOSITION_ERROR"
+
+// devComposer composes the execution config from the subgraphs of the dev composition config
+type devComposer struct {
+	config   *config.DevComposition
+	composer DevComposer
+	logger   *zap.Logger
+	// failed is true while the last composition failed and requests are answered with its errors.
+	// Guarded by the serverLock of the router.
+	failed bool
+}
+
+func validateDevComposition(cfg *config.DevComposition) error {
+	if len(cfg.Subgraphs) == 0 {
+		return errors.New("dev composition requires at least one subgraph")
+	}
+
+	names := make(map[string]struct{}, len(cfg.Subgraphs))
+	for i, sg := range cfg.Subgraphs {
+		if sg.Name == "" {
+			return fmt.Errorf("dev composition subgraph #%d has no name", i)
+		}
+		if sg.RoutingURL == "" {
+			return fmt.Errorf("dev composition subgraph %s has no routing url", sg.Name)
+		}
+		if _, ok := names[sg.Name]; ok {
+			return fmt.Errorf("dev composition subgraph %s is defined more than once", sg.Name)
+		}
+		names[sg.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+func (c *devComposer) compose(ctx context.Context) (*nodev1.RouterConfig, error) {
+	result, err := c.composer.Compose(ctx, c.config)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, warning := range result.Warnings {
+		c.logger.Warn("Composition warning", zap.String("message", warning))
+	}
+
+	return result.RouterConfig, nil
+}
+
+func (c *devComposer) Close() {
+	c.composer.Close()
+}
+
+// startDevComposition serves the composition of the local subgraphs and recomposes them whenever a schema file changes.
+func (r *Router) startDevComposition(ctx context.Context) error {
+	if err := r.listenAndServe(); err != nil {
+		return err
+	}
+
+	r.recompose(ctx)
+
+	for _, sg := range r.devComposition.Subgraphs {
+		if sg.SchemaFile == "" {
+			continue
+		}
+
+		subgraphName, schemaFile := sg.Name, sg.SchemaFile
+
+		w, err := watcher.NewWatcher(r.logger.With(zap.String("watcher", "dev_composition"), zap.String("subgraph_name", subgraphName)))
+		if err != nil {
+			return fmt.Errorf("failed to start watcher for schema file of subgraph %s: %w", subgraphName, err)
+		}
+
+		// Errors are reported by recompose, returning an error would stop the watcher
+		err = w.Watch(ctx, schemaFile, func(events []watcher.Event) error {
+			if r.shutdown.Load() {
+				r.logger.Warn("Router is in shutdown state. Skipping composition")
+				return nil
+			}
+
+			r.logger.Info("Subgraph schema changed. Composing subgraphs",
+				zap.String("subgraph_name", subgraphName),
+				zap.String("path", schemaFile),
+			)
+
+			r.recompose(ctx)
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to watch schema file of subgraph %s: %w", subgraphName, err)
+		}
+	}
+
+	// The router is ready even if the composition failed, so the errors can be inspected
+	r.httpServer.healthcheck.SetReady(true)
+
+	r.logger.Info("Server initialized in dev composition mode. Router will recompose automatically when a schema file changes",
+		zap.String("listen_addr", r.listenAddr),
+		zap.Int("subgraphs", len(r.devComposition.Subgraphs)),
+		zap.Bool("playground", r.playgroundConfig.Enabled),
+	)
+
+	return nil
+}
+
+// recompose composes the subgraphs and swaps the graph server. When the composition fails, GraphQL requests are
+// answered with the composition errors until the next composition succeeds.
+func (r *Router) recompose(ctx context.Context) {
+	cfg, err := r.devComposer.compose(ctx)
+
+	r.serverLock.Lock()
+	defer r.serverLock.Unlock()
+
+	if err == nil {
+		if !r.devComposer.failed && cfg.GetVersion() == r.activeRouterConfig.GetVersion() {
+			r.logger.Info("Composition is unchanged. Keeping the current server", zap.String("config_version", cfg.GetVersion()))
+			return
+		}
+
+		err = r.swapGraphServer(ctx, cfg)
+		if err == nil {
+			r.devComposer.failed = false
+			r.logger.Info("Subgraphs composed successfully. Server updated", zap.String("config_version", cfg.GetVersion()))
+			return
+		}
+	}
+
+	requestErrors := compositionRequestErrors(err)
+
+	messages := make([]string, 0, len(requestErrors))
+	for _, requestError := range requestErrors {
+		messages = append(messages, requestError.Message)
+	}
+	r.logger.Error("Failed to compose subgraphs. GraphQL requests are answered with the composition errors until the next successful composition",
+		zap.Strings("errors", messages),
+	)
+
+	r.devComposer.failed = true
+	r.httpServer.setHandler(r.compositionErrorHandler(requestErrors))
+}
+
+// compositionErrorHandler answers GraphQL requests with the composition errors. Health checks are still served and
+// all other requests are handled by the last successfully composed graph server, if any.
+// Must be called with the serverLock held.
+func (r *Router) compositionErrorHandler(requestErrors graphqlerrors.RequestErrors) http.Handler {
+	mux := chi.NewMux()
+	mux.Get(r.healthCheckPath, r.healthcheck.Liveness())
+	mux.Get(r.livenessCheckPath, r.healthcheck.Liveness())
+	mux.Get(r.readinessCheckPath, r.healthcheck.Readiness())
+
+	mux.Handle(r.graphqlPath, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		writeRequestErrors(req, w, http.StatusServiceUnavailable, requestErrors, r.logger)
+	}))
+
+	if graphServer := r.httpServer.graphServer; graphServer != nil {
+		mux.NotFound(graphServer.mux.ServeHTTP)
+	}
+
+	return mux
+}
+
+func compositionRequestErrors(err error) graphqlerrors.RequestErrors {
+	var requestErrors graphqlerrors.RequestErrors
+	if errors.As(err, &requestErrors) {
+		return requestErrors
+	}
+
+	return graphqlerrors.RequestErrors{
+		{
+			Message:    fmt.Sprintf("Failed to compose subgraphs: %s", err),
+			Extensions: &graphqlerrors.Extensions{Code: devCompositionErrorCode},
+		},
+	}
+}
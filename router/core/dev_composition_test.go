@@ -0,0 +1,54 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wundergraph/cosmo/router/pkg/config"
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/graphqlerrors"
+)
+
+func TestCompositionRequestErrors(t *testing.T) {
+	t.Parallel()
+
+	// Request errors of the composer are returned as is
+	compositionErrs := graphqlerrors.RequestErrors{
+		{Message: "[employees] Unexpected token", Extensions: &graphqlerrors.Extensions{Code: "INVALID_SUBGRAPH_SCHEMA"}},
+		{Message: "Composition failed", Extensions: &graphqlerrors.Extensions{Code: "COMPOSITION_ERROR"}},
+	}
+	require.Equal(t, compositionErrs, compositionRequestErrors(fmt.Errorf("failed to compose: %w", compositionErrs)))
+
+	requestErrors := compositionRequestErrors(errors.New("failed to read schema of subgraph employees"))
+	require.Len(t, requestErrors, 1)
+	require.Equal(t, "Failed to compose subgraphs: failed to read schema of subgraph employees", requestErrors[0].Message)
+	require.Equal(t, devCompositionErrorCode, requestErrors[0].Extensions.Code)
+}
+
+func TestValidateDevComposition(t *testing.T) {
+	t.Parallel()
+
+	require.ErrorContains(t, validateDevComposition(&config.DevComposition{Enabled: true}), "at least one subgraph")
+	require.ErrorContains(t, validateDevComposition(&config.DevComposition{
+		Enabled:   true,
+		Subgraphs: []config.DevCompositionSubgraph{{Name: "a"}},
+	}), "has no routing url")
+	require.ErrorContains(t, validateDevComposition(&config.DevComposition{
+		Enabled: true,
+		Subgraphs: []config.DevCompositionSubgraph{
+			{Name: "a", RoutingURL: "http://localhost:4001/graphql"},
+			{Name: "a", RoutingURL: "http://localhost:4002/graphql"},
+		},
+	}), "defined more than once")
+}
+
+func TestDevCompositionRequiresComposer(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewRouter(WithDevComposition(&config.DevComposition{
+		Enabled:   true,
+		Subgraphs: []config.DevCompositionSubgraph{{Name: "employees", RoutingURL: "http://localhost:4001/graphql"}},
+	}))
+	require.ErrorContains(t, err, "dev composition requires a composer")
+}
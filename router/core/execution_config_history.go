@@ -0,0 +1,203 @@
+package core
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	nodev1 "github.com/wundergraph/cosmo/router/gen/proto/wg/cosmo/node/v1"
+	"github.com/wundergraph/cosmo/router/pkg/execution_config"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const executionConfigHistoryFilePrefix = "execution_config_"
+
+// ExecutionConfigHistoryEntry describes an execution config that was successfully loaded by the router.
+type ExecutionConfigHistoryEntry struct {
+	Version  string    `json:"version"`
+	LoadedAt time.Time `json:"loaded_at"`
+	Active   bool      `json:"active"`
+	Pinned   bool      `json:"pinned"`
+}
+
+type executionConfigHistoryItem struct {
+	config   *nodev1.RouterConfig
+	loadedAt time.Time
+}
+
+// executionConfigHistory keeps the last successfully loaded execution configs, oldest first.
+// If a directory is configured, every config is persisted as a file, so the history survives restarts.
+type executionConfigHistory struct {
+	mu     sync.Mutex
+	size   int
+	dir    string
+	logger *zap.Logger
+	items  []executionConfigHistoryItem
+}
+
+func newExecutionConfigHistory(size int, dir string, logger *zap.Logger) (*executionConfigHistory, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("execution config history size must be greater than 0, got %d", size)
+	}
+
+	h := &executionConfigHistory{
+		size:   size,
+		dir:    dir,
+		logger: logger,
+	}
+
+	if dir == "" {
+		return h, nil
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create execution config history directory: %w", err)
+	}
+
+	if err := h.restore(); err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// restore loads the persisted execution configs ordered by the time they were loaded
+func (h *executionConfigHistory) restore() error {
+	entries, err := os.ReadDir(h.dir)
+	if err != nil {
+		return fmt.Errorf("failed to read execution config history directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasPrefix(entry.Name(), executionConfigHistoryFilePrefix) || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		cfg, err := execution_config.FromFile(filepath.Join(h.dir, entry.Name()))
+		if err != nil {
+			// A corrupt file must not prevent the router from starting
+			h.logger.Warn("Failed to restore execution config from history. Skipping file",
+				zap.String("file", entry.Name()),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		h.items = append(h.items, executionConfigHistoryItem{config: cfg, loadedAt: info.ModTime()})
+	}
+
+	sort.Slice(h.items, func(i, j int) bool {
+		return h.items[i].loadedAt.Before(h.items[j].loadedAt)
+	})
+
+	h.trim()
+
+	return nil
+}
+
+// Add records the config as the most recently loaded config. A config with a version that is already
+// part of the history is moved to the end.
+func (h *executionConfigHistory) Add(cfg *nodev1.RouterConfig) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	version := cfg.GetVersion()
+
+	for i, item := range h.items {
+		if item.config.GetVersion() == version {
+			h.items = append(h.items[:i], h.items[i+1:]...)
+			break
+		}
+	}
+
+	item := executionConfigHistoryItem{config: cfg, loadedAt: time.Now()}
+	h.items = append(h.items, item)
+
+	if h.dir != "" {
+		if err := h.persist(item); err != nil {
+			h.logger.Error("Failed to persist execution config history", zap.String("version", version), zap.Error(err))
+		}
+	}
+
+	h.trim()
+}
+
+// Get returns the config with the given version
+func (h *executionConfigHistory) Get(version string) (*nodev1.RouterConfig, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, item := range h.items {
+		if item.config.GetVersion() == version {
+			return item.config, true
+		}
+	}
+
+	return nil, false
+}
+
+// Entries returns the recorded configs, most recently loaded first
+func (h *executionConfigHistory) Entries() []ExecutionConfigHistoryEntry {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	entries := make([]ExecutionConfigHistoryEntry, 0, len(h.items))
+	for i := len(h.items) - 1; i >= 0; i-- {
+		entries = append(entries, ExecutionConfigHistoryEntry{
+			Version:  h.items[i].config.GetVersion(),
+			LoadedAt: h.items[i].loadedAt,
+		})
+	}
+
+	return entries
+}
+
+// trim removes the oldest configs that exceed the size of the history. Must be called with the lock held.
+func (h *executionConfigHistory) trim() {
+	for len(h.items) > h.size {
+		removed := h.items[0]
+		h.items = h.items[1:]
+
+		if h.dir != "" {
+			if err := os.Remove(h.filePath(removed.config.GetVersion())); err != nil && !os.IsNotExist(err) {
+				h.logger.Error("Failed to remove execution config from history", zap.String("version", removed.config.GetVersion()), zap.Error(err))
+			}
+		}
+	}
+}
+
+func (h *executionConfigHistory) persist(item executionConfigHistoryItem) error {
+	data, err := protojson.Marshal(item.config)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first, so a crash can't leave a truncated config behind
+	path := h.filePath(item.config.GetVersion())
+	tmp := path + ".tmp"
+
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	if err := os.Chtimes(tmp, item.loadedAt, item.loadedAt); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+func (h *executionConfigHistory) filePath(version string) string {
+	// Versions are usually UUIDs but escaping ensures that a version can't point outside the directory
+	return filepath.Join(h.dir, executionConfigHistoryFilePrefix+url.PathEscape(version)+".json")
+}
@@ -0,0 +1,144 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	nodev1 "github.com/wundergraph/cosmo/router/gen/proto/wg/cosmo/node/v1"
+	"github.com/wundergraph/cosmo/router/pkg/config"
+	"go.uber.org/zap"
+)
+
+func historyVersions(entries []ExecutionConfigHistoryEntry) []string {
+	versions := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		versions = append(versions, entry.Version)
+	}
+	return versions
+}
+
+func TestExecutionConfigHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("keeps the last configs", func(t *testing.T) {
+		t.Parallel()
+
+		history, err := newExecutionConfigHistory(2, "", zap.NewNop())
+		require.NoError(t, err)
+
+		history.Add(&nodev1.RouterConfig{Version: "1"})
+		history.Add(&nodev1.RouterConfig{Version: "2"})
+		history.Add(&nodev1.RouterConfig{Version: "3"})
+		history.Add(&nodev1.RouterConfig{Version: "2"})
+
+		require.Equal(t, []string{"2", "3"}, historyVersions(history.Entries()))
+
+		_, ok := history.Get("1")
+		require.False(t, ok)
+
+		cfg, ok := history.Get("3")
+		require.True(t, ok)
+		require.Equal(t, "3", cfg.GetVersion())
+	})
+
+	t.Run("restores persisted configs", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+
+		history, err := newExecutionConfigHistory(2, dir, zap.NewNop())
+		require.NoError(t, err)
+
+		history.Add(&nodev1.RouterConfig{Version: "1"})
+		history.Add(&nodev1.RouterConfig{Version: "2"})
+		history.Add(&nodev1.RouterConfig{Version: "../3"})
+
+		restored, err := newExecutionConfigHistory(2, dir, zap.NewNop())
+		require.NoError(t, err)
+		require.Equal(t, []string{"../3", "2"}, historyVersions(restored.Entries()))
+	})
+
+	t.Run("rejects an invalid size", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newExecutionConfigHistory(0, "", zap.NewNop())
+		require.Error(t, err)
+	})
+}
+
+func TestAdminAPI(t *testing.T) {
+	t.Parallel()
+
+	t.Run("requires a token", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewRouter(WithAdminAPI(&config.AdminAPIConfiguration{Enabled: true}))
+		require.ErrorContains(t, err, "admin api token must be set")
+	})
+
+	t.Run("lists the history and rejects unknown versions", func(t *testing.T) {
+		t.Parallel()
+
+		router, err := NewRouter(WithAdminAPI(&config.AdminAPIConfiguration{Enabled: true, Token: "secret"}))
+		require.NoError(t, err)
+
+		router.configHistory, err = newExecutionConfigHistory(10, "", zap.NewNop())
+		require.NoError(t, err)
+		router.configHistory.Add(&nodev1.RouterConfig{Version: "1"})
+		router.configHistory.Add(&nodev1.RouterConfig{Version: "2"})
+		router.activeRouterConfig = &nodev1.RouterConfig{Version: "2"}
+
+		handler := router.adminAPIHandler(context.Background(), router.adminAPI.Token)
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/execution-config/history", nil))
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		req := httptest.NewRequest(http.MethodGet, "/execution-config/history", nil)
+		req.Header.Set("Authorization", "Bearer secret")
+		rec = httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var res executionConfigHistoryResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, "2", res.ActiveVersion)
+		require.Equal(t, []string{"2", "1"}, historyVersions(res.Versions))
+		require.True(t, res.Versions[0].Active)
+
+		req = httptest.NewRequest(http.MethodPost, "/execution-config/rollback", strings.NewReader(`{"version":"unknown"}`))
+		req.Header.Set("Authorization", "Bearer secret")
+		rec = httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("pins the active version on rollback", func(t *testing.T) {
+		t.Parallel()
+
+		router, err := NewRouter()
+		require.NoError(t, err)
+
+		router.configHistory, err = newExecutionConfigHistory(10, "", zap.NewNop())
+		require.NoError(t, err)
+		router.configHistory.Add(&nodev1.RouterConfig{Version: "1"})
+		router.activeRouterConfig = &nodev1.RouterConfig{Version: "1"}
+
+		// Rolling back to the active version doesn't swap the graph server
+		require.NoError(t, router.RollbackExecutionConfig(context.Background(), "1"))
+		require.Equal(t, "1", router.pinnedConfigVersion)
+
+		// Updates are deferred while the version is pinned
+		require.NoError(t, router.updateExecutionConfig(context.Background(), &nodev1.RouterConfig{Version: "2"}))
+		require.Equal(t, "1", router.activeRouterConfig.GetVersion())
+		require.Equal(t, "2", router.pendingExecutionConfig.GetVersion())
+
+		entries := router.ExecutionConfigHistory()
+		require.True(t, entries[0].Pinned)
+	})
+}
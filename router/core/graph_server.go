@@ -36,11 +36,12 @@ import (
 	nodev1 "github.com/wundergraph/cosmo/router/gen/proto/wg/cosmo/node/v1"
 	rjwt "github.com/wundergraph/cosmo/router/internal/jwt"
 	rmiddleware "github.com/wundergraph/cosmo/router/internal/middleware"
+	"github.com/wundergraph/cosmo/router/internal/ratelimit"
 	"github.com/wundergraph/cosmo/router/internal/recoveryhandler"
 	"github.com/wundergraph/cosmo/router/internal/requestlogger"
-	"github.com/wundergraph/cosmo/router/internal/retrytransport"
 	"github.com/wundergraph/cosmo/router/pkg/config"
 	"github.com/wundergraph/cosmo/router/pkg/cors"
+	"github.com/wundergraph/cosmo/router/pkg/costanalysis"
 	"github.com/wundergraph/cosmo/router/pkg/execution_config"
 	"github.com/wundergraph/cosmo/router/pkg/health"
 	"github.com/wundergraph/cosmo/router/pkg/logging"
@@ -97,11 +98,17 @@ type (
 		prometheusEngineMetrics *rmetric.EngineMetrics
 		hostName                string
 		routerListenAddr        string
+		// requestStats is only set during canary rollouts to compare the error rates of graph servers
+		requestStats *requestStats
+		// circuitBreakers is the circuit breaker registry of the router
+		circuitBreakers *circuitBreakerRegistry
+		// bulkheads is the bulkhead registry of the router
+		bulkheads *bulkheadRegistry
 	}
 )
 
-// newGraphServer creates a new server instance.
-func newGraphServer(ctx context.Context, r *Router, routerConfig *nodev1.RouterConfig, proxy ProxyFunc) (*graphServer, error) {
+// newGraphServer creates a new server instance with the given router config.
+func newGraphServer(ctx context.Context, r *Router, cfg *Config, routerConfig *nodev1.RouterConfig, proxy ProxyFunc) (*graphServer, error) {
 	/* Older versions of composition will not populate a compatibility version.
 	 * Currently, all "old" router execution configurations are compatible as there have been no breaking
 	 * changes.
@@ -112,13 +119,17 @@ func newGraphServer(ctx context.Context, r *Router, routerConfig *nodev1.RouterC
 		return nil, fmt.Errorf(`the compatibility version "%s" is not compatible with this router version`, routerConfig.CompatibilityVersion)
 	}
 
+	// The admin API replaces the graph server, so its graph servers are created with the context of the router
+	// instead of the context of this graph server, which is cancelled on shutdown
+	routerCtx := ctx
+
 	ctx, cancel := context.WithCancel(ctx)
 	s := &graphServer{
 		context:                 ctx,
 		cancelFunc:              cancel,
-		Config:                  &r.Config,
+		Config:                  cfg,
 		engineStats:             r.EngineStats,
-		executionTransport:      newHTTPTransport(r.subgraphTransportOptions.TransportRequestOptions, proxy),
+		executionTransport:      newHTTPTransport(cfg.subgraphTransportOptions.TransportRequestOptions, proxy),
 		executionTransportProxy: proxy,
 		playgroundHandler:       r.playgroundHandler,
 		baseRouterConfigVersion: routerConfig.GetVersion(),
@@ -126,12 +137,18 @@ func newGraphServer(ctx context.Context, r *Router, routerConfig *nodev1.RouterC
 		graphMuxList:            make([]*graphMux, 0, 1),
 		routerListenAddr:        r.listenAddr,
 		hostName:                r.hostName,
+		circuitBreakers:         r.circuitBreakers,
+		bulkheads:               r.bulkheads,
 		pubSubProviders: &EnginePubSubProviders{
 			nats:  map[string]pubsub_datasource.NatsPubSub{},
 			kafka: map[string]pubsub_datasource.KafkaPubSub{},
 		},
 	}
 
+	if r.executionConfigCanary != nil && r.executionConfigCanary.Enabled {
+		s.requestStats = &requestStats{}
+	}
+
 	baseOtelAttributes := []attribute.KeyValue{
 		otel.WgRouterVersion.String(Version),
 		otel.WgRouterClusterName.String(r.clusterName),
@@ -262,6 +279,10 @@ func newGraphServer(ctx context.Context, r *Router, routerConfig *nodev1.RouterC
 	httpRouter.Get(s.livenessCheckPath, r.healthcheck.Liveness())
 	httpRouter.Get(s.readinessCheckPath, r.healthcheck.Readiness())
 
+	if s.adminAPI != nil && s.adminAPI.Enabled {
+		httpRouter.Mount(s.adminAPI.Path, r.adminAPIHandler(routerCtx, s.adminAPI.Token))
+	}
+
 	s.mux = httpRouter
 
 	return s, nil
@@ -358,6 +379,8 @@ type graphMux struct {
 	metricStore                rmetric.Store
 	prometheusCacheMetrics     *rmetric.CacheMetrics
 	otelCacheMetrics           *rmetric.CacheMetrics
+	circuitBreakerMetrics      *rmetric.CircuitBreakerMetrics
+	bulkheadMetrics            *rmetric.BulkheadMetrics
 }
 
 // buildOperationCaches creates the caches for the graph mux.
@@ -543,6 +566,50 @@ func (s *graphMux) configureCacheMetrics(srv *graphServer, baseOtelAttributes []
 	return nil
 }
 
+// buildCircuitBreakers collects the circuit breakers of the subgraphs from the router and registers their metrics if
+// enabled.
+func (s *graphMux) buildCircuitBreakers(srv *graphServer, subgraphs []Subgraph, baseAttributes []attribute.KeyValue) (*SubgraphCircuitBreakers, error) {
+	var metrics *rmetric.CircuitBreakerMetrics
+	if srv.metricConfig.IsEnabled() {
+		var err error
+		metrics, err = rmetric.NewCircuitBreakerMetrics(baseAttributes, srv.otlpMeterProvider, srv.promMeterProvider)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create circuit breaker metrics: %w", err)
+		}
+	}
+
+	circuitBreakers := newSubgraphCircuitBreakers(srv.circuitBreakers, srv.subgraphTransportOptions, subgraphs, metrics, srv.logger)
+	if circuitBreakers == nil || metrics == nil {
+		return circuitBreakers, nil
+	}
+
+	if err := metrics.RegisterObserver(circuitBreakers.States); err != nil {
+		return nil, fmt.Errorf("failed to register observer for circuit breaker metrics: %w", err)
+	}
+	s.circuitBreakerMetrics = metrics
+
+	return circuitBreakers, nil
+}
+
+// buildBulkheads collects the bulkheads of the subgraphs from the router and registers their metrics if enabled.
+func (s *graphMux) buildBulkheads(srv *graphServer, subgraphs []Subgraph, baseAttributes []attribute.KeyValue) (*SubgraphBulkheads, error) {
+	bulkheads := newSubgraphBulkheads(srv.bulkheads, srv.subgraphTransportOptions, subgraphs)
+	if bulkheads == nil || !srv.metricConfig.IsEnabled() {
+		return bulkheads, nil
+	}
+
+	metrics, err := rmetric.NewBulkheadMetrics(baseAttributes, srv.otlpMeterProvider, srv.promMeterProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bulkhead metrics: %w", err)
+	}
+	if err := metrics.RegisterObserver(bulkheads.Stats); err != nil {
+		return nil, fmt.Errorf("failed to register observer for bulkhead metrics: %w", err)
+	}
+	s.bulkheadMetrics = metrics
+
+	return bulkheads, nil
+}
+
 func (s *graphMux) Shutdown(ctx context.Context) error {
 	var err error
 
@@ -584,6 +651,18 @@ func (s *graphMux) Shutdown(ctx context.Context) error {
 		}
 	}
 
+	if s.circuitBreakerMetrics != nil {
+		if aErr := s.circuitBreakerMetrics.Shutdown(); aErr != nil {
+			err = errors.Join(err, aErr)
+		}
+	}
+
+	if s.bulkheadMetrics != nil {
+		if aErr := s.bulkheadMetrics.Shutdown(); aErr != nil {
+			err = errors.Join(err, aErr)
+		}
+	}
+
 	if s.metricStore != nil {
 		if aErr := s.metricStore.Shutdown(ctx); aErr != nil {
 			err = errors.Join(err, aErr)
@@ -652,6 +731,10 @@ func (s *graphServer) buildGraphMux(ctx context.Context,
 		gm.metricStore = m
 	}
 
+	if s.requestStats != nil {
+		gm.metricStore = &statsMetricStore{Store: gm.metricStore, stats: s.requestStats}
+	}
+
 	subgraphs, err := configureSubgraphOverwrites(
 		engineConfig,
 		configSubgraphs,
@@ -875,6 +958,33 @@ func (s *graphServer) buildGraphMux(ctx context.Context,
 		return nil, fmt.Errorf("failed to build pubsub configuration: %w", err)
 	}
 
+	// Header rules are applied before the handlers of custom modules
+	var preOriginHandlers []TransportPreHandler
+	var postOriginHandlers []TransportPostHandler
+	if s.headerPropagation.HasRequestRules() {
+		preOriginHandlers = append(preOriginHandlers, s.headerPropagation.OnOriginRequest)
+	}
+	if s.headerPropagation.HasResponseRules() {
+		postOriginHandlers = append(postOriginHandlers, s.headerPropagation.OnOriginResponse)
+	}
+	preOriginHandlers = append(preOriginHandlers, s.preOriginHandlers...)
+	postOriginHandlers = append(postOriginHandlers, s.postOriginHandlers...)
+
+	circuitBreakers, err := gm.buildCircuitBreakers(s, subgraphs, baseMetricAttributes)
+	if err != nil {
+		return nil, err
+	}
+
+	bulkheads, err := gm.buildBulkheads(s, subgraphs, baseMetricAttributes)
+	if err != nil {
+		return nil, err
+	}
+
+	retryOptions, subgraphRetryOptions, err := buildSubgraphRetryOptions(s.retryOptions, s.subgraphTransportOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build subgraph retry options: %w", err)
+	}
+
 	ecb := &ExecutorConfigurationBuilder{
 		introspection:  s.introspection,
 		baseURL:        s.baseURL,
@@ -882,20 +992,15 @@ func (s *graphServer) buildGraphMux(ctx context.Context,
 		logger:         s.logger,
 		trackUsageInfo: s.graphqlMetricsConfig.Enabled,
 		transportOptions: &TransportOptions{
-			Proxy:                    s.executionTransportProxy,
-			SubgraphTransportOptions: s.subgraphTransportOptions,
-			PreHandlers:              s.preOriginHandlers,
-			PostHandlers:             s.postOriginHandlers,
-			MetricStore:              gm.metricStore,
-			RetryOptions: retrytransport.RetryOptions{
-				Enabled:       s.retryOptions.Enabled,
-				MaxRetryCount: s.retryOptions.MaxRetryCount,
-				MaxDuration:   s.retryOptions.MaxDuration,
-				Interval:      s.retryOptions.Interval,
-				ShouldRetry: func(err error, req *http.Request, resp *http.Response) bool {
-					return retrytransport.IsRetryableError(err, resp) && !isMutationRequest(req.Context())
-				},
-			},
+			Proxy:                         s.executionTransportProxy,
+			SubgraphTransportOptions:      s.subgraphTransportOptions,
+			PreHandlers:                   preOriginHandlers,
+			PostHandlers:                  postOriginHandlers,
+			MetricStore:                   gm.metricStore,
+			RetryOptions:                  retryOptions,
+			SubgraphRetryOptions:          subgraphRetryOptions,
+			CircuitBreakers:               circuitBreakers,
+			Bulkheads:                     bulkheads,
 			TracerProvider:                s.tracerProvider,
 			TracePropagators:              s.compositePropagator,
 			LocalhostFallbackInsideDocker: s.localhostFallbackInsideDocker,
@@ -920,6 +1025,18 @@ func (s *graphServer) buildGraphMux(ctx context.Context,
 		return nil, fmt.Errorf("failed to build plan configuration: %w", err)
 	}
 
+	var costCalculator *costanalysis.Calculator
+	if s.securityConfiguration.ComplexityLimits != nil && s.securityConfiguration.ComplexityLimits.Cost != nil && s.securityConfiguration.ComplexityLimits.Cost.Enabled {
+		costConfig := s.securityConfiguration.ComplexityLimits.Cost
+		costCalculator = costanalysis.NewCalculator(executor.RouterSchema, costanalysis.Config{
+			DefaultListSize: costConfig.DefaultListSize,
+			MaxListSize:     costConfig.MaxListSize,
+			FieldWeights:    costConfig.FieldWeights,
+			TypeWeights:     costConfig.TypeWeights,
+			ListSizes:       costConfig.ListSizes,
+		})
+	}
+
 	operationProcessor := NewOperationProcessor(OperationProcessorOptions{
 		Executor:                            executor,
 		MaxOperationSizeInBytes:             int64(s.routerTrafficConfig.MaxRequestBodyBytes),
@@ -935,10 +1052,11 @@ func (s *graphServer) buildGraphMux(ctx context.Context,
 		IntrospectionEnabled:                s.Config.introspection,
 		ApolloCompatibilityFlags:            s.apolloCompatibilityFlags,
 		ApolloRouterCompatibilityFlags:      s.apolloRouterCompatibilityFlags,
+		CostCalculator:                      costCalculator,
 	})
 	operationPlanner := NewOperationPlanner(executor, gm.planCache)
 
-	if s.Config.cacheWarmup != nil && s.Config.cacheWarmup.Enabled {
+	if s.Config.cacheWarmup != nil && s.Config.cacheWarmup.Enabled && !s.dryRun {
 
 		if s.graphApiToken == "" {
 			return nil, fmt.Errorf("graph token is required for cache warmup in order to communicate with the CDN")
@@ -1021,19 +1139,46 @@ func (s *graphServer) buildGraphMux(ctx context.Context,
 		EngineLoaderHooks:                           NewEngineRequestHooks(gm.metricStore, subgraphAccessLogger, s.tracerProvider),
 	}
 
-	if s.redisClient != nil {
+	if (s.redisClient != nil || s.memoryRateLimiter != nil) && s.rateLimit != nil && s.rateLimit.Enabled {
 		handlerOpts.RateLimitConfig = s.rateLimit
-		handlerOpts.RateLimiter, err = NewCosmoRateLimiter(&CosmoRateLimiterOptions{
+		handlerOpts.EnableRateLimitResponseHeaders = s.rateLimit.ResponseHeaders
+		rateLimiterOpts := &CosmoRateLimiterOptions{
 			RedisClient:         s.redisClient,
+			MemoryLimiter:       s.memoryRateLimiter,
 			Debug:               s.rateLimit.Debug,
 			RejectStatusCode:    s.rateLimit.SimpleStrategy.RejectStatusCode,
 			KeySuffixExpression: s.rateLimit.KeySuffixExpression,
-		})
+		}
+		if s.rateLimit.Strategy == "cost" {
+			if s.rateLimit.CostStrategy.TokensExpression == "" && costCalculator == nil {
+				return nil, errors.New("the cost rate limit strategy requires a tokens expression or the cost analysis to be enabled")
+			}
+			rateLimiterOpts.RejectStatusCode = s.rateLimit.CostStrategy.RejectStatusCode
+			rateLimiterOpts.CostBased = true
+			rateLimiterOpts.TokensExpression = s.rateLimit.CostStrategy.TokensExpression
+		}
+		if s.rateLimit.Strategy == "quota" {
+			if len(s.rateLimit.QuotaStrategy.Windows) == 0 {
+				return nil, errors.New("the quota rate limit strategy requires at least one window")
+			}
+			rateLimiterOpts.RejectStatusCode = s.rateLimit.QuotaStrategy.RejectStatusCode
+			rateLimiterOpts.Quota = s.rateLimit.QuotaStrategy
+			if s.memoryQuotaLimiter != nil {
+				rateLimiterOpts.QuotaLimiter = s.memoryQuotaLimiter
+			} else {
+				rateLimiterOpts.QuotaLimiter = ratelimit.NewRedisQuotaLimiter(s.redisClient)
+			}
+		}
+		handlerOpts.RateLimiter, err = NewCosmoRateLimiter(rateLimiterOpts)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create rate limiter: %w", err)
 		}
 	}
 
+	if costCalculator != nil {
+		handlerOpts.ExposeCost = s.securityConfiguration.ComplexityLimits.Cost.ExposeInResponse
+	}
+
 	if s.apolloCompatibilityFlags.SubscriptionMultipartPrintBoundary.Enabled {
 		handlerOpts.ApolloSubscriptionMultipartPrintBoundary = s.apolloCompatibilityFlags.SubscriptionMultipartPrintBoundary.Enabled
 	}
@@ -1061,6 +1206,12 @@ func (s *graphServer) buildGraphMux(ctx context.Context,
 		return nil, fmt.Errorf("failed to create operation blocker: %w", err)
 	}
 
+	complexityLimits := s.securityConfiguration.ComplexityLimits
+	if complexityLimits == nil && handlerOpts.RateLimiter != nil && s.rateLimit.Strategy == "cost" {
+		// The tokens of the cost based rate limit are calculated from the complexity of the operation
+		complexityLimits = &config.ComplexityLimits{}
+	}
+
 	graphqlPreHandler := NewPreHandler(&PreHandlerOptions{
 		Logger:                      s.logger,
 		Executor:                    executor,
@@ -1078,7 +1229,7 @@ func (s *graphServer) buildGraphMux(ctx context.Context,
 		FileUploadEnabled:           s.fileUploadConfig.Enabled,
 		MaxUploadFiles:              s.fileUploadConfig.MaxFiles,
 		MaxUploadFileSize:           int(s.fileUploadConfig.MaxFileSizeBytes),
-		ComplexityLimits:            s.securityConfiguration.ComplexityLimits,
+		ComplexityLimits:            complexityLimits,
 		AlwaysIncludeQueryPlan:      s.engineExecutionConfiguration.Debug.AlwaysIncludeQueryPlan,
 		AlwaysSkipLoader:            s.engineExecutionConfiguration.Debug.AlwaysSkipLoader,
 		QueryPlansEnabled:           s.Config.queryPlansEnabled,
@@ -1182,6 +1333,11 @@ func (s *graphServer) buildPubSubConfiguration(ctx context.Context, engineConfig
 					if err != nil {
 						return fmt.Errorf("failed to build options for Nats provider with ID \"%s\": %w", providerID, err)
 					}
+					// Only the provider reference is validated in dry-run mode
+					if s.dryRun {
+						s.pubSubProviders.nats[providerID] = nil
+						break
+					}
 					natsConnection, err := nats.Connect(eventSource.URL, options...)
 					if err != nil {
 						return fmt.Errorf("failed to create connection for Nats provider with ID \"%s\": %w", providerID, err)
@@ -1218,6 +1374,10 @@ func (s *graphServer) buildPubSubConfiguration(ctx context.Context, engineConfig
 					if err != nil {
 						return fmt.Errorf("failed to build options for Kafka provider with ID \"%s\": %w", providerID, err)
 					}
+					if s.dryRun {
+						s.pubSubProviders.kafka[providerID] = nil
+						break
+					}
 					ps, err := kafka.NewConnector(s.logger, options)
 					if err != nil {
 						return fmt.Errorf("failed to create connection for Kafka provider with ID \"%s\": %w", providerID, err)
@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 
+	"github.com/buger/jsonparser"
 	rErrors "github.com/wundergraph/cosmo/router/internal/errors"
 	rotel "github.com/wundergraph/cosmo/router/pkg/otel"
 	"github.com/wundergraph/graphql-go-tools/v2/pkg/engine/datasource/graphql_datasource"
@@ -75,9 +77,13 @@ type HandlerOptions struct {
 	Authorizer                                  *CosmoAuthorizer
 	RateLimiter                                 *CosmoRateLimiter
 	RateLimitConfig                             *config.RateLimitConfiguration
-	SubgraphErrorPropagation                    config.SubgraphErrorPropagationConfiguration
-	EngineLoaderHooks                           resolve.LoaderHooks
-	ApolloSubscriptionMultipartPrintBoundary    bool
+	// EnableRateLimitResponseHeaders sets the IETF RateLimit and Retry-After headers on responses
+	EnableRateLimitResponseHeaders           bool
+	SubgraphErrorPropagation                 config.SubgraphErrorPropagationConfiguration
+	EngineLoaderHooks                        resolve.LoaderHooks
+	ApolloSubscriptionMultipartPrintBoundary bool
+	// ExposeCost adds the estimated cost of the operation to the extensions of the response
+	ExposeCost bool
 }
 
 func NewGraphQLHandler(opts HandlerOptions) *GraphQLHandler {
@@ -96,9 +102,11 @@ func NewGraphQLHandler(opts HandlerOptions) *GraphQLHandler {
 		authorizer:                               opts.Authorizer,
 		rateLimiter:                              opts.RateLimiter,
 		rateLimitConfig:                          opts.RateLimitConfig,
+		enableRateLimitResponseHeaders:           opts.EnableRateLimitResponseHeaders,
 		subgraphErrorPropagation:                 opts.SubgraphErrorPropagation,
 		engineLoaderHooks:                        opts.EngineLoaderHooks,
 		apolloSubscriptionMultipartPrintBoundary: opts.ApolloSubscriptionMultipartPrintBoundary,
+		exposeCost:                               opts.ExposeCost,
 	}
 	return graphQLHandler
 }
@@ -129,8 +137,10 @@ type GraphQLHandler struct {
 	enablePersistedOperationCacheResponseHeader bool
 	enableNormalizationCacheResponseHeader      bool
 	enableResponseHeaderPropagation             bool
+	enableRateLimitResponseHeaders              bool
 
 	apolloSubscriptionMultipartPrintBoundary bool
+	exposeCost                               bool
 }
 
 func (h *GraphQLHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -171,13 +181,29 @@ func (h *GraphQLHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		h.setDebugCacheHeaders(w, requestContext.operation)
 
+		if h.enableRateLimitResponseHeaders && ctx.RateLimitOptions.Enable {
+			w = &rateLimitHeadersWriter{ResponseWriter: w, ctx: ctx, rateLimiter: h.rateLimiter}
+		}
+
 		if h.enableResponseHeaderPropagation {
 			ctx = WithResponseHeaderPropagation(ctx)
 		}
 
 		defer propagateSubgraphErrors(ctx)
 
-		resp, err := h.executor.Resolver.ResolveGraphQLResponse(ctx, p.Response, nil, HeaderPropagationWriter(w, ctx.Context()))
+		var (
+			writer           = HeaderPropagationWriter(w, ctx.Context())
+			bufferedResponse *bytes.Buffer
+		)
+		// The engine writes a fixed set of extensions and the query plan as JSON. Other plan formats and the cost
+		// extension are added to the buffered response.
+		formatQueryPlan := requestContext.operation.queryPlanFormat != "" && ctx.ExecutionOptions.IncludeQueryPlanInResponse
+		if formatQueryPlan || h.exposeCost {
+			bufferedResponse = &bytes.Buffer{}
+			writer = bufferedResponse
+		}
+
+		resp, err := h.executor.Resolver.ResolveGraphQLResponse(ctx, p.Response, nil, writer)
 		requestContext.dataSourceNames = getSubgraphNames(p.Response.DataSources)
 
 		if err != nil {
@@ -186,6 +212,10 @@ func (h *GraphQLHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		if bufferedResponse != nil {
+			h.writeBufferedResponse(ctx, bufferedResponse.Bytes(), formatQueryPlan, requestContext, w)
+		}
+
 		graphqlExecutionSpan.SetAttributes(rotel.WgAcquireResolverWaitTimeMs.Int64(resp.ResolveAcquireWaitTime.Milliseconds()))
 	case *plan.SubscriptionResponsePlan:
 		var (
@@ -234,6 +264,32 @@ func (h *GraphQLHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// writeBufferedResponse adds the extensions the engine can't write to the response and writes it to the client
+func (h *GraphQLHandler) writeBufferedResponse(ctx *resolve.Context, response []byte, formatQueryPlan bool, requestContext *requestContext, w http.ResponseWriter) {
+	if formatQueryPlan {
+		rendered, err := renderQueryPlanExtension(response, requestContext.operation.queryPlanFormat)
+		if err != nil {
+			// The response is still valid, only the plan stays in the JSON format
+			requestContext.logger.Warn("failed to render query plan", zap.Error(err))
+		} else {
+			response = rendered
+		}
+	}
+
+	if h.exposeCost {
+		withCost, err := jsonparser.Set(response, []byte(strconv.Itoa(requestContext.operation.complexity.Cost)), "extensions", "cost")
+		if err != nil {
+			requestContext.logger.Warn("failed to add cost to response extensions", zap.Error(err))
+		} else {
+			response = withCost
+		}
+	}
+
+	if _, err := HeaderPropagationWriter(w, ctx.Context()).Write(response); err != nil {
+		requestContext.logger.Debug("failed to write response", zap.Error(err))
+	}
+}
+
 func (h *GraphQLHandler) configureRateLimiting(ctx *resolve.Context) *resolve.Context {
 	if h.rateLimiter == nil {
 		return ctx
@@ -244,23 +300,45 @@ func (h *GraphQLHandler) configureRateLimiting(ctx *resolve.Context) *resolve.Co
 	if !h.rateLimitConfig.Enabled {
 		return ctx
 	}
-	if h.rateLimitConfig.Strategy != "simple" {
+	errorExtensionCode := resolve.RateLimitErrorExtensionCode{
+		Enabled: h.rateLimitConfig.ErrorExtensionCode.Enabled,
+		Code:    h.rateLimitConfig.ErrorExtensionCode.Code,
+	}
+	switch h.rateLimitConfig.Strategy {
+	case "simple":
+		ctx.RateLimitOptions = resolve.RateLimitOptions{
+			Enable:                          true,
+			IncludeStatsInResponseExtension: !h.rateLimitConfig.SimpleStrategy.HideStatsFromResponseExtension,
+			Rate:                            h.rateLimitConfig.SimpleStrategy.Rate,
+			Burst:                           h.rateLimitConfig.SimpleStrategy.Burst,
+			Period:                          h.rateLimitConfig.SimpleStrategy.Period,
+			RateLimitKey:                    h.rateLimitConfig.Storage.KeyPrefix,
+			RejectExceedingRequests:         h.rateLimitConfig.SimpleStrategy.RejectExceedingRequests,
+			ErrorExtensionCode:              errorExtensionCode,
+		}
+	case "cost":
+		ctx.RateLimitOptions = resolve.RateLimitOptions{
+			Enable:                          true,
+			IncludeStatsInResponseExtension: !h.rateLimitConfig.CostStrategy.HideStatsFromResponseExtension,
+			Rate:                            h.rateLimitConfig.CostStrategy.Rate,
+			Burst:                           h.rateLimitConfig.CostStrategy.Burst,
+			Period:                          h.rateLimitConfig.CostStrategy.Period,
+			RateLimitKey:                    h.rateLimitConfig.Storage.KeyPrefix,
+			RejectExceedingRequests:         h.rateLimitConfig.CostStrategy.RejectExceedingRequests,
+			ErrorExtensionCode:              errorExtensionCode,
+		}
+	case "quota":
+		ctx.RateLimitOptions = resolve.RateLimitOptions{
+			Enable:                          true,
+			IncludeStatsInResponseExtension: !h.rateLimitConfig.QuotaStrategy.HideStatsFromResponseExtension,
+			RateLimitKey:                    h.rateLimitConfig.Storage.KeyPrefix,
+			RejectExceedingRequests:         h.rateLimitConfig.QuotaStrategy.RejectExceedingRequests,
+			ErrorExtensionCode:              errorExtensionCode,
+		}
+	default:
 		return ctx
 	}
 	ctx.SetRateLimiter(h.rateLimiter)
-	ctx.RateLimitOptions = resolve.RateLimitOptions{
-		Enable:                          true,
-		IncludeStatsInResponseExtension: !h.rateLimitConfig.SimpleStrategy.HideStatsFromResponseExtension,
-		Rate:                            h.rateLimitConfig.SimpleStrategy.Rate,
-		Burst:                           h.rateLimitConfig.SimpleStrategy.Burst,
-		Period:                          h.rateLimitConfig.SimpleStrategy.Period,
-		RateLimitKey:                    h.rateLimitConfig.Storage.KeyPrefix,
-		RejectExceedingRequests:         h.rateLimitConfig.SimpleStrategy.RejectExceedingRequests,
-		ErrorExtensionCode: resolve.RateLimitErrorExtensionCode{
-			Enabled: h.rateLimitConfig.ErrorExtensionCode.Enabled,
-			Code:    h.rateLimitConfig.ErrorExtensionCode.Code,
-		},
-	}
 	return WithRateLimiterStats(ctx)
 }
 
@@ -293,6 +371,10 @@ func (h *GraphQLHandler) WriteError(ctx *resolve.Context, err error, res *resolv
 		response.Errors[0].Message = errMerge.Error()
 	case errorTypeRateLimit:
 		response.Errors[0].Message = "Rate limit exceeded"
+		var burstErr *rateLimitBurstExceededError
+		if errors.As(err, &burstErr) {
+			response.Errors[0].Message = burstErr.Error()
+		}
 		if h.rateLimitConfig.ErrorExtensionCode.Enabled {
 			response.Errors[0].Extensions = &Extensions{
 				Code: h.rateLimitConfig.ErrorExtensionCode.Code,
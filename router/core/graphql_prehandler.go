@@ -249,6 +249,15 @@ func (h *PreHandler) Handler(next http.Handler) http.Handler {
 			return
 		}
 
+		if executionOptions.IncludeQueryPlanInResponse {
+			requestContext.operation.queryPlanFormat, err = parseQueryPlanFormat(r)
+			if err != nil {
+				requestContext.error = err
+				writeRequestErrors(r, w, http.StatusBadRequest, graphqlerrors.RequestErrorsFromError(err), requestLogger)
+				return
+			}
+		}
+
 		requestContext.operation.protocol = OperationProtocolHTTP
 		requestContext.operation.executionOptions = executionOptions
 		requestContext.operation.traceOptions = traceOptions
@@ -783,6 +792,10 @@ func (h *PreHandler) handleOperation(req *http.Request, variablesParser *astjson
 		engineValidateSpan.SetAttributes(otel.WgQueryRootFields.Int(complexityCalcs.RootFields))
 		engineValidateSpan.SetAttributes(otel.WgQueryRootFieldAliases.Int(complexityCalcs.RootFieldAliases))
 		engineValidateSpan.SetAttributes(otel.WgQueryDepthCacheHit.Bool(cacheHit))
+		if h.complexityLimits.Cost != nil && h.complexityLimits.Cost.Enabled {
+			engineValidateSpan.SetAttributes(otel.WgQueryCost.Int(complexityCalcs.Cost))
+		}
+		requestContext.operation.complexity = complexityCalcs
 		if queryDepthErr != nil {
 			rtrace.AttachErrToSpan(engineValidateSpan, err)
 
@@ -116,6 +116,27 @@ func (s *server) SwapGraphServer(ctx context.Context, svr *graphServer) {
 	s.graphServer = svr
 }
 
+// replaceGraphServer swaps the graph server like SwapGraphServer, but returns the previous graph server instead of
+// shutting it down, so that the caller can drain it without blocking other config changes.
+func (s *server) replaceGraphServer(svr *graphServer) *graphServer {
+	s.mu.Lock()
+	s.handler = svr.mux
+	s.mu.Unlock()
+
+	prev := s.graphServer
+	s.graphServer = svr
+
+	return prev
+}
+
+// setHandler replaces the handler without shutting down the current graph server.
+// It is used to split the traffic between graph servers during a canary rollout.
+func (s *server) setHandler(handler http.Handler) {
+	s.mu.Lock()
+	s.handler = handler
+	s.mu.Unlock()
+}
+
 // listenAndServe starts the server and blocks until the server is shutdown.
 func (s *server) listenAndServe() error {
 	if s.tlsConfig != nil && s.tlsConfig.Enabled {
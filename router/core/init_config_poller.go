@@ -8,11 +8,12 @@ import (
 	"github.com/wundergraph/cosmo/router/pkg/execution_config"
 	"github.com/wundergraph/cosmo/router/pkg/routerconfig"
 	configCDNProvider "github.com/wundergraph/cosmo/router/pkg/routerconfig/cdn"
+	configHTTPProvider "github.com/wundergraph/cosmo/router/pkg/routerconfig/http"
 	configs3Provider "github.com/wundergraph/cosmo/router/pkg/routerconfig/s3"
 	"go.uber.org/zap"
 )
 
-func getConfigClient(r *Router, cdnProviders map[string]config.BaseStorageProvider, s3Providers map[string]config.S3StorageProvider, providerID string, isFallbackClient bool) (client *routerconfig.Client, err error) {
+func getConfigClient(r *Router, cdnProviders map[string]config.BaseStorageProvider, s3Providers map[string]config.S3StorageProvider, httpProviders map[string]config.HTTPStorageProvider, providerID string, isFallbackClient bool) (client *routerconfig.Client, err error) {
 	// CDN Providers
 	if provider, ok := cdnProviders[providerID]; ok {
 		if r.graphApiToken == "" {
@@ -57,6 +58,14 @@ func getConfigClient(r *Router, cdnProviders map[string]config.BaseStorageProvid
 			Region:          provider.Region,
 			ObjectPath:      r.routerConfigPollerConfig.Storage.ObjectPath,
 			Secure:          provider.Secure,
+			Logger:          r.logger,
+		}
+
+		if provider.VerifySignature {
+			if r.routerConfigPollerConfig.GraphSignKey == "" {
+				return nil, fmt.Errorf("verifying the signature of the execution config of S3 storage provider '%s' requires the graph sign key", provider.ID)
+			}
+			clientOptions.SignatureKey = r.routerConfigPollerConfig.GraphSignKey
 		}
 
 		if isFallbackClient {
@@ -82,6 +91,45 @@ func getConfigClient(r *Router, cdnProviders map[string]config.BaseStorageProvid
 		return &c, nil
 	}
 
+	// HTTP Providers
+	if provider, ok := httpProviders[providerID]; ok {
+		clientOptions := &configHTTPProvider.Options{
+			Logger:       r.logger,
+			ObjectPath:   r.routerConfigPollerConfig.Storage.ObjectPath,
+			Headers:      provider.Headers,
+			SignatureKey: provider.SignatureKey,
+		}
+
+		if clientOptions.SignatureKey == "" && provider.VerifySignature {
+			if r.routerConfigPollerConfig.GraphSignKey == "" {
+				return nil, fmt.Errorf("verifying the signature of the execution config of HTTP storage provider '%s' requires a signature key or the graph sign key", provider.ID)
+			}
+			clientOptions.SignatureKey = r.routerConfigPollerConfig.GraphSignKey
+		}
+
+		if isFallbackClient {
+			clientOptions.ObjectPath = r.routerConfigPollerConfig.FallbackStorage.ObjectPath
+		}
+
+		c, err := configHTTPProvider.NewClient(provider.URL, clientOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		if isFallbackClient {
+			r.logger.Info("Using HTTP as fallback execution config provider",
+				zap.String("provider_id", provider.ID),
+			)
+		} else {
+			r.logger.Info("Polling for execution config updates from HTTP storage in the background",
+				zap.String("provider_id", provider.ID),
+				zap.String("interval", r.routerConfigPollerConfig.PollInterval.String()),
+			)
+		}
+
+		return &c, nil
+	}
+
 	if providerID != "" {
 		return nil, fmt.Errorf("unknown storage provider id '%s' for execution config", providerID)
 	}
@@ -114,12 +162,12 @@ func getConfigClient(r *Router, cdnProviders map[string]config.BaseStorageProvid
 }
 
 // InitializeConfigPoller creates a poller to fetch execution config. It is only initialized when a config poller is configured and the router is not started with a static config
-func InitializeConfigPoller(r *Router, cdnProviders map[string]config.BaseStorageProvider, s3Providers map[string]config.S3StorageProvider) (*configpoller.ConfigPoller, error) {
+func InitializeConfigPoller(r *Router, cdnProviders map[string]config.BaseStorageProvider, s3Providers map[string]config.S3StorageProvider, httpProviders map[string]config.HTTPStorageProvider) (*configpoller.ConfigPoller, error) {
 	if r.staticExecutionConfig != nil || r.routerConfigPollerConfig == nil || r.configPoller != nil {
 		return nil, nil
 	}
 
-	primaryClient, err := getConfigClient(r, cdnProviders, s3Providers, r.routerConfigPollerConfig.Storage.ProviderID, false)
+	primaryClient, err := getConfigClient(r, cdnProviders, s3Providers, httpProviders, r.routerConfigPollerConfig.Storage.ProviderID, false)
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +182,7 @@ func InitializeConfigPoller(r *Router, cdnProviders map[string]config.BaseStorag
 			return nil, errors.New("cannot use the same storage as both primary and fallback provider for execution config")
 		}
 
-		fallbackClient, err = getConfigClient(r, cdnProviders, s3Providers, r.routerConfigPollerConfig.FallbackStorage.ProviderID, true)
+		fallbackClient, err = getConfigClient(r, cdnProviders, s3Providers, httpProviders, r.routerConfigPollerConfig.FallbackStorage.ProviderID, true)
 		if err != nil {
 			return nil, err
 		}
@@ -10,6 +10,7 @@ import (
 	"sync"
 
 	"github.com/pkg/errors"
+	"github.com/wundergraph/cosmo/router/pkg/schemadiff"
 	"github.com/wundergraph/graphql-go-tools/v2/pkg/graphqlerrors"
 
 	"go.uber.org/zap"
@@ -140,6 +141,21 @@ type Provisioner interface {
 	Provision(*ModuleContext) error
 }
 
+// SchemaChangeHandler is called after the router has swapped to an execution config with a different schema.
+// It allows you to alert on schema changes as they are seen by the router. The handler is called while no other
+// execution config can be applied, so it should not block.
+type SchemaChangeHandler interface {
+	// OnSchemaChange is called with the changes between the previous and the new schema
+	OnSchemaChange(ctx stdContext.Context, event *SchemaChangeEvent)
+}
+
+// SchemaChangeEvent describes the schema changes between two execution configs.
+type SchemaChangeEvent struct {
+	PreviousConfigVersion string
+	ConfigVersion         string
+	Diff                  *schemadiff.Diff
+}
+
 type Cleaner interface {
 	// Cleanup is called after the server stops
 	Cleanup() error
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"hash"
@@ -35,6 +36,7 @@ import (
 	"github.com/wundergraph/cosmo/router/internal/persistedoperation"
 	"github.com/wundergraph/cosmo/router/internal/unsafebytes"
 	"github.com/wundergraph/cosmo/router/pkg/config"
+	"github.com/wundergraph/cosmo/router/pkg/costanalysis"
 )
 
 var (
@@ -109,6 +111,8 @@ type OperationProcessorOptions struct {
 	IntrospectionEnabled           bool
 	ApolloCompatibilityFlags       config.ApolloCompatibilityFlags
 	ApolloRouterCompatibilityFlags config.ApolloRouterCompatibilityFlags
+	// CostCalculator estimates the cost of operations. Cost analysis is disabled if nil.
+	CostCalculator *costanalysis.Calculator
 }
 
 // OperationProcessor provides shared resources to the parseKit and OperationKit.
@@ -122,6 +126,7 @@ type OperationProcessor struct {
 	parseKitSemaphore        chan int
 	introspectionEnabled     bool
 	parseKitOptions          *parseKitOptions
+	costCalculator           *costanalysis.Calculator
 }
 
 // parseKit is a helper struct to parse, normalize and validate operations
@@ -675,6 +680,8 @@ type ComplexityCacheEntry struct {
 	TotalFields      int
 	RootFields       int
 	RootFieldAliases int
+	// Cost is the estimated cost of the operation. Only calculated if cost analysis is enabled.
+	Cost int
 }
 
 func (o *OperationKit) normalizeNonPersistedOperation() (cached bool, err error) {
@@ -1061,8 +1068,9 @@ func (o *OperationKit) Validate(skipLoader bool, remapVariables map[string]strin
 
 // ValidateQueryComplexity validates that the query complexity is within the limits set in the configuration
 func (o *OperationKit) ValidateQueryComplexity(complexityLimitConfig *config.ComplexityLimits, operation, definition *ast.Document, isPersisted bool) (bool, ComplexityCacheEntry, error) {
+	cacheKey := o.complexityCacheKey()
 	if o.cache != nil && o.cache.complexityCache != nil {
-		if cachedComplexity, ok := o.cache.complexityCache.Get(o.parsedOperation.InternalID); ok {
+		if cachedComplexity, ok := o.cache.complexityCache.Get(cacheKey); ok {
 			return ok, cachedComplexity, o.runComplexityComparisons(complexityLimitConfig, cachedComplexity, isPersisted)
 		}
 	}
@@ -1081,13 +1089,46 @@ func (o *OperationKit) ValidateQueryComplexity(complexityLimitConfig *config.Com
 		}
 	}
 
+	if o.operationProcessor.costCalculator != nil {
+		cacheResult.Cost = o.operationProcessor.costCalculator.Cost(operation, o.costVariableValue)
+	}
+
 	if o.cache != nil && o.cache.complexityCache != nil {
-		o.cache.complexityCache.Set(o.parsedOperation.InternalID, cacheResult, 1)
+		o.cache.complexityCache.Set(cacheKey, cacheResult, 1)
 	}
 
 	return false, cacheResult, o.runComplexityComparisons(complexityLimitConfig, cacheResult, isPersisted)
 }
 
+// complexityCacheKey returns the key of the complexity cache. The cost depends on slicing arguments, which are
+// variables after normalization, so the variables are part of the key when cost analysis is enabled.
+func (o *OperationKit) complexityCacheKey() uint64 {
+	variables := o.parsedOperation.Request.Variables
+	if o.operationProcessor.costCalculator == nil || len(variables) == 0 || string(variables) == "{}" {
+		return o.parsedOperation.InternalID
+	}
+
+	o.kit.keyGen.Reset()
+	_ = binary.Write(o.kit.keyGen, binary.LittleEndian, o.parsedOperation.InternalID)
+	_, _ = o.kit.keyGen.Write(variables)
+	key := o.kit.keyGen.Sum64()
+	o.kit.keyGen.Reset()
+
+	return key
+}
+
+// costVariableValue returns the integer value of a variable of the normalized operation for the cost analysis
+func (o *OperationKit) costVariableValue(name string) (int, bool) {
+	if originalName, ok := o.parsedOperation.RemapVariables[name]; ok {
+		name = originalName
+	}
+	value, err := jsonparser.GetInt(o.parsedOperation.Request.Variables, name)
+	if err != nil {
+		return 0, false
+	}
+	return int(value), true
+}
+
 func (o *OperationKit) runComplexityComparisons(complexityLimitConfig *config.ComplexityLimits, cachedComplexity ComplexityCacheEntry, isPersisted bool) error {
 	testComparisons := []complexityComparison{}
 	if complexityLimitConfig.Depth != nil && complexityLimitConfig.Depth.ApplyLimit(isPersisted) {
@@ -1107,6 +1148,11 @@ func (o *OperationKit) runComplexityComparisons(complexityLimitConfig *config.Co
 			complexityComparison{complexityLimitConfig.RootFieldAliases.Limit, cachedComplexity.RootFieldAliases, fmt.Sprintf("The number of root field aliases %d exceeds the root field aliases limit allowed (%d)", cachedComplexity.RootFieldAliases, complexityLimitConfig.RootFieldAliases.Limit)})
 	}
 
+	if complexityLimitConfig.Cost != nil && complexityLimitConfig.Cost.ApplyLimit(isPersisted) {
+		testComparisons = append(testComparisons,
+			complexityComparison{complexityLimitConfig.Cost.Limit, cachedComplexity.Cost, fmt.Sprintf("The estimated query cost %d exceeds the maximum cost allowed (%d)", cachedComplexity.Cost, complexityLimitConfig.Cost.Limit)})
+	}
+
 	for _, comparison := range testComparisons {
 		valid := comparison.field <= 0 || comparison.cachedField <= comparison.field
 		if !valid {
@@ -1201,6 +1247,7 @@ func NewOperationProcessor(opts OperationProcessorOptions) *OperationProcessor {
 		parseKits:                make(map[int]*parseKit, opts.ParseKitPoolSize),
 		parseKitSemaphore:        make(chan int, opts.ParseKitPoolSize),
 		introspectionEnabled:     opts.IntrospectionEnabled,
+		costCalculator:           opts.CostCalculator,
 		parseKitOptions: &parseKitOptions{
 			apolloCompatibilityFlags:       opts.ApolloCompatibilityFlags,
 			apolloRouterCompatibilityFlags: opts.ApolloRouterCompatibilityFlags,
@@ -0,0 +1,246 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/engine/resolve"
+)
+
+type PlanChangeType string
+
+const (
+	// PlanChangeFetchAdded is reported for every subgraph fetch only present in the new plan
+	PlanChangeFetchAdded PlanChangeType = "FETCH_ADDED"
+	// PlanChangeFetchRemoved is reported for every root fetch only present in the old plan
+	PlanChangeFetchRemoved PlanChangeType = "FETCH_REMOVED"
+	// PlanChangeEntityFetchRemoved is reported for every entity call only present in the old plan
+	PlanChangeEntityFetchRemoved PlanChangeType = "ENTITY_FETCH_REMOVED"
+	// PlanChangeSequentialStepsChanged is reported when the number of fetches that have to wait for each other changed
+	PlanChangeSequentialStepsChanged PlanChangeType = "SEQUENTIAL_STEPS_CHANGED"
+)
+
+type PlanChange struct {
+	Type     PlanChangeType `json:"type"`
+	Subgraph string         `json:"subgraph,omitempty"`
+	Path     string         `json:"path,omitempty"`
+	Message  string         `json:"message"`
+}
+
+// QueryPlanDiff compares the fetch trees of an operation planned against two execution configs
+type QueryPlanDiff struct {
+	Changes            []PlanChange `json:"changes"`
+	OldFetches         int          `json:"oldFetches"`
+	NewFetches         int          `json:"newFetches"`
+	OldSequentialSteps int          `json:"oldSequentialSteps"`
+	NewSequentialSteps int          `json:"newSequentialSteps"`
+}
+
+func (d *QueryPlanDiff) HasChanges() bool {
+	return len(d.Changes) > 0
+}
+
+type planFetch struct {
+	kind     string
+	subgraph string
+	path     string
+}
+
+// DiffQueryPlans compares the fetches and the number of sequential steps of two query plans. Fetches are identified
+// by their kind, subgraph and response path. Changes of the subgraph queries themselves are not reported, they
+// change with every selection and don't add subgraph hops.
+func DiffQueryPlans(oldPlan, newPlan *resolve.FetchTreeQueryPlanNode) *QueryPlanDiff {
+	oldFetches := collectPlanFetches(oldPlan, nil)
+	newFetches := collectPlanFetches(newPlan, nil)
+
+	diff := &QueryPlanDiff{
+		OldFetches:         len(oldFetches),
+		NewFetches:         len(newFetches),
+		OldSequentialSteps: sequentialSteps(oldPlan),
+		NewSequentialSteps: sequentialSteps(newPlan),
+	}
+
+	// Fetches are compared as multisets, the same fetch can occur more than once in a plan
+	remaining := make(map[planFetch]int, len(oldFetches))
+	for _, fetch := range oldFetches {
+		remaining[fetch]++
+	}
+
+	for _, fetch := range newFetches {
+		if remaining[fetch] > 0 {
+			remaining[fetch]--
+			continue
+		}
+		diff.Changes = append(diff.Changes, PlanChange{
+			Type:     PlanChangeFetchAdded,
+			Subgraph: fetch.subgraph,
+			Path:     fetch.path,
+			Message:  fmt.Sprintf("%s fetch to subgraph '%s'%s was added", fetch.kind, fetch.subgraph, formatFetchPath(fetch.path)),
+		})
+	}
+
+	for _, fetch := range oldFetches {
+		if remaining[fetch] == 0 {
+			continue
+		}
+		remaining[fetch]--
+
+		changeType := PlanChangeFetchRemoved
+		if fetch.kind != "Single" {
+			changeType = PlanChangeEntityFetchRemoved
+		}
+		diff.Changes = append(diff.Changes, PlanChange{
+			Type:     changeType,
+			Subgraph: fetch.subgraph,
+			Path:     fetch.path,
+			Message:  fmt.Sprintf("%s fetch to subgraph '%s'%s was removed", fetch.kind, fetch.subgraph, formatFetchPath(fetch.path)),
+		})
+	}
+
+	if diff.OldSequentialSteps != diff.NewSequentialSteps {
+		diff.Changes = append(diff.Changes, PlanChange{
+			Type:    PlanChangeSequentialStepsChanged,
+			Message: fmt.Sprintf("Sequential steps changed from %d to %d", diff.OldSequentialSteps, diff.NewSequentialSteps),
+		})
+	}
+
+	return diff
+}
+
+func formatFetchPath(path string) string {
+	if path == "" {
+		return ""
+	}
+	return fmt.Sprintf(" at path '%s'", path)
+}
+
+func collectPlanFetches(node *resolve.FetchTreeQueryPlanNode, fetches []planFetch) []planFetch {
+	if node == nil {
+		return fetches
+	}
+
+	if node.Fetch != nil {
+		fetches = append(fetches, planFetch{
+			kind:     node.Fetch.Kind,
+			subgraph: node.Fetch.SubgraphName,
+			path:     node.Fetch.Path,
+		})
+	}
+	for _, child := range node.Children {
+		fetches = collectPlanFetches(child, fetches)
+	}
+
+	return fetches
+}
+
+// sequentialSteps returns the length of the longest chain of fetches that are executed one after another
+func sequentialSteps(node *resolve.FetchTreeQueryPlanNode) int {
+	if node == nil {
+		return 0
+	}
+
+	switch node.Kind {
+	case resolve.FetchTreeNodeKindSequence:
+		steps := 0
+		for _, child := range node.Children {
+			steps += sequentialSteps(child)
+		}
+		return steps
+	case resolve.FetchTreeNodeKindParallel:
+		steps := 0
+		for _, child := range node.Children {
+			steps = max(steps, sequentialSteps(child))
+		}
+		return steps
+	default:
+		if node.Fetch != nil {
+			return 1
+		}
+		return 0
+	}
+}
+
+// OperationPlanDiff is the result of planning a single operation against both execution configs
+type OperationPlanDiff struct {
+	Name   string     `json:"name"`
+	Status PlanStatus `json:"status"`
+	// Error is set if the operation failed to plan against one of the execution configs
+	Error string `json:"error,omitempty"`
+	*QueryPlanDiff
+}
+
+type PlanRegressionReport struct {
+	// Operations contains the operations with a changed plan or an error, ordered by file name
+	Operations []OperationPlanDiff `json:"operations"`
+	Compared   int                 `json:"compared"`
+	Changed    int                 `json:"changed"`
+	Failed     int                 `json:"failed"`
+	TotalTime  time.Duration       `json:"totalTimeNs"`
+}
+
+// ComparePlans plans all operations of the operations folder against the old execution config of the PlanGenerator
+// and the new one of newGenerator and reports the operations whose fetch tree changed. OutputPath of the options is
+// ignored.
+func (pg *PlanGenerator) ComparePlans(ctx context.Context, newGenerator *PlanGenerator, opts PlanOperationsOptions) (*PlanRegressionReport, error) {
+	names, err := operationFileNames(opts.OperationsPath, opts.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+
+	results := make([]OperationPlanDiff, len(names))
+
+	err = runPlanWorkers(ctx, opts.Concurrency, len(names), func() (func(i int), error) {
+		oldPlanner, err := pg.GetPlanner()
+		if err != nil {
+			return nil, err
+		}
+		newPlanner, err := newGenerator.GetPlanner()
+		if err != nil {
+			return nil, err
+		}
+
+		return func(i int) {
+			operationFilePath := filepath.Join(opts.OperationsPath, names[i])
+			results[i] = OperationPlanDiff{Name: names[i], Status: PlanStatusError}
+
+			oldResult, oldPlan := planOperationFile(oldPlanner, operationFilePath)
+			if oldResult.Status != PlanStatusSuccess {
+				results[i].Error = fmt.Sprintf("old execution config: %s", oldResult.Error)
+				return
+			}
+			newResult, newPlan := planOperationFile(newPlanner, operationFilePath)
+			if newResult.Status != PlanStatusSuccess {
+				results[i].Error = fmt.Sprintf("new execution config: %s", newResult.Error)
+				return
+			}
+
+			results[i].Status = PlanStatusSuccess
+			results[i].QueryPlanDiff = DiffQueryPlans(oldPlan, newPlan)
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &PlanRegressionReport{
+		Compared:  len(results),
+		TotalTime: time.Since(start),
+	}
+	for _, result := range results {
+		switch {
+		case result.Status != PlanStatusSuccess:
+			report.Failed++
+		case result.HasChanges():
+			report.Changed++
+		default:
+			continue
+		}
+		report.Operations = append(report.Operations, result)
+	}
+
+	return report, nil
+}
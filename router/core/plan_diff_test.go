@@ -0,0 +1,96 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/engine/resolve"
+)
+
+func singleFetch(kind, subgraph, path string) *resolve.FetchTreeQueryPlanNode {
+	return &resolve.FetchTreeQueryPlanNode{
+		Kind:  resolve.FetchTreeNodeKindSingle,
+		Fetch: &resolve.FetchTreeQueryPlan{Kind: kind, SubgraphName: subgraph, Path: path},
+	}
+}
+
+func sequence(children ...*resolve.FetchTreeQueryPlanNode) *resolve.FetchTreeQueryPlanNode {
+	return &resolve.FetchTreeQueryPlanNode{Kind: resolve.FetchTreeNodeKindSequence, Children: children}
+}
+
+func parallel(children ...*resolve.FetchTreeQueryPlanNode) *resolve.FetchTreeQueryPlanNode {
+	return &resolve.FetchTreeQueryPlanNode{Kind: resolve.FetchTreeNodeKindParallel, Children: children}
+}
+
+func TestDiffQueryPlans(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unchanged", func(t *testing.T) {
+		t.Parallel()
+
+		plan := sequence(
+			singleFetch("Single", "employees", "query"),
+			parallel(
+				singleFetch("BatchEntity", "products", "query.employees"),
+				singleFetch("BatchEntity", "mood", "query.employees"),
+			),
+		)
+
+		diff := DiffQueryPlans(plan, plan)
+		require.False(t, diff.HasChanges())
+		require.Equal(t, 3, diff.OldFetches)
+		require.Equal(t, 2, diff.OldSequentialSteps)
+	})
+
+	t.Run("additional sequential entity fetch", func(t *testing.T) {
+		t.Parallel()
+
+		oldPlan := sequence(
+			singleFetch("Single", "employees", "query"),
+			parallel(
+				singleFetch("BatchEntity", "products", "query.employees"),
+				singleFetch("BatchEntity", "mood", "query.employees"),
+			),
+		)
+		newPlan := sequence(
+			singleFetch("Single", "employees", "query"),
+			singleFetch("BatchEntity", "products", "query.employees"),
+			singleFetch("BatchEntity", "availability", "query.employees.products"),
+		)
+
+		diff := DiffQueryPlans(oldPlan, newPlan)
+		require.Equal(t, []PlanChange{
+			{
+				Type:     PlanChangeFetchAdded,
+				Subgraph: "availability",
+				Path:     "query.employees.products",
+				Message:  "BatchEntity fetch to subgraph 'availability' at path 'query.employees.products' was added",
+			},
+			{
+				Type:     PlanChangeEntityFetchRemoved,
+				Subgraph: "mood",
+				Path:     "query.employees",
+				Message:  "BatchEntity fetch to subgraph 'mood' at path 'query.employees' was removed",
+			},
+			{
+				Type:    PlanChangeSequentialStepsChanged,
+				Message: "Sequential steps changed from 2 to 3",
+			},
+		}, diff.Changes)
+	})
+
+	t.Run("duplicate fetches", func(t *testing.T) {
+		t.Parallel()
+
+		oldPlan := parallel(singleFetch("Single", "employees", "query"))
+		newPlan := parallel(singleFetch("Single", "employees", "query"), singleFetch("Single", "employees", "query"))
+
+		diff := DiffQueryPlans(oldPlan, newPlan)
+		require.Len(t, diff.Changes, 1)
+		require.Equal(t, PlanChangeFetchAdded, diff.Changes[0].Type)
+
+		diff = DiffQueryPlans(newPlan, oldPlan)
+		require.Len(t, diff.Changes, 1)
+		require.Equal(t, PlanChangeFetchRemoved, diff.Changes[0].Type)
+	})
+}
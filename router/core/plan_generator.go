@@ -26,40 +26,75 @@ import (
 
 type PlanGenerator struct {
 	planConfiguration *plan.Configuration
-	planner           *plan.Planner
+	planner           *Planner
 	definition        *ast.Document
 }
 
+// Planner plans operations against the execution config of the PlanGenerator it was created from.
+// A Planner is not safe for concurrent use, create one per goroutine with PlanGenerator.GetPlanner.
+type Planner struct {
+	planner    *plan.Planner
+	definition *ast.Document
+}
+
 func NewPlanGenerator(configFilePath string) (*PlanGenerator, error) {
 	pg := &PlanGenerator{}
 	if err := pg.loadConfiguration(configFilePath); err != nil {
 		return nil, err
 	}
 
-	planner, err := plan.NewPlanner(*pg.planConfiguration)
+	planner, err := pg.GetPlanner()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create planner: %w", err)
+		return nil, err
 	}
 	pg.planner = planner
 
 	return pg, nil
 }
 
-func (pg *PlanGenerator) PlanOperation(operationFilePath string) (string, error) {
-	operation, err := pg.parseOperation(operationFilePath)
+// GetPlanner returns a new Planner. The plan configuration and the schema are shared between all planners.
+func (pg *PlanGenerator) GetPlanner() (*Planner, error) {
+	planner, err := plan.NewPlanner(*pg.planConfiguration)
 	if err != nil {
-		return "", fmt.Errorf("failed to parse operation: %w", err)
+		return nil, fmt.Errorf("failed to create planner: %w", err)
 	}
 
-	rawPlan, err := pg.planOperation(operation)
+	return &Planner{
+		planner:    planner,
+		definition: pg.definition,
+	}, nil
+}
+
+// PlanOperation plans the operation with the planner of the PlanGenerator. It must not be called concurrently.
+func (pg *PlanGenerator) PlanOperation(operationFilePath string) (string, error) {
+	return pg.planner.PlanOperation(operationFilePath)
+}
+
+func (pl *Planner) PlanOperation(operationFilePath string) (string, error) {
+	rawPlan, err := pl.ParseAndPlanOperation(operationFilePath)
 	if err != nil {
-		return "", fmt.Errorf("failed to plan operation: %w", err)
+		return "", err
 	}
 
 	return rawPlan.PrettyPrint(), nil
 }
 
-func (pg *PlanGenerator) planOperation(operation *ast.Document) (*resolve.FetchTreeQueryPlanNode, error) {
+// ParseAndPlanOperation parses the operation file and returns its query plan
+func (pl *Planner) ParseAndPlanOperation(operationFilePath string) (*resolve.FetchTreeQueryPlanNode, error) {
+	operation, err := pl.parseOperation(operationFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse operation: %w", err)
+	}
+
+	rawPlan, err := pl.planOperation(operation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to plan operation: %w", err)
+	}
+
+	return rawPlan, nil
+}
+
+func (pl *Planner) planOperation(operation *ast.Document) (*resolve.FetchTreeQueryPlanNode, error) {
 	report := operationreport.Report{}
 
 	var operationName []byte
@@ -75,10 +110,10 @@ func (pg *PlanGenerator) planOperation(operation *ast.Document) (*resolve.FetchT
 		return nil, errors.New("operation name not found")
 	}
 
-	astnormalization.NormalizeNamedOperation(operation, pg.definition, operationName, &report)
+	astnormalization.NormalizeNamedOperation(operation, pl.definition, operationName, &report)
 
 	// create and postprocess the plan
-	preparedPlan := pg.planner.Plan(operation, pg.definition, string(operationName), &report, plan.IncludeQueryPlanInResponse())
+	preparedPlan := pl.planner.Plan(operation, pl.definition, string(operationName), &report, plan.IncludeQueryPlanInResponse())
 	if report.HasErrors() {
 		return nil, errors.New(report.Error())
 	}
@@ -92,7 +127,7 @@ func (pg *PlanGenerator) planOperation(operation *ast.Document) (*resolve.FetchT
 	return &resolve.FetchTreeQueryPlanNode{}, nil
 }
 
-func (pg *PlanGenerator) parseOperation(operationFilePath string) (*ast.Document, error) {
+func (pl *Planner) parseOperation(operationFilePath string) (*ast.Document, error) {
 	content, err := os.ReadFile(operationFilePath)
 	if err != nil {
 		return nil, err
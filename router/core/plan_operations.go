@@ -0,0 +1,251 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/engine/resolve"
+
+	"github.com/wundergraph/cosmo/router/pkg/queryplan"
+)
+
+type PlanStatus string
+
+const (
+	PlanStatusSuccess PlanStatus = "success"
+	PlanStatusError   PlanStatus = "error"
+)
+
+type PlanOperationsOptions struct {
+	// OperationsPath is the folder with the .graphql files to plan
+	OperationsPath string
+	// OutputPath is the folder the pretty printed plans are written to. Plans are not written if empty.
+	OutputPath string
+	// Filter contains the file names of the operations to plan. All operations are planned if empty.
+	Filter []string
+	// Concurrency is the number of operations planned in parallel. Defaults to GOMAXPROCS.
+	Concurrency int
+	// Format is the format of the written plans. Defaults to the pretty printed text format.
+	Format queryplan.Format
+}
+
+// OperationPlanResult is the outcome of planning a single operation
+type OperationPlanResult struct {
+	Name         string        `json:"name"`
+	Status       PlanStatus    `json:"status"`
+	PlanningTime time.Duration `json:"planningTimeNs"`
+	// Fetches is the number of subgraph fetches of the plan
+	Fetches int    `json:"fetches"`
+	Error   string `json:"error,omitempty"`
+}
+
+type PlanOperationsReport struct {
+	Operations []OperationPlanResult `json:"operations"`
+	Succeeded  int                   `json:"succeeded"`
+	Failed     int                   `json:"failed"`
+	TotalTime  time.Duration         `json:"totalTimeNs"`
+}
+
+// PlanOperations plans all operations of the operations folder with a pool of planners and returns the result of
+// each operation, ordered by file name. Failing operations are part of the report, errors are only returned when
+// the folders can't be read or written.
+func (pg *PlanGenerator) PlanOperations(ctx context.Context, opts PlanOperationsOptions) (*PlanOperationsReport, error) {
+	names, err := operationFileNames(opts.OperationsPath, opts.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	format := opts.Format
+	if format == "" {
+		format = queryplan.FormatText
+	}
+
+	if opts.OutputPath != "" {
+		if err := os.MkdirAll(opts.OutputPath, 0755); err != nil {
+			return nil, fmt.Errorf("failed to create output directory: %w", err)
+		}
+	}
+
+	start := time.Now()
+
+	results := make([]OperationPlanResult, len(names))
+
+	var (
+		writeErr error
+		errOnce  sync.Once
+	)
+
+	err = runPlanWorkers(ctx, opts.Concurrency, len(names), func() (func(i int), error) {
+		planner, err := pg.GetPlanner()
+		if err != nil {
+			return nil, err
+		}
+
+		return func(i int) {
+			result, rawPlan := planOperationFile(planner, filepath.Join(opts.OperationsPath, names[i]))
+			results[i] = result
+
+			if opts.OutputPath == "" {
+				return
+			}
+
+			// The plan file of a failed operation is empty
+			var content string
+			if rawPlan != nil {
+				rendered, err := queryplan.Render(rawPlan, format)
+				if err != nil {
+					errOnce.Do(func() {
+						writeErr = fmt.Errorf("failed to render plan of operation %s: %w", names[i], err)
+					})
+					return
+				}
+				content = rendered
+			}
+			if err := os.WriteFile(filepath.Join(opts.OutputPath, names[i]), []byte(content), 0644); err != nil {
+				errOnce.Do(func() {
+					writeErr = fmt.Errorf("failed to write plan of operation %s: %w", names[i], err)
+				})
+			}
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if writeErr != nil {
+		return nil, writeErr
+	}
+
+	report := &PlanOperationsReport{
+		Operations: results,
+		TotalTime:  time.Since(start),
+	}
+	for _, result := range results {
+		if result.Status == PlanStatusSuccess {
+			report.Succeeded++
+		} else {
+			report.Failed++
+		}
+	}
+
+	return report, nil
+}
+
+// operationFileNames returns the names of the .graphql files of the folder that match the filter, ordered by name
+func operationFileNames(operationsPath string, filter []string) ([]string, error) {
+	entries, err := os.ReadDir(operationsPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read operations directory: %w", err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".graphql" {
+			continue
+		}
+		if len(filter) > 0 && !slices.Contains(filter, entry.Name()) {
+			continue
+		}
+		names = append(names, entry.Name())
+	}
+
+	return names, nil
+}
+
+// runPlanWorkers calls the work function of a pool of workers for every index in [0, n). Every worker is created by
+// newWorker and runs in its own goroutine, so it can hold a Planner, which is not safe for concurrent use.
+func runPlanWorkers(ctx context.Context, concurrency, n int, newWorker func() (func(i int), error)) error {
+	if concurrency <= 0 {
+		concurrency = runtime.GOMAXPROCS(0)
+	}
+	concurrency = min(concurrency, max(n, 1))
+
+	workers := make([]func(i int), concurrency)
+	for i := range workers {
+		worker, err := newWorker()
+		if err != nil {
+			return err
+		}
+		workers[i] = worker
+	}
+
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for _, worker := range workers {
+		wg.Add(1)
+		go func(worker func(i int)) {
+			defer wg.Done()
+			for i := range jobs {
+				worker(i)
+			}
+		}(worker)
+	}
+
+	for i := 0; i < n; i++ {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return ctx.Err()
+}
+
+// planOperationFile plans the operation and returns its result together with the plan. The plan is nil if the
+// operation failed.
+func planOperationFile(planner *Planner, operationFilePath string) (result OperationPlanResult, rawPlan *resolve.FetchTreeQueryPlanNode) {
+	result.Name = filepath.Base(operationFilePath)
+
+	start := time.Now()
+
+	// A panic while planning a single operation must not abort the whole run
+	defer func() {
+		if r := recover(); r != nil {
+			result.PlanningTime = time.Since(start)
+			result.Status = PlanStatusError
+			result.Error = fmt.Sprintf("panic while planning operation: %v", r)
+			rawPlan = nil
+		}
+	}()
+
+	rawPlan, err := planner.ParseAndPlanOperation(operationFilePath)
+	result.PlanningTime = time.Since(start)
+
+	if err != nil {
+		result.Status = PlanStatusError
+		result.Error = err.Error()
+		return result, nil
+	}
+
+	result.Status = PlanStatusSuccess
+	result.Fetches = countFetches(rawPlan)
+
+	return result, rawPlan
+}
+
+func countFetches(node *resolve.FetchTreeQueryPlanNode) int {
+	if node == nil {
+		return 0
+	}
+
+	count := 0
+	if node.Fetch != nil {
+		count++
+	}
+	for _, child := range node.Children {
+		count += countFetches(child)
+	}
+
+	return count
+}
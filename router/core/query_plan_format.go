@@ -0,0 +1,64 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/buger/jsonparser"
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/engine/resolve"
+
+	"github.com/wundergraph/cosmo/router/pkg/queryplan"
+)
+
+// parseQueryPlanFormat returns the query plan format requested by the client. The engine renders the plan as JSON,
+// so the JSON format is returned as empty to skip rendering.
+func parseQueryPlanFormat(r *http.Request) (queryplan.Format, error) {
+	value := r.Header.Get("X-WG-Query-Plan-Format")
+	if value == "" {
+		value = r.URL.Query().Get("wg_query_plan_format")
+	}
+	if value == "" {
+		return "", nil
+	}
+
+	format, err := queryplan.ParseFormat(value)
+	if err != nil {
+		return "", err
+	}
+	if format == queryplan.FormatJSON {
+		return "", nil
+	}
+
+	return format, nil
+}
+
+// renderQueryPlanExtension replaces the JSON query plan in the extensions of the response with the plan rendered
+// in the given format. The response is returned unchanged if it has no query plan.
+func renderQueryPlanExtension(response []byte, format queryplan.Format) ([]byte, error) {
+	rawPlan, _, _, err := jsonparser.Get(response, "extensions", "queryPlan")
+	if errors.Is(err, jsonparser.KeyPathNotFoundError) {
+		return response, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read query plan from response: %w", err)
+	}
+
+	var plan resolve.FetchTreeQueryPlanNode
+	if err := json.Unmarshal(rawPlan, &plan); err != nil {
+		return nil, fmt.Errorf("failed to parse query plan: %w", err)
+	}
+
+	rendered, err := queryplan.Render(&plan, format)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := json.Marshal(rendered)
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonparser.Set(response, value, "extensions", "queryPlan")
+}
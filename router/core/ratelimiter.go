@@ -7,12 +7,20 @@ import (
 	"errors"
 	"fmt"
 	rd "github.com/wundergraph/cosmo/router/internal/persistedoperation/operationstorage/redis"
+	"github.com/wundergraph/cosmo/router/internal/ratelimit"
 	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/expr-lang/expr/vm"
 	"github.com/go-redis/redis_rate/v10"
 	"github.com/wundergraph/cosmo/router/internal/expr"
+	"github.com/wundergraph/cosmo/router/pkg/authentication"
+	"github.com/wundergraph/cosmo/router/pkg/config"
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/engine/plan"
 	"github.com/wundergraph/graphql-go-tools/v2/pkg/engine/resolve"
 )
 
@@ -20,22 +28,59 @@ var (
 	ErrRateLimitExceeded = errors.New("rate limit exceeded")
 )
 
+// rateLimitBurstExceededError is returned for operations that consume more tokens than the burst of the cost
+// strategy. The bucket never holds enough tokens for them, so they are rejected without waiting.
+type rateLimitBurstExceededError struct {
+	tokens int
+	burst  int
+}
+
+func (e *rateLimitBurstExceededError) Error() string {
+	return fmt.Sprintf("The operation consumes %d rate limit tokens, which exceeds the maximum of %d tokens per operation.", e.tokens, e.burst)
+}
+
+func (e *rateLimitBurstExceededError) Is(target error) bool {
+	return target == ErrRateLimitExceeded
+}
+
 type CosmoRateLimiterOptions struct {
 	RedisClient rd.RDCloser
-	Debug       bool
+	// MemoryLimiter keeps the buckets in memory instead of Redis. RedisClient is ignored if set.
+	MemoryLimiter *ratelimit.MemoryLimiter
+	Debug         bool
 
 	RejectStatusCode int
 
 	KeySuffixExpression string
+
+	// CostBased charges every operation once with its tokens instead of charging a fixed rate per fetch
+	CostBased bool
+	// TokensExpression calculates the tokens of an operation. If empty, the estimated cost of the operation is used.
+	TokensExpression string
+
+	// QuotaLimiter counts the windows of the quota strategy. The quota strategy is used if set.
+	QuotaLimiter ratelimit.QuotaLimiter
+	Quota        config.RateLimitQuotaStrategy
+}
+
+// rateLimitStorage takes tokens from the bucket of a key. It is implemented by the Redis and the memory limiter.
+type rateLimitStorage interface {
+	AllowN(ctx context.Context, key string, limit redis_rate.Limit, n int) (*redis_rate.Result, error)
 }
 
 func NewCosmoRateLimiter(opts *CosmoRateLimiterOptions) (rl *CosmoRateLimiter, err error) {
-	limiter := redis_rate.NewLimiter(opts.RedisClient)
+	var limiter rateLimitStorage
+	if opts.MemoryLimiter != nil {
+		limiter = opts.MemoryLimiter
+	} else {
+		limiter = redis_rate.NewLimiter(opts.RedisClient)
+	}
 	rl = &CosmoRateLimiter{
 		client:           opts.RedisClient,
 		limiter:          limiter,
 		debug:            opts.Debug,
 		rejectStatusCode: opts.RejectStatusCode,
+		costBased:        opts.CostBased,
 	}
 	if rl.rejectStatusCode == 0 {
 		rl.rejectStatusCode = 200
@@ -46,23 +91,50 @@ func NewCosmoRateLimiter(opts *CosmoRateLimiterOptions) (rl *CosmoRateLimiter, e
 			return nil, err
 		}
 	}
+	if opts.QuotaLimiter != nil {
+		rl.quotaLimiter = opts.QuotaLimiter
+		rl.quota = opts.Quota
+		rl.quotaWindows = make([]ratelimit.QuotaWindow, len(opts.Quota.Windows))
+		for i, window := range opts.Quota.Windows {
+			rl.quotaWindows[i] = ratelimit.QuotaWindow{Name: window.Name, Limit: window.Limit, Period: window.Period}
+		}
+	}
+	if opts.CostBased && opts.TokensExpression != "" {
+		rl.tokensProgram, err = expr.CompileOperationCostExpression(opts.TokensExpression)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile tokens expression: %w", err)
+		}
+	}
 	return rl, nil
 }
 
 type CosmoRateLimiter struct {
 	client  rd.RDCloser
-	limiter *redis_rate.Limiter
+	limiter rateLimitStorage
 	debug   bool
 
 	rejectStatusCode int
 
 	keySuffixProgram *vm.Program
+
+	costBased     bool
+	tokensProgram *vm.Program
+
+	quotaLimiter ratelimit.QuotaLimiter
+	quota        config.RateLimitQuotaStrategy
+	quotaWindows []ratelimit.QuotaWindow
 }
 
 func (c *CosmoRateLimiter) RateLimitPreFetch(ctx *resolve.Context, info *resolve.FetchInfo, input json.RawMessage) (result *resolve.RateLimitDeny, err error) {
 	if c.isIntrospectionQuery(info.RootFields) {
 		return nil, nil
 	}
+	if c.quotaLimiter != nil {
+		return c.rateLimitOperation(ctx, c.chargeQuota)
+	}
+	if c.costBased {
+		return c.rateLimitOperation(ctx, c.chargeOperation)
+	}
 	requestRate := c.calculateRate()
 	limit := redis_rate.Limit{
 		Rate:   ctx.RateLimitOptions.Rate,
@@ -77,7 +149,7 @@ func (c *CosmoRateLimiter) RateLimitPreFetch(ctx *resolve.Context, info *resolve
 	if err != nil {
 		return nil, err
 	}
-	c.setRateLimitStats(ctx, key, requestRate, allow.Remaining, allow.RetryAfter.Milliseconds(), allow.ResetAfter.Milliseconds())
+	c.setRateLimitStats(ctx, key, requestRate, 0, allow.Remaining, allow.RetryAfter.Milliseconds(), allow.ResetAfter.Milliseconds())
 	if allow.Allowed >= requestRate {
 		return nil, nil
 	}
@@ -87,6 +159,165 @@ func (c *CosmoRateLimiter) RateLimitPreFetch(ctx *resolve.Context, info *resolve
 	return &resolve.RateLimitDeny{}, nil
 }
 
+// rateLimitOperation charges the operation on the first fetch of the request. Every other fetch of the request gets
+// the same decision, so an operation is charged once no matter how many fetches it has.
+func (c *CosmoRateLimiter) rateLimitOperation(ctx *resolve.Context, charge func(ctx *resolve.Context) (bool, error)) (*resolve.RateLimitDeny, error) {
+	v := ctx.Context().Value(rateLimitStatsCtxKey{})
+	if v == nil {
+		return nil, errors.New("no rate limit stats in context")
+	}
+	statsCtx := v.(*rateLimitStatsCtx)
+
+	statsCtx.chargeOnce.Do(func() {
+		statsCtx.chargeAllowed, statsCtx.chargeErr = charge(ctx)
+	})
+	if statsCtx.chargeErr != nil {
+		return nil, statsCtx.chargeErr
+	}
+	if statsCtx.chargeAllowed {
+		return nil, nil
+	}
+	if ctx.RateLimitOptions.RejectExceedingRequests {
+		return nil, ErrRateLimitExceeded
+	}
+	return &resolve.RateLimitDeny{}, nil
+}
+
+func (c *CosmoRateLimiter) chargeOperation(ctx *resolve.Context) (bool, error) {
+	tokens, err := c.operationTokens(ctx)
+	if err != nil {
+		return false, err
+	}
+	limit := redis_rate.Limit{
+		Rate:   ctx.RateLimitOptions.Rate,
+		Burst:  ctx.RateLimitOptions.Burst,
+		Period: ctx.RateLimitOptions.Period,
+	}
+	key, err := c.generateKey(ctx)
+	if err != nil {
+		return false, err
+	}
+	if tokens > limit.Burst {
+		c.setRateLimitStats(ctx, key, tokens, 0, 0, 0, 0)
+		return false, &rateLimitBurstExceededError{tokens: tokens, burst: limit.Burst}
+	}
+	allow, err := c.limiter.AllowN(ctx.Context(), key, limit, tokens)
+	if err != nil {
+		return false, err
+	}
+	c.setRateLimitStats(ctx, key, tokens, allow.Allowed, allow.Remaining, allow.RetryAfter.Milliseconds(), allow.ResetAfter.Milliseconds())
+	return allow.Allowed >= tokens, nil
+}
+
+// chargeQuota counts the operation in all windows of the quota key
+func (c *CosmoRateLimiter) chargeQuota(ctx *resolve.Context) (bool, error) {
+	key, value, err := c.quotaKey(ctx)
+	if err != nil {
+		return false, err
+	}
+	windows := c.quotaWindows
+	if overrides, ok := c.quota.Overrides[value]; ok && value != "" {
+		windows = make([]ratelimit.QuotaWindow, len(c.quotaWindows))
+		for i, window := range c.quotaWindows {
+			if limit, ok := overrides[window.Name]; ok {
+				window.Limit = limit
+			}
+			windows[i] = window
+		}
+	}
+	res, err := c.quotaLimiter.AllowN(ctx.Context(), key, windows, 1)
+	if err != nil {
+		return false, err
+	}
+	c.setQuotaStats(ctx, key, res)
+	return res.Allowed, nil
+}
+
+// quotaKey returns the key of the quota and the value the key was built from, which is used to look up overrides
+func (c *CosmoRateLimiter) quotaKey(ctx *resolve.Context) (key string, value string, err error) {
+	rc := getRequestContext(ctx.Context())
+	if rc == nil {
+		return "", "", errors.New("no request context")
+	}
+	switch c.quota.KeyBy {
+	case "client_name":
+		if rc.operation != nil && rc.operation.clientInfo != nil {
+			value = rc.operation.clientInfo.Name
+		}
+	case "claim":
+		if auth := authentication.FromContext(ctx.Context()); auth != nil {
+			if claim, ok := auth.Claims()[c.quota.Claim]; ok && claim != nil {
+				value = fmt.Sprint(claim)
+			}
+		}
+	default:
+		if c.keySuffixProgram != nil {
+			value, err = rc.ResolveStringExpression(c.keySuffixProgram)
+			if err != nil {
+				return "", "", fmt.Errorf("failed to resolve key suffix expression: %w", err)
+			}
+		}
+	}
+	if value == "" {
+		return ctx.RateLimitOptions.RateLimitKey, "", nil
+	}
+	return ctx.RateLimitOptions.RateLimitKey + ":" + value, value, nil
+}
+
+// operationTokens returns the tokens the operation consumes. Every operation consumes at least one token.
+func (c *CosmoRateLimiter) operationTokens(ctx *resolve.Context) (int, error) {
+	rc := getRequestContext(ctx.Context())
+	if rc == nil {
+		return 0, errors.New("no request context")
+	}
+	complexity := rc.operation.complexity
+
+	if c.tokensProgram == nil {
+		return max(complexity.Cost, 1), nil
+	}
+
+	cost := expr.OperationCost{
+		Fields:     complexity.TotalFields,
+		RootFields: complexity.RootFields,
+		Depth:      complexity.Depth,
+		Cost:       complexity.Cost,
+	}
+	if rc.operation.preparedPlan != nil {
+		cost.Fetches = planFetchCount(rc.operation.preparedPlan.preparedPlan)
+	}
+
+	tokens, err := expr.ResolveOperationCostExpression(c.tokensProgram, cost)
+	if err != nil {
+		return 0, fmt.Errorf("failed to resolve tokens expression: %w", err)
+	}
+	return max(tokens, 1), nil
+}
+
+func planFetchCount(p plan.Plan) int {
+	switch p := p.(type) {
+	case *plan.SynchronousResponsePlan:
+		return fetchTreeCount(p.Response.Fetches)
+	case *plan.SubscriptionResponsePlan:
+		return fetchTreeCount(p.Response.Response.Fetches)
+	default:
+		return 0
+	}
+}
+
+func fetchTreeCount(node *resolve.FetchTreeNode) int {
+	if node == nil {
+		return 0
+	}
+	count := 0
+	if node.Item != nil && node.Item.Fetch != nil {
+		count++
+	}
+	for _, child := range node.ChildNodes {
+		count += fetchTreeCount(child)
+	}
+	return count
+}
+
 func (c *CosmoRateLimiter) generateKey(ctx *resolve.Context) (string, error) {
 	if c.keySuffixProgram == nil {
 		return ctx.RateLimitOptions.RateLimitKey, nil
@@ -111,11 +342,27 @@ func (c *CosmoRateLimiter) RejectStatusCode() int {
 }
 
 type RateLimitStats struct {
-	Key                    string `json:"key,omitempty"`
-	RequestRate            int    `json:"requestRate"`
+	Key         string `json:"key,omitempty"`
+	RequestRate int    `json:"requestRate"`
+	// Consumed is the number of tokens the operation took from the bucket, denied operations don't consume tokens.
+	// Only set by the cost based rate limiter, RequestRate is the number of tokens the operation asked for.
+	Consumed               int   `json:"consumed,omitempty"`
+	Remaining              int   `json:"remaining"`
+	RetryAfterMilliseconds int64 `json:"retryAfterMs"`
+	ResetAfterMilliseconds int64 `json:"resetAfterMs"`
+	// ExhaustedWindow is the name of the quota window that denied the operation
+	ExhaustedWindow string `json:"exhaustedWindow,omitempty"`
+	// Windows are only set by the quota rate limiter
+	Windows []RateLimitWindowStats `json:"windows,omitempty"`
+}
+
+type RateLimitWindowStats struct {
+	Name                   string `json:"name"`
+	Limit                  int    `json:"limit"`
 	Remaining              int    `json:"remaining"`
-	RetryAfterMilliseconds int64  `json:"retryAfterMs"`
 	ResetAfterMilliseconds int64  `json:"resetAfterMs"`
+	// periodSeconds is used for the RateLimit-Policy header
+	periodSeconds int64
 }
 
 func (c *CosmoRateLimiter) RenderResponseExtension(ctx *resolve.Context, out io.Writer) error {
@@ -144,18 +391,120 @@ func (c *CosmoRateLimiter) calculateRate() int {
 	return 1
 }
 
+// setResponseHeaders sets the IETF RateLimit headers and Retry-After from the stats of the request. Nothing is set if
+// the request didn't pass the rate limiter, e.g. for introspection queries.
+func (c *CosmoRateLimiter) setResponseHeaders(ctx *resolve.Context, header http.Header) {
+	stats := c.getRateLimitStats(ctx)
+	if stats.RequestRate == 0 {
+		return
+	}
+
+	// Allowed requests have a negative retry after
+	denied := stats.RetryAfterMilliseconds > 0
+	if c.debug {
+		stats = debugRateLimitStats(stats)
+	}
+
+	if len(stats.Windows) > 0 {
+		setQuotaResponseHeaders(stats, header, denied)
+		return
+	}
+
+	opts := ctx.RateLimitOptions
+	header.Set("RateLimit-Limit", strconv.Itoa(opts.Burst))
+	header.Set("RateLimit-Remaining", strconv.Itoa(stats.Remaining))
+	header.Set("RateLimit-Reset", strconv.FormatInt(secondsCeil(stats.ResetAfterMilliseconds), 10))
+	header.Set("RateLimit-Policy", fmt.Sprintf("%d;w=%d;burst=%d", opts.Rate, int64(math.Ceil(opts.Period.Seconds())), opts.Burst))
+	if denied {
+		header.Set("Retry-After", strconv.FormatInt(max(secondsCeil(stats.RetryAfterMilliseconds), 1), 10))
+	}
+}
+
+// setQuotaResponseHeaders sets the limit, remaining and reset of the most restrictive window and the policies of
+// all windows
+func setQuotaResponseHeaders(stats RateLimitStats, header http.Header, denied bool) {
+	restrictive := stats.Windows[0]
+	policies := make([]string, len(stats.Windows))
+	for i, window := range stats.Windows {
+		if stats.ExhaustedWindow != "" {
+			if window.Name == stats.ExhaustedWindow {
+				restrictive = window
+			}
+		} else if window.Remaining < restrictive.Remaining {
+			restrictive = window
+		}
+		policies[i] = fmt.Sprintf("%d;w=%d", window.Limit, window.periodSeconds)
+	}
+
+	header.Set("RateLimit-Limit", strconv.Itoa(restrictive.Limit))
+	header.Set("RateLimit-Remaining", strconv.Itoa(restrictive.Remaining))
+	header.Set("RateLimit-Reset", strconv.FormatInt(secondsCeil(restrictive.ResetAfterMilliseconds), 10))
+	header.Set("RateLimit-Policy", strings.Join(policies, ", "))
+	if denied {
+		header.Set("Retry-After", strconv.FormatInt(max(secondsCeil(stats.RetryAfterMilliseconds), 1), 10))
+	}
+}
+
+func secondsCeil(milliseconds int64) int64 {
+	if milliseconds <= 0 {
+		return 0
+	}
+	return (milliseconds + 999) / 1000
+}
+
+// rateLimitHeadersWriter sets the rate limit headers before the status code or the first bytes are written. The
+// stats are only complete once all fetches passed the rate limiter, which is before the engine writes the response.
+type rateLimitHeadersWriter struct {
+	http.ResponseWriter
+	ctx         *resolve.Context
+	rateLimiter *CosmoRateLimiter
+	headersSet  bool
+}
+
+func (w *rateLimitHeadersWriter) setHeaders() {
+	if w.headersSet {
+		return
+	}
+	w.headersSet = true
+	w.rateLimiter.setResponseHeaders(w.ctx, w.ResponseWriter.Header())
+}
+
+func (w *rateLimitHeadersWriter) WriteHeader(statusCode int) {
+	w.setHeaders()
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *rateLimitHeadersWriter) Write(p []byte) (int, error) {
+	w.setHeaders()
+	return w.ResponseWriter.Write(p)
+}
+
 func (c *CosmoRateLimiter) statsJSON(ctx *resolve.Context) ([]byte, error) {
 	stats := c.getRateLimitStats(ctx)
 	if c.debug {
-		stats.ResetAfterMilliseconds = 1234
-		stats.RetryAfterMilliseconds = 1234
+		stats = debugRateLimitStats(stats)
 	} else {
 		stats.Key = "" // hide key when not in debug mode
 	}
 	return json.Marshal(stats)
 }
 
-func (c *CosmoRateLimiter) setRateLimitStats(ctx *resolve.Context, key string, requestRate, remaining int, retryAfter, resetAfter int64) {
+// debugRateLimitStats replaces the durations of the stats with stable values
+func debugRateLimitStats(stats RateLimitStats) RateLimitStats {
+	stats.ResetAfterMilliseconds = 1234
+	stats.RetryAfterMilliseconds = 1234
+	if len(stats.Windows) > 0 {
+		windows := make([]RateLimitWindowStats, len(stats.Windows))
+		for i, window := range stats.Windows {
+			window.ResetAfterMilliseconds = 1234
+			windows[i] = window
+		}
+		stats.Windows = windows
+	}
+	return stats
+}
+
+func (c *CosmoRateLimiter) setRateLimitStats(ctx *resolve.Context, key string, requestRate, consumed, remaining int, retryAfter, resetAfter int64) {
 	v := ctx.Context().Value(rateLimitStatsCtxKey{})
 	if v == nil {
 		return
@@ -164,12 +513,50 @@ func (c *CosmoRateLimiter) setRateLimitStats(ctx *resolve.Context, key string, r
 	statsCtx.mux.Lock()
 	statsCtx.stats.Key = key
 	statsCtx.stats.RequestRate = statsCtx.stats.RequestRate + requestRate
+	statsCtx.stats.Consumed = statsCtx.stats.Consumed + consumed
 	statsCtx.stats.Remaining = remaining
 	statsCtx.stats.RetryAfterMilliseconds = retryAfter
 	statsCtx.stats.ResetAfterMilliseconds = resetAfter
 	statsCtx.mux.Unlock()
 }
 
+// setQuotaStats sets the stats of the quota windows. Remaining and reset are taken from the window with the fewest
+// remaining requests, retry after from the exhausted window.
+func (c *CosmoRateLimiter) setQuotaStats(ctx *resolve.Context, key string, res *ratelimit.QuotaResult) {
+	v := ctx.Context().Value(rateLimitStatsCtxKey{})
+	if v == nil {
+		return
+	}
+	stats := RateLimitStats{
+		Key:             key,
+		RequestRate:     1,
+		Remaining:       math.MaxInt,
+		ExhaustedWindow: res.Exhausted,
+		Windows:         make([]RateLimitWindowStats, len(res.Windows)),
+	}
+	for i, window := range res.Windows {
+		stats.Windows[i] = RateLimitWindowStats{
+			Name:                   window.Name,
+			Limit:                  window.Limit,
+			Remaining:              window.Remaining,
+			ResetAfterMilliseconds: window.ResetAfter.Milliseconds(),
+			periodSeconds:          int64(math.Ceil(window.Period.Seconds())),
+		}
+		if window.Remaining < stats.Remaining {
+			stats.Remaining = window.Remaining
+			stats.ResetAfterMilliseconds = window.ResetAfter.Milliseconds()
+		}
+		if window.Name == res.Exhausted {
+			stats.RetryAfterMilliseconds = max(window.ResetAfter.Milliseconds(), 1)
+		}
+	}
+
+	statsCtx := v.(*rateLimitStatsCtx)
+	statsCtx.mux.Lock()
+	statsCtx.stats = stats
+	statsCtx.mux.Unlock()
+}
+
 func (c *CosmoRateLimiter) getRateLimitStats(ctx *resolve.Context) RateLimitStats {
 	v := ctx.Context().Value(rateLimitStatsCtxKey{})
 	if v == nil {
@@ -182,6 +569,11 @@ func (c *CosmoRateLimiter) getRateLimitStats(ctx *resolve.Context) RateLimitStat
 type rateLimitStatsCtx struct {
 	stats RateLimitStats
 	mux   sync.Mutex
+
+	// The operation is charged once per request by the cost based and the quota rate limiter
+	chargeOnce    sync.Once
+	chargeAllowed bool
+	chargeErr     error
 }
 
 type rateLimitStatsCtxKey struct{}
@@ -0,0 +1,149 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	rd "github.com/wundergraph/cosmo/router/internal/persistedoperation/operationstorage/redis"
+	"github.com/wundergraph/cosmo/router/internal/ratelimit"
+	"github.com/wundergraph/cosmo/router/internal/retrytransport"
+	"github.com/wundergraph/cosmo/router/pkg/config"
+	"github.com/wundergraph/cosmo/router/pkg/cors"
+	"go.uber.org/zap"
+)
+
+// ErrConfigNotReloadable is returned by Router.Reload when the new config changes settings that require a restart.
+var ErrConfigNotReloadable = errors.New("config changes require a restart of the router")
+
+// reloadableConfig is the subset of the router configuration that can be changed without a restart.
+type reloadableConfig struct {
+	headerRules              *config.HeaderRules
+	headerPropagation        *HeaderPropagation
+	corsOptions              *cors.Config
+	routerTrafficConfig      *config.RouterTrafficConfiguration
+	subgraphTransportOptions *SubgraphTransportOptions
+	retryOptions             retrytransport.RetryOptions
+	rateLimit                *config.RateLimitConfiguration
+	securityConfiguration    config.SecurityConfiguration
+	accessLogsConfig         *AccessLogsConfig
+	adminAPI                 *config.AdminAPIConfiguration
+}
+
+func (c *Config) reloadableConfig() reloadableConfig {
+	return reloadableConfig{
+		headerRules:              c.headerRules,
+		headerPropagation:        c.headerPropagation,
+		corsOptions:              c.corsOptions,
+		routerTrafficConfig:      c.routerTrafficConfig,
+		subgraphTransportOptions: c.subgraphTransportOptions,
+		retryOptions:             c.retryOptions,
+		rateLimit:                c.rateLimit,
+		securityConfiguration:    c.securityConfiguration,
+		accessLogsConfig:         c.accessLogsConfig,
+		adminAPI:                 c.adminAPI,
+	}
+}
+
+func (c *Config) applyReloadableConfig(rc reloadableConfig) {
+	c.headerRules = rc.headerRules
+	c.headerPropagation = rc.headerPropagation
+	c.corsOptions = rc.corsOptions
+	c.routerTrafficConfig = rc.routerTrafficConfig
+	c.subgraphTransportOptions = rc.subgraphTransportOptions
+	c.retryOptions = rc.retryOptions
+	c.rateLimit = rc.rateLimit
+	c.securityConfiguration = rc.securityConfiguration
+	c.accessLogsConfig = rc.accessLogsConfig
+	c.adminAPI = rc.adminAPI
+}
+
+// Reload applies header rules, CORS, traffic shaping, rate limiting, security, access log and admin API settings
+// of the given options to a running router. The options are evaluated the same way as in NewRouter and all other
+// settings of the options are ignored.
+// A new graph server is built with a copy of the active config that holds the new settings and is swapped with the
+// active one. Reload returns after the previous graph server has been drained. The config of the running graph
+// servers is never modified.
+// If the options change the rate limit storage while rate limiting is active, ErrConfigNotReloadable is returned and
+// the router keeps running with the current config.
+func (r *Router) Reload(ctx context.Context, opts ...Option) error {
+	if r.shutdown.Load() {
+		return fmt.Errorf("router is shutdown. Create a new instance with router.NewRouter()")
+	}
+
+	next, err := NewRouter(append([]Option{WithLogger(r.logger)}, opts...)...)
+	if err != nil {
+		return fmt.Errorf("failed to apply router config: %w", err)
+	}
+
+	r.serverLock.Lock()
+	defer r.serverLock.Unlock()
+
+	active := r.graphServerConfig()
+
+	if changed := nonReloadableChanges(active, &next.Config); len(changed) > 0 {
+		return fmt.Errorf("%w: %s", ErrConfigNotReloadable, strings.Join(changed, ", "))
+	}
+
+	if r.activeRouterConfig == nil {
+		return errors.New("router has no active execution config to reload")
+	}
+
+	cfg := *active
+	cfg.applyReloadableConfig(next.reloadableConfig())
+
+	if cfg.rateLimit != nil && cfg.rateLimit.Enabled {
+		if cfg.rateLimit.Storage.Provider == "memory" {
+			if cfg.memoryRateLimiter == nil {
+				cfg.memoryRateLimiter = ratelimit.NewMemoryLimiter(ratelimit.MemoryLimiterOptions{
+					MaxKeys: cfg.rateLimit.Storage.MaxKeys,
+				})
+				cfg.memoryQuotaLimiter = ratelimit.NewMemoryQuotaLimiter(ratelimit.MemoryQuotaLimiterOptions{
+					MaxKeys: cfg.rateLimit.Storage.MaxKeys,
+				})
+			}
+		} else if cfg.redisClient == nil {
+			cfg.redisClient, err = rd.NewRedisCloser(&rd.RedisCloserOptions{
+				URLs:           cfg.rateLimit.Storage.URLs,
+				ClusterEnabled: cfg.rateLimit.Storage.ClusterEnabled,
+				Logger:         r.logger,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create redis client: %w", err)
+			}
+		}
+	}
+
+	prev := r.activeConfig
+	r.activeConfig = &cfg
+
+	if err := r.swapGraphServer(ctx, r.activeRouterConfig); err != nil {
+		r.activeConfig = prev
+		if cfg.redisClient != active.redisClient {
+			if closeErr := cfg.redisClient.Close(); closeErr != nil {
+				r.logger.Error("Failed to close redis client", zap.Error(closeErr))
+			}
+		}
+		return err
+	}
+
+	r.logger.Info("Router config reloaded", zap.String("config_version", r.activeRouterConfig.GetVersion()))
+
+	return nil
+}
+
+// nonReloadableChanges returns the settings that differ between the active and the next config
+// but can't be applied to the running router.
+func nonReloadableChanges(active, next *Config) []string {
+	var changed []string
+
+	// The redis client and the memory buckets are shared across graph servers and can't be replaced while in-flight
+	// requests use them
+	if (active.redisClient != nil || active.memoryRateLimiter != nil) && next.rateLimit != nil && next.rateLimit.Enabled && !reflect.DeepEqual(active.rateLimit.Storage, next.rateLimit.Storage) {
+		changed = append(changed, "rate_limit.storage")
+	}
+
+	return changed
+}
@@ -0,0 +1,70 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wundergraph/cosmo/router/internal/ratelimit"
+	"github.com/wundergraph/cosmo/router/pkg/config"
+)
+
+func TestReload(t *testing.T) {
+	t.Parallel()
+
+	t.Run("rejects invalid header rules", func(t *testing.T) {
+		t.Parallel()
+
+		router, err := NewRouter(WithListenerAddr("localhost:3002"))
+		require.NoError(t, err)
+
+		err = router.Reload(context.Background(),
+			WithListenerAddr("localhost:3002"),
+			WithHeaderRules(config.HeaderRules{
+				All: &config.GlobalHeaderRule{
+					Request: []*config.RequestHeaderRule{
+						{
+							Operation: config.HeaderRuleOperationPropagate,
+							Matching:  "[",
+						},
+					},
+				},
+			}),
+		)
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrConfigNotReloadable)
+		require.Nil(t, router.headerPropagation)
+	})
+
+	t.Run("requires an active execution config", func(t *testing.T) {
+		t.Parallel()
+
+		router, err := NewRouter(WithListenerAddr("localhost:3002"))
+		require.NoError(t, err)
+
+		err = router.Reload(context.Background(), WithListenerAddr("localhost:3002"))
+		require.ErrorContains(t, err, "no active execution config")
+	})
+
+	t.Run("rejects changes of the rate limit storage of an active rate limiter", func(t *testing.T) {
+		t.Parallel()
+
+		active := &Config{
+			rateLimit: &config.RateLimitConfiguration{
+				Enabled: true,
+				Storage: config.RedisConfiguration{Provider: "memory"},
+			},
+			memoryRateLimiter: ratelimit.NewMemoryLimiter(ratelimit.MemoryLimiterOptions{}),
+		}
+		next := &Config{
+			rateLimit: &config.RateLimitConfiguration{
+				Enabled: true,
+				Storage: config.RedisConfiguration{Provider: "redis", URLs: []string{"redis://localhost:6379"}},
+			},
+		}
+		require.Equal(t, []string{"rate_limit.storage"}, nonReloadableChanges(active, next))
+
+		next.rateLimit.Storage = active.rateLimit.Storage
+		require.Empty(t, nonReloadableChanges(active, next))
+	})
+}
@@ -0,0 +1,185 @@
+package core
+
+import (
+	"cmp"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/wundergraph/cosmo/router/internal/retrytransport"
+	"github.com/wundergraph/cosmo/router/pkg/config"
+	rotel "github.com/wundergraph/cosmo/router/pkg/otel"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	otrace "go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// The defaults of a retry policy that is configured for a specific subgraph
+const (
+	defaultRetryMaxAttempts = 5
+	defaultRetryInterval    = 3 * time.Second
+	defaultRetryMaxDuration = 10 * time.Second
+)
+
+// buildSubgraphRetryOptions creates the retry options of all subgraphs and of every subgraph with its own retry policy.
+// The retry options of all subgraphs are based on the given options. Their retryable errors, Retry-After handling and
+// retry budget are taken from the traffic shaping rules of all subgraphs.
+func buildSubgraphRetryOptions(all retrytransport.RetryOptions, opts *SubgraphTransportOptions) (retrytransport.RetryOptions, map[string]retrytransport.RetryOptions, error) {
+	var allPolicy config.BackoffJitterRetry
+	if opts != nil && opts.TransportRequestOptions != nil {
+		allPolicy = opts.TransportRequestOptions.Retry
+	}
+
+	all, err := newRetryOptions(all, allPolicy, false)
+	if err != nil {
+		return retrytransport.RetryOptions{}, nil, err
+	}
+
+	if opts == nil {
+		return all, nil, nil
+	}
+
+	subgraphs := make(map[string]retrytransport.RetryOptions)
+	for name, subgraphOpts := range opts.SubgraphMap {
+		if subgraphOpts == nil || !subgraphOpts.Retry.IsConfigured() {
+			continue
+		}
+
+		policy := subgraphOpts.Retry
+		subgraphs[name], err = newRetryOptions(retrytransport.RetryOptions{
+			Enabled:       policy.IsEnabled(),
+			MaxRetryCount: cmp.Or(policy.MaxAttempts, defaultRetryMaxAttempts),
+			Interval:      cmp.Or(policy.Interval, defaultRetryInterval),
+			MaxDuration:   cmp.Or(policy.MaxDuration, defaultRetryMaxDuration),
+		}, policy, true)
+		if err != nil {
+			return retrytransport.RetryOptions{}, nil, fmt.Errorf("invalid retry configuration of subgraph '%s': %w", name, err)
+		}
+	}
+
+	return all, subgraphs, nil
+}
+
+// newRetryOptions completes the retry options with the retryable errors, Retry-After handling and retry budget of the
+// policy. Mutations are never retried. The Retry-After header is respected by default for the retry policies of
+// specific subgraphs only, so that the retries of all subgraphs behave as before.
+func newRetryOptions(opts retrytransport.RetryOptions, policy config.BackoffJitterRetry, respectRetryAfter bool) (retrytransport.RetryOptions, error) {
+	classifier, err := retrytransport.NewClassifier(policy.StatusCodes, policy.ErrorClasses)
+	if err != nil {
+		return retrytransport.RetryOptions{}, err
+	}
+
+	budget := policy.RequestBudget
+
+	opts.RespectRetryAfter = or(policy.RespectRetryAfter, respectRetryAfter)
+	opts.OnRetry = recordSubgraphRetry
+	opts.ShouldRetry = func(err error, req *http.Request, resp *http.Response) bool {
+		if !classifier.IsRetryable(err, resp) || isMutationRequest(req.Context()) {
+			return false
+		}
+		return budget <= 0 || takeSubgraphRetryBudget(req, budget)
+	}
+
+	return opts, nil
+}
+
+// subgraphRetryBudget counts the retries of the subgraph requests of a client request by subgraph name
+type subgraphRetryBudget struct {
+	mu      sync.Mutex
+	retries map[string]int
+}
+
+// take counts a retry of the subgraph unless the subgraph already used up the budget
+func (b *subgraphRetryBudget) take(subgraph string, budget int) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.retries == nil {
+		b.retries = make(map[string]int)
+	}
+	if b.retries[subgraph] >= budget {
+		return false
+	}
+	b.retries[subgraph]++
+
+	return true
+}
+
+func takeSubgraphRetryBudget(req *http.Request, budget int) bool {
+	reqContext := getRequestContext(req.Context())
+	if reqContext == nil {
+		return true
+	}
+
+	var subgraphName string
+	if subgraph := reqContext.ActiveSubgraph(req); subgraph != nil {
+		subgraphName = subgraph.Name
+	}
+
+	return reqContext.subgraphRetries.take(subgraphName, budget)
+}
+
+// recordSubgraphRetry adds an event for the retry with the result of the failed attempt to the span of the request
+func recordSubgraphRetry(count int, req *http.Request, resp *http.Response, err error) {
+	span := otrace.SpanFromContext(req.Context())
+	if !span.IsRecording() {
+		return
+	}
+
+	attributes := []attribute.KeyValue{
+		rotel.WgSubgraphRetryAttempt.Int(count + 1),
+	}
+
+	if reqContext := getRequestContext(req.Context()); reqContext != nil {
+		if subgraph := reqContext.ActiveSubgraph(req); subgraph != nil {
+			attributes = append(attributes, rotel.WgSubgraphName.String(subgraph.Name))
+		}
+	}
+	if resp != nil {
+		attributes = append(attributes, semconv.HTTPStatusCode(resp.StatusCode))
+	}
+	if err != nil {
+		attributes = append(attributes, rotel.WgSubgraphRetryError.String(err.Error()))
+	}
+
+	span.AddEvent("Subgraph request retry", otrace.WithAttributes(attributes...))
+}
+
+// subgraphRetryTransport retries the requests of a subgraph with the retry options of the subgraph. Subgraphs without
+// their own retry policy use the retry options of all subgraphs.
+type subgraphRetryTransport struct {
+	roundTripper http.RoundTripper
+	subgraphs    map[string]http.RoundTripper
+}
+
+func newSubgraphRetryTransport(roundTripper http.RoundTripper, all retrytransport.RetryOptions, subgraphs map[string]retrytransport.RetryOptions, logger *zap.Logger) *subgraphRetryTransport {
+	withRetries := func(opts retrytransport.RetryOptions) http.RoundTripper {
+		if !opts.Enabled {
+			return roundTripper
+		}
+		return retrytransport.NewRetryHTTPTransport(roundTripper, opts, logger)
+	}
+
+	t := &subgraphRetryTransport{
+		roundTripper: withRetries(all),
+		subgraphs:    make(map[string]http.RoundTripper, len(subgraphs)),
+	}
+	for name, opts := range subgraphs {
+		t.subgraphs[name] = withRetries(opts)
+	}
+
+	return t
+}
+
+func (t *subgraphRetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if reqContext := getRequestContext(req.Context()); reqContext != nil {
+		if subgraph := reqContext.ActiveSubgraph(req); subgraph != nil {
+			if roundTripper, ok := t.subgraphs[subgraph.Name]; ok {
+				return roundTripper.RoundTrip(req)
+			}
+		}
+	}
+	return t.roundTripper.RoundTrip(req)
+}
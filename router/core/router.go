@@ -14,6 +14,7 @@ import (
 	"time"
 
 	rd "github.com/wundergraph/cosmo/router/internal/persistedoperation/operationstorage/redis"
+	"github.com/wundergraph/cosmo/router/internal/ratelimit"
 
 	"connectrpc.com/connect"
 	"github.com/mitchellh/mapstructure"
@@ -45,7 +46,9 @@ import (
 	"github.com/wundergraph/cosmo/router/pkg/execution_config"
 	"github.com/wundergraph/cosmo/router/pkg/health"
 	rmetric "github.com/wundergraph/cosmo/router/pkg/metric"
+	rotel "github.com/wundergraph/cosmo/router/pkg/otel"
 	"github.com/wundergraph/cosmo/router/pkg/otel/otelconfig"
+	"github.com/wundergraph/cosmo/router/pkg/routerconfig"
 	"github.com/wundergraph/cosmo/router/pkg/statistics"
 	rtrace "github.com/wundergraph/cosmo/router/pkg/trace"
 	"github.com/wundergraph/cosmo/router/pkg/watcher"
@@ -84,6 +87,28 @@ type (
 		EngineStats       statistics.EngineStatistics
 		playgroundHandler func(http.Handler) http.Handler
 		proxy             ProxyFunc
+		shutdown          atomic.Bool
+		bootstrapped      atomic.Bool
+		// serverLock serializes graph server swaps from the config poller, the file watchers and reloads
+		serverLock sync.Mutex
+		// activeConfig is the config new graph servers are built with. It is replaced by Reload and is the embedded
+		// config until the first reload. Guarded by the serverLock.
+		activeConfig *Config
+		// activeRouterConfig is the execution config of the graph server that is currently serving traffic
+		activeRouterConfig *nodev1.RouterConfig
+		configHistory      *executionConfigHistory
+		// pinnedConfigVersion is set after a rollback. Updates are stored in pendingExecutionConfig until the pin is released.
+		pinnedConfigVersion    string
+		pendingExecutionConfig *nodev1.RouterConfig
+		// canary is the running canary rollout of a new execution config
+		canary              *canaryRollout
+		schemaChangeMetrics *rmetric.SchemaChangeMetrics
+		// devComposer composes the execution config from local subgraphs in dev composition mode
+		devComposer *devComposer
+		// circuitBreakers holds the circuit breakers of the subgraphs across graph servers
+		circuitBreakers *circuitBreakerRegistry
+		// bulkheads holds the bulkheads of the subgraphs across graph servers
+		bulkheads *bulkheadRegistry
 	}
 
 	TransportRequestOptions struct {
@@ -98,6 +123,10 @@ type (
 		MaxConnsPerHost     int
 		MaxIdleConns        int
 		MaxIdleConnsPerHost int
+
+		Retry          config.BackoffJitterRetry
+		CircuitBreaker config.CircuitBreaker
+		Bulkhead       config.Bulkhead
 	}
 
 	SubgraphTransportOptions struct {
@@ -138,6 +167,8 @@ type (
 	ExecutionConfig struct {
 		Watch bool
 		Path  string
+		// SignKey is used to verify the signature of the config in the sidecar file <Path>.sig
+		SignKey string
 	}
 
 	AccessLogsConfig struct {
@@ -163,8 +194,7 @@ type (
 		routerGracePeriod               time.Duration
 		staticExecutionConfig           *nodev1.RouterConfig
 		awsLambda                       bool
-		shutdown                        atomic.Bool
-		bootstrapped                    atomic.Bool
+		dryRun                          bool
 		ipAnonymization                 *IPAnonymizationConfig
 		listenAddr                      string
 		baseURL                         string
@@ -196,6 +226,7 @@ type (
 		preOriginHandlers               []TransportPreHandler
 		postOriginHandlers              []TransportPostHandler
 		headerRules                     *config.HeaderRules
+		headerPropagation               *HeaderPropagation
 		subgraphTransportOptions        *SubgraphTransportOptions
 		graphqlMetricsConfig            *GraphQLMetricsConfig
 		routerTrafficConfig             *config.RouterTrafficConfiguration
@@ -203,6 +234,8 @@ type (
 		accessController                *AccessController
 		retryOptions                    retrytransport.RetryOptions
 		redisClient                     rd.RDCloser
+		memoryRateLimiter               *ratelimit.MemoryLimiter
+		memoryQuotaLimiter              *ratelimit.MemoryQuotaLimiter
 		processStartTime                time.Time
 		developmentMode                 bool
 		healthcheck                     health.Checker
@@ -233,6 +266,11 @@ type (
 		cacheWarmup                *config.CacheWarmupConfiguration
 		multipartHeartbeatInterval time.Duration
 		hostName                   string
+		executionConfigHistory     *config.ExecutionConfigHistory
+		adminAPI                   *config.AdminAPIConfiguration
+		executionConfigCanary      *config.ExecutionConfigCanary
+		devComposition             *config.DevComposition
+		devCompositionComposer     DevComposer
 	}
 	// Option defines the method to customize server.
 	Option func(svr *Router)
@@ -242,7 +280,9 @@ type (
 // Alternatively, use Router.NewServer() to create a new server instance without starting it.
 func NewRouter(opts ...Option) (*Router, error) {
 	r := &Router{
-		EngineStats: statistics.NewNoopEngineStats(),
+		EngineStats:     statistics.NewNoopEngineStats(),
+		circuitBreakers: newCircuitBreakerRegistry(),
+		bulkheads:       newBulkheadRegistry(),
 	}
 
 	for _, opt := range opts {
@@ -343,17 +383,38 @@ func NewRouter(opts ...Option) (*Router, error) {
 		r.livenessCheckPath = "/health/live"
 	}
 
-	r.headerRules = AddCacheControlPolicyToRules(r.headerRules, r.cacheControlPolicy)
-	hr, err := NewHeaderPropagation(r.headerRules)
-	if err != nil {
-		return nil, err
+	if r.adminAPI != nil && r.adminAPI.Enabled {
+		if r.adminAPI.Token == "" {
+			return nil, errors.New("admin api token must be set when the admin api is enabled")
+		}
+		if r.adminAPI.Path == "" {
+			r.adminAPI.Path = "/admin"
+		}
 	}
 
-	if hr.HasRequestRules() {
-		r.preOriginHandlers = append(r.preOriginHandlers, hr.OnOriginRequest)
+	if r.executionConfigCanary != nil && r.executionConfigCanary.Enabled {
+		if r.executionConfigCanary.TrafficShare <= 0 || r.executionConfigCanary.TrafficShare >= 1 {
+			return nil, fmt.Errorf("execution config canary traffic share must be between 0 and 1, got %v", r.executionConfigCanary.TrafficShare)
+		}
+		if r.executionConfigCanary.Duration <= 0 || r.executionConfigCanary.CheckInterval <= 0 {
+			return nil, errors.New("execution config canary duration and check interval must be greater than 0")
+		}
 	}
-	if hr.HasResponseRules() {
-		r.postOriginHandlers = append(r.postOriginHandlers, hr.OnOriginResponse)
+
+	if r.devComposition != nil && r.devComposition.Enabled {
+		if err := validateDevComposition(r.devComposition); err != nil {
+			return nil, err
+		}
+		if r.devCompositionComposer == nil {
+			return nil, errors.New("dev composition requires a composer. Run the dev router of the router-dev module instead")
+		}
+	}
+
+	r.headerRules = AddCacheControlPolicyToRules(r.headerRules, r.cacheControlPolicy)
+	var err error
+	r.headerPropagation, err = NewHeaderPropagation(r.headerRules)
+	if err != nil {
+		return nil, err
 	}
 
 	defaultHeaders := []string{
@@ -594,16 +655,54 @@ func NewRouter(opts ...Option) (*Router, error) {
 	return r, nil
 }
 
-// newGraphServer creates a new server.
+// newServer creates a new graph server and swaps it with the current one.
 func (r *Router) newServer(ctx context.Context, cfg *nodev1.RouterConfig) error {
-	server, err := newGraphServer(ctx, r, cfg, r.proxy)
+	r.serverLock.Lock()
+	defer r.serverLock.Unlock()
+
+	return r.swapGraphServer(ctx, cfg)
+}
+
+// graphServerConfig returns the config new graph servers are built with. Must be called with the serverLock held.
+func (r *Router) graphServerConfig() *Config {
+	if r.activeConfig != nil {
+		return r.activeConfig
+	}
+	return &r.Config
+}
+
+// swapGraphServer must be called with the serverLock held. A running canary rollout is restarted next to the new
+// graph server, unless the new graph server serves the execution config of the canary.
+func (r *Router) swapGraphServer(ctx context.Context, cfg *nodev1.RouterConfig) error {
+	server, err := newGraphServer(ctx, r, r.graphServerConfig(), cfg, r.proxy)
 	if err != nil {
 		r.logger.Error("Failed to create graph server. Keeping the old server", zap.Error(err))
 		return err
 	}
 
+	var canaryCfg *nodev1.RouterConfig
+	if r.canary != nil && r.canary.canaryCfg != cfg {
+		canaryCfg = r.canary.canaryCfg
+	}
+	r.stopCanary(ctx, "")
+
 	r.httpServer.SwapGraphServer(ctx, server)
 
+	if r.configHistory != nil && r.activeRouterConfig != cfg {
+		r.configHistory.Add(cfg)
+	}
+	r.reportSchemaChanges(ctx, r.activeRouterConfig, cfg)
+	r.activeRouterConfig = cfg
+
+	if canaryCfg != nil {
+		r.logger.Info("Restarting canary rollout of execution config next to the new graph server",
+			zap.String("canary_version", canaryCfg.GetVersion()),
+		)
+		if err := r.startCanary(ctx, canaryCfg); err != nil {
+			return fmt.Errorf("failed to restart canary rollout: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -727,6 +826,14 @@ func (r *Router) NewServer(ctx context.Context) (Server, error) {
 		healthCheckPath:    r.healthCheckPath,
 	})
 
+	if r.devComposer != nil {
+		cfg, err := r.devComposer.compose(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compose subgraphs: %w", err)
+		}
+		return r.httpServer, r.newServer(ctx, cfg)
+	}
+
 	// Start the server with the static config without polling
 	if r.staticExecutionConfig != nil {
 		r.logger.Info("Static execution config provided. Polling is disabled. Updating execution config is only possible by providing a config.")
@@ -827,6 +934,14 @@ func (r *Router) bootstrap(ctx context.Context) error {
 			r.otlpMeterProvider = mp
 		}
 
+		schemaChangeMetrics, err := rmetric.NewSchemaChangeMetrics([]attribute.KeyValue{
+			rotel.WgRouterVersion.String(Version),
+			rotel.WgRouterClusterName.String(r.clusterName),
+		}, r.otlpMeterProvider, r.promMeterProvider)
+		if err != nil {
+			return fmt.Errorf("failed to create schema change metrics: %w", err)
+		}
+		r.schemaChangeMetrics = schemaChangeMetrics
 	}
 
 	if r.graphqlMetricsConfig.Enabled {
@@ -850,16 +965,24 @@ func (r *Router) bootstrap(ctx context.Context) error {
 	}
 
 	if r.Config.rateLimit != nil && r.Config.rateLimit.Enabled {
-		var err error
-		r.redisClient, err = rd.NewRedisCloser(&rd.RedisCloserOptions{
-			URLs:           r.Config.rateLimit.Storage.URLs,
-			ClusterEnabled: r.Config.rateLimit.Storage.ClusterEnabled,
-			Logger:         r.logger,
-		})
-		if err != nil {
-			return fmt.Errorf("failed to create redis client: %w", err)
+		if r.Config.rateLimit.Storage.Provider == "memory" {
+			r.memoryRateLimiter = ratelimit.NewMemoryLimiter(ratelimit.MemoryLimiterOptions{
+				MaxKeys: r.Config.rateLimit.Storage.MaxKeys,
+			})
+			r.memoryQuotaLimiter = ratelimit.NewMemoryQuotaLimiter(ratelimit.MemoryQuotaLimiterOptions{
+				MaxKeys: r.Config.rateLimit.Storage.MaxKeys,
+			})
+		} else {
+			var err error
+			r.redisClient, err = rd.NewRedisCloser(&rd.RedisCloserOptions{
+				URLs:           r.Config.rateLimit.Storage.URLs,
+				ClusterEnabled: r.Config.rateLimit.Storage.ClusterEnabled,
+				Logger:         r.logger,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create redis client: %w", err)
+			}
 		}
-
 	}
 
 	if r.metricConfig.OpenTelemetry.EngineStats.Enabled() || r.metricConfig.Prometheus.EngineStats.Enabled() || r.engineExecutionConfiguration.Debug.ReportWebSocketConnections {
@@ -870,22 +993,28 @@ func (r *Router) bootstrap(ctx context.Context) error {
 		debug.ReportMemoryUsage(ctx, r.logger)
 	}
 
-	if r.playgroundConfig.Enabled {
-		playgroundUrl, err := url.JoinPath(r.baseURL, r.playgroundConfig.Path)
+	if err := r.initPlayground(); err != nil {
+		return err
+	}
+
+	if r.executionConfigHistory != nil && r.executionConfigHistory.Enabled {
+		history, err := newExecutionConfigHistory(r.executionConfigHistory.Size, r.executionConfigHistory.Dir, r.logger)
 		if err != nil {
-			return fmt.Errorf("failed to join playground url: %w", err)
-		}
-		r.logger.Info("Serving GraphQL playground", zap.String("url", playgroundUrl))
-		r.playgroundHandler = graphiql.NewPlayground(&graphiql.PlaygroundOptions{
-			Html:             graphiql.PlaygroundHTML(),
-			GraphqlURL:       r.graphqlWebURL,
-			PlaygroundPath:   r.playgroundPath,
-			ConcurrencyLimit: int64(r.playgroundConfig.ConcurrencyLimit),
-		})
+			return fmt.Errorf("failed to create execution config history: %w", err)
+		}
+		r.configHistory = history
+	}
+
+	if r.devComposition != nil && r.devComposition.Enabled {
+		r.devComposer = &devComposer{
+			config:   r.devComposition,
+			composer: r.devCompositionComposer,
+			logger:   r.logger,
+		}
 	}
 
 	if r.executionConfig != nil && r.executionConfig.Path != "" {
-		executionConfig, err := execution_config.FromFile(r.executionConfig.Path)
+		executionConfig, err := execution_config.FromSignedFile(r.executionConfig.Path, r.executionConfig.SignKey)
 		if err != nil {
 			return fmt.Errorf("failed to read execution config: %w", err)
 		}
@@ -914,11 +1043,34 @@ func (r *Router) bootstrap(ctx context.Context) error {
 	return nil
 }
 
+// initPlayground creates the playground handler if the playground is enabled.
+func (r *Router) initPlayground() error {
+	if !r.playgroundConfig.Enabled {
+		return nil
+	}
+
+	playgroundUrl, err := url.JoinPath(r.baseURL, r.playgroundConfig.Path)
+	if err != nil {
+		return fmt.Errorf("failed to join playground url: %w", err)
+	}
+	r.logger.Info("Serving GraphQL playground", zap.String("url", playgroundUrl))
+	r.playgroundHandler = graphiql.NewPlayground(&graphiql.PlaygroundOptions{
+		Html:             graphiql.PlaygroundHTML(),
+		GraphqlURL:       r.graphqlWebURL,
+		PlaygroundPath:   r.playgroundPath,
+		ConcurrencyLimit: int64(r.playgroundConfig.ConcurrencyLimit),
+	})
+
+	return nil
+}
+
 // buildClients initializes the storage clients for persisted operations and router config.
+// In a dry run, the clients are built without connecting to Redis.
 func (r *Router) buildClients() error {
 	s3Providers := map[string]config.S3StorageProvider{}
 	cdnProviders := map[string]config.BaseStorageProvider{}
 	redisProviders := map[string]config.RedisStorageProvider{}
+	httpProviders := map[string]config.HTTPStorageProvider{}
 
 	for _, provider := range r.storageProviders.S3 {
 		if _, ok := s3Providers[provider.ID]; ok {
@@ -941,6 +1093,13 @@ func (r *Router) buildClients() error {
 		redisProviders[provider.ID] = provider
 	}
 
+	for _, provider := range r.storageProviders.HTTP {
+		if _, ok := httpProviders[provider.ID]; ok {
+			return fmt.Errorf("duplicate http storage provider with id '%s'", provider.ID)
+		}
+		httpProviders[provider.ID] = provider
+	}
+
 	var pClient persistedoperation.Client
 
 	if provider, ok := cdnProviders[r.persistedOperationsConfig.Storage.ProviderID]; ok {
@@ -978,11 +1137,9 @@ func (r *Router) buildClients() error {
 		r.logger.Info("Use S3 as storage provider for persisted operations",
 			zap.String("provider_id", provider.ID),
 		)
+	} else if r.persistedOperationsConfig.Storage.ProviderID != "" {
+		return fmt.Errorf("unknown storage provider id '%s' for persisted operations", r.persistedOperationsConfig.Storage.ProviderID)
 	} else if r.graphApiToken != "" {
-		if r.persistedOperationsConfig.Storage.ProviderID != "" {
-			return fmt.Errorf("unknown storage provider id '%s' for persisted operations", r.persistedOperationsConfig.Storage.ProviderID)
-		}
-
 		c, err := cdn.NewClient(r.cdnConfig.URL, r.graphApiToken, cdn.Options{
 			Logger: r.logger,
 		})
@@ -998,18 +1155,23 @@ func (r *Router) buildClients() error {
 
 	var kvClient apq.KVClient
 	if provider, ok := redisProviders[r.automaticPersistedQueriesConfig.Storage.ProviderID]; ok {
-		c, err := apq.NewRedisClient(&apq.RedisOptions{
-			Logger:        r.logger,
-			StorageConfig: &provider,
-			Prefix:        r.automaticPersistedQueriesConfig.Storage.ObjectPrefix,
-		})
-		if err != nil {
-			return err
+		// A dry run does not connect to Redis
+		if !r.dryRun {
+			c, err := apq.NewRedisClient(&apq.RedisOptions{
+				Logger:        r.logger,
+				StorageConfig: &provider,
+				Prefix:        r.automaticPersistedQueriesConfig.Storage.ObjectPrefix,
+			})
+			if err != nil {
+				return err
+			}
+			kvClient = c
+			r.logger.Info("Use redis as storage provider for automatic persisted operations",
+				zap.String("provider_id", provider.ID),
+			)
 		}
-		kvClient = c
-		r.logger.Info("Use redis as storage provider for automatic persisted operations",
-			zap.String("provider_id", provider.ID),
-		)
+	} else if r.automaticPersistedQueriesConfig.Enabled && r.automaticPersistedQueriesConfig.Storage.ProviderID != "" {
+		return fmt.Errorf("unknown storage provider id '%s' for automatic persisted queries", r.automaticPersistedQueriesConfig.Storage.ProviderID)
 	}
 
 	var apqClient apq.Client
@@ -1045,7 +1207,7 @@ func (r *Router) buildClients() error {
 		r.persistedOperationClient = c
 	}
 
-	configPoller, err := InitializeConfigPoller(r, cdnProviders, s3Providers)
+	configPoller, err := InitializeConfigPoller(r, cdnProviders, s3Providers, httpProviders)
 	if err != nil {
 		return err
 	}
@@ -1082,6 +1244,10 @@ func (r *Router) Start(ctx context.Context) error {
 		healthCheckPath:    r.healthCheckPath,
 	})
 
+	if r.devComposer != nil {
+		return r.startDevComposition(ctx)
+	}
+
 	// Start the server with the static config without polling
 	if r.staticExecutionConfig != nil {
 		if err := r.listenAndServe(); err != nil {
@@ -1105,43 +1271,49 @@ func (r *Router) Start(ctx context.Context) error {
 
 		if r.executionConfig != nil && r.executionConfig.Watch {
 
-			w, err := watcher.NewWatcher(r.logger.With(zap.String("watcher", "execution_config")))
-			if err != nil {
-				return fmt.Errorf("failed to start watcher for execution config file: %w", err)
+			watchedFiles := []string{r.executionConfig.Path}
+			// The signature file is usually written after the config, so a change of the signature has to trigger an update as well
+			if r.executionConfig.SignKey != "" {
+				watchedFiles = append(watchedFiles, r.executionConfig.Path+execution_config.SignatureFileSuffix)
 			}
 
-			// Watch the execution config file for changes. Returning an error will stop the watcher.
-			// We intentionally ignore the error here because the user can retry. The watcher is closed when context is done.
-			err = w.Watch(ctx, r.executionConfig.Path, func(events []watcher.Event) error {
-				if r.shutdown.Load() {
-					r.logger.Warn("Router is in shutdown state. Skipping config update")
-					return nil
-				}
-
-				data, err := os.ReadFile(r.executionConfig.Path)
+			for _, watchedFile := range watchedFiles {
+				w, err := watcher.NewWatcher(r.logger.With(zap.String("watcher", "execution_config")))
 				if err != nil {
-					r.logger.Error("Failed to read config file", zap.Error(err))
-					return nil
+					return fmt.Errorf("failed to start watcher for execution config file: %w", err)
 				}
 
-				r.logger.Info("Config file changed. Updating server with new config", zap.String("path", r.executionConfig.Path))
+				// Watch the execution config file for changes. Returning an error will stop the watcher.
+				// We intentionally ignore the error here because the user can retry. The watcher is closed when context is done.
+				err = w.Watch(ctx, watchedFile, func(events []watcher.Event) error {
+					if r.shutdown.Load() {
+						r.logger.Warn("Router is in shutdown state. Skipping config update")
+						return nil
+					}
+
+					r.logger.Info("Config file changed. Updating server with new config", zap.String("path", watchedFile))
 
-				cfg, err := execution_config.UnmarshalConfig(data)
-				if err != nil {
-					r.logger.Error("Failed to serialize config file", zap.Error(err))
-					return nil
-				}
+					cfg, err := execution_config.FromSignedFile(r.executionConfig.Path, r.executionConfig.SignKey)
+					if err != nil {
+						if errors.Is(err, routerconfig.ErrInvalidSignature) || errors.Is(err, execution_config.ErrMissingSignatureFile) {
+							r.logger.Error("Invalid config signature, potential tampering detected. Keeping the current config", zap.Error(err))
+						} else {
+							r.logger.Error("Failed to read config file", zap.Error(err))
+						}
+						return nil
+					}
+
+					if err := r.updateExecutionConfig(ctx, cfg); err != nil {
+						r.logger.Error("Failed to update server with new config", zap.Error(err))
+						return nil
+					}
 
-				if err := r.newServer(ctx, cfg); err != nil {
-					r.logger.Error("Failed to update server with new config", zap.Error(err))
 					return nil
+				})
+				if err != nil {
+					r.logger.Error("Failed to watch execution config file. Restart the router to apply changes", zap.Error(err))
+					return fmt.Errorf("failed to watch execution config file: %w", err)
 				}
-
-				return nil
-			})
-			if err != nil {
-				r.logger.Error("Failed to watch execution config file. Restart the router to apply changes", zap.Error(err))
-				return fmt.Errorf("failed to watch execution config file: %w", err)
 			}
 
 			r.logger.Info("Watching config file for changes. Router will hot-reload automatically without downtime",
@@ -1198,13 +1370,38 @@ func (r *Router) Start(ctx context.Context) error {
 		r.logger.Warn("Advanced Request Tracing (ART) is enabled in development mode but requires a graph token to work in production. For more information see https://cosmo-docs.wundergraph.com/router/advanced-request-tracing-art")
 	}
 
-	if r.redisClient != nil {
-		r.logger.Info("Rate limiting enabled",
-			zap.Int("rate", r.rateLimit.SimpleStrategy.Rate),
-			zap.Int("burst", r.rateLimit.SimpleStrategy.Burst),
-			zap.Duration("duration", r.Config.rateLimit.SimpleStrategy.Period),
-			zap.Bool("rejectExceeding", r.Config.rateLimit.SimpleStrategy.RejectExceedingRequests),
-		)
+	if r.redisClient != nil || r.memoryRateLimiter != nil {
+		switch r.rateLimit.Strategy {
+		case "quota":
+			windows := make([]string, len(r.rateLimit.QuotaStrategy.Windows))
+			for i, window := range r.rateLimit.QuotaStrategy.Windows {
+				windows[i] = fmt.Sprintf("%s=%d/%s", window.Name, window.Limit, window.Period)
+			}
+			r.logger.Info("Quota rate limiting enabled",
+				zap.String("keyBy", r.rateLimit.QuotaStrategy.KeyBy),
+				zap.Strings("windows", windows),
+				zap.Int("overrides", len(r.rateLimit.QuotaStrategy.Overrides)),
+				zap.Bool("rejectExceeding", r.Config.rateLimit.QuotaStrategy.RejectExceedingRequests),
+				zap.String("storage", r.Config.rateLimit.Storage.Provider),
+			)
+		case "cost":
+			r.logger.Info("Cost based rate limiting enabled",
+				zap.Int("rate", r.rateLimit.CostStrategy.Rate),
+				zap.Int("burst", r.rateLimit.CostStrategy.Burst),
+				zap.Duration("duration", r.Config.rateLimit.CostStrategy.Period),
+				zap.Bool("rejectExceeding", r.Config.rateLimit.CostStrategy.RejectExceedingRequests),
+				zap.String("tokensExpression", r.Config.rateLimit.CostStrategy.TokensExpression),
+				zap.String("storage", r.Config.rateLimit.Storage.Provider),
+			)
+		default:
+			r.logger.Info("Rate limiting enabled",
+				zap.Int("rate", r.rateLimit.SimpleStrategy.Rate),
+				zap.Int("burst", r.rateLimit.SimpleStrategy.Burst),
+				zap.Duration("duration", r.Config.rateLimit.SimpleStrategy.Period),
+				zap.Bool("rejectExceeding", r.Config.rateLimit.SimpleStrategy.RejectExceedingRequests),
+				zap.String("storage", r.Config.rateLimit.Storage.Provider),
+			)
+		}
 	}
 
 	r.configPoller.Subscribe(ctx, func(newConfig *nodev1.RouterConfig, oldVersion string) error {
@@ -1213,7 +1410,7 @@ func (r *Router) Start(ctx context.Context) error {
 			return nil
 		}
 
-		if err := r.newServer(ctx, newConfig); err != nil {
+		if err := r.updateExecutionConfig(ctx, newConfig); err != nil {
 			return err
 		}
 
@@ -1254,7 +1451,15 @@ func (r *Router) Shutdown(ctx context.Context) (err error) {
 		}
 	}
 
+	if r.devComposer != nil {
+		r.devComposer.Close()
+	}
+
 	if r.httpServer != nil {
+		r.serverLock.Lock()
+		r.stopCanary(ctx, "router is shutting down")
+		r.serverLock.Unlock()
+
 		if subErr := r.httpServer.Shutdown(ctx); subErr != nil {
 			if errors.Is(err, context.DeadlineExceeded) {
 				r.logger.Warn(
@@ -1326,13 +1531,18 @@ func (r *Router) Shutdown(ctx context.Context) (err error) {
 		}()
 	}
 
-	if r.redisClient != nil {
+	// A reload creates the redis client if rate limiting was enabled after startup
+	r.serverLock.Lock()
+	redisClient := r.graphServerConfig().redisClient
+	r.serverLock.Unlock()
+
+	if redisClient != nil {
 		wg.Add(1)
 
 		go func() {
 			defer wg.Done()
 
-			if closeErr := r.redisClient.Close(); closeErr != nil {
+			if closeErr := redisClient.Close(); closeErr != nil {
 				err = errors.Join(err, fmt.Errorf("failed to close redis client: %w", closeErr))
 			}
 		}()
@@ -1501,6 +1711,43 @@ func WithExecutionConfig(cfg *ExecutionConfig) Option {
 	}
 }
 
+// WithExecutionConfigHistory keeps the last successfully loaded execution configs, so they can be rolled back with the admin API.
+func WithExecutionConfigHistory(cfg *config.ExecutionConfigHistory) Option {
+	return func(r *Router) {
+		r.executionConfigHistory = cfg
+	}
+}
+
+// WithExecutionConfigCanary rolls out new execution configs to a share of the traffic first. The new config is
+// promoted when its error rate doesn't exceed the error rate of the current config during the rollout.
+func WithExecutionConfigCanary(cfg *config.ExecutionConfigCanary) Option {
+	return func(r *Router) {
+		r.executionConfigCanary = cfg
+	}
+}
+
+// WithDevComposition composes the execution config from local subgraph schemas instead of loading it from a file
+// or the CDN. Schema files are watched and the router is updated on every successful composition.
+func WithDevComposition(cfg *config.DevComposition) Option {
+	return func(r *Router) {
+		r.devComposition = cfg
+	}
+}
+
+// WithDevComposer sets the composer of the dev composition mode. It is required by WithDevComposition.
+func WithDevComposer(composer DevComposer) Option {
+	return func(r *Router) {
+		r.devCompositionComposer = composer
+	}
+}
+
+// WithAdminAPI enables the admin API to list and roll back execution configs.
+func WithAdminAPI(cfg *config.AdminAPIConfiguration) Option {
+	return func(r *Router) {
+		r.adminAPI = cfg
+	}
+}
+
 // WithStaticExecutionConfig sets the static execution config. This disables polling and file watching.
 func WithStaticExecutionConfig(cfg *nodev1.RouterConfig) Option {
 	return func(r *Router) {
@@ -1683,6 +1930,9 @@ func NewTransportRequestOptions(cfg config.GlobalSubgraphRequestRule) *Transport
 		MaxConnsPerHost:        or(cfg.MaxConnsPerHost, defaults.MaxConnsPerHost),
 		MaxIdleConns:           or(cfg.MaxIdleConns, defaults.MaxIdleConns),
 		MaxIdleConnsPerHost:    or(cfg.MaxIdleConnsPerHost, defaults.MaxIdleConnsPerHost),
+		Retry:                  cfg.BackoffJitterRetry,
+		CircuitBreaker:         cfg.CircuitBreaker,
+		Bulkhead:               cfg.Bulkhead,
 	}
 }
 
@@ -1715,6 +1965,15 @@ func NewSubgraphTransportOptions(cfg config.TrafficShapingRules) *SubgraphTransp
 	return base
 }
 
+// forSubgraph returns the options of the subgraph. Subgraphs without their own traffic shaping rules use the options
+// of all subgraphs.
+func (o *SubgraphTransportOptions) forSubgraph(name string) *TransportRequestOptions {
+	if subgraphOpts, ok := o.SubgraphMap[name]; ok && subgraphOpts != nil {
+		return subgraphOpts
+	}
+	return o.TransportRequestOptions
+}
+
 func DefaultSubgraphTransportOptions() *SubgraphTransportOptions {
 	return &SubgraphTransportOptions{
 		TransportRequestOptions: DefaultTransportRequestOptions(),
@@ -0,0 +1,104 @@
+package core
+
+import (
+	"context"
+
+	nodev1 "github.com/wundergraph/cosmo/router/gen/proto/wg/cosmo/node/v1"
+	"github.com/wundergraph/cosmo/router/pkg/otel"
+	"github.com/wundergraph/cosmo/router/pkg/schemadiff"
+	"go.uber.org/zap"
+)
+
+// reportSchemaChanges compares the schemas of the previous and the new execution config. The changes are logged,
+// counted in the schema change metric and passed to modules that implement SchemaChangeHandler.
+// Must be called with the serverLock held.
+func (r *Router) reportSchemaChanges(ctx context.Context, prev, next *nodev1.RouterConfig) {
+	// Nothing to compare on startup
+	if prev == nil || prev == next {
+		return
+	}
+
+	oldSchema := prev.GetEngineConfig().GetGraphqlSchema()
+	newSchema := next.GetEngineConfig().GetGraphqlSchema()
+
+	if oldSchema == newSchema {
+		return
+	}
+
+	diff, err := schemadiff.Compare(oldSchema, newSchema)
+	if err != nil {
+		r.logger.Warn("Failed to compare the schemas of the execution configs", zap.Error(err))
+		return
+	}
+
+	if !diff.HasChanges() {
+		return
+	}
+
+	breaking := diff.Filter(schemadiff.CriticalityBreaking)
+	dangerous := diff.Filter(schemadiff.CriticalityDangerous)
+
+	logger := r.logger.With(
+		zap.String("previous_config_version", prev.GetVersion()),
+		zap.String("config_version", next.GetVersion()),
+		zap.Int("breaking_changes", len(breaking)),
+		zap.Int("dangerous_changes", len(dangerous)),
+		zap.Int("safe_changes", diff.Count(schemadiff.CriticalitySafe)),
+	)
+
+	if len(breaking) > 0 || len(dangerous) > 0 {
+		logger.Warn("Schema of the execution config has breaking or dangerous changes",
+			zap.Strings("breaking", changeMessages(breaking)),
+			zap.Strings("dangerous", changeMessages(dangerous)),
+		)
+	} else {
+		logger.Info("Schema of the execution config has changed")
+	}
+
+	logger.Debug("Schema changes", zap.Strings("changes", changeMessages(diff.Changes)))
+
+	if r.schemaChangeMetrics != nil {
+		r.measureSchemaChanges(ctx, prev, next, diff)
+	}
+
+	event := &SchemaChangeEvent{
+		PreviousConfigVersion: prev.GetVersion(),
+		ConfigVersion:         next.GetVersion(),
+		Diff:                  diff,
+	}
+
+	for _, module := range r.modules {
+		if handler, ok := module.(SchemaChangeHandler); ok {
+			handler.OnSchemaChange(ctx, event)
+		}
+	}
+}
+
+func (r *Router) measureSchemaChanges(ctx context.Context, prev, next *nodev1.RouterConfig, diff *schemadiff.Diff) {
+	type changeKey struct {
+		changeType  schemadiff.ChangeType
+		criticality schemadiff.Criticality
+	}
+
+	counts := make(map[changeKey]int64)
+	for _, change := range diff.Changes {
+		counts[changeKey{changeType: change.Type, criticality: change.Criticality}]++
+	}
+
+	for key, count := range counts {
+		r.schemaChangeMetrics.MeasureSchemaChanges(ctx, count,
+			otel.WgSchemaChangeType.String(string(key.changeType)),
+			otel.WgSchemaChangeCriticality.String(string(key.criticality)),
+			otel.WgRouterPreviousConfigVersion.String(prev.GetVersion()),
+			otel.WgRouterConfigVersion.String(next.GetVersion()),
+		)
+	}
+}
+
+func changeMessages(changes []schemadiff.Change) []string {
+	messages := make([]string, 0, len(changes))
+	for _, change := range changes {
+		messages = append(messages, change.Message)
+	}
+	return messages
+}
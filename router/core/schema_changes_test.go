@@ -0,0 +1,58 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	nodev1 "github.com/wundergraph/cosmo/router/gen/proto/wg/cosmo/node/v1"
+	"github.com/wundergraph/cosmo/router/pkg/schemadiff"
+)
+
+type schemaChangeRecorder struct {
+	events []*SchemaChangeEvent
+}
+
+func (m *schemaChangeRecorder) Module() ModuleInfo {
+	return ModuleInfo{ID: "schemaChangeRecorder", New: func() Module { return &schemaChangeRecorder{} }}
+}
+
+func (m *schemaChangeRecorder) OnSchemaChange(_ context.Context, event *SchemaChangeEvent) {
+	m.events = append(m.events, event)
+}
+
+func routerConfigWithSchema(version, schema string) *nodev1.RouterConfig {
+	return &nodev1.RouterConfig{
+		Version:      version,
+		EngineConfig: &nodev1.EngineConfiguration{GraphqlSchema: schema},
+	}
+}
+
+func TestReportSchemaChanges(t *testing.T) {
+	t.Parallel()
+
+	router, err := NewRouter()
+	require.NoError(t, err)
+
+	recorder := &schemaChangeRecorder{}
+	router.modules = append(router.modules, recorder)
+
+	v1 := routerConfigWithSchema("1", `type Query { a: String b: Int }`)
+	v2 := routerConfigWithSchema("2", `type Query { a: String b: Int }`)
+	v3 := routerConfigWithSchema("3", `type Query { a: String! c: Int }`)
+
+	// No previous config on startup
+	router.reportSchemaChanges(context.Background(), nil, v1)
+	// Same schema with a different version
+	router.reportSchemaChanges(context.Background(), v1, v2)
+	require.Empty(t, recorder.events)
+
+	router.reportSchemaChanges(context.Background(), v2, v3)
+	require.Len(t, recorder.events, 1)
+
+	event := recorder.events[0]
+	require.Equal(t, "2", event.PreviousConfigVersion)
+	require.Equal(t, "3", event.ConfigVersion)
+	require.Equal(t, 1, event.Diff.Count(schemadiff.CriticalityBreaking))
+	require.Equal(t, 2, event.Diff.Count(schemadiff.CriticalitySafe))
+}
@@ -2,6 +2,8 @@ package core
 
 import (
 	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -151,6 +153,13 @@ func (ct *CustomTransport) RoundTrip(req *http.Request) (resp *http.Response, er
 		resp, err = ct.roundTripSingleFlight(req)
 	}
 
+	// Requests rejected by the circuit breaker or the bulkhead are answered with a GraphQL error instead of failing
+	// the fetch
+	var rejectedErr *subgraphRequestRejectedError
+	if errors.As(err, &rejectedErr) {
+		resp, err = rejectedErr.response(req), nil
+	}
+
 	// Set the error on the request context so that it can be checked by the post handlers
 	if err != nil {
 		moduleContext.sendError = err
@@ -173,6 +182,49 @@ func (ct *CustomTransport) RoundTrip(req *http.Request) (resp *http.Response, er
 	return resp, err
 }
 
+// subgraphRequestRejectedError is returned for requests that the router didn't send to the subgraph. It is not a
+// retryable error, so the retries of a request stop as well.
+type subgraphRequestRejectedError struct {
+	cause   error
+	message string
+	code    string
+}
+
+func (e *subgraphRequestRejectedError) Error() string {
+	return e.message
+}
+
+func (e *subgraphRequestRejectedError) Unwrap() error {
+	return e.cause
+}
+
+// response renders the rejection as a GraphQL error response of the subgraph, so the error is propagated to the
+// client with its extension code like any other subgraph error
+func (e *subgraphRequestRejectedError) response(req *http.Request) *http.Response {
+	body, _ := json.Marshal(map[string]any{
+		"errors": []any{
+			map[string]any{
+				"message": e.message,
+				"extensions": map[string]any{
+					"code": e.code,
+				},
+			},
+		},
+	})
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", http.StatusServiceUnavailable, http.StatusText(http.StatusServiceUnavailable)),
+		StatusCode:    http.StatusServiceUnavailable,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
 func (ct *CustomTransport) allowSingleFlight(req *http.Request) bool {
 	if ct.sf == nil {
 		// Single flight is disabled
@@ -307,6 +359,9 @@ type TransportFactory struct {
 	postHandlers                  []TransportPostHandler
 	subgraphTransportOptions      *SubgraphTransportOptions
 	retryOptions                  retrytransport.RetryOptions
+	subgraphRetryOptions          map[string]retrytransport.RetryOptions
+	circuitBreakers               *SubgraphCircuitBreakers
+	bulkheads                     *SubgraphBulkheads
 	localhostFallbackInsideDocker bool
 	metricStore                   metric.Store
 	logger                        *zap.Logger
@@ -323,6 +378,9 @@ type TransportOptions struct {
 	SubgraphTransportOptions      *SubgraphTransportOptions
 	Proxy                         ProxyFunc
 	RetryOptions                  retrytransport.RetryOptions
+	SubgraphRetryOptions          map[string]retrytransport.RetryOptions
+	CircuitBreakers               *SubgraphCircuitBreakers
+	Bulkheads                     *SubgraphBulkheads
 	LocalhostFallbackInsideDocker bool
 	MetricStore                   metric.Store
 	Logger                        *zap.Logger
@@ -335,6 +393,9 @@ func NewTransport(opts *TransportOptions) *TransportFactory {
 		preHandlers:                   opts.PreHandlers,
 		postHandlers:                  opts.PostHandlers,
 		retryOptions:                  opts.RetryOptions,
+		subgraphRetryOptions:          opts.SubgraphRetryOptions,
+		circuitBreakers:               opts.CircuitBreakers,
+		bulkheads:                     opts.Bulkheads,
 		subgraphTransportOptions:      opts.SubgraphTransportOptions,
 		localhostFallbackInsideDocker: opts.LocalhostFallbackInsideDocker,
 		metricStore:                   opts.MetricStore,
@@ -384,10 +445,26 @@ func (t TransportFactory) RoundTripper(enableSingleFlight bool, baseTransport ht
 			span.SetAttributes(attributes...)
 		}),
 	)
+	var roundTripper http.RoundTripper = traceTransport
+	if t.circuitBreakers != nil {
+		roundTripper = &circuitBreakerTransport{roundTripper: roundTripper, breakers: t.circuitBreakers}
+	}
+	// Requests rejected by the bulkhead don't count as failures of the subgraph
+	if t.bulkheads != nil {
+		roundTripper = &bulkheadTransport{roundTripper: roundTripper, bulkheads: t.bulkheads}
+	}
+
+	retryOptions := t.retryOptions
+	if len(t.subgraphRetryOptions) > 0 {
+		roundTripper = newSubgraphRetryTransport(roundTripper, t.retryOptions, t.subgraphRetryOptions, t.logger)
+		// Retries are already handled per subgraph
+		retryOptions = retrytransport.RetryOptions{}
+	}
+
 	tp := NewCustomTransport(
 		t.logger,
-		traceTransport,
-		t.retryOptions,
+		roundTripper,
+		retryOptions,
 		t.metricStore,
 		enableSingleFlight,
 	)
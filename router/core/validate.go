@@ -0,0 +1,172 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	nodev1 "github.com/wundergraph/cosmo/router/gen/proto/wg/cosmo/node/v1"
+	"github.com/wundergraph/cosmo/router/internal/expr"
+	"github.com/wundergraph/cosmo/router/pkg/execution_config"
+)
+
+type ValidationCheckStatus string
+
+const (
+	ValidationCheckPassed  ValidationCheckStatus = "passed"
+	ValidationCheckFailed  ValidationCheckStatus = "failed"
+	ValidationCheckSkipped ValidationCheckStatus = "skipped"
+)
+
+const (
+	ValidationCheckConfig           = "config"
+	ValidationCheckRouter           = "router"
+	ValidationCheckExpressions      = "expressions"
+	ValidationCheckStorageProviders = "storage_providers"
+	ValidationCheckExecutionConfig  = "execution_config"
+)
+
+type (
+	// ValidationCheck is the outcome of a single step of a dry-run startup.
+	ValidationCheck struct {
+		Name    string                `json:"name"`
+		Status  ValidationCheckStatus `json:"status"`
+		Errors  []string              `json:"errors,omitempty"`
+		Message string                `json:"message,omitempty"`
+	}
+
+	// ValidationReport is the structured result of Router.Validate.
+	ValidationReport struct {
+		Valid  bool              `json:"valid"`
+		Checks []ValidationCheck `json:"checks"`
+	}
+)
+
+// Add appends a check to the report. A check with errors is marked as failed and invalidates the report.
+func (r *ValidationReport) Add(name string, errs ...error) {
+	check := ValidationCheck{
+		Name:   name,
+		Status: ValidationCheckPassed,
+	}
+	for _, err := range errs {
+		if err != nil {
+			check.Errors = append(check.Errors, err.Error())
+		}
+	}
+	if len(check.Errors) > 0 {
+		check.Status = ValidationCheckFailed
+	}
+	r.Checks = append(r.Checks, check)
+	r.Valid = r.isValid()
+}
+
+// Skip appends a check that could not be performed with the given configuration.
+func (r *ValidationReport) Skip(name, reason string) {
+	r.Checks = append(r.Checks, ValidationCheck{
+		Name:    name,
+		Status:  ValidationCheckSkipped,
+		Message: reason,
+	})
+	r.Valid = r.isValid()
+}
+
+func (r *ValidationReport) isValid() bool {
+	for _, check := range r.Checks {
+		if check.Status == ValidationCheckFailed {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate performs a dry-run of the router startup. It compiles all expressions, resolves the storage provider
+// references and builds the graph server from the local execution config if one is configured.
+// No listener is started, no connections to event providers or Redis are established and the router is not registered
+// on the control plane. The router instance must not be started after validation.
+func (r *Router) Validate(ctx context.Context) *ValidationReport {
+	report := &ValidationReport{Valid: true}
+
+	r.dryRun = true
+
+	report.Add(ValidationCheckExpressions, r.validateExpressions()...)
+	// The storage clients are built the same way as on startup, but without connecting to Redis
+	report.Add(ValidationCheckStorageProviders, r.buildClients())
+
+	routerConfig, err := r.localExecutionConfig()
+	if err != nil {
+		report.Add(ValidationCheckExecutionConfig, err)
+		return report
+	}
+	if routerConfig == nil {
+		report.Skip(ValidationCheckExecutionConfig, "no local execution config provided. The execution config is fetched from a storage provider at startup")
+		return report
+	}
+
+	report.Add(ValidationCheckExecutionConfig, r.validateExecutionConfig(ctx, routerConfig))
+
+	return report
+}
+
+func (r *Router) validateExpressions() []error {
+	var errs []error
+
+	_, err := NewOperationBlocker(&OperationBlockerOptions{
+		BlockMutations: BlockMutationOptions{
+			Enabled:   r.securityConfiguration.BlockMutations.Enabled,
+			Condition: r.securityConfiguration.BlockMutations.Condition,
+		},
+		BlockSubscriptions: BlockSubscriptionOptions{
+			Enabled:   r.securityConfiguration.BlockSubscriptions.Enabled,
+			Condition: r.securityConfiguration.BlockSubscriptions.Condition,
+		},
+		BlockNonPersisted: BlockNonPersistedOptions{
+			Enabled:   r.securityConfiguration.BlockNonPersistedOperations.Enabled,
+			Condition: r.securityConfiguration.BlockNonPersistedOperations.Condition,
+		},
+	})
+	if err != nil {
+		errs = append(errs, fmt.Errorf("security: %w", err))
+	}
+
+	if r.rateLimit != nil && r.rateLimit.Enabled && r.rateLimit.KeySuffixExpression != "" {
+		if _, err := expr.CompileStringExpression(r.rateLimit.KeySuffixExpression); err != nil {
+			errs = append(errs, fmt.Errorf("rate_limit.key_suffix_expression: %w", err))
+		}
+	}
+
+	if _, err := newAttributeExpressions(r.telemetryAttributes); err != nil {
+		errs = append(errs, fmt.Errorf("telemetry.attributes: %w", err))
+	}
+
+	if _, err := newAttributeExpressions(r.metricConfig.Attributes); err != nil {
+		errs = append(errs, fmt.Errorf("telemetry.metrics.attributes: %w", err))
+	}
+
+	return errs
+}
+
+// localExecutionConfig returns the execution config that is available without contacting a storage provider.
+func (r *Router) localExecutionConfig() (*nodev1.RouterConfig, error) {
+	if r.executionConfig != nil && r.executionConfig.Path != "" {
+		routerConfig, err := execution_config.FromSignedFile(r.executionConfig.Path, r.executionConfig.SignKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read execution config: %w", err)
+		}
+		return routerConfig, nil
+	}
+
+	return r.staticExecutionConfig, nil
+}
+
+// validateExecutionConfig builds the graph server including all feature flag muxes without serving it.
+func (r *Router) validateExecutionConfig(ctx context.Context, routerConfig *nodev1.RouterConfig) error {
+	if err := r.initPlayground(); err != nil {
+		return err
+	}
+
+	server, err := newGraphServer(ctx, r, &r.Config, routerConfig, r.proxy)
+	if err != nil {
+		return err
+	}
+
+	return server.Shutdown(ctx)
+}
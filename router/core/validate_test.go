@@ -0,0 +1,159 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wundergraph/cosmo/router/pkg/config"
+	"github.com/wundergraph/cosmo/router/pkg/routerconfig"
+)
+
+func findValidationCheck(t *testing.T, report *ValidationReport, name string) ValidationCheck {
+	t.Helper()
+	for _, check := range report.Checks {
+		if check.Name == name {
+			return check
+		}
+	}
+	t.Fatalf("check %s not found in report", name)
+	return ValidationCheck{}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reports invalid expressions", func(t *testing.T) {
+		t.Parallel()
+
+		router, err := NewRouter(
+			WithSecurityConfig(config.SecurityConfiguration{
+				BlockMutations: config.BlockOperationConfiguration{
+					Enabled:   true,
+					Condition: "request.header.Get(",
+				},
+			}),
+			WithRateLimitConfig(&config.RateLimitConfiguration{
+				Enabled:             true,
+				KeySuffixExpression: "request.auth.claims.sub == 1",
+			}),
+			WithTelemetryAttributes([]config.CustomAttribute{
+				{
+					Key: "user",
+					ValueFrom: &config.CustomDynamicAttribute{
+						Expression: "request.unknown",
+					},
+				},
+			}),
+		)
+		require.NoError(t, err)
+
+		report := router.Validate(context.Background())
+		require.False(t, report.Valid)
+
+		check := findValidationCheck(t, report, ValidationCheckExpressions)
+		require.Equal(t, ValidationCheckFailed, check.Status)
+		require.Len(t, check.Errors, 3)
+		require.Contains(t, check.Errors[0], "security")
+		require.Contains(t, check.Errors[1], "rate_limit.key_suffix_expression")
+		require.Contains(t, check.Errors[2], "telemetry.attributes")
+	})
+
+	t.Run("reports duplicate storage providers", func(t *testing.T) {
+		t.Parallel()
+
+		router, err := NewRouter(
+			WithStorageProviders(config.StorageProviders{
+				CDN: []config.BaseStorageProvider{
+					{ID: "cdn", URL: "https://cdn.example.com"},
+					{ID: "cdn", URL: "https://cdn2.example.com"},
+				},
+			}),
+		)
+		require.NoError(t, err)
+
+		report := router.Validate(context.Background())
+		require.False(t, report.Valid)
+
+		check := findValidationCheck(t, report, ValidationCheckStorageProviders)
+		require.Equal(t, ValidationCheckFailed, check.Status)
+		require.Equal(t, []string{"duplicate cdn storage provider with id 'cdn'"}, check.Errors)
+	})
+
+	t.Run("reports unknown storage providers", func(t *testing.T) {
+		t.Parallel()
+
+		router, err := NewRouter(
+			WithPersistedOperationsConfig(config.PersistedOperationsConfig{
+				Storage: config.PersistedOperationsStorageConfig{
+					ProviderID: "unknown",
+				},
+			}),
+		)
+		require.NoError(t, err)
+
+		report := router.Validate(context.Background())
+		require.False(t, report.Valid)
+
+		check := findValidationCheck(t, report, ValidationCheckStorageProviders)
+		require.Equal(t, ValidationCheckFailed, check.Status)
+		require.Equal(t, []string{"unknown storage provider id 'unknown' for persisted operations"}, check.Errors)
+	})
+
+	t.Run("builds the graph server from a valid config", func(t *testing.T) {
+		t.Parallel()
+
+		router, err := NewRouter(
+			WithStaticExecutionConfig(routerconfig.GetDefaultConfig()),
+			WithStorageProviders(config.StorageProviders{
+				Redis: []config.RedisStorageProvider{
+					// Not reachable, a dry run must not connect to Redis
+					{ID: "redis", URLs: []string{"redis://localhost:1"}},
+				},
+			}),
+			WithAutomatedPersistedQueriesConfig(config.AutomaticPersistedQueriesConfig{
+				Enabled: true,
+				Storage: config.AutomaticPersistedQueriesStorageConfig{
+					ProviderID: "redis",
+				},
+			}),
+		)
+		require.NoError(t, err)
+
+		report := router.Validate(context.Background())
+		require.True(t, report.Valid)
+
+		for _, name := range []string{ValidationCheckExpressions, ValidationCheckStorageProviders, ValidationCheckExecutionConfig} {
+			check := findValidationCheck(t, report, name)
+			require.Equal(t, ValidationCheckPassed, check.Status, check.Errors)
+		}
+	})
+
+	t.Run("skips the execution config without a local config", func(t *testing.T) {
+		t.Parallel()
+
+		router, err := NewRouter()
+		require.NoError(t, err)
+
+		report := router.Validate(context.Background())
+		require.True(t, report.Valid)
+
+		check := findValidationCheck(t, report, ValidationCheckExecutionConfig)
+		require.Equal(t, ValidationCheckSkipped, check.Status)
+	})
+
+	t.Run("reports an unreadable execution config", func(t *testing.T) {
+		t.Parallel()
+
+		router, err := NewRouter(WithExecutionConfig(&ExecutionConfig{
+			Path: "testdata/does-not-exist.json",
+		}))
+		require.NoError(t, err)
+
+		report := router.Validate(context.Background())
+		require.False(t, report.Valid)
+
+		check := findValidationCheck(t, report, ValidationCheckExecutionConfig)
+		require.Equal(t, ValidationCheckFailed, check.Status)
+	})
+}
@@ -0,0 +1,111 @@
+// Package bulkhead limits the number of concurrent requests to an upstream.
+package bulkhead
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	// ErrQueueFull is returned if all slots are taken and the wait queue is full
+	ErrQueueFull = errors.New("bulkhead wait queue is full")
+	// ErrQueueTimeout is returned if no slot became free within the queue timeout
+	ErrQueueTimeout = errors.New("timed out in the bulkhead wait queue")
+)
+
+// DefaultMaxConcurrentRequests is the number of concurrent requests if not configured
+const DefaultMaxConcurrentRequests = 100
+
+type Config struct {
+	// MaxConcurrentRequests is the number of requests that are in flight at the same time. Defaults to
+	// DefaultMaxConcurrentRequests.
+	MaxConcurrentRequests int
+	// MaxQueueSize is the number of requests that wait for a free slot. 0 rejects requests right away if all slots
+	// are taken.
+	MaxQueueSize int
+	// QueueTimeout is the time a request waits for a free slot. 0 waits until the context of the request is done.
+	QueueTimeout time.Duration
+	// Rejected counts the rejected requests. A bulkhead that replaces another one continues its count if they share
+	// the counter. Defaults to a new counter.
+	Rejected *atomic.Int64
+}
+
+// Bulkhead is a semaphore with a bounded wait queue. All methods are safe for concurrent use.
+type Bulkhead struct {
+	slots        chan struct{}
+	maxQueueSize int64
+	queueTimeout time.Duration
+
+	queued   atomic.Int64
+	rejected *atomic.Int64
+}
+
+func New(cfg Config) *Bulkhead {
+	if cfg.MaxConcurrentRequests <= 0 {
+		cfg.MaxConcurrentRequests = DefaultMaxConcurrentRequests
+	}
+	if cfg.Rejected == nil {
+		cfg.Rejected = new(atomic.Int64)
+	}
+	return &Bulkhead{
+		slots:        make(chan struct{}, cfg.MaxConcurrentRequests),
+		maxQueueSize: int64(max(cfg.MaxQueueSize, 0)),
+		queueTimeout: cfg.QueueTimeout,
+		rejected:     cfg.Rejected,
+	}
+}
+
+// Acquire takes a slot and returns the function to release it. If all slots are taken, it waits in the queue until
+// a slot is released, the queue timeout expires or the context is done.
+func (b *Bulkhead) Acquire(ctx context.Context) (release func(), err error) {
+	select {
+	case b.slots <- struct{}{}:
+		return b.release, nil
+	default:
+	}
+
+	if b.queued.Add(1) > b.maxQueueSize {
+		b.queued.Add(-1)
+		b.rejected.Add(1)
+		return nil, ErrQueueFull
+	}
+	defer b.queued.Add(-1)
+
+	var timeout <-chan time.Time
+	if b.queueTimeout > 0 {
+		timer := time.NewTimer(b.queueTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case b.slots <- struct{}{}:
+		return b.release, nil
+	case <-timeout:
+		b.rejected.Add(1)
+		return nil, ErrQueueTimeout
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (b *Bulkhead) release() {
+	<-b.slots
+}
+
+// InFlight returns the number of requests that hold a slot
+func (b *Bulkhead) InFlight() int64 {
+	return int64(len(b.slots))
+}
+
+// Queued returns the number of requests that wait for a slot
+func (b *Bulkhead) Queued() int64 {
+	return b.queued.Load()
+}
+
+// Rejected returns the number of requests that were rejected because the queue was full or timed out
+func (b *Bulkhead) Rejected() int64 {
+	return b.rejected.Load()
+}
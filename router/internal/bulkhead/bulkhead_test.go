@@ -0,0 +1,81 @@
+package bulkhead
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkhead(t *testing.T) {
+	t.Parallel()
+
+	b := New(Config{MaxConcurrentRequests: 1, MaxQueueSize: 1, QueueTimeout: time.Second})
+	ctx := context.Background()
+
+	release, err := b.Acquire(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), b.InFlight())
+
+	acquired := make(chan func())
+	go func() {
+		r, _ := b.Acquire(ctx)
+		acquired <- r
+	}()
+	require.Eventually(t, func() bool { return b.Queued() == 1 }, time.Second, time.Millisecond)
+
+	// The queue is full
+	_, err = b.Acquire(ctx)
+	require.ErrorIs(t, err, ErrQueueFull)
+	require.Equal(t, int64(1), b.Rejected())
+
+	// The queued request takes the released slot
+	release()
+	queuedRelease := <-acquired
+	require.NotNil(t, queuedRelease)
+	require.Equal(t, int64(0), b.Queued())
+	require.Equal(t, int64(1), b.InFlight())
+
+	queuedRelease()
+	require.Equal(t, int64(0), b.InFlight())
+}
+
+func TestBulkheadQueueTimeout(t *testing.T) {
+	t.Parallel()
+
+	b := New(Config{MaxConcurrentRequests: 1, MaxQueueSize: 1, QueueTimeout: 10 * time.Millisecond})
+
+	release, err := b.Acquire(context.Background())
+	require.NoError(t, err)
+	defer release()
+
+	_, err = b.Acquire(context.Background())
+	require.ErrorIs(t, err, ErrQueueTimeout)
+	require.Equal(t, int64(1), b.Rejected())
+	require.Equal(t, int64(0), b.Queued())
+
+	// A canceled request is not a rejection
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	b = New(Config{MaxConcurrentRequests: 1, MaxQueueSize: 1})
+	release, err = b.Acquire(context.Background())
+	require.NoError(t, err)
+	defer release()
+	_, err = b.Acquire(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, int64(0), b.Rejected())
+}
+
+func TestBulkheadWithoutQueue(t *testing.T) {
+	t.Parallel()
+
+	b := New(Config{MaxConcurrentRequests: 2})
+
+	for i := 0; i < 2; i++ {
+		_, err := b.Acquire(context.Background())
+		require.NoError(t, err)
+	}
+	_, err := b.Acquire(context.Background())
+	require.ErrorIs(t, err, ErrQueueFull)
+}
@@ -0,0 +1,257 @@
+// Package circuitbreaker stops sending requests to an upstream while it fails.
+package circuitbreaker
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrOpen is returned for requests that are rejected because the circuit is open
+var ErrOpen = errors.New("circuit breaker is open")
+
+const (
+	DefaultErrorThresholdPercentage = 50
+	DefaultRequestThreshold         = 20
+	DefaultRollingDuration          = 10 * time.Second
+	DefaultSleepWindow              = 5 * time.Second
+	DefaultHalfOpenRequests         = 1
+
+	// numBuckets is the number of buckets the rolling window is divided into. Requests leave the window one bucket
+	// at a time.
+	numBuckets = 10
+)
+
+type State int32
+
+const (
+	// StateClosed lets all requests through and counts their failures
+	StateClosed State = iota
+	// StateHalfOpen lets a limited number of probe requests through after the sleep window
+	StateHalfOpen
+	// StateOpen rejects all requests until the sleep window has passed
+	StateOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateHalfOpen:
+		return "half_open"
+	case StateOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+// Outcome is the result of a request that was let through
+type Outcome int
+
+const (
+	Success Outcome = iota
+	Failure
+	// Ignored doesn't count the request, e.g. because it was canceled by the client. The probe slot of a half open
+	// circuit is released for the next request.
+	Ignored
+)
+
+type Config struct {
+	// ErrorThresholdPercentage is the failure rate in the rolling window that opens the circuit
+	ErrorThresholdPercentage int
+	// RequestThreshold is the number of requests in the rolling window before the failure rate is considered
+	RequestThreshold int
+	// ConsecutiveFailures opens the circuit after as many failed requests in a row. 0 disables the threshold.
+	ConsecutiveFailures int
+	// RollingDuration is the time window the failure rate is calculated for
+	RollingDuration time.Duration
+	// SleepWindow is the time the circuit stays open before probe requests are let through
+	SleepWindow time.Duration
+	// HalfOpenRequests is the number of probe requests that must succeed to close the circuit again
+	HalfOpenRequests int
+	// OnStateChange is called with the lock of the breaker held and must not call the breaker
+	OnStateChange func(name string, from, to State)
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+type bucket struct {
+	start     int64
+	successes int
+	failures  int
+}
+
+// Breaker is a circuit breaker with a failure rate and a consecutive failures threshold. All methods are safe for
+// concurrent use.
+type Breaker struct {
+	name string
+	cfg  Config
+
+	mu    sync.Mutex
+	state State
+	// generation changes with every state change. Results of requests that were let through in a previous
+	// generation are ignored.
+	generation     uint64
+	buckets        [numBuckets]bucket
+	bucketDuration int64
+	consecutive    int
+	openedAt       time.Time
+	probes         int
+	probeSuccesses int
+}
+
+func New(name string, cfg Config) *Breaker {
+	if cfg.ErrorThresholdPercentage <= 0 {
+		cfg.ErrorThresholdPercentage = DefaultErrorThresholdPercentage
+	}
+	if cfg.RequestThreshold <= 0 {
+		cfg.RequestThreshold = DefaultRequestThreshold
+	}
+	if cfg.RollingDuration <= 0 {
+		cfg.RollingDuration = DefaultRollingDuration
+	}
+	if cfg.SleepWindow <= 0 {
+		cfg.SleepWindow = DefaultSleepWindow
+	}
+	if cfg.HalfOpenRequests <= 0 {
+		cfg.HalfOpenRequests = DefaultHalfOpenRequests
+	}
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+
+	return &Breaker{
+		name:           name,
+		cfg:            cfg,
+		bucketDuration: max(int64(cfg.RollingDuration)/numBuckets, 1),
+	}
+}
+
+func (b *Breaker) Name() string {
+	return b.name
+}
+
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// Allow reports whether a request may be sent. If so, done must be called with the outcome of the request.
+// ErrOpen is returned if the circuit is open or all probe requests of the half open circuit are in flight.
+func (b *Breaker) Allow() (done func(outcome Outcome), err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.cfg.Now()
+
+	switch b.state {
+	case StateOpen:
+		if now.Sub(b.openedAt) < b.cfg.SleepWindow {
+			return nil, ErrOpen
+		}
+		b.setState(StateHalfOpen, now)
+		b.probes++
+	case StateHalfOpen:
+		if b.probes >= b.cfg.HalfOpenRequests {
+			return nil, ErrOpen
+		}
+		b.probes++
+	}
+
+	generation := b.generation
+	return func(outcome Outcome) {
+		b.done(generation, outcome)
+	}, nil
+}
+
+func (b *Breaker) done(generation uint64, outcome Outcome) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if generation != b.generation {
+		return
+	}
+
+	now := b.cfg.Now()
+
+	switch b.state {
+	case StateHalfOpen:
+		if outcome == Ignored {
+			b.probes--
+			return
+		}
+		if outcome == Failure {
+			b.setState(StateOpen, now)
+			return
+		}
+		b.probeSuccesses++
+		if b.probeSuccesses >= b.cfg.HalfOpenRequests {
+			b.setState(StateClosed, now)
+		}
+	case StateClosed:
+		if outcome == Ignored {
+			return
+		}
+		current := b.currentBucket(now.UnixNano())
+		if outcome == Success {
+			current.successes++
+			b.consecutive = 0
+			return
+		}
+		current.failures++
+		b.consecutive++
+		if b.shouldOpen(now.UnixNano()) {
+			b.setState(StateOpen, now)
+		}
+	}
+}
+
+func (b *Breaker) shouldOpen(now int64) bool {
+	if b.cfg.ConsecutiveFailures > 0 && b.consecutive >= b.cfg.ConsecutiveFailures {
+		return true
+	}
+
+	requests, failures := 0, 0
+	for _, bk := range b.buckets {
+		if bk.start > now-int64(b.cfg.RollingDuration) {
+			requests += bk.successes + bk.failures
+			failures += bk.failures
+		}
+	}
+
+	return requests >= b.cfg.RequestThreshold && failures*100 >= b.cfg.ErrorThresholdPercentage*requests
+}
+
+// currentBucket returns the bucket of now and resets it if it still holds the requests of a previous window
+func (b *Breaker) currentBucket(now int64) *bucket {
+	start := now - now%b.bucketDuration
+	bk := &b.buckets[(now/b.bucketDuration)%numBuckets]
+	if bk.start != start {
+		*bk = bucket{start: start}
+	}
+	return bk
+}
+
+// setState must be called with the lock held
+func (b *Breaker) setState(state State, now time.Time) {
+	from := b.state
+	b.state = state
+	b.generation++
+
+	switch state {
+	case StateClosed:
+		b.buckets = [numBuckets]bucket{}
+		b.consecutive = 0
+	case StateOpen:
+		b.openedAt = now
+	case StateHalfOpen:
+		b.probes = 0
+		b.probeSuccesses = 0
+	}
+
+	if b.cfg.OnStateChange != nil {
+		b.cfg.OnStateChange(b.name, from, state)
+	}
+}
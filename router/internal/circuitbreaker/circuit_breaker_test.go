@@ -0,0 +1,188 @@
+package circuitbreaker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func request(t *testing.T, b *Breaker, success bool) {
+	t.Helper()
+	done, err := b.Allow()
+	require.NoError(t, err)
+	if success {
+		done(Success)
+	} else {
+		done(Failure)
+	}
+}
+
+func TestBreakerFailureRate(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	b := New("employees", Config{
+		ErrorThresholdPercentage: 50,
+		RequestThreshold:         4,
+		RollingDuration:          10 * time.Second,
+		Now:                      clock.Now,
+	})
+
+	request(t, b, false)
+	request(t, b, true)
+	request(t, b, false)
+	require.Equal(t, StateClosed, b.State(), "below the request threshold")
+
+	request(t, b, true)
+	require.Equal(t, StateClosed, b.State(), "failures are only evaluated on failed requests")
+
+	request(t, b, false)
+	require.Equal(t, StateOpen, b.State())
+
+	_, err := b.Allow()
+	require.ErrorIs(t, err, ErrOpen)
+}
+
+func TestBreakerRollingWindow(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	b := New("employees", Config{
+		ErrorThresholdPercentage: 50,
+		RequestThreshold:         3,
+		RollingDuration:          10 * time.Second,
+		Now:                      clock.Now,
+	})
+
+	request(t, b, false)
+	request(t, b, false)
+
+	// The failures left the rolling window
+	clock.Advance(11 * time.Second)
+	request(t, b, true)
+	request(t, b, true)
+	request(t, b, false)
+	require.Equal(t, StateClosed, b.State())
+}
+
+func TestBreakerConsecutiveFailures(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	b := New("employees", Config{
+		ConsecutiveFailures: 3,
+		RequestThreshold:    100,
+		Now:                 clock.Now,
+	})
+
+	request(t, b, false)
+	request(t, b, false)
+	request(t, b, true)
+	request(t, b, false)
+	request(t, b, false)
+	require.Equal(t, StateClosed, b.State(), "a success resets the consecutive failures")
+
+	request(t, b, false)
+	require.Equal(t, StateOpen, b.State())
+}
+
+func TestBreakerHalfOpen(t *testing.T) {
+	t.Parallel()
+
+	var transitions []string
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	b := New("employees", Config{
+		ConsecutiveFailures: 1,
+		SleepWindow:         5 * time.Second,
+		HalfOpenRequests:    2,
+		Now:                 clock.Now,
+		OnStateChange: func(name string, from, to State) {
+			transitions = append(transitions, name+":"+from.String()+"->"+to.String())
+		},
+	})
+
+	// A request that was let through before the circuit opened doesn't count
+	late, err := b.Allow()
+	require.NoError(t, err)
+	request(t, b, false)
+	require.Equal(t, StateOpen, b.State())
+	late(Success)
+	require.Equal(t, StateOpen, b.State())
+
+	clock.Advance(5 * time.Second)
+	probe1, err := b.Allow()
+	require.NoError(t, err)
+	require.Equal(t, StateHalfOpen, b.State())
+	probe2, err := b.Allow()
+	require.NoError(t, err)
+	_, err = b.Allow()
+	require.ErrorIs(t, err, ErrOpen, "only the probe requests are let through")
+
+	// A failed probe opens the circuit again
+	probe1(Success)
+	probe2(Failure)
+	require.Equal(t, StateOpen, b.State())
+	_, err = b.Allow()
+	require.ErrorIs(t, err, ErrOpen)
+
+	clock.Advance(5 * time.Second)
+	request(t, b, true)
+	require.Equal(t, StateHalfOpen, b.State())
+	request(t, b, true)
+	require.Equal(t, StateClosed, b.State())
+
+	require.Equal(t, []string{
+		"employees:closed->open",
+		"employees:open->half_open",
+		"employees:half_open->open",
+		"employees:open->half_open",
+		"employees:half_open->closed",
+	}, transitions)
+}
+
+func TestBreakerIgnoredRequests(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	b := New("employees", Config{
+		ConsecutiveFailures: 2,
+		RequestThreshold:    100,
+		SleepWindow:         5 * time.Second,
+		HalfOpenRequests:    1,
+		Now:                 clock.Now,
+	})
+
+	// An ignored request neither resets the consecutive failures nor counts as a failure
+	request(t, b, false)
+	done, err := b.Allow()
+	require.NoError(t, err)
+	done(Ignored)
+	require.Equal(t, StateClosed, b.State())
+	request(t, b, false)
+	require.Equal(t, StateOpen, b.State())
+
+	// An ignored probe releases its slot without closing or opening the circuit
+	clock.Advance(5 * time.Second)
+	probe, err := b.Allow()
+	require.NoError(t, err)
+	_, err = b.Allow()
+	require.ErrorIs(t, err, ErrOpen)
+	probe(Ignored)
+	require.Equal(t, StateHalfOpen, b.State())
+
+	request(t, b, true)
+	require.Equal(t, StateClosed, b.State())
+}
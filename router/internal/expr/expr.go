@@ -121,6 +121,18 @@ func LoadAuth(ctx context.Context) RequestAuth {
 	}
 }
 
+// OperationCost is the context for expressions calculating the rate limit tokens an operation consumes.
+// It is independent of the request context, the expressions only see the complexity of the operation.
+type OperationCost struct {
+	// Fetches is the number of subgraph fetches of the query plan
+	Fetches    int `expr:"fetches"`
+	Fields     int `expr:"fields"`
+	RootFields int `expr:"rootFields"`
+	Depth      int `expr:"depth"`
+	// Cost is the estimated cost of the cost analysis, 0 if the cost analysis is disabled
+	Cost int `expr:"cost"`
+}
+
 func compileOptions(extra ...expr.Option) []expr.Option {
 	options := []expr.Option{
 		expr.Env(Context{}),
@@ -167,6 +179,31 @@ func CompileAnyExpression(s string) (*vm.Program, error) {
 	return v, nil
 }
 
+// CompileOperationCostExpression compiles an expression over the OperationCost context that returns a number.
+// Fractional results are truncated to int.
+func CompileOperationCostExpression(s string) (*vm.Program, error) {
+	v, err := expr.Compile(s, expr.Env(OperationCost{}), expr.AsInt())
+	if err != nil {
+		return nil, handleExpressionError(err)
+	}
+	return v, nil
+}
+
+// ResolveOperationCostExpression evaluates an expression compiled by CompileOperationCostExpression
+func ResolveOperationCostExpression(vm *vm.Program, cost OperationCost) (int, error) {
+	r, err := expr.Run(vm, cost)
+	if err != nil {
+		return 0, handleExpressionError(err)
+	}
+
+	switch v := r.(type) {
+	case int:
+		return v, nil
+	default:
+		return 0, fmt.Errorf("expected int, got %T", r)
+	}
+}
+
 // ResolveStringExpression evaluates the expression and returns the result as a string. The exprContext is used to
 // provide the context for the expression evaluation. Not safe for concurrent use.
 func ResolveStringExpression(vm *vm.Program, ctx Context) (string, error) {
@@ -0,0 +1,163 @@
+// Package ratelimit contains the GCRA and quota rate limiters that are not provided by redis_rate.
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/go-redis/redis_rate/v10"
+)
+
+const (
+	// DefaultMaxKeys is the number of keys a MemoryLimiter keeps if not configured
+	DefaultMaxKeys = 100_000
+	// DefaultShards is the number of shards a MemoryLimiter distributes the keys across if not configured
+	DefaultShards = 64
+)
+
+type MemoryLimiterOptions struct {
+	// MaxKeys bounds the number of keys kept in memory. Defaults to DefaultMaxKeys.
+	MaxKeys int
+	// Shards is the number of independently locked partitions of the keys. Defaults to DefaultShards.
+	Shards int
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// MemoryLimiter is a GCRA rate limiter that keeps the state of its keys in memory. It implements the same algorithm
+// as the redis_rate limiter and returns the same results, so both can be used interchangeably. The limits are
+// enforced per process, multiple router instances don't share their buckets.
+//
+// The state of a key is its theoretical arrival time (TAT). Once the TAT has passed, the bucket of the key is full
+// again and the key is idle, so removing it doesn't change any result. Idle keys are evicted when a shard is full.
+// If a shard only has active keys, the key closest to a full bucket is evicted, which can allow a few more
+// requests for that key but keeps the memory bounded.
+type MemoryLimiter struct {
+	shards          []*memoryShard
+	maxKeysPerShard int
+	now             func() time.Time
+}
+
+type memoryShard struct {
+	mu sync.Mutex
+	// tats maps the keys to their theoretical arrival time in unix nanoseconds
+	tats map[string]int64
+}
+
+func NewMemoryLimiter(opts MemoryLimiterOptions) *MemoryLimiter {
+	if opts.MaxKeys <= 0 {
+		opts.MaxKeys = DefaultMaxKeys
+	}
+	if opts.Shards <= 0 {
+		opts.Shards = DefaultShards
+	}
+	opts.Shards = min(opts.Shards, opts.MaxKeys)
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+
+	l := &MemoryLimiter{
+		shards:          make([]*memoryShard, opts.Shards),
+		maxKeysPerShard: opts.MaxKeys / opts.Shards,
+		now:             opts.Now,
+	}
+	for i := range l.shards {
+		l.shards[i] = &memoryShard{tats: make(map[string]int64)}
+	}
+
+	return l
+}
+
+// AllowN reports whether n events may happen for the key at time now
+func (l *MemoryLimiter) AllowN(_ context.Context, key string, limit redis_rate.Limit, n int) (*redis_rate.Result, error) {
+	if limit.Rate <= 0 || limit.Period <= 0 {
+		return nil, errors.New("rate limit rate and period must be positive")
+	}
+
+	now := l.now().UnixNano()
+	emissionInterval := int64(limit.Period) / int64(limit.Rate)
+	if emissionInterval <= 0 {
+		emissionInterval = 1
+	}
+	increment := emissionInterval * int64(n)
+	burstOffset := emissionInterval * int64(limit.Burst)
+
+	shard := l.shards[xxhash.Sum64String(key)%uint64(len(l.shards))]
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	tat, ok := shard.tats[key]
+	if !ok || tat < now {
+		tat = now
+	}
+
+	newTat := tat + increment
+	allowAt := newTat - burstOffset
+	diff := now - allowAt
+
+	if diff < 0 {
+		return &redis_rate.Result{
+			Limit:      limit,
+			Allowed:    0,
+			Remaining:  0,
+			RetryAfter: time.Duration(-diff),
+			ResetAfter: time.Duration(tat - now),
+		}, nil
+	}
+
+	resetAfter := newTat - now
+	if resetAfter > 0 {
+		shard.set(key, newTat, now, l.maxKeysPerShard)
+	}
+
+	return &redis_rate.Result{
+		Limit:      limit,
+		Allowed:    n,
+		Remaining:  int(diff / emissionInterval),
+		RetryAfter: -1,
+		ResetAfter: time.Duration(resetAfter),
+	}, nil
+}
+
+// Len returns the number of keys kept in memory
+func (l *MemoryLimiter) Len() int {
+	count := 0
+	for _, shard := range l.shards {
+		shard.mu.Lock()
+		count += len(shard.tats)
+		shard.mu.Unlock()
+	}
+	return count
+}
+
+// set stores the TAT of the key and evicts keys if the shard is full. Must be called with the lock held.
+func (s *memoryShard) set(key string, tat, now int64, maxKeys int) {
+	if _, ok := s.tats[key]; ok || len(s.tats) < maxKeys {
+		s.tats[key] = tat
+		return
+	}
+
+	for k, t := range s.tats {
+		if t <= now {
+			delete(s.tats, k)
+		}
+	}
+
+	if len(s.tats) >= maxKeys {
+		var (
+			oldestKey string
+			oldestTat int64
+		)
+		for k, t := range s.tats {
+			if oldestKey == "" || t < oldestTat {
+				oldestKey, oldestTat = k, t
+			}
+		}
+		delete(s.tats, oldestKey)
+	}
+
+	s.tats[key] = tat
+}
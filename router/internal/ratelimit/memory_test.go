@@ -0,0 +1,113 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis_rate/v10"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func TestMemoryLimiter(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	limiter := NewMemoryLimiter(MemoryLimiterOptions{Now: clock.Now})
+	limit := redis_rate.Limit{Rate: 2, Burst: 2, Period: 2 * time.Second}
+	ctx := context.Background()
+
+	res, err := limiter.AllowN(ctx, "key", limit, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, res.Allowed)
+	require.Equal(t, 1, res.Remaining)
+	require.Equal(t, time.Duration(-1), res.RetryAfter)
+	require.Equal(t, time.Second, res.ResetAfter)
+
+	res, err = limiter.AllowN(ctx, "key", limit, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, res.Allowed)
+	require.Equal(t, 0, res.Remaining)
+	require.Equal(t, 2*time.Second, res.ResetAfter)
+
+	res, err = limiter.AllowN(ctx, "key", limit, 1)
+	require.NoError(t, err)
+	require.Equal(t, 0, res.Allowed)
+	require.Equal(t, 0, res.Remaining)
+	require.Equal(t, time.Second, res.RetryAfter)
+	require.Equal(t, 2*time.Second, res.ResetAfter)
+
+	// Other keys have their own bucket
+	res, err = limiter.AllowN(ctx, "other", limit, 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, res.Allowed)
+
+	// One token is refilled per emission interval
+	clock.Advance(time.Second)
+	res, err = limiter.AllowN(ctx, "key", limit, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, res.Allowed)
+	require.Equal(t, 0, res.Remaining)
+
+	// Requests of more tokens than the burst are never allowed
+	res, err = limiter.AllowN(ctx, "new", limit, 3)
+	require.NoError(t, err)
+	require.Equal(t, 0, res.Allowed)
+	require.Equal(t, 2, limiter.Len())
+}
+
+func TestMemoryLimiterEviction(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	limiter := NewMemoryLimiter(MemoryLimiterOptions{MaxKeys: 4, Shards: 1, Now: clock.Now})
+	limit := redis_rate.Limit{Rate: 1, Burst: 1, Period: time.Second}
+	ctx := context.Background()
+
+	for i := 0; i < 4; i++ {
+		_, err := limiter.AllowN(ctx, fmt.Sprintf("key-%d", i), limit, 1)
+		require.NoError(t, err)
+		clock.Advance(100 * time.Millisecond)
+	}
+	require.Equal(t, 4, limiter.Len())
+
+	// All keys are active, the key closest to a full bucket is evicted
+	_, err := limiter.AllowN(ctx, "key-4", limit, 1)
+	require.NoError(t, err)
+	require.Equal(t, 4, limiter.Len())
+
+	res, err := limiter.AllowN(ctx, "key-0", limit, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, res.Allowed, "evicted key starts with a full bucket")
+
+	res, err = limiter.AllowN(ctx, "key-4", limit, 1)
+	require.NoError(t, err)
+	require.Equal(t, 0, res.Allowed, "active key is kept")
+
+	// Keys with a full bucket are idle and evicted together
+	clock.Advance(2 * time.Second)
+	_, err = limiter.AllowN(ctx, "key-5", limit, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, limiter.Len())
+}
+
+func TestMemoryLimiterInvalidLimit(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewMemoryLimiter(MemoryLimiterOptions{})
+	_, err := limiter.AllowN(context.Background(), "key", redis_rate.Limit{Burst: 1}, 1)
+	require.Error(t, err)
+}
@@ -0,0 +1,262 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// QuotaWindow is a fixed window that allows Limit requests per Period. Windows are aligned to the unix epoch, so a
+// window with a period of a day resets at midnight UTC.
+type QuotaWindow struct {
+	Name   string
+	Limit  int
+	Period time.Duration
+}
+
+type QuotaWindowResult struct {
+	Name       string
+	Limit      int
+	Period     time.Duration
+	Remaining  int
+	ResetAfter time.Duration
+}
+
+type QuotaResult struct {
+	Allowed bool
+	// Exhausted is the name of the first window without enough remaining requests. Empty if allowed.
+	Exhausted string
+	Windows   []QuotaWindowResult
+}
+
+// QuotaLimiter checks all windows of a key together. Requests are only counted if every window allows them.
+type QuotaLimiter interface {
+	AllowN(ctx context.Context, key string, windows []QuotaWindow, n int) (*QuotaResult, error)
+}
+
+func validateWindows(windows []QuotaWindow) error {
+	if len(windows) == 0 {
+		return errors.New("quota requires at least one window")
+	}
+	for _, window := range windows {
+		if window.Period <= 0 {
+			return fmt.Errorf("period of quota window '%s' must be positive", window.Name)
+		}
+	}
+	return nil
+}
+
+// windowStart returns the start of the window that contains now. time.Truncate aligns to the zero time instead of the
+// unix epoch, so the start is computed from the unix time.
+func windowStart(now time.Time, period time.Duration) time.Time {
+	nanos := now.UnixNano()
+	return time.Unix(0, nanos-nanos%int64(period))
+}
+
+// allowQuota checks and counts the windows in a single round trip. KEYS are the keys of the current windows, ARGV
+// is n followed by the limit and the TTL in milliseconds of every window. It returns the index of the first
+// exhausted window, 0 if allowed, followed by the count of every window.
+var allowQuota = redis.NewScript(`
+local n = tonumber(ARGV[1])
+local exhausted = 0
+local counts = {}
+
+for i, key in ipairs(KEYS) do
+  local count = tonumber(redis.call("GET", key) or "0")
+  counts[i] = count
+  if exhausted == 0 and count + n > tonumber(ARGV[i * 2]) then
+    exhausted = i
+  end
+end
+
+if exhausted == 0 and n > 0 then
+  for i, key in ipairs(KEYS) do
+    counts[i] = redis.call("INCRBY", key, n)
+    redis.call("PEXPIRE", key, ARGV[i * 2 + 1])
+  end
+end
+
+table.insert(counts, 1, exhausted)
+return counts
+`)
+
+// RedisQuotaLimiter counts the windows in Redis. The windows of a key share a hash tag, so they are stored on the
+// same node of a cluster.
+type RedisQuotaLimiter struct {
+	client redis.Scripter
+	now    func() time.Time
+}
+
+func NewRedisQuotaLimiter(client redis.Scripter) *RedisQuotaLimiter {
+	return &RedisQuotaLimiter{client: client, now: time.Now}
+}
+
+func (l *RedisQuotaLimiter) AllowN(ctx context.Context, key string, windows []QuotaWindow, n int) (*QuotaResult, error) {
+	if err := validateWindows(windows); err != nil {
+		return nil, err
+	}
+
+	now := l.now()
+	keys := make([]string, len(windows))
+	args := make([]any, 0, len(windows)*2+1)
+	args = append(args, n)
+	resets := make([]time.Duration, len(windows))
+
+	for i, window := range windows {
+		start := windowStart(now, window.Period)
+		resets[i] = start.Add(window.Period).Sub(now)
+		keys[i] = "{" + key + "}:" + window.Name + ":" + strconv.FormatInt(start.Unix(), 10)
+		args = append(args, window.Limit, max(resets[i].Milliseconds(), 1))
+	}
+
+	values, err := allowQuota.Run(ctx, l.client, keys, args...).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != len(windows)+1 {
+		return nil, fmt.Errorf("unexpected quota result length %d", len(values))
+	}
+
+	result := &QuotaResult{
+		Allowed: values[0] == 0,
+		Windows: make([]QuotaWindowResult, len(windows)),
+	}
+	if !result.Allowed {
+		result.Exhausted = windows[values[0]-1].Name
+	}
+	for i, window := range windows {
+		result.Windows[i] = QuotaWindowResult{
+			Name:       window.Name,
+			Limit:      window.Limit,
+			Period:     window.Period,
+			Remaining:  max(window.Limit-int(values[i+1]), 0),
+			ResetAfter: resets[i],
+		}
+	}
+
+	return result, nil
+}
+
+type MemoryQuotaLimiterOptions struct {
+	// MaxKeys bounds the number of window counters kept in memory. Defaults to DefaultMaxKeys.
+	MaxKeys int
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// MemoryQuotaLimiter counts the windows in memory. Counters of past windows are evicted when the limiter is full.
+// If all counters are current, the counter that resets next is evicted.
+type MemoryQuotaLimiter struct {
+	mu       sync.Mutex
+	counters map[string]quotaCounter
+	maxKeys  int
+	now      func() time.Time
+}
+
+type quotaCounter struct {
+	count int
+	// end is the end of the window in unix nanoseconds
+	end int64
+}
+
+func NewMemoryQuotaLimiter(opts MemoryQuotaLimiterOptions) *MemoryQuotaLimiter {
+	if opts.MaxKeys <= 0 {
+		opts.MaxKeys = DefaultMaxKeys
+	}
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+	return &MemoryQuotaLimiter{
+		counters: make(map[string]quotaCounter),
+		maxKeys:  opts.MaxKeys,
+		now:      opts.Now,
+	}
+}
+
+func (l *MemoryQuotaLimiter) AllowN(_ context.Context, key string, windows []QuotaWindow, n int) (*QuotaResult, error) {
+	if err := validateWindows(windows); err != nil {
+		return nil, err
+	}
+
+	now := l.now()
+	keys := make([]string, len(windows))
+	ends := make([]int64, len(windows))
+	for i, window := range windows {
+		end := windowStart(now, window.Period).Add(window.Period)
+		keys[i] = key + ":" + window.Name
+		ends[i] = end.UnixNano()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	result := &QuotaResult{Allowed: true, Windows: make([]QuotaWindowResult, len(windows))}
+	counts := make([]int, len(windows))
+	for i, window := range windows {
+		// A counter of a previous window starts over
+		if counter, ok := l.counters[keys[i]]; ok && counter.end == ends[i] {
+			counts[i] = counter.count
+		}
+		if result.Allowed && counts[i]+n > window.Limit {
+			result.Allowed = false
+			result.Exhausted = window.Name
+		}
+	}
+
+	for i, window := range windows {
+		if result.Allowed && n > 0 {
+			counts[i] += n
+			l.set(keys[i], quotaCounter{count: counts[i], end: ends[i]}, now.UnixNano())
+		}
+		result.Windows[i] = QuotaWindowResult{
+			Name:       window.Name,
+			Limit:      window.Limit,
+			Period:     window.Period,
+			Remaining:  max(window.Limit-counts[i], 0),
+			ResetAfter: time.Duration(ends[i] - now.UnixNano()),
+		}
+	}
+
+	return result, nil
+}
+
+// Len returns the number of window counters kept in memory
+func (l *MemoryQuotaLimiter) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.counters)
+}
+
+// set stores the counter and evicts counters if the limiter is full. Must be called with the lock held.
+func (l *MemoryQuotaLimiter) set(key string, counter quotaCounter, now int64) {
+	if _, ok := l.counters[key]; ok || len(l.counters) < l.maxKeys {
+		l.counters[key] = counter
+		return
+	}
+
+	for k, c := range l.counters {
+		if c.end <= now {
+			delete(l.counters, k)
+		}
+	}
+
+	if len(l.counters) >= l.maxKeys {
+		var (
+			nextKey string
+			nextEnd int64
+		)
+		for k, c := range l.counters {
+			if nextKey == "" || c.end < nextEnd {
+				nextKey, nextEnd = k, c.end
+			}
+		}
+		delete(l.counters, nextKey)
+	}
+
+	l.counters[key] = counter
+}
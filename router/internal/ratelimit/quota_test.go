@@ -0,0 +1,124 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuotaLimiter(t *testing.T) {
+	t.Parallel()
+
+	windows := []QuotaWindow{
+		{Name: "second", Limit: 2, Period: time.Second},
+		{Name: "day", Limit: 3, Period: 24 * time.Hour},
+	}
+
+	limiters := map[string]func(t *testing.T, clock *fakeClock) QuotaLimiter{
+		"memory": func(t *testing.T, clock *fakeClock) QuotaLimiter {
+			return NewMemoryQuotaLimiter(MemoryQuotaLimiterOptions{Now: clock.Now})
+		},
+		"redis": func(t *testing.T, clock *fakeClock) QuotaLimiter {
+			mr := miniredis.RunT(t)
+			limiter := NewRedisQuotaLimiter(redis.NewClient(&redis.Options{Addr: mr.Addr()}))
+			limiter.now = clock.Now
+			return limiter
+		},
+	}
+
+	for name, newLimiter := range limiters {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			clock := &fakeClock{now: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)}
+			limiter := newLimiter(t, clock)
+			ctx := context.Background()
+
+			res, err := limiter.AllowN(ctx, "key", windows, 1)
+			require.NoError(t, err)
+			require.True(t, res.Allowed)
+			require.Equal(t, QuotaWindowResult{Name: "second", Limit: 2, Period: time.Second, Remaining: 1, ResetAfter: time.Second}, res.Windows[0])
+			require.Equal(t, QuotaWindowResult{Name: "day", Limit: 3, Period: 24 * time.Hour, Remaining: 2, ResetAfter: 14 * time.Hour}, res.Windows[1])
+
+			res, err = limiter.AllowN(ctx, "key", windows, 1)
+			require.NoError(t, err)
+			require.True(t, res.Allowed)
+
+			// The per second window is exhausted, the request is not counted in the daily window
+			res, err = limiter.AllowN(ctx, "key", windows, 1)
+			require.NoError(t, err)
+			require.False(t, res.Allowed)
+			require.Equal(t, "second", res.Exhausted)
+			require.Equal(t, 0, res.Windows[0].Remaining)
+			require.Equal(t, 1, res.Windows[1].Remaining)
+
+			// Other keys have their own windows
+			res, err = limiter.AllowN(ctx, "other", windows, 1)
+			require.NoError(t, err)
+			require.True(t, res.Allowed)
+
+			clock.Advance(1500 * time.Millisecond)
+			res, err = limiter.AllowN(ctx, "key", windows, 1)
+			require.NoError(t, err)
+			require.True(t, res.Allowed)
+			require.Equal(t, 1, res.Windows[0].Remaining)
+			require.Equal(t, 500*time.Millisecond, res.Windows[0].ResetAfter)
+			require.Equal(t, 0, res.Windows[1].Remaining)
+
+			clock.Advance(time.Second)
+			res, err = limiter.AllowN(ctx, "key", windows, 1)
+			require.NoError(t, err)
+			require.False(t, res.Allowed)
+			require.Equal(t, "day", res.Exhausted)
+
+			// The daily window resets at midnight
+			clock.now = time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+			res, err = limiter.AllowN(ctx, "key", windows, 1)
+			require.NoError(t, err)
+			require.True(t, res.Allowed)
+			require.Equal(t, 2, res.Windows[1].Remaining)
+
+			_, err = limiter.AllowN(ctx, "key", nil, 1)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestMemoryQuotaLimiterEviction(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	limiter := NewMemoryQuotaLimiter(MemoryQuotaLimiterOptions{MaxKeys: 2, Now: clock.Now})
+	windows := []QuotaWindow{{Name: "minute", Limit: 1, Period: time.Minute}}
+	ctx := context.Background()
+
+	for _, key := range []string{"a", "b", "c"} {
+		res, err := limiter.AllowN(ctx, key, windows, 1)
+		require.NoError(t, err)
+		require.True(t, res.Allowed)
+	}
+	require.Equal(t, 2, limiter.Len())
+
+	clock.Advance(time.Minute)
+	_, err := limiter.AllowN(ctx, "d", windows, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, limiter.Len())
+}
+
+func TestWindowStartIsAlignedToUnixEpoch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 10, 12, 30, 0, 0, time.UTC)
+
+	require.True(t, time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC).Equal(windowStart(now, 24*time.Hour)))
+
+	week := 7 * 24 * time.Hour
+	start := windowStart(now, week)
+	require.Zero(t, start.Unix()%int64(week.Seconds()))
+	// The unix epoch was a Thursday
+	require.True(t, time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC).Equal(start))
+}
@@ -2,9 +2,11 @@ package retrytransport
 
 import (
 	"errors"
+	"fmt"
 	"github.com/cloudflare/backoff"
 	"go.uber.org/zap"
 	"net/http"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -31,6 +33,87 @@ var defaultRetryableStatusCodes = []int{
 	http.StatusTooManyRequests,
 }
 
+// retryableErrorClasses groups the retryable network errors, so that only some of them can be retried
+var retryableErrorClasses = map[string][]error{
+	"connection_refused": {syscall.ECONNREFUSED},
+	"connection_reset":   {syscall.ECONNRESET},
+	"timeout": {
+		syscall.ETIMEDOUT,
+		errors.New("i/o timeout"),
+		errors.New("timeout awaiting response headers"),
+	},
+	"dns": {errors.New("no such host")},
+	"tls_handshake": {
+		errors.New("handshake failure"),
+		errors.New("handshake timeout"),
+	},
+	"unexpected_eof": {
+		errors.New("unexpected EOF"),
+		errors.New("unexpected EOF reading trailer"),
+	},
+}
+
+var defaultClassifier = &Classifier{
+	statusCodes: defaultRetryableStatusCodes,
+	errors:      defaultRetryableErrors,
+}
+
+// Classifier decides if a failed request is retried based on the network error or the status code of the response.
+type Classifier struct {
+	statusCodes []int
+	errors      []error
+}
+
+// NewClassifier creates a classifier that retries the given status codes and the network errors of the given error
+// classes. If no status codes or error classes are given, the default ones are retried.
+func NewClassifier(statusCodes []int, errorClasses []string) (*Classifier, error) {
+	c := &Classifier{
+		statusCodes: defaultRetryableStatusCodes,
+		errors:      defaultRetryableErrors,
+	}
+
+	if len(statusCodes) > 0 {
+		c.statusCodes = statusCodes
+	}
+
+	if len(errorClasses) > 0 {
+		c.errors = nil
+		for _, class := range errorClasses {
+			classErrors, ok := retryableErrorClasses[class]
+			if !ok {
+				return nil, fmt.Errorf("unknown retryable error class '%s'", class)
+			}
+			c.errors = append(c.errors, classErrors...)
+		}
+	}
+
+	return c, nil
+}
+
+// IsRetryable reports whether the request failed with a retryable network error or status code
+func (c *Classifier) IsRetryable(err error, resp *http.Response) bool {
+	if err != nil {
+		// Network
+		s := strings.ToLower(err.Error())
+		for _, retryableError := range c.errors {
+			if strings.HasSuffix(s, strings.ToLower(retryableError.Error())) {
+				return true
+			}
+		}
+	}
+
+	if resp != nil {
+		// HTTP
+		for _, retryableStatusCode := range c.statusCodes {
+			if resp.StatusCode == retryableStatusCode {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 type ShouldRetryFunc func(err error, req *http.Request, resp *http.Response) bool
 
 type RetryOptions struct {
@@ -38,8 +121,11 @@ type RetryOptions struct {
 	MaxRetryCount int
 	Interval      time.Duration
 	MaxDuration   time.Duration
-	OnRetry       func(count int, req *http.Request, resp *http.Response, err error)
-	ShouldRetry   ShouldRetryFunc
+	// RespectRetryAfter waits for the duration of the Retry-After header of 429 and 503 responses instead of the
+	// backoff. If the header asks to wait longer than MaxDuration, the request isn't retried.
+	RespectRetryAfter bool
+	OnRetry           func(count int, req *http.Request, resp *http.Response, err error)
+	ShouldRetry       ShouldRetryFunc
 }
 
 type RetryHTTPTransport struct {
@@ -69,24 +155,52 @@ func (rt *RetryHTTPTransport) RoundTrip(req *http.Request) (*http.Response, erro
 
 	// Retry logic
 	retries := 0
-	for rt.RetryOptions.ShouldRetry(err, req, resp) && retries < rt.RetryOptions.MaxRetryCount {
+	for retries < rt.RetryOptions.MaxRetryCount {
+		// Wait for the specified backoff period
+		sleepDuration := b.Duration()
+
+		if rt.RetryOptions.RespectRetryAfter {
+			if retryAfter, ok := parseRetryAfter(resp, time.Now()); ok {
+				if retryAfter > rt.RetryOptions.MaxDuration {
+					rt.Logger.Debug("Not retrying request, Retry-After exceeds the max duration",
+						zap.String("url", req.URL.String()),
+						zap.Duration("retry_after", retryAfter),
+					)
+					break
+				}
+				sleepDuration = retryAfter
+			}
+		}
+
+		if !rt.RetryOptions.ShouldRetry(err, req, resp) {
+			break
+		}
+
 		if rt.RetryOptions.OnRetry != nil {
 			rt.RetryOptions.OnRetry(retries, req, resp, err)
 		}
 
 		retries++
 
-		// Wait for the specified backoff period
-		sleepDuration := b.Duration()
-
 		rt.Logger.Debug("Retrying request",
 			zap.Int("retry", retries),
 			zap.String("url", req.URL.String()),
 			zap.Duration("sleep", sleepDuration),
 		)
 
-		// Wait for the specified backoff period
-		time.Sleep(sleepDuration)
+		// Wait for the backoff period, unless the request is canceled in the meantime
+		timer := time.NewTimer(sleepDuration)
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			timer.Stop()
+			return resp, err
+		}
+
+		// Close the response of the failed attempt, so that its connection and resources are released
+		if resp != nil && resp.Body != nil {
+			_ = resp.Body.Close()
+		}
 
 		// Retry the request
 		resp, err = rt.RoundTripper.RoundTrip(req)
@@ -101,34 +215,36 @@ func (rt *RetryHTTPTransport) RoundTrip(req *http.Request) (*http.Response, erro
 	return resp, err
 }
 
+// parseRetryAfter returns the duration to wait according to the Retry-After header of a 429 or 503 response. The
+// header is either a number of seconds or an HTTP date.
+func parseRetryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if resp == nil || (resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable) {
+		return 0, false
+	}
+
+	value := strings.TrimSpace(resp.Header.Get("Retry-After"))
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(max(seconds, 0)) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+
+	return 0, false
+}
+
 func isResponseOK(resp *http.Response) bool {
 	// Ensure we don't wait for no reason when subgraphs don't behave
 	// spec-compliant and returns a different status code than 200.
 	return resp.StatusCode >= 200 && resp.StatusCode < 300
 }
 
+// IsRetryableError reports whether the request failed with one of the default retryable network errors or status codes
 func IsRetryableError(err error, resp *http.Response) bool {
-
-	if err != nil {
-		// Network
-		s := err.Error()
-		for _, retryableError := range defaultRetryableErrors {
-			if strings.HasSuffix(
-				strings.ToLower(s),
-				strings.ToLower(retryableError.Error())) {
-				return true
-			}
-		}
-	}
-
-	if resp != nil {
-		// HTTP
-		for _, retryableStatusCode := range defaultRetryableStatusCodes {
-			if resp.StatusCode == retryableStatusCode {
-				return true
-			}
-		}
-	}
-
-	return false
+	return defaultClassifier.IsRetryable(err, resp)
 }
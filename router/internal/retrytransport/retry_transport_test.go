@@ -1,10 +1,14 @@
 package retrytransport
 
 import (
+	"errors"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"syscall"
 	"testing"
 	"time"
 )
@@ -108,3 +112,142 @@ func TestRetryOnNetErrors(t *testing.T) {
 	assert.Equal(t, len(defaultRetryableErrors), retries)
 
 }
+
+func TestRetryAfter(t *testing.T) {
+
+	newTransport := func(retryAfter string, retries *[]time.Time) *RetryHTTPTransport {
+		return &RetryHTTPTransport{
+			RoundTripper: &MockTransport{
+				handler: func(req *http.Request) (*http.Response, error) {
+					*retries = append(*retries, time.Now())
+					if len(*retries) == 1 {
+						return &http.Response{
+							StatusCode: http.StatusTooManyRequests,
+							Header:     http.Header{"Retry-After": []string{retryAfter}},
+						}, nil
+					}
+					return &http.Response{
+						StatusCode: http.StatusOK,
+					}, nil
+				},
+			},
+			RetryOptions: RetryOptions{
+				MaxRetryCount:     3,
+				Interval:          1 * time.Millisecond,
+				MaxDuration:       2 * time.Second,
+				RespectRetryAfter: true,
+				ShouldRetry: func(err error, req *http.Request, resp *http.Response) bool {
+					return IsRetryableError(err, resp)
+				},
+			},
+			Logger: zap.NewNop(),
+		}
+	}
+
+	t.Run("waits for the Retry-After duration", func(t *testing.T) {
+		var attempts []time.Time
+		tr := newTransport("1", &attempts)
+
+		resp, err := tr.RoundTrip(httptest.NewRequest("GET", "http://localhost:3000/graphql", nil))
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Len(t, attempts, 2)
+		assert.GreaterOrEqual(t, attempts[1].Sub(attempts[0]), time.Second)
+	})
+
+	t.Run("doesn't retry if Retry-After exceeds the max duration", func(t *testing.T) {
+		var attempts []time.Time
+		tr := newTransport("60", &attempts)
+
+		resp, err := tr.RoundTrip(httptest.NewRequest("GET", "http://localhost:3000/graphql", nil))
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		assert.Len(t, attempts, 1)
+	})
+}
+
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestRetryClosesFailedResponses(t *testing.T) {
+	var bodies []*closeRecorder
+
+	tr := RetryHTTPTransport{
+		RoundTripper: &MockTransport{
+			handler: func(req *http.Request) (*http.Response, error) {
+				body := &closeRecorder{Reader: strings.NewReader("")}
+				bodies = append(bodies, body)
+				if len(bodies) < 3 {
+					return &http.Response{StatusCode: http.StatusBadGateway, Body: body}, nil
+				}
+				return &http.Response{StatusCode: http.StatusOK, Body: body}, nil
+			},
+		},
+		RetryOptions: RetryOptions{
+			MaxRetryCount: 3,
+			Interval:      time.Millisecond,
+			MaxDuration:   10 * time.Millisecond,
+			ShouldRetry: func(err error, req *http.Request, resp *http.Response) bool {
+				return IsRetryableError(err, resp)
+			},
+		},
+		Logger: zap.NewNop(),
+	}
+
+	resp, err := tr.RoundTrip(httptest.NewRequest("GET", "http://localhost:3000/graphql", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Len(t, bodies, 3)
+	assert.True(t, bodies[0].closed)
+	assert.True(t, bodies[1].closed)
+	assert.False(t, bodies[2].closed, "the returned response is closed by the caller")
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	response := func(statusCode int, retryAfter string) *http.Response {
+		return &http.Response{
+			StatusCode: statusCode,
+			Header:     http.Header{"Retry-After": []string{retryAfter}},
+		}
+	}
+
+	d, ok := parseRetryAfter(response(http.StatusServiceUnavailable, "5"), now)
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Second, d)
+
+	d, ok = parseRetryAfter(response(http.StatusTooManyRequests, "Mon, 01 Jan 2024 12:00:30 GMT"), now)
+	assert.True(t, ok)
+	assert.Equal(t, 30*time.Second, d)
+
+	d, ok = parseRetryAfter(response(http.StatusTooManyRequests, "Mon, 01 Jan 2024 11:00:00 GMT"), now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), d)
+
+	_, ok = parseRetryAfter(response(http.StatusBadGateway, "5"), now)
+	assert.False(t, ok)
+
+	_, ok = parseRetryAfter(response(http.StatusTooManyRequests, "soon"), now)
+	assert.False(t, ok)
+}
+
+func TestClassifier(t *testing.T) {
+	c, err := NewClassifier([]int{http.StatusBadGateway}, []string{"connection_refused"})
+	assert.NoError(t, err)
+
+	assert.True(t, c.IsRetryable(nil, &http.Response{StatusCode: http.StatusBadGateway}))
+	assert.False(t, c.IsRetryable(nil, &http.Response{StatusCode: http.StatusServiceUnavailable}))
+	assert.True(t, c.IsRetryable(syscall.ECONNREFUSED, nil))
+	assert.False(t, c.IsRetryable(errors.New("dial tcp: lookup subgraph: no such host"), nil))
+
+	_, err = NewClassifier(nil, []string{"unknown"})
+	assert.Error(t, err)
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"reflect"
 	"time"
 
 	"github.com/caarlos0/env/v11"
@@ -180,6 +181,37 @@ type GlobalSubgraphRequestRule struct {
 	MaxConnsPerHost     *int `yaml:"max_conns_per_host,omitempty" envDefault:"100"`
 	MaxIdleConns        *int `yaml:"max_idle_conns,omitempty" envDefault:"1024"`
 	MaxIdleConnsPerHost *int `yaml:"max_idle_conns_per_host,omitempty" envDefault:"20"`
+
+	CircuitBreaker CircuitBreaker `yaml:"circuit_breaker"`
+	Bulkhead       Bulkhead       `yaml:"bulkhead"`
+}
+
+// Bulkhead limits the number of concurrent requests to a subgraph. Requests that exceed the limit wait in a bounded
+// queue for a free slot.
+type Bulkhead struct {
+	Enabled               bool `yaml:"enabled" envDefault:"false"`
+	MaxConcurrentRequests int  `yaml:"max_concurrent_requests" envDefault:"100"`
+	// MaxQueueSize is the number of requests that wait for a free slot. Further requests are rejected.
+	MaxQueueSize int `yaml:"max_queue_size" envDefault:"100"`
+	// QueueTimeout is the time a request waits for a free slot before it is rejected
+	QueueTimeout time.Duration `yaml:"queue_timeout" envDefault:"1s"`
+}
+
+// CircuitBreaker stops sending requests to a subgraph while it fails. Requests fail if the subgraph can't be reached
+// or responds with a 5xx status code.
+type CircuitBreaker struct {
+	Enabled bool `yaml:"enabled" envDefault:"false"`
+	// ErrorThresholdPercentage is the failure rate in the rolling window that opens the circuit
+	ErrorThresholdPercentage int `yaml:"error_threshold_percentage" envDefault:"50"`
+	// RequestThreshold is the number of requests in the rolling window before the failure rate is considered
+	RequestThreshold int `yaml:"request_threshold" envDefault:"20"`
+	// ConsecutiveFailures opens the circuit after as many failed requests in a row. 0 disables the threshold.
+	ConsecutiveFailures int           `yaml:"consecutive_failures" envDefault:"0"`
+	RollingDuration     time.Duration `yaml:"rolling_duration" envDefault:"10s"`
+	// SleepWindow is the time the circuit stays open before probe requests are sent to the subgraph
+	SleepWindow time.Duration `yaml:"sleep_window" envDefault:"5s"`
+	// HalfOpenRequests is the number of probe requests that must succeed to close the circuit again
+	HalfOpenRequests int `yaml:"half_open_requests" envDefault:"1"`
 }
 
 type SubgraphTrafficRequestRule struct {
@@ -191,12 +223,49 @@ type GraphqlMetrics struct {
 	CollectorEndpoint string `yaml:"collector_endpoint" envDefault:"https://cosmo-metrics.wundergraph.com" env:"GRAPHQL_METRICS_COLLECTOR_ENDPOINT"`
 }
 
+// BackoffJitterRetry retries failed subgraph requests with an exponential backoff and jitter. A subgraph with its own
+// traffic shaping rules only has its own retry policy if it sets any of the retry options, otherwise the policy of all
+// subgraphs applies.
 type BackoffJitterRetry struct {
-	Enabled     bool          `yaml:"enabled" envDefault:"true" env:"RETRY_ENABLED"`
+	// Enabled enables retries. Defaults to true, also for the retry policy of a specific subgraph that doesn't set it.
+	Enabled     *bool         `yaml:"enabled" envDefault:"true" env:"RETRY_ENABLED"`
 	Algorithm   string        `yaml:"algorithm" envDefault:"backoff_jitter"`
 	MaxAttempts int           `yaml:"max_attempts" envDefault:"5"`
 	MaxDuration time.Duration `yaml:"max_duration" envDefault:"10s"`
 	Interval    time.Duration `yaml:"interval" envDefault:"3s"`
+	// StatusCodes are the retryable status codes. Defaults to 429, 500, 502, 503 and 504.
+	StatusCodes []int `yaml:"status_codes,omitempty"`
+	// ErrorClasses are the classes of retryable network errors. Defaults to all classes.
+	ErrorClasses []string `yaml:"error_classes,omitempty"`
+	// RespectRetryAfter waits as long as the Retry-After header of 429 and 503 responses asks to. Defaults to false
+	// for all subgraphs and to true for the retry policy of a specific subgraph.
+	RespectRetryAfter *bool `yaml:"respect_retry_after,omitempty" envDefault:"false"`
+	// RequestBudget is the number of retries of all requests to the subgraph for a single client request. 0 doesn't
+	// limit the retries.
+	RequestBudget int `yaml:"request_budget" envDefault:"0"`
+
+	// configured is set when the retry policy is present in the config file
+	configured bool
+}
+
+func (r *BackoffJitterRetry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain BackoffJitterRetry
+	if err := unmarshal((*plain)(r)); err != nil {
+		return err
+	}
+	r.configured = true
+	return nil
+}
+
+// IsEnabled reports whether retries are enabled. A retry policy that doesn't set Enabled is enabled.
+func (r *BackoffJitterRetry) IsEnabled() bool {
+	return r.Enabled == nil || *r.Enabled
+}
+
+// IsConfigured reports whether the retry policy is present in the config file or any of its options is set. A
+// subgraph without its own retry policy uses the retry policy of all subgraphs.
+func (r *BackoffJitterRetry) IsConfigured() bool {
+	return !reflect.ValueOf(*r).IsZero()
 }
 
 type SubgraphCacheControlRule struct {
@@ -382,6 +451,33 @@ type ComplexityLimits struct {
 	TotalFields      *ComplexityLimit `yaml:"total_fields"`
 	RootFields       *ComplexityLimit `yaml:"root_fields"`
 	RootFieldAliases *ComplexityLimit `yaml:"root_field_aliases"`
+	Cost             *CostAnalysis    `yaml:"cost"`
+}
+
+// CostAnalysis estimates the cost of an operation from the weights of its fields and types and the expected size of
+// its lists. Weights are read from the @cost and @listSize directives of the schema and can be overridden per field or
+// type in the router config.
+type CostAnalysis struct {
+	Enabled bool `yaml:"enabled" envDefault:"false"`
+	// Limit rejects operations with a higher estimated cost. If the limit is 0, operations are not rejected.
+	Limit                     int  `yaml:"limit,omitempty" envDefault:"0"`
+	IgnorePersistedOperations bool `yaml:"ignore_persisted_operations,omitempty" envDefault:"false"`
+	// ExposeInResponse adds the estimated cost to the extensions of the response
+	ExposeInResponse bool `yaml:"expose_in_response" envDefault:"false"`
+	// DefaultListSize is the assumed size of lists without a @listSize directive, slicing argument or mapping
+	DefaultListSize int `yaml:"default_list_size,omitempty" envDefault:"10"`
+	// MaxListSize caps the list sizes requested by slicing arguments of operations
+	MaxListSize int `yaml:"max_list_size,omitempty" envDefault:"10000"`
+	// FieldWeights maps fields as Type.field to their weight
+	FieldWeights map[string]int `yaml:"field_weights,omitempty"`
+	// TypeWeights maps types to their weight. It applies to every field returning the type without a field weight.
+	TypeWeights map[string]int `yaml:"type_weights,omitempty"`
+	// ListSizes maps list fields as Type.field to their assumed size
+	ListSizes map[string]int `yaml:"list_sizes,omitempty"`
+}
+
+func (c *CostAnalysis) ApplyLimit(isPersistent bool) bool {
+	return c.Enabled && c.Limit > 0 && (!isPersistent || !c.IgnorePersistedOperations)
 }
 
 type ComplexityLimit struct {
@@ -442,12 +538,17 @@ type RateLimitConfiguration struct {
 	Enabled        bool                    `yaml:"enabled" envDefault:"false" env:"RATE_LIMIT_ENABLED"`
 	Strategy       string                  `yaml:"strategy" envDefault:"simple" env:"RATE_LIMIT_STRATEGY"`
 	SimpleStrategy RateLimitSimpleStrategy `yaml:"simple_strategy"`
+	CostStrategy   RateLimitCostStrategy   `yaml:"cost_strategy"`
+	QuotaStrategy  RateLimitQuotaStrategy  `yaml:"quota_strategy"`
 	Storage        RedisConfiguration      `yaml:"storage"`
 	// Debug ensures that retryAfter and resetAfter are set to stable values for testing
 	// Debug also exposes the rate limit key in the response extension for debugging purposes
 	Debug               bool                        `yaml:"debug" envDefault:"false" env:"RATE_LIMIT_DEBUG"`
 	KeySuffixExpression string                      `yaml:"key_suffix_expression,omitempty" env:"RATE_LIMIT_KEY_SUFFIX_EXPRESSION"`
 	ErrorExtensionCode  RateLimitErrorExtensionCode `yaml:"error_extension_code"`
+	// ResponseHeaders sets the RateLimit-Limit, RateLimit-Remaining, RateLimit-Reset, RateLimit-Policy and
+	// Retry-After headers on responses
+	ResponseHeaders bool `yaml:"response_headers" envDefault:"false" env:"RATE_LIMIT_RESPONSE_HEADERS"`
 }
 
 type RateLimitErrorExtensionCode struct {
@@ -456,9 +557,14 @@ type RateLimitErrorExtensionCode struct {
 }
 
 type RedisConfiguration struct {
+	// Provider is the storage of the rate limit buckets, either redis or memory. The memory storage doesn't share
+	// the buckets between router instances.
+	Provider       string   `yaml:"provider,omitempty" envDefault:"redis" env:"RATE_LIMIT_STORAGE_PROVIDER"`
 	URLs           []string `yaml:"urls,omitempty" env:"RATE_LIMIT_REDIS_URLS"`
 	ClusterEnabled bool     `yaml:"cluster_enabled,omitempty" envDefault:"false" env:"RATE_LIMIT_REDIS_CLUSTER_ENABLED"`
 	KeyPrefix      string   `yaml:"key_prefix,omitempty" envDefault:"cosmo_rate_limit" env:"RATE_LIMIT_REDIS_KEY_PREFIX"`
+	// MaxKeys bounds the number of keys kept by the memory storage. Idle keys are evicted first.
+	MaxKeys int `yaml:"max_keys,omitempty" envDefault:"100000" env:"RATE_LIMIT_MEMORY_MAX_KEYS"`
 }
 
 type RateLimitSimpleStrategy struct {
@@ -470,6 +576,41 @@ type RateLimitSimpleStrategy struct {
 	HideStatsFromResponseExtension bool          `yaml:"hide_stats_from_response_extension" envDefault:"false" env:"RATE_LIMIT_SIMPLE_HIDE_STATS_FROM_RESPONSE_EXTENSION"`
 }
 
+// RateLimitCostStrategy limits the tokens operations consume per period instead of the number of requests.
+// Rate and Burst are specified in tokens.
+type RateLimitCostStrategy struct {
+	Rate                           int           `yaml:"rate" envDefault:"1000" env:"RATE_LIMIT_COST_RATE"`
+	Burst                          int           `yaml:"burst" envDefault:"1000" env:"RATE_LIMIT_COST_BURST"`
+	Period                         time.Duration `yaml:"period" envDefault:"1s" env:"RATE_LIMIT_COST_PERIOD"`
+	RejectExceedingRequests        bool          `yaml:"reject_exceeding_requests" envDefault:"false" env:"RATE_LIMIT_COST_REJECT_EXCEEDING_REQUESTS"`
+	RejectStatusCode               int           `yaml:"reject_status_code" envDefault:"200" env:"RATE_LIMIT_COST_REJECT_STATUS_CODE"`
+	HideStatsFromResponseExtension bool          `yaml:"hide_stats_from_response_extension" envDefault:"false" env:"RATE_LIMIT_COST_HIDE_STATS_FROM_RESPONSE_EXTENSION"`
+	// TokensExpression calculates the tokens an operation consumes from fetches, fields, rootFields, depth and cost.
+	// If empty, an operation consumes its estimated cost, which requires the cost analysis to be enabled.
+	TokensExpression string `yaml:"tokens_expression,omitempty" env:"RATE_LIMIT_COST_TOKENS_EXPRESSION"`
+}
+
+// RateLimitQuotaStrategy counts the operations of a key in several fixed windows. An operation is only allowed if
+// every window has remaining requests.
+type RateLimitQuotaStrategy struct {
+	// KeyBy is what the windows are counted for, either client_name, claim or key_suffix_expression
+	KeyBy string `yaml:"key_by" envDefault:"key_suffix_expression" env:"RATE_LIMIT_QUOTA_KEY_BY"`
+	// Claim is the JWT claim the windows are counted for if KeyBy is claim
+	Claim   string                 `yaml:"claim,omitempty" env:"RATE_LIMIT_QUOTA_CLAIM"`
+	Windows []RateLimitQuotaWindow `yaml:"windows,omitempty"`
+	// Overrides maps keys to the limits of their windows by window name
+	Overrides                      map[string]map[string]int `yaml:"overrides,omitempty"`
+	RejectExceedingRequests        bool                      `yaml:"reject_exceeding_requests" envDefault:"false" env:"RATE_LIMIT_QUOTA_REJECT_EXCEEDING_REQUESTS"`
+	RejectStatusCode               int                       `yaml:"reject_status_code" envDefault:"200" env:"RATE_LIMIT_QUOTA_REJECT_STATUS_CODE"`
+	HideStatsFromResponseExtension bool                      `yaml:"hide_stats_from_response_extension" envDefault:"false" env:"RATE_LIMIT_QUOTA_HIDE_STATS_FROM_RESPONSE_EXTENSION"`
+}
+
+type RateLimitQuotaWindow struct {
+	Name   string        `yaml:"name"`
+	Limit  int           `yaml:"limit"`
+	Period time.Duration `yaml:"period"`
+}
+
 type CDNConfiguration struct {
 	URL       string      `yaml:"url" env:"CDN_URL" envDefault:"https://cosmo-cdn.wundergraph.com"`
 	CacheSize BytesString `yaml:"cache_size,omitempty" env:"CDN_CACHE_SIZE" envDefault:"100MB"`
@@ -634,6 +775,7 @@ type StorageProviders struct {
 	S3    []S3StorageProvider    `yaml:"s3,omitempty"`
 	CDN   []BaseStorageProvider  `yaml:"cdn,omitempty"`
 	Redis []RedisStorageProvider `yaml:"redis,omitempty"`
+	HTTP  []HTTPStorageProvider  `yaml:"http,omitempty"`
 }
 
 type PersistedOperationsStorageConfig struct {
@@ -654,6 +796,17 @@ type S3StorageProvider struct {
 	Bucket    string `yaml:"bucket,omitempty"`
 	Region    string `yaml:"region,omitempty"`
 	Secure    bool   `yaml:"secure,omitempty"`
+	// VerifySignature verifies the signature of the execution config with the graph sign key
+	VerifySignature bool `yaml:"verify_signature,omitempty"`
+}
+
+type HTTPStorageProvider struct {
+	ID           string            `yaml:"id,omitempty"`
+	URL          string            `yaml:"url,omitempty"`
+	Headers      map[string]string `yaml:"headers,omitempty"`
+	SignatureKey string            `yaml:"signature_key,omitempty"`
+	// VerifySignature verifies the signature of the execution config with the graph sign key if no signature key is set
+	VerifySignature bool `yaml:"verify_signature,omitempty"`
 }
 
 type BaseStorageProvider struct {
@@ -685,6 +838,8 @@ type FallbackExecutionConfigStorage struct {
 type ExecutionConfigFile struct {
 	Path  string `yaml:"path,omitempty" env:"EXECUTION_CONFIG_FILE_PATH"`
 	Watch bool   `yaml:"watch,omitempty" envDefault:"false" env:"EXECUTION_CONFIG_FILE_WATCH"`
+	// VerifySignature verifies the signature in the file <path>.sig with the graph sign key
+	VerifySignature bool `yaml:"verify_signature,omitempty" envDefault:"false" env:"EXECUTION_CONFIG_FILE_VERIFY_SIGNATURE"`
 }
 
 type ExecutionConfig struct {
@@ -693,6 +848,51 @@ type ExecutionConfig struct {
 	FallbackStorage FallbackExecutionConfigStorage `yaml:"fallback_storage,omitempty" envPrefix:"EXECUTION_CONFIG_FALLBACK_STORAGE_"`
 }
 
+type ExecutionConfigHistory struct {
+	Enabled bool `yaml:"enabled" envDefault:"false" env:"ENABLED"`
+	// Size is the number of execution configs that are kept
+	Size int `yaml:"size,omitempty" envDefault:"10" env:"SIZE"`
+	// Dir is an optional directory to persist the execution configs across restarts
+	Dir string `yaml:"dir,omitempty" env:"DIR"`
+}
+
+type ExecutionConfigCanary struct {
+	Enabled bool `yaml:"enabled" envDefault:"false" env:"ENABLED"`
+	// TrafficShare is the share of requests between 0 and 1 that is routed to the new execution config
+	TrafficShare float64 `yaml:"traffic_share,omitempty" envDefault:"0.1" env:"TRAFFIC_SHARE"`
+	// StickyHeader is hashed to route all requests of a client or user to the same execution config.
+	// Without the header, the client IP is used.
+	StickyHeader string `yaml:"sticky_header,omitempty" env:"STICKY_HEADER"`
+	// Duration is the time after which the new execution config is promoted if its error rate is acceptable
+	Duration      time.Duration `yaml:"duration,omitempty" envDefault:"5m" env:"DURATION"`
+	CheckInterval time.Duration `yaml:"check_interval,omitempty" envDefault:"10s" env:"CHECK_INTERVAL"`
+	// MinRequests is the number of requests the new execution config must serve before it can be abandoned
+	MinRequests int `yaml:"min_requests,omitempty" envDefault:"100" env:"MIN_REQUESTS"`
+	// MaxErrorRateIncrease is the allowed increase of the error rate compared to the current execution config e.g. 0.01 for one percentage point
+	MaxErrorRateIncrease float64 `yaml:"max_error_rate_increase,omitempty" envDefault:"0.01" env:"MAX_ERROR_RATE_INCREASE"`
+}
+
+// DevComposition composes the execution config from local subgraph schemas instead of loading it from a file or the CDN.
+// It is meant for local development only and requires the dev router of the router-dev module.
+type DevComposition struct {
+	Enabled   bool                     `yaml:"enabled" envDefault:"false" env:"ENABLED"`
+	Subgraphs []DevCompositionSubgraph `yaml:"subgraphs,omitempty"`
+	// IntrospectionTimeout limits the introspection of subgraphs without a schema file
+	IntrospectionTimeout time.Duration `yaml:"introspection_timeout,omitempty" envDefault:"10s" env:"INTROSPECTION_TIMEOUT"`
+}
+
+type DevCompositionSubgraph struct {
+	Name       string `yaml:"name"`
+	RoutingURL string `yaml:"routing_url"`
+	// SchemaFile is watched for changes. Subgraphs without a schema file are introspected.
+	SchemaFile string `yaml:"schema_file,omitempty"`
+	// IntrospectionURL defaults to the routing URL
+	IntrospectionURL     string            `yaml:"introspection_url,omitempty"`
+	IntrospectionHeaders map[string]string `yaml:"introspection_headers,omitempty"`
+	SubscriptionURL      string            `yaml:"subscription_url,omitempty"`
+	SubscriptionProtocol string            `yaml:"subscription_protocol,omitempty"`
+}
+
 type PersistedOperationsCacheConfig struct {
 	Size BytesString `yaml:"size,omitempty" env:"PERSISTED_OPERATIONS_CACHE_SIZE" envDefault:"100MB"`
 }
@@ -878,6 +1078,10 @@ type Config struct {
 	Events                        EventsConfiguration         `yaml:"events,omitempty"`
 	CacheWarmup                   CacheWarmupConfiguration    `yaml:"cache_warmup,omitempty"`
 
+	WatchConfig WatchConfig `yaml:"watch_config,omitempty"`
+
+	AdminAPI AdminAPIConfiguration `yaml:"admin_api,omitempty"`
+
 	RouterConfigPath   string `yaml:"router_config_path,omitempty" env:"ROUTER_CONFIG_PATH"`
 	RouterRegistration bool   `yaml:"router_registration" env:"ROUTER_REGISTRATION" envDefault:"true"`
 
@@ -895,6 +1099,9 @@ type Config struct {
 
 	StorageProviders               StorageProviders                `yaml:"storage_providers"`
 	ExecutionConfig                ExecutionConfig                 `yaml:"execution_config"`
+	ExecutionConfigHistory         ExecutionConfigHistory          `yaml:"execution_config_history,omitempty" envPrefix:"EXECUTION_CONFIG_HISTORY_"`
+	ExecutionConfigCanary          ExecutionConfigCanary           `yaml:"execution_config_canary,omitempty" envPrefix:"EXECUTION_CONFIG_CANARY_"`
+	DevComposition                 DevComposition                  `yaml:"dev_composition,omitempty" envPrefix:"DEV_COMPOSITION_"`
 	PersistedOperationsConfig      PersistedOperationsConfig       `yaml:"persisted_operations"`
 	AutomaticPersistedQueries      AutomaticPersistedQueriesConfig `yaml:"automatic_persisted_queries"`
 	ApolloCompatibilityFlags       ApolloCompatibilityFlags        `yaml:"apollo_compatibility_flags"`
@@ -902,6 +1109,17 @@ type Config struct {
 	ClientHeader                   ClientHeader                    `yaml:"client_header"`
 }
 
+type WatchConfig struct {
+	Enabled bool `yaml:"enabled" envDefault:"false" env:"WATCH_CONFIG_ENABLED"`
+}
+
+type AdminAPIConfiguration struct {
+	Enabled bool   `yaml:"enabled" envDefault:"false" env:"ADMIN_API_ENABLED"`
+	Path    string `yaml:"path" envDefault:"/admin" env:"ADMIN_API_PATH"`
+	// Token must be sent as bearer token in the Authorization header of every admin API request
+	Token string `yaml:"token,omitempty" env:"ADMIN_API_TOKEN"`
+}
+
 type PlaygroundConfig struct {
 	Enabled          bool   `yaml:"enabled" envDefault:"true" env:"PLAYGROUND_ENABLED"`
 	Path             string `yaml:"path" envDefault:"/" env:"PLAYGROUND_PATH"`
@@ -911,6 +1129,12 @@ type PlaygroundConfig struct {
 type LoadResult struct {
 	Config        Config
 	DefaultLoaded bool
+	// Files are the config files that were merged in order
+	Files []string
+	// Sources maps the path of every value set by a config file e.g. headers.all.request[0].name to the file
+	Sources map[string]string
+
+	secrets resolvedSecrets
 }
 
 func LoadConfig(configFilePath string, envOverride string) (*LoadResult, error) {
@@ -933,9 +1157,7 @@ func LoadConfig(configFilePath string, envOverride string) (*LoadResult, error)
 		return nil, err
 	}
 
-	// Read the custom config file
-
-	var configFileBytes []byte
+	// Read the custom config files. Multiple files are merged in order
 
 	if configFilePath == "" {
 		configFilePath = os.Getenv("CONFIG_PATH")
@@ -945,37 +1167,66 @@ func LoadConfig(configFilePath string, envOverride string) (*LoadResult, error)
 	}
 
 	isDefaultConfigPath := configFilePath == DefaultConfigPath
-	configFileBytes, err = os.ReadFile(configFilePath)
-	if err != nil {
-		if isDefaultConfigPath {
-			cfg.DefaultLoaded = false
-		} else {
-			return nil, fmt.Errorf("could not read custom config file %s: %w", configFilePath, err)
+
+	var mergedConfig any = map[string]any{}
+	cfg.Sources = map[string]string{}
+
+	for _, filePath := range SplitConfigFilePaths(configFilePath) {
+		configFileBytes, err := os.ReadFile(filePath)
+		if err != nil {
+			if isDefaultConfigPath {
+				cfg.DefaultLoaded = false
+				continue
+			}
+			return nil, fmt.Errorf("could not read custom config file %s: %w", filePath, err)
 		}
+
+		// Expand environment variables in the config file. Secret references are resolved after merging
+
+		configYamlData := expandEnv(string(configFileBytes))
+
+		var fileConfig any
+		if err := yaml.Unmarshal([]byte(configYamlData), &fileConfig); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal router config %s: %w", filePath, err)
+		}
+
+		// Empty files don't contribute any value
+		if fileConfig != nil {
+			mergedConfig = mergeConfigValues(mergedConfig, fileConfig, "", filePath, cfg.Sources)
+		}
+
+		cfg.Files = append(cfg.Files, filePath)
 	}
 
-	if configFileBytes != nil {
-		// Expand environment variables in the config file
-		// and unmarshal it into the config struct
+	if len(cfg.Files) > 0 {
+		// Resolve secret references before the validation, so that the resolved values are validated
 
-		configYamlData := os.ExpandEnv(string(configFileBytes))
-		if err := yaml.Unmarshal([]byte(configYamlData), &cfg.Config); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal router config: %w", err)
+		cfg.secrets = resolvedSecrets{references: map[string]string{}}
+		mergedConfig, err = resolveSecretReferences(mergedConfig, "", &cfg.secrets)
+		if err != nil {
+			return nil, err
 		}
 
-		// Validate the config against the JSON schema
+		configFileBytes, err := yaml.Marshal(mergedConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to merge router config: %w", err)
+		}
+
+		if err := yaml.Unmarshal(configFileBytes, &cfg.Config); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal router config: %w", cfg.secrets.redactError(err))
+		}
 
-		configFileBytes = []byte(configYamlData)
+		// Validate the merged config against the JSON schema
 
 		err = ValidateConfig(configFileBytes, JSONSchema)
 		if err != nil {
-			return nil, fmt.Errorf("router config validation error: %w", err)
+			return nil, fmt.Errorf("router config validation error: %w", cfg.secrets.redactError(err))
 		}
 
 		// Unmarshal the final config
 
 		if err := yaml.Unmarshal(configFileBytes, &cfg.Config); err != nil {
-			return nil, err
+			return nil, cfg.secrets.redactError(err)
 		}
 	}
 
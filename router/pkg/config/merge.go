@@ -0,0 +1,109 @@
+package config
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// ConfigFileSeparator separates multiple config files in the config path e.g. base.yaml,production.yaml
+const ConfigFileSeparator = ","
+
+// appendListPaths are the lists that are concatenated when config files are merged. The entries of later
+// files are appended to the entries of earlier files. All other lists are replaced by the list of the later file.
+// A '*' matches a single path segment e.g. the name of a subgraph.
+var appendListPaths = []string{
+	"headers.all.request",
+	"headers.all.response",
+	"headers.subgraphs.*.request",
+	"headers.subgraphs.*.response",
+	"telemetry.tracing.exporters",
+	"telemetry.metrics.otlp.exporters",
+}
+
+// SplitConfigFilePaths splits a list of config files separated by ConfigFileSeparator.
+func SplitConfigFilePaths(configFilePath string) []string {
+	var paths []string
+	for _, p := range strings.Split(configFilePath, ConfigFileSeparator) {
+		if p = strings.TrimSpace(p); p != "" {
+			paths = append(paths, p)
+		}
+	}
+	return paths
+}
+
+// mergeConfigValues deep-merges the overlay into the base value and records the file of every merged value
+// in sources. Maps are merged key by key, scalars of the overlay replace the base and lists are replaced
+// unless they are listed in appendListPaths.
+func mergeConfigValues(base, overlay any, valuePath string, file string, sources map[string]string) any {
+	overlayMap, overlayIsMap := overlay.(map[string]any)
+	baseMap, baseIsMap := base.(map[string]any)
+
+	if overlayIsMap && baseIsMap {
+		for key, value := range overlayMap {
+			baseMap[key] = mergeConfigValues(baseMap[key], value, joinConfigPath(valuePath, key), file, sources)
+		}
+		return baseMap
+	}
+
+	overlayList, overlayIsList := overlay.([]any)
+	baseList, baseIsList := base.([]any)
+
+	if overlayIsList && baseIsList && isAppendListPath(valuePath) {
+		for i, value := range overlayList {
+			recordConfigSources(value, fmt.Sprintf("%s[%d]", valuePath, len(baseList)+i), file, sources)
+		}
+		return append(baseList, overlayList...)
+	}
+
+	removeConfigSources(valuePath, sources)
+	recordConfigSources(overlay, valuePath, file, sources)
+
+	return overlay
+}
+
+func recordConfigSources(value any, valuePath string, file string, sources map[string]string) {
+	switch v := value.(type) {
+	case map[string]any:
+		if len(v) == 0 {
+			sources[valuePath] = file
+		}
+		for key, child := range v {
+			recordConfigSources(child, joinConfigPath(valuePath, key), file, sources)
+		}
+	case []any:
+		if len(v) == 0 {
+			sources[valuePath] = file
+		}
+		for i, child := range v {
+			recordConfigSources(child, fmt.Sprintf("%s[%d]", valuePath, i), file, sources)
+		}
+	default:
+		sources[valuePath] = file
+	}
+}
+
+func removeConfigSources(valuePath string, sources map[string]string) {
+	for key := range sources {
+		if key == valuePath || strings.HasPrefix(key, valuePath+".") || strings.HasPrefix(key, valuePath+"[") {
+			delete(sources, key)
+		}
+	}
+}
+
+func isAppendListPath(valuePath string) bool {
+	for _, pattern := range appendListPaths {
+		// Config keys don't contain slashes, so we can reuse the glob matching of path.Match
+		if ok, _ := path.Match(strings.ReplaceAll(pattern, ".", "/"), strings.ReplaceAll(valuePath, ".", "/")); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func joinConfigPath(parent, key string) string {
+	if parent == "" {
+		return key
+	}
+	return parent + "." + key
+}
@@ -0,0 +1,115 @@
+package config
+
+import (
+	"reflect"
+)
+
+// configSection is a setting of the config identified by its YAML key
+type configSection struct {
+	key   string
+	value func(c *Config) any
+}
+
+// reloadableSections are the YAML keys of the settings that are applied by a reload of the router config.
+// Settings nested in these sections that can't be changed at runtime are listed in the rejected sections.
+var reloadableSections = []string{
+	"headers",
+	"cors",
+	"traffic_shaping",
+	"rate_limit",
+	"security",
+	"access_logs",
+	"admin_api",
+}
+
+// reloadRejectedSections are the settings that can only be applied on startup. A change of them rejects the reload.
+var reloadRejectedSections = []configSection{
+	{"listen_addr", func(c *Config) any { return c.ListenAddr }},
+	{"tls", func(c *Config) any { return c.TLS }},
+	{"traffic_shaping.router.max_header_bytes", func(c *Config) any { return c.TrafficShaping.Router.MaxHeaderBytes }},
+	// The history is created on startup and keeps the configs of previous graph servers
+	{"execution_config_history", func(c *Config) any { return c.ExecutionConfigHistory }},
+	// The settings of a running canary rollout are captured when the rollout starts
+	{"execution_config_canary", func(c *Config) any { return c.ExecutionConfigCanary }},
+	// The subgraphs are watched since startup
+	{"dev_composition", func(c *Config) any { return c.DevComposition }},
+}
+
+// reloadIgnoredSections are the settings that aren't applied by a reload. A change of them takes effect after a restart.
+var reloadIgnoredSections = []configSection{
+	{"version", func(c *Config) any { return c.Version }},
+	{"instance_id", func(c *Config) any { return c.InstanceID }},
+	{"graph", func(c *Config) any { return c.Graph }},
+	{"telemetry", func(c *Config) any { return c.Telemetry }},
+	{"graphql_metrics", func(c *Config) any { return c.GraphqlMetrics }},
+	{"cluster", func(c *Config) any { return c.Cluster }},
+	{"compliance", func(c *Config) any { return c.Compliance }},
+	{"cache_control_policy", func(c *Config) any { return c.CacheControl }},
+	{"modules", func(c *Config) any { return c.Modules }},
+	{"file_upload", func(c *Config) any { return c.FileUpload }},
+	{"controlplane_url", func(c *Config) any { return c.ControlplaneURL }},
+	{"playground", func(c *Config) any { return c.PlaygroundConfig }},
+	{"playground_enabled", func(c *Config) any { return c.PlaygroundEnabled }},
+	{"introspection_enabled", func(c *Config) any { return c.IntrospectionEnabled }},
+	{"query_plans_enabled", func(c *Config) any { return c.QueryPlansEnabled }},
+	{"log_level", func(c *Config) any { return c.LogLevel }},
+	{"json_log", func(c *Config) any { return c.JSONLog }},
+	{"shutdown_delay", func(c *Config) any { return c.ShutdownDelay }},
+	{"grace_period", func(c *Config) any { return c.GracePeriod }},
+	{"poll_interval", func(c *Config) any { return c.PollInterval }},
+	{"poll_jitter", func(c *Config) any { return c.PollJitter }},
+	{"health_check_path", func(c *Config) any { return c.HealthCheckPath }},
+	{"readiness_check_path", func(c *Config) any { return c.ReadinessCheckPath }},
+	{"liveness_check_path", func(c *Config) any { return c.LivenessCheckPath }},
+	{"graphql_path", func(c *Config) any { return c.GraphQLPath }},
+	{"playground_path", func(c *Config) any { return c.PlaygroundPath }},
+	{"authentication", func(c *Config) any { return c.Authentication }},
+	{"authorization", func(c *Config) any { return c.Authorization }},
+	{"localhost_fallback_inside_docker", func(c *Config) any { return c.LocalhostFallbackInsideDocker }},
+	{"cdn", func(c *Config) any { return c.CDN }},
+	{"dev_mode", func(c *Config) any { return c.DevelopmentMode }},
+	{"events", func(c *Config) any { return c.Events }},
+	{"cache_warmup", func(c *Config) any { return c.CacheWarmup }},
+	{"watch_config", func(c *Config) any { return c.WatchConfig }},
+	{"router_config_path", func(c *Config) any { return c.RouterConfigPath }},
+	{"router_registration", func(c *Config) any { return c.RouterRegistration }},
+	{"override_routing_url", func(c *Config) any { return c.OverrideRoutingURL }},
+	{"overrides", func(c *Config) any { return c.Overrides }},
+	{"engine", func(c *Config) any { return c.EngineExecutionConfiguration }},
+	{"websocket", func(c *Config) any { return c.WebSocket }},
+	{"subgraph_error_propagation", func(c *Config) any { return c.SubgraphErrorPropagation }},
+	{"storage_providers", func(c *Config) any { return c.StorageProviders }},
+	{"execution_config", func(c *Config) any { return c.ExecutionConfig }},
+	{"persisted_operations", func(c *Config) any { return c.PersistedOperationsConfig }},
+	{"automatic_persisted_queries", func(c *Config) any { return c.AutomaticPersistedQueries }},
+	{"apollo_compatibility_flags", func(c *Config) any { return c.ApolloCompatibilityFlags }},
+	{"apollo_router_compatibility_flags", func(c *Config) any { return c.ApolloRouterCompatibilityFlags }},
+	{"client_header", func(c *Config) any { return c.ClientHeader }},
+}
+
+// ReloadChanges are the changes between two configs that are not applied by a reload of the router config
+type ReloadChanges struct {
+	// Rejected are the YAML keys of the changed settings that can only be applied on startup
+	Rejected []string
+	// Ignored are the YAML keys of the changed settings that take effect after a restart
+	Ignored []string
+}
+
+// ReloadChanges compares the config with the next config and returns the changed settings that can't be applied
+// by a reload of the router config.
+func (c *Config) ReloadChanges(next *Config) ReloadChanges {
+	return ReloadChanges{
+		Rejected: changedSections(reloadRejectedSections, c, next),
+		Ignored:  changedSections(reloadIgnoredSections, c, next),
+	}
+}
+
+func changedSections(sections []configSection, active, next *Config) []string {
+	var changed []string
+	for _, section := range sections {
+		if !reflect.DeepEqual(section.value(active), section.value(next)) {
+			changed = append(changed, section.key)
+		}
+	}
+	return changed
+}
@@ -0,0 +1,116 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReloadChanges(t *testing.T) {
+	t.Parallel()
+
+	load := func(t *testing.T, content string) *Config {
+		t.Helper()
+		result, err := LoadConfig(createTempFileFromFixture(t, content), "")
+		require.NoError(t, err)
+		return &result.Config
+	}
+
+	active := load(t, `
+version: "1"
+
+listen_addr: "localhost:3002"
+introspection_enabled: true
+`)
+
+	t.Run("reports no changes of the same config", func(t *testing.T) {
+		t.Parallel()
+
+		changes := active.ReloadChanges(load(t, `
+version: "1"
+
+listen_addr: "localhost:3002"
+introspection_enabled: true
+`))
+		require.Empty(t, changes.Rejected)
+		require.Empty(t, changes.Ignored)
+	})
+
+	t.Run("applies reloadable settings", func(t *testing.T) {
+		t.Parallel()
+
+		changes := active.ReloadChanges(load(t, `
+version: "1"
+
+listen_addr: "localhost:3002"
+introspection_enabled: true
+
+headers:
+  all:
+    request:
+      - op: "propagate"
+        named: "X-Test"
+cors:
+  allow_origins: ["https://example.com"]
+traffic_shaping:
+  router:
+    max_request_body_size: 1MB
+`))
+		require.Empty(t, changes.Rejected)
+		require.Empty(t, changes.Ignored)
+	})
+
+	t.Run("rejects settings that can only be applied on startup", func(t *testing.T) {
+		t.Parallel()
+
+		changes := active.ReloadChanges(load(t, `
+version: "1"
+
+listen_addr: "localhost:3003"
+introspection_enabled: true
+
+traffic_shaping:
+  router:
+    max_header_bytes: 1MiB
+`))
+		require.Equal(t, []string{"listen_addr", "traffic_shaping.router.max_header_bytes"}, changes.Rejected)
+		require.Empty(t, changes.Ignored)
+	})
+
+	t.Run("reports settings that take effect after a restart", func(t *testing.T) {
+		t.Parallel()
+
+		changes := active.ReloadChanges(load(t, `
+version: "1"
+
+listen_addr: "localhost:3002"
+introspection_enabled: false
+graphql_path: "/api/graphql"
+`))
+		require.Empty(t, changes.Rejected)
+		require.Equal(t, []string{"introspection_enabled", "graphql_path"}, changes.Ignored)
+	})
+}
+
+func TestReloadSectionsCoverConfig(t *testing.T) {
+	t.Parallel()
+
+	covered := map[string]bool{}
+	for _, key := range reloadableSections {
+		covered[key] = true
+	}
+	for _, sections := range [][]configSection{reloadRejectedSections, reloadIgnoredSections} {
+		for _, section := range sections {
+			key, _, _ := strings.Cut(section.key, ".")
+			covered[key] = true
+		}
+	}
+
+	configType := reflect.TypeOf(Config{})
+	for i := 0; i < configType.NumField(); i++ {
+		key, _, _ := strings.Cut(configType.Field(i).Tag.Get("yaml"), ",")
+		require.True(t, covered[key], "config key %s is neither reloadable nor listed as rejected or ignored on reload", key)
+	}
+}
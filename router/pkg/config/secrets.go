@@ -0,0 +1,193 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// SecretProvider resolves secret references of the form ${<scheme>:<reference>} in the router config
+// e.g. ${file:/run/secrets/kafka_password}. Custom modules can register additional providers with
+// RegisterSecretProvider. Providers must be registered in an init function because the router config
+// is loaded before modules are provisioned.
+type SecretProvider interface {
+	// Scheme is the prefix of the reference e.g. "vault" for ${vault:kv/data/router#token}
+	Scheme() string
+	// Resolve returns the secret for the reference. The returned error must not contain the secret.
+	Resolve(reference string) (string, error)
+}
+
+var (
+	secretProvidersMu sync.RWMutex
+	secretProviders   = map[string]SecretProvider{}
+
+	// secretReferenceRegex matches ${<scheme>:<reference>}
+	secretReferenceRegex = regexp.MustCompile(`\$\{([a-zA-Z][a-zA-Z0-9_-]*):([^}]*)}`)
+)
+
+func init() {
+	RegisterSecretProvider(fileSecretProvider{})
+	RegisterSecretProvider(envSecretProvider{})
+}
+
+// RegisterSecretProvider registers a provider for secret references with the scheme of the provider.
+// It panics if the scheme is empty or a provider with the same scheme is already registered.
+func RegisterSecretProvider(provider SecretProvider) {
+	scheme := provider.Scheme()
+	if scheme == "" {
+		panic("secret provider scheme missing")
+	}
+
+	secretProvidersMu.Lock()
+	defer secretProvidersMu.Unlock()
+
+	if _, ok := secretProviders[scheme]; ok {
+		panic(fmt.Sprintf("secret provider already registered: %s", scheme))
+	}
+	secretProviders[scheme] = provider
+}
+
+func getSecretProvider(scheme string) (SecretProvider, bool) {
+	secretProvidersMu.RLock()
+	defer secretProvidersMu.RUnlock()
+
+	provider, ok := secretProviders[scheme]
+	return provider, ok
+}
+
+// expandEnv replaces ${var} or $var in the config file with the value of the environment variable
+// like os.ExpandEnv but keeps secret references intact. They are resolved after the file is parsed.
+func expandEnv(data string) string {
+	return os.Expand(data, func(name string) string {
+		if scheme, _, ok := strings.Cut(name, ":"); ok {
+			if _, ok := getSecretProvider(scheme); ok {
+				return "${" + name + "}"
+			}
+		}
+		return os.Getenv(name)
+	})
+}
+
+// resolvedSecrets tracks the config values that contain secret references
+type resolvedSecrets struct {
+	// references maps the config path to the unresolved value e.g. ${file:/run/secrets/token}
+	references map[string]string
+	values     []string
+}
+
+// resolveSecretReferences replaces all secret references in the string values of the parsed config.
+func resolveSecretReferences(value any, valuePath string, secrets *resolvedSecrets) (any, error) {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, child := range v {
+			resolved, err := resolveSecretReferences(child, joinConfigPath(valuePath, key), secrets)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = resolved
+		}
+		return v, nil
+	case []any:
+		for i, child := range v {
+			resolved, err := resolveSecretReferences(child, fmt.Sprintf("%s[%d]", valuePath, i), secrets)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = resolved
+		}
+		return v, nil
+	case string:
+		if !secretReferenceRegex.MatchString(v) {
+			return v, nil
+		}
+
+		var resolveErr error
+		resolved := secretReferenceRegex.ReplaceAllStringFunc(v, func(match string) string {
+			groups := secretReferenceRegex.FindStringSubmatch(match)
+			provider, ok := getSecretProvider(groups[1])
+			if !ok {
+				// Not a secret reference e.g. a literal value that looks similar
+				return match
+			}
+			secret, err := provider.Resolve(groups[2])
+			if err != nil {
+				resolveErr = fmt.Errorf("failed to resolve secret reference '%s' at '%s': %w", match, valuePath, err)
+				return match
+			}
+			secrets.values = append(secrets.values, secret)
+			return secret
+		})
+		if resolveErr != nil {
+			return nil, resolveErr
+		}
+		if resolved != v {
+			secrets.references[valuePath] = v
+		}
+		return resolved, nil
+	default:
+		return v, nil
+	}
+}
+
+// redact replaces all resolved secrets in the message. Longer secrets are replaced first,
+// so that secrets containing other secrets are fully redacted.
+func (s *resolvedSecrets) redact(message string) string {
+	values := make([]string, 0, len(s.values))
+	for _, v := range s.values {
+		if v != "" {
+			values = append(values, v)
+		}
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return len(values[i]) > len(values[j])
+	})
+	for _, v := range values {
+		message = strings.ReplaceAll(message, v, redactedValue)
+	}
+	return message
+}
+
+// redactError hides resolved secrets in error messages e.g. of the schema validation. The original error isn't
+// wrapped, because it would still expose the secrets through errors.As or formatting verbs like %+v.
+func (s *resolvedSecrets) redactError(err error) error {
+	if err == nil || len(s.values) == 0 {
+		return err
+	}
+	return errors.New(s.redact(err.Error()))
+}
+
+// fileSecretProvider resolves ${file:/path/to/secret} to the content of the file.
+// Trailing line breaks are removed because most secret files end with one.
+type fileSecretProvider struct{}
+
+func (fileSecretProvider) Scheme() string {
+	return "file"
+}
+
+func (fileSecretProvider) Resolve(reference string) (string, error) {
+	data, err := os.ReadFile(reference)
+	if err != nil {
+		return "", fmt.Errorf("could not read secret file: %w", err)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// envSecretProvider resolves ${env:NAME} to the value of the environment variable.
+// Unlike ${NAME}, the variable must be set.
+type envSecretProvider struct{}
+
+func (envSecretProvider) Scheme() string {
+	return "env"
+}
+
+func (envSecretProvider) Resolve(reference string) (string, error) {
+	value, ok := os.LookupEnv(reference)
+	if !ok {
+		return "", fmt.Errorf("environment variable %s is not set", reference)
+	}
+	return value, nil
+}
@@ -0,0 +1,379 @@
+// Package configdiff compares two execution configs of the router and classifies the changes by their
+// impact on existing clients. Besides the schema, it compares the parts of the execution config that
+// change the behavior of the router without changing the schema: the authorization of fields, the fields
+// each subgraph resolves and the event configurations. Feature flag configs are not compared.
+package configdiff
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	nodev1 "github.com/wundergraph/cosmo/router/gen/proto/wg/cosmo/node/v1"
+	"github.com/wundergraph/cosmo/router/pkg/schemadiff"
+	"google.golang.org/protobuf/proto"
+)
+
+// Kind is the part of the execution config a change belongs to.
+type Kind string
+
+const (
+	KindSchema             Kind = "SCHEMA"
+	KindFieldConfiguration Kind = "FIELD_CONFIGURATION"
+	KindRootNode           Kind = "ROOT_NODE"
+	KindEventConfiguration Kind = "EVENT_CONFIGURATION"
+)
+
+// Change types of the execution config in addition to the schema change types of schemadiff.
+const (
+	RequiresAuthenticationAdded   schemadiff.ChangeType = "REQUIRES_AUTHENTICATION_ADDED"
+	RequiresAuthenticationRemoved schemadiff.ChangeType = "REQUIRES_AUTHENTICATION_REMOVED"
+	RequiredScopesChanged         schemadiff.ChangeType = "REQUIRED_SCOPES_CHANGED"
+	SubscriptionFilterChanged     schemadiff.ChangeType = "SUBSCRIPTION_FILTER_CHANGED"
+	RootNodeAdded                 schemadiff.ChangeType = "ROOT_NODE_ADDED"
+	RootNodeRemoved               schemadiff.ChangeType = "ROOT_NODE_REMOVED"
+	EventConfigurationAdded       schemadiff.ChangeType = "EVENT_CONFIGURATION_ADDED"
+	EventConfigurationRemoved     schemadiff.ChangeType = "EVENT_CONFIGURATION_REMOVED"
+	EventConfigurationChanged     schemadiff.ChangeType = "EVENT_CONFIGURATION_CHANGED"
+)
+
+// Change is a single difference between two execution configs.
+type Change struct {
+	Kind        Kind                   `json:"kind"`
+	Type        schemadiff.ChangeType  `json:"type"`
+	Criticality schemadiff.Criticality `json:"criticality"`
+	// Path is the schema coordinate of the changed element, e.g. Query.employee
+	Path string `json:"path"`
+	// Subgraph is the name of the subgraph the change belongs to. Empty for schema changes.
+	Subgraph string `json:"subgraph,omitempty"`
+	Message  string `json:"message"`
+}
+
+// Report is the list of changes between two execution configs ordered by path.
+type Report struct {
+	Changes []Change `json:"changes"`
+}
+
+// HasChanges returns true if the execution configs differ.
+func (r *Report) HasChanges() bool {
+	return len(r.Changes) > 0
+}
+
+// Count returns the number of changes with the given criticality.
+func (r *Report) Count(criticality schemadiff.Criticality) int {
+	return len(r.Filter(criticality))
+}
+
+// Filter returns the changes with the given criticality.
+func (r *Report) Filter(criticality schemadiff.Criticality) []Change {
+	var changes []Change
+	for _, change := range r.Changes {
+		if change.Criticality == criticality {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+// Compare returns the changes from the old to the new execution config.
+func Compare(oldConfig, newConfig *nodev1.RouterConfig) (*Report, error) {
+	schemaDiff, err := schemadiff.Compare(oldConfig.GetEngineConfig().GetGraphqlSchema(), newConfig.GetEngineConfig().GetGraphqlSchema())
+	if err != nil {
+		return nil, err
+	}
+
+	c := &comparer{
+		removedFromSchema: make(map[string]struct{}),
+		addedToSchema:     make(map[string]struct{}),
+	}
+
+	for _, change := range schemaDiff.Changes {
+		switch change.Type {
+		case schemadiff.FieldRemoved, schemadiff.TypeRemoved, schemadiff.TypeKindChanged:
+			c.removedFromSchema[change.Path] = struct{}{}
+		case schemadiff.FieldAdded, schemadiff.TypeAdded:
+			c.addedToSchema[change.Path] = struct{}{}
+		}
+		c.changes = append(c.changes, Change{
+			Kind:        KindSchema,
+			Type:        change.Type,
+			Criticality: change.Criticality,
+			Path:        change.Path,
+			Message:     change.Message,
+		})
+	}
+
+	c.compareFieldConfigurations(oldConfig.GetEngineConfig().GetFieldConfigurations(), newConfig.GetEngineConfig().GetFieldConfigurations())
+	c.compareSubgraphs(oldConfig, newConfig)
+
+	// The changes are collected from maps, so every change must have a distinct position in the order
+	sort.Slice(c.changes, func(i, j int) bool {
+		a, b := c.changes[i], c.changes[j]
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.Subgraph != b.Subgraph {
+			return a.Subgraph < b.Subgraph
+		}
+		return a.Message < b.Message
+	})
+
+	return &Report{Changes: c.changes}, nil
+}
+
+type comparer struct {
+	changes []Change
+	// removedFromSchema and addedToSchema hold the fields and types that were removed or added in the schema
+	removedFromSchema map[string]struct{}
+	addedToSchema     map[string]struct{}
+}
+
+func (c *comparer) add(kind Kind, changeType schemadiff.ChangeType, criticality schemadiff.Criticality, path, subgraph, message string) {
+	c.changes = append(c.changes, Change{
+		Kind:        kind,
+		Type:        changeType,
+		Criticality: criticality,
+		Path:        path,
+		Subgraph:    subgraph,
+		Message:     message,
+	})
+}
+
+func (c *comparer) compareFieldConfigurations(oldConfigs, newConfigs []*nodev1.FieldConfiguration) {
+	oldByPath := fieldConfigurationsByPath(oldConfigs)
+	newByPath := fieldConfigurationsByPath(newConfigs)
+
+	// Fields without a configuration have neither authorization nor a subscription filter,
+	// so fields that appear or disappear are compared against an empty configuration
+	paths := make(map[string]struct{}, len(oldByPath)+len(newByPath))
+	for path := range oldByPath {
+		paths[path] = struct{}{}
+	}
+	for path := range newByPath {
+		paths[path] = struct{}{}
+	}
+
+	for path := range paths {
+		// The configuration of added or removed fields doesn't affect existing clients beyond the schema change
+		if c.isAddedToSchema(path) || c.isRemovedFromSchema(path) {
+			continue
+		}
+
+		oldConfig, newConfig := oldByPath[path], newByPath[path]
+
+		oldAuth, newAuth := oldConfig.GetAuthorizationConfiguration(), newConfig.GetAuthorizationConfiguration()
+
+		switch {
+		case !oldAuth.GetRequiresAuthentication() && newAuth.GetRequiresAuthentication():
+			c.add(KindFieldConfiguration, RequiresAuthenticationAdded, schemadiff.CriticalityBreaking, path, "",
+				fmt.Sprintf("Field '%s' requires authentication", path))
+		case oldAuth.GetRequiresAuthentication() && !newAuth.GetRequiresAuthentication():
+			c.add(KindFieldConfiguration, RequiresAuthenticationRemoved, schemadiff.CriticalityDangerous, path, "",
+				fmt.Sprintf("Field '%s' no longer requires authentication", path))
+		}
+
+		oldScopes, newScopes := scopeSets(oldAuth.GetRequiredOrScopes()), scopeSets(newAuth.GetRequiredOrScopes())
+		if !slices.EqualFunc(oldScopes, newScopes, slices.Equal[[]string]) {
+			criticality := schemadiff.CriticalityDangerous
+			if !scopesAtLeastAsPermissive(oldScopes, newScopes) {
+				// Clients with the scopes of the old configuration can lose access
+				criticality = schemadiff.CriticalityBreaking
+			}
+			c.add(KindFieldConfiguration, RequiredScopesChanged, criticality, path, "",
+				fmt.Sprintf("Required scopes of field '%s' changed from %s to %s", path, formatScopes(oldScopes), formatScopes(newScopes)))
+		}
+
+		if !proto.Equal(oldConfig.GetSubscriptionFilterCondition(), newConfig.GetSubscriptionFilterCondition()) {
+			c.add(KindFieldConfiguration, SubscriptionFilterChanged, schemadiff.CriticalityDangerous, path, "",
+				fmt.Sprintf("Subscription filter of field '%s' changed", path))
+		}
+	}
+}
+
+func (c *comparer) isRemovedFromSchema(path string) bool {
+	return containsFieldOrType(c.removedFromSchema, path)
+}
+
+func (c *comparer) isAddedToSchema(path string) bool {
+	return containsFieldOrType(c.addedToSchema, path)
+}
+
+func containsFieldOrType(paths map[string]struct{}, path string) bool {
+	if _, ok := paths[path]; ok {
+		return true
+	}
+	typeName, _, _ := strings.Cut(path, ".")
+	_, ok := paths[typeName]
+	return ok
+}
+
+func fieldConfigurationsByPath(configs []*nodev1.FieldConfiguration) map[string]*nodev1.FieldConfiguration {
+	byPath := make(map[string]*nodev1.FieldConfiguration, len(configs))
+	for _, config := range configs {
+		byPath[config.GetTypeName()+"."+config.GetFieldName()] = config
+	}
+	return byPath
+}
+
+// scopeSets returns the sorted OR-combined sets of AND-combined scopes
+func scopeSets(orScopes []*nodev1.Scopes) [][]string {
+	sets := make([][]string, 0, len(orScopes))
+	for _, andScopes := range orScopes {
+		set := slices.Clone(andScopes.GetRequiredAndScopes())
+		slices.Sort(set)
+		sets = append(sets, slices.Compact(set))
+	}
+	slices.SortFunc(sets, func(a, b []string) int {
+		return strings.Compare(strings.Join(a, " "), strings.Join(b, " "))
+	})
+	return sets
+}
+
+// scopesAtLeastAsPermissive returns true if every client that satisfies the old scopes also satisfies the new ones.
+// That is the case if every old set of scopes contains at least one new set of scopes.
+func scopesAtLeastAsPermissive(oldScopes, newScopes [][]string) bool {
+	if len(newScopes) == 0 {
+		return true
+	}
+	if len(oldScopes) == 0 {
+		return false
+	}
+	for _, oldSet := range oldScopes {
+		satisfied := slices.ContainsFunc(newScopes, func(newSet []string) bool {
+			for _, scope := range newSet {
+				if !slices.Contains(oldSet, scope) {
+					return false
+				}
+			}
+			return true
+		})
+		if !satisfied {
+			return false
+		}
+	}
+	return true
+}
+
+func formatScopes(scopes [][]string) string {
+	if len(scopes) == 0 {
+		return "none"
+	}
+	sets := make([]string, 0, len(scopes))
+	for _, set := range scopes {
+		sets = append(sets, "["+strings.Join(set, " AND ")+"]")
+	}
+	return strings.Join(sets, " OR ")
+}
+
+type eventConfiguration struct {
+	subgraph string
+	path     string
+	config   proto.Message
+}
+
+func (c *comparer) compareSubgraphs(oldConfig, newConfig *nodev1.RouterConfig) {
+	oldOwners, oldEvents := dataSourceNodes(oldConfig)
+	newOwners, newEvents := dataSourceNodes(newConfig)
+
+	for path, oldSubgraphs := range oldOwners {
+		newSubgraphs := newOwners[path]
+		for _, subgraph := range oldSubgraphs {
+			if slices.Contains(newSubgraphs, subgraph) {
+				continue
+			}
+			criticality := schemadiff.CriticalityDangerous
+			message := fmt.Sprintf("Field '%s' is no longer resolved by subgraph '%s'", path, subgraph)
+			if len(newSubgraphs) == 0 {
+				// Fields removed from the schema are already reported by the schema changes
+				if c.isRemovedFromSchema(path) {
+					continue
+				}
+				// The field is still in the schema but operations that select it can't be planned
+				criticality = schemadiff.CriticalityBreaking
+				message = fmt.Sprintf("Field '%s' is no longer resolved by any subgraph", path)
+			}
+			c.add(KindRootNode, RootNodeRemoved, criticality, path, subgraph, message)
+		}
+	}
+
+	for path, newSubgraphs := range newOwners {
+		oldSubgraphs := oldOwners[path]
+		for _, subgraph := range newSubgraphs {
+			if !slices.Contains(oldSubgraphs, subgraph) {
+				c.add(KindRootNode, RootNodeAdded, schemadiff.CriticalitySafe, path, subgraph,
+					fmt.Sprintf("Field '%s' is resolved by subgraph '%s'", path, subgraph))
+			}
+		}
+	}
+
+	for key, oldEvent := range oldEvents {
+		newEvent, ok := newEvents[key]
+		if !ok {
+			if c.isRemovedFromSchema(oldEvent.path) {
+				continue
+			}
+			c.add(KindEventConfiguration, EventConfigurationRemoved, schemadiff.CriticalityBreaking, oldEvent.path, oldEvent.subgraph,
+				fmt.Sprintf("Event configuration of field '%s' was removed from subgraph '%s'", oldEvent.path, oldEvent.subgraph))
+			continue
+		}
+		if !proto.Equal(oldEvent.config, newEvent.config) {
+			c.add(KindEventConfiguration, EventConfigurationChanged, schemadiff.CriticalityDangerous, oldEvent.path, oldEvent.subgraph,
+				fmt.Sprintf("Event configuration of field '%s' in subgraph '%s' changed", oldEvent.path, oldEvent.subgraph))
+		}
+	}
+
+	for key, newEvent := range newEvents {
+		if _, ok := oldEvents[key]; !ok {
+			c.add(KindEventConfiguration, EventConfigurationAdded, schemadiff.CriticalitySafe, newEvent.path, newEvent.subgraph,
+				fmt.Sprintf("Event configuration of field '%s' was added to subgraph '%s'", newEvent.path, newEvent.subgraph))
+		}
+	}
+}
+
+// dataSourceNodes returns the subgraphs that resolve each root field and the event configurations by subgraph and field.
+// Subgraphs are identified by name because the ids of the data sources aren't stable across compositions.
+func dataSourceNodes(config *nodev1.RouterConfig) (map[string][]string, map[string]eventConfiguration) {
+	names := make(map[string]string, len(config.GetSubgraphs()))
+	for _, subgraph := range config.GetSubgraphs() {
+		names[subgraph.GetId()] = subgraph.GetName()
+	}
+
+	owners := make(map[string][]string)
+	events := make(map[string]eventConfiguration)
+
+	for _, ds := range config.GetEngineConfig().GetDatasourceConfigurations() {
+		subgraph, ok := names[ds.GetId()]
+		if !ok {
+			subgraph = ds.GetId()
+		}
+
+		for _, node := range ds.GetRootNodes() {
+			for _, fieldName := range node.GetFieldNames() {
+				path := node.GetTypeName() + "." + fieldName
+				if !slices.Contains(owners[path], subgraph) {
+					owners[path] = append(owners[path], subgraph)
+				}
+			}
+		}
+
+		addEvent := func(engineConfig *nodev1.EngineEventConfiguration, config proto.Message) {
+			path := engineConfig.GetTypeName() + "." + engineConfig.GetFieldName()
+			events[subgraph+"/"+path] = eventConfiguration{subgraph: subgraph, path: path, config: config}
+		}
+		for _, nats := range ds.GetCustomEvents().GetNats() {
+			addEvent(nats.GetEngineEventConfiguration(), nats)
+		}
+		for _, kafka := range ds.GetCustomEvents().GetKafka() {
+			addEvent(kafka.GetEngineEventConfiguration(), kafka)
+		}
+	}
+
+	return owners, events
+}
@@ -0,0 +1,289 @@
+package configdiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	nodev1 "github.com/wundergraph/cosmo/router/gen/proto/wg/cosmo/node/v1"
+	"github.com/wundergraph/cosmo/router/pkg/schemadiff"
+)
+
+const oldSchema = `
+type Query {
+  employee(id: Int!): Employee
+  employees: [Employee!]!
+  products: [String]
+}
+
+type Employee {
+  id: Int!
+  name: String
+  salary: Int
+}
+
+type Subscription {
+  employeeUpdated: Employee
+}
+`
+
+const newSchema = `
+type Query {
+  employee(id: Int!): Employee
+  employees: [Employee!]!
+  products: [String]
+}
+
+type Employee {
+  id: Int!
+  name: String
+  salary: Int
+  role: String
+}
+
+type Subscription {
+  employeeUpdated: Employee
+}
+`
+
+func scopes(sets ...[]string) *nodev1.AuthorizationConfiguration {
+	auth := &nodev1.AuthorizationConfiguration{}
+	for _, set := range sets {
+		auth.RequiredOrScopes = append(auth.RequiredOrScopes, &nodev1.Scopes{RequiredAndScopes: set})
+	}
+	return auth
+}
+
+func routerConfig(schema string, fieldConfigurations []*nodev1.FieldConfiguration, dataSources ...*nodev1.DataSourceConfiguration) *nodev1.RouterConfig {
+	return &nodev1.RouterConfig{
+		EngineConfig: &nodev1.EngineConfiguration{
+			GraphqlSchema:            schema,
+			FieldConfigurations:      fieldConfigurations,
+			DatasourceConfigurations: dataSources,
+		},
+		Subgraphs: []*nodev1.Subgraph{
+			{Id: "0", Name: "employees"},
+			{Id: "1", Name: "products"},
+		},
+	}
+}
+
+func natsEvent(subject string) *nodev1.DataSourceCustomEvents {
+	return &nodev1.DataSourceCustomEvents{
+		Nats: []*nodev1.NatsEventConfiguration{
+			{
+				EngineEventConfiguration: &nodev1.EngineEventConfiguration{
+					ProviderId: "default",
+					Type:       nodev1.EventType_SUBSCRIBE,
+					TypeName:   "Subscription",
+					FieldName:  "employeeUpdated",
+				},
+				Subjects: []string{subject},
+			},
+		},
+	}
+}
+
+func changesByType(report *Report) map[schemadiff.ChangeType]Change {
+	changes := make(map[schemadiff.ChangeType]Change, len(report.Changes))
+	for _, change := range report.Changes {
+		changes[change.Type] = change
+	}
+	return changes
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	oldConfig := routerConfig(oldSchema,
+		[]*nodev1.FieldConfiguration{
+			{TypeName: "Employee", FieldName: "salary", AuthorizationConfiguration: scopes([]string{"read:salary"})},
+			{TypeName: "Query", FieldName: "employees", AuthorizationConfiguration: scopes([]string{"read:employees"}, []string{"admin"})},
+		},
+		&nodev1.DataSourceConfiguration{
+			Id: "0",
+			RootNodes: []*nodev1.TypeField{
+				{TypeName: "Query", FieldNames: []string{"employee", "employees"}},
+				{TypeName: "Subscription", FieldNames: []string{"employeeUpdated"}},
+			},
+			CustomEvents: natsEvent("employees.updated"),
+		},
+		&nodev1.DataSourceConfiguration{
+			Id:        "1",
+			RootNodes: []*nodev1.TypeField{{TypeName: "Query", FieldNames: []string{"products"}}},
+		},
+	)
+
+	newConfig := routerConfig(newSchema,
+		[]*nodev1.FieldConfiguration{
+			// Additional scope required
+			{TypeName: "Employee", FieldName: "salary", AuthorizationConfiguration: scopes([]string{"read:salary", "read:hr"})},
+			// Additional alternative scope
+			{TypeName: "Query", FieldName: "employees", AuthorizationConfiguration: scopes([]string{"read:employees"}, []string{"admin"}, []string{"read:all"})},
+			{TypeName: "Employee", FieldName: "name", AuthorizationConfiguration: &nodev1.AuthorizationConfiguration{RequiresAuthentication: true}},
+			// New fields are not reported
+			{TypeName: "Employee", FieldName: "role", AuthorizationConfiguration: &nodev1.AuthorizationConfiguration{RequiresAuthentication: true}},
+		},
+		&nodev1.DataSourceConfiguration{
+			Id: "0",
+			RootNodes: []*nodev1.TypeField{
+				{TypeName: "Query", FieldNames: []string{"employee", "employees", "products"}},
+				{TypeName: "Subscription", FieldNames: []string{"employeeUpdated"}},
+			},
+			CustomEvents: natsEvent("employees.*.updated"),
+		},
+	)
+
+	report, err := Compare(oldConfig, newConfig)
+	require.NoError(t, err)
+
+	changes := changesByType(report)
+
+	require.Equal(t, Change{
+		Kind:        KindSchema,
+		Type:        schemadiff.FieldAdded,
+		Criticality: schemadiff.CriticalitySafe,
+		Path:        "Employee.role",
+		Message:     "Field 'Employee.role' was added",
+	}, changes[schemadiff.FieldAdded])
+
+	require.Equal(t, schemadiff.CriticalityBreaking, changes[RequiresAuthenticationAdded].Criticality)
+	require.Equal(t, "Employee.name", changes[RequiresAuthenticationAdded].Path)
+
+	var scopeChanges []Change
+	for _, change := range report.Changes {
+		if change.Type == RequiredScopesChanged {
+			scopeChanges = append(scopeChanges, change)
+		}
+	}
+	require.Len(t, scopeChanges, 2)
+	require.Equal(t, "Employee.salary", scopeChanges[0].Path)
+	require.Equal(t, schemadiff.CriticalityBreaking, scopeChanges[0].Criticality)
+	require.Equal(t, "Required scopes of field 'Employee.salary' changed from [read:salary] to [read:hr AND read:salary]", scopeChanges[0].Message)
+	require.Equal(t, "Query.employees", scopeChanges[1].Path)
+	require.Equal(t, schemadiff.CriticalityDangerous, scopeChanges[1].Criticality)
+
+	require.Equal(t, Change{
+		Kind:        KindRootNode,
+		Type:        RootNodeRemoved,
+		Criticality: schemadiff.CriticalityDangerous,
+		Path:        "Query.products",
+		Subgraph:    "products",
+		Message:     "Field 'Query.products' is no longer resolved by subgraph 'products'",
+	}, changes[RootNodeRemoved])
+	require.Equal(t, "employees", changes[RootNodeAdded].Subgraph)
+
+	require.Equal(t, schemadiff.CriticalityDangerous, changes[EventConfigurationChanged].Criticality)
+	require.Equal(t, "Subscription.employeeUpdated", changes[EventConfigurationChanged].Path)
+
+	require.Equal(t, 2, report.Count(schemadiff.CriticalityBreaking))
+}
+
+func TestCompareRootNodeWithoutSubgraph(t *testing.T) {
+	t.Parallel()
+
+	dataSource := func(fieldNames ...string) *nodev1.DataSourceConfiguration {
+		return &nodev1.DataSourceConfiguration{
+			Id:        "0",
+			RootNodes: []*nodev1.TypeField{{TypeName: "Query", FieldNames: fieldNames}},
+		}
+	}
+
+	report, err := Compare(
+		routerConfig(`type Query { a: String b: String }`, nil, dataSource("a", "b")),
+		routerConfig(`type Query { a: String b: String }`, nil, dataSource("a")),
+	)
+	require.NoError(t, err)
+	require.Len(t, report.Changes, 1)
+	require.Equal(t, schemadiff.CriticalityBreaking, report.Changes[0].Criticality)
+	require.Equal(t, "Field 'Query.b' is no longer resolved by any subgraph", report.Changes[0].Message)
+
+	// Removing the field from the schema is reported once
+	report, err = Compare(
+		routerConfig(`type Query { a: String b: String }`, nil, dataSource("a", "b")),
+		routerConfig(`type Query { a: String }`, nil, dataSource("a")),
+	)
+	require.NoError(t, err)
+	require.Len(t, report.Changes, 1)
+	require.Equal(t, schemadiff.FieldRemoved, report.Changes[0].Type)
+}
+
+func TestCompareOrder(t *testing.T) {
+	t.Parallel()
+
+	dataSource := func(id string, fieldNames ...string) *nodev1.DataSourceConfiguration {
+		return &nodev1.DataSourceConfiguration{
+			Id:        id,
+			RootNodes: []*nodev1.TypeField{{TypeName: "Query", FieldNames: fieldNames}},
+		}
+	}
+
+	oldConfig := routerConfig(`type Query { a: String b: String }`, nil, dataSource("0", "a", "b"), dataSource("1", "a", "b"))
+	newConfig := routerConfig(`type Query { a: String b: String }`, nil, dataSource("0", "b"), dataSource("1"))
+
+	// Changes of the same path, kind and type are ordered by subgraph, regardless of the map iteration order
+	for i := 0; i < 10; i++ {
+		report, err := Compare(oldConfig, newConfig)
+		require.NoError(t, err)
+		require.Len(t, report.Changes, 3)
+		require.Equal(t, []string{"Query.a", "Query.a", "Query.b"}, []string{report.Changes[0].Path, report.Changes[1].Path, report.Changes[2].Path})
+		require.Equal(t, "employees", report.Changes[0].Subgraph)
+		require.Equal(t, "products", report.Changes[1].Subgraph)
+	}
+}
+
+func TestScopesAtLeastAsPermissive(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, scopesAtLeastAsPermissive([][]string{{"a"}}, nil))
+	require.False(t, scopesAtLeastAsPermissive(nil, [][]string{{"a"}}))
+	require.True(t, scopesAtLeastAsPermissive([][]string{{"a", "b"}}, [][]string{{"a"}}))
+	require.False(t, scopesAtLeastAsPermissive([][]string{{"a"}, {"b"}}, [][]string{{"a"}}))
+	require.True(t, scopesAtLeastAsPermissive([][]string{{"a"}, {"b"}}, [][]string{{"a"}, {"b"}, {"c"}}))
+}
+
+func TestAffectedOperations(t *testing.T) {
+	t.Parallel()
+
+	report, err := Compare(
+		routerConfig(`
+			type Query { employee(id: Int!, filter: Filter): Employee employees: [Employee] }
+			type Employee { id: Int! name: String tag: String }
+			input Filter { role: Role }
+			enum Role { ENGINEER MANAGER }
+		`, nil),
+		routerConfig(`
+			type Query { employee(id: Int!, filter: Filter): Employee employees(limit: Int!): [Employee] }
+			type Employee { id: Int! name: String }
+			input Filter { role: Role }
+			enum Role { ENGINEER }
+		`, nil),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 3, report.Count(schemadiff.CriticalityBreaking))
+
+	operations := []Operation{
+		{Name: "Employee.graphql", Content: `query Employee { employee(id: 1) { id name } }`},
+		{Name: "EmployeeTag.graphql", Content: `query EmployeeTag { employee(id: 1) { ...Tag } } fragment Tag on Employee { tag }`},
+		{Name: "Employees.graphql", Content: `query Employees { employees { id } }`},
+		{Name: "Filtered.graphql", Content: `query Filtered($filter: Filter) { employee(id: 1, filter: $filter) { id } }`},
+	}
+
+	affected, err := report.AffectedOperations(`
+			type Query { employee(id: Int!, filter: Filter): Employee employees: [Employee] }
+			type Employee { id: Int! name: String tag: String }
+			input Filter { role: Role }
+			enum Role { ENGINEER MANAGER }
+		`, operations, schemadiff.CriticalityBreaking)
+	require.NoError(t, err)
+	require.Len(t, affected, 3)
+
+	require.Equal(t, "EmployeeTag.graphql", affected[0].Name)
+	require.Equal(t, "Employee.tag", affected[0].Changes[0].Path)
+	require.Equal(t, "Employees.graphql", affected[1].Name)
+	require.Equal(t, "Query.employees(limit)", affected[1].Changes[0].Path)
+	require.Equal(t, "Filtered.graphql", affected[2].Name)
+	require.Equal(t, "Role.MANAGER", affected[2].Changes[0].Path)
+
+	_, err = report.AffectedOperations(oldSchema, []Operation{{Name: "Invalid.graphql", Content: `query {`}}, schemadiff.CriticalityBreaking)
+	require.ErrorContains(t, err, "operation Invalid.graphql")
+}
@@ -0,0 +1,224 @@
+package configdiff
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/wundergraph/cosmo/router/pkg/schemadiff"
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/ast"
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/astparser"
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/asttransform"
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/astvisitor"
+)
+
+// Operation is a GraphQL operation of a client
+type Operation struct {
+	// Name is the file name of the operation
+	Name    string
+	Content string
+}
+
+// AffectedOperation is an operation that uses elements of the schema changed between the execution configs.
+type AffectedOperation struct {
+	Name    string   `json:"name"`
+	Changes []Change `json:"changes"`
+}
+
+// LoadOperations reads the .graphql files of the directory. It uses the same layout as the operations folder
+// of the plan generator.
+func LoadOperations(dir string) ([]Operation, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read operations directory: %w", err)
+	}
+
+	var operations []Operation
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".graphql" {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read operation %s: %w", entry.Name(), err)
+		}
+		operations = append(operations, Operation{Name: entry.Name(), Content: string(content)})
+	}
+
+	return operations, nil
+}
+
+// AffectedOperations returns the operations that use elements changed with one of the given criticalities. The
+// operations are resolved against the old schema, which must be the schema of the old execution config.
+// Operations that can't be parsed are returned as an error.
+func (r *Report) AffectedOperations(oldSchema string, operations []Operation, criticalities ...schemadiff.Criticality) ([]AffectedOperation, error) {
+	var changes []Change
+	for _, criticality := range criticalities {
+		changes = append(changes, r.Filter(criticality)...)
+	}
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	definition, report := astparser.ParseGraphqlDocumentString(oldSchema)
+	if report.HasErrors() {
+		return nil, fmt.Errorf("failed to parse old schema: %w", report)
+	}
+	if err := asttransform.MergeDefinitionWithBaseSchema(&definition); err != nil {
+		return nil, fmt.Errorf("failed to merge old schema with base schema: %w", err)
+	}
+
+	var affected []AffectedOperation
+	var errs error
+
+	for _, operation := range operations {
+		usage, err := schemaUsage(&definition, operation.Content)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("operation %s: %w", operation.Name, err))
+			continue
+		}
+
+		var operationChanges []Change
+		for _, change := range changes {
+			if usage.isAffectedBy(change) {
+				operationChanges = append(operationChanges, change)
+			}
+		}
+
+		if len(operationChanges) > 0 {
+			affected = append(affected, AffectedOperation{Name: operation.Name, Changes: operationChanges})
+		}
+	}
+
+	sort.Slice(affected, func(i, j int) bool {
+		return affected[i].Name < affected[j].Name
+	})
+
+	return affected, errs
+}
+
+// usage holds the schema coordinates an operation uses
+type usage struct {
+	// fields holds the fields as Type.field and the arguments as Type.field(argument)
+	fields map[string]struct{}
+	// types holds all named types the operation selects or passes as input, including nested input types
+	types map[string]struct{}
+}
+
+func (u *usage) isAffectedBy(change Change) bool {
+	if _, ok := u.fields[change.Path]; ok {
+		return true
+	}
+
+	typeName, member, hasMember := strings.Cut(change.Path, ".")
+
+	switch change.Type {
+	case schemadiff.TypeRemoved, schemadiff.TypeKindChanged:
+		_, ok := u.types[change.Path]
+		return ok
+	case schemadiff.ArgumentAdded:
+		// A new required argument breaks every operation that selects the field
+		field, _, _ := strings.Cut(change.Path, "(")
+		_, ok := u.fields[field]
+		return ok
+	case schemadiff.InputFieldAdded, schemadiff.InputFieldRemoved, schemadiff.InputFieldTypeChanged, schemadiff.InputFieldDefaultChanged,
+		schemadiff.EnumValueAdded, schemadiff.EnumValueRemoved,
+		schemadiff.UnionMemberAdded, schemadiff.UnionMemberRemoved,
+		schemadiff.InterfaceAdded, schemadiff.InterfaceRemoved:
+		// Input fields and values can't be attributed to single operations, every operation using the type is affected
+		if !hasMember || member == "" {
+			return false
+		}
+		_, ok := u.types[typeName]
+		return ok
+	}
+
+	return false
+}
+
+type usageVisitor struct {
+	*astvisitor.Walker
+	operation, definition *ast.Document
+	usage                 *usage
+}
+
+func schemaUsage(definition *ast.Document, content string) (*usage, error) {
+	operation, report := astparser.ParseGraphqlDocumentString(content)
+	if report.HasErrors() {
+		return nil, fmt.Errorf("failed to parse operation: %w", report)
+	}
+
+	walker := astvisitor.NewWalker(48)
+	v := &usageVisitor{
+		Walker:     &walker,
+		operation:  &operation,
+		definition: definition,
+		usage: &usage{
+			fields: make(map[string]struct{}),
+			types:  make(map[string]struct{}),
+		},
+	}
+
+	walker.RegisterEnterFieldVisitor(v)
+	walker.RegisterEnterArgumentVisitor(v)
+	walker.RegisterEnterVariableDefinitionVisitor(v)
+
+	walker.Walk(&operation, definition, &report)
+	if report.HasErrors() {
+		return nil, fmt.Errorf("failed to walk operation: %w", report)
+	}
+
+	return v.usage, nil
+}
+
+func (v *usageVisitor) EnterField(ref int) {
+	typeName := v.definition.NodeNameString(v.EnclosingTypeDefinition)
+	fieldName := v.operation.FieldNameString(ref)
+
+	v.addType(typeName)
+	v.usage.fields[typeName+"."+fieldName] = struct{}{}
+
+	if definition, ok := v.FieldDefinition(ref); ok {
+		v.addType(v.definition.ResolveTypeNameString(v.definition.FieldDefinitionType(definition)))
+	}
+}
+
+func (v *usageVisitor) EnterArgument(ref int) {
+	ancestor := v.Ancestors[len(v.Ancestors)-1]
+	if ancestor.Kind != ast.NodeKindField {
+		return
+	}
+
+	// The enclosing type is the type of the field, the type that defines the field is the previous one
+	typeName := v.definition.NodeNameString(v.TypeDefinitions[len(v.TypeDefinitions)-2])
+	path := typeName + "." + v.operation.FieldNameString(ancestor.Ref) + "(" + v.operation.ArgumentNameString(ref) + ")"
+	v.usage.fields[path] = struct{}{}
+
+	if definition, ok := v.ArgumentInputValueDefinition(ref); ok {
+		v.addType(v.definition.ResolveTypeNameString(v.definition.InputValueDefinitionType(definition)))
+	}
+}
+
+func (v *usageVisitor) EnterVariableDefinition(ref int) {
+	v.addType(v.operation.ResolveTypeNameString(v.operation.VariableDefinitions[ref].Type))
+}
+
+// addType adds the type and, for input objects, the types of its input fields
+func (v *usageVisitor) addType(typeName string) {
+	if _, ok := v.usage.types[typeName]; ok {
+		return
+	}
+	v.usage.types[typeName] = struct{}{}
+
+	node, ok := v.definition.Index.FirstNodeByNameStr(typeName)
+	if !ok || node.Kind != ast.NodeKindInputObjectTypeDefinition {
+		return
+	}
+
+	for _, inputField := range v.definition.InputObjectTypeDefinitions[node.Ref].InputFieldsDefinition.Refs {
+		v.addType(v.definition.ResolveTypeNameString(v.definition.InputValueDefinitionType(inputField)))
+	}
+}
@@ -0,0 +1,443 @@
+// Package costanalysis estimates the cost of GraphQL operations before they are executed. The cost is based on the
+// weights of the selected fields and types and on the expected size of lists, as described by the @cost and
+// @listSize directives.
+package costanalysis
+
+import (
+	"bytes"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/ast"
+)
+
+const (
+	// DefaultListSize is the assumed size of lists without any size information
+	DefaultListSize = 10
+	// DefaultMaxListSize is the largest list size a slicing argument of an operation can request
+	DefaultMaxListSize = 10000
+
+	compositeTypeWeight = 1
+	leafTypeWeight      = 0
+)
+
+var (
+	literalCost             = []byte("cost")
+	literalListSize         = []byte("listSize")
+	literalWeight           = []byte("weight")
+	literalAssumedSize      = []byte("assumedSize")
+	literalSlicingArguments = []byte("slicingArguments")
+	literalSizedFields      = []byte("sizedFields")
+)
+
+type Config struct {
+	// DefaultListSize is the assumed size of lists without @listSize directive, slicing argument or mapping.
+	// Defaults to DefaultListSize if not positive.
+	DefaultListSize int
+	// MaxListSize caps the values of slicing arguments, which are chosen by the client. Defaults to
+	// DefaultMaxListSize if not positive.
+	MaxListSize int
+	// FieldWeights maps fields as Type.field to their weight. Overrides the @cost directive of the field.
+	FieldWeights map[string]int
+	// TypeWeights maps types to their weight. Overrides the @cost directive of the type.
+	TypeWeights map[string]int
+	// ListSizes maps list fields as Type.field to their assumed size. Overrides the assumedSize of the @listSize
+	// directive, slicing arguments of the operation still take precedence.
+	ListSizes map[string]int
+}
+
+// VariableResolver returns the integer value of a variable of the operation
+type VariableResolver func(name string) (int, bool)
+
+type listSize struct {
+	assumedSize      int
+	hasAssumedSize   bool
+	slicingArguments []string
+	sizedFields      []string
+}
+
+// Calculator calculates the cost of operations against a schema. The weights of the schema are read once, so a
+// Calculator should be reused for all operations of the same schema. It is safe for concurrent use.
+type Calculator struct {
+	definition      *ast.Document
+	defaultListSize int
+	maxListSize     int
+	fieldWeights    map[string]int
+	typeWeights     map[string]int
+	argumentWeights map[string]int
+	listSizes       map[string]listSize
+}
+
+// NewCalculator reads the @cost and @listSize directives of the schema and merges them with the weights of the config
+func NewCalculator(definition *ast.Document, cfg Config) *Calculator {
+	c := &Calculator{
+		definition:      definition,
+		defaultListSize: cfg.DefaultListSize,
+		maxListSize:     cfg.MaxListSize,
+		fieldWeights:    make(map[string]int),
+		typeWeights:     make(map[string]int),
+		argumentWeights: make(map[string]int),
+		listSizes:       make(map[string]listSize),
+	}
+	if c.defaultListSize <= 0 {
+		c.defaultListSize = DefaultListSize
+	}
+	if c.maxListSize <= 0 {
+		c.maxListSize = DefaultMaxListSize
+	}
+
+	c.readSchemaDirectives()
+
+	for coordinate, weight := range cfg.FieldWeights {
+		c.fieldWeights[coordinate] = weight
+	}
+	for typeName, weight := range cfg.TypeWeights {
+		c.typeWeights[typeName] = weight
+	}
+	for coordinate, size := range cfg.ListSizes {
+		ls := c.listSizes[coordinate]
+		ls.assumedSize, ls.hasAssumedSize = size, true
+		c.listSizes[coordinate] = ls
+	}
+
+	return c
+}
+
+func (c *Calculator) readSchemaDirectives() {
+	d := c.definition
+
+	for _, node := range d.RootNodes {
+		switch node.Kind {
+		case ast.NodeKindObjectTypeDefinition, ast.NodeKindInterfaceTypeDefinition, ast.NodeKindUnionTypeDefinition,
+			ast.NodeKindScalarTypeDefinition, ast.NodeKindEnumTypeDefinition,
+			ast.NodeKindObjectTypeExtension, ast.NodeKindInterfaceTypeExtension:
+		default:
+			continue
+		}
+
+		typeName := d.NodeNameString(node)
+		for _, directive := range d.NodeDirectives(node) {
+			if weight, ok := c.costWeight(directive); ok {
+				c.typeWeights[typeName] = weight
+			}
+		}
+
+		for _, field := range d.NodeFieldDefinitions(node) {
+			coordinate := typeName + "." + d.FieldDefinitionNameString(field)
+
+			for _, directive := range d.FieldDefinitionDirectives(field) {
+				if weight, ok := c.costWeight(directive); ok {
+					c.fieldWeights[coordinate] = weight
+				}
+				if ls, ok := c.listSizeDirective(directive); ok {
+					c.listSizes[coordinate] = ls
+				}
+			}
+
+			for _, argument := range d.FieldDefinitionArgumentsDefinitions(field) {
+				for _, directive := range d.InputValueDefinitions[argument].Directives.Refs {
+					if weight, ok := c.costWeight(directive); ok {
+						c.argumentWeights[coordinate+"("+d.InputValueDefinitionNameString(argument)+")"] = weight
+					}
+				}
+			}
+		}
+	}
+}
+
+// costWeight returns the weight of a @cost directive. The weight is an Int or, as in the IBM cost specification,
+// a String containing a number.
+func (c *Calculator) costWeight(directive int) (int, bool) {
+	d := c.definition
+	if !bytes.Equal(d.DirectiveNameBytes(directive), literalCost) {
+		return 0, false
+	}
+	value, ok := d.DirectiveArgumentValueByName(directive, literalWeight)
+	if !ok {
+		return 0, false
+	}
+	return c.intValue(value)
+}
+
+func (c *Calculator) listSizeDirective(directive int) (listSize, bool) {
+	d := c.definition
+	if !bytes.Equal(d.DirectiveNameBytes(directive), literalListSize) {
+		return listSize{}, false
+	}
+
+	var ls listSize
+	if value, ok := d.DirectiveArgumentValueByName(directive, literalAssumedSize); ok {
+		ls.assumedSize, ls.hasAssumedSize = c.intValue(value)
+	}
+	if value, ok := d.DirectiveArgumentValueByName(directive, literalSlicingArguments); ok {
+		ls.slicingArguments = c.stringList(value)
+	}
+	if value, ok := d.DirectiveArgumentValueByName(directive, literalSizedFields); ok {
+		ls.sizedFields = c.stringList(value)
+	}
+
+	return ls, true
+}
+
+func (c *Calculator) intValue(value ast.Value) (int, bool) {
+	d := c.definition
+	switch value.Kind {
+	case ast.ValueKindInteger:
+		return int(d.IntValueAsInt(value.Ref)), true
+	case ast.ValueKindString:
+		weight, err := strconv.ParseFloat(d.StringValueContentString(value.Ref), 64)
+		if err != nil {
+			return 0, false
+		}
+		return int(weight), true
+	default:
+		return 0, false
+	}
+}
+
+func (c *Calculator) stringList(value ast.Value) []string {
+	d := c.definition
+	switch value.Kind {
+	case ast.ValueKindList:
+		values := make([]string, 0, len(d.ListValues[value.Ref].Refs))
+		for _, ref := range d.ListValues[value.Ref].Refs {
+			if d.Values[ref].Kind == ast.ValueKindString {
+				values = append(values, d.StringValueContentString(d.Values[ref].Ref))
+			}
+		}
+		return values
+	case ast.ValueKindString:
+		return []string{d.StringValueContentString(value.Ref)}
+	default:
+		return nil
+	}
+}
+
+// Cost returns the estimated cost of the operations of the normalized operation document. Fields of fragments on
+// different types are all counted, so the cost is an upper bound for abstract types. The cost saturates at
+// math.MaxInt instead of overflowing.
+func (c *Calculator) Cost(operation *ast.Document, variables VariableResolver) int {
+	cost := 0
+
+	for _, node := range operation.RootNodes {
+		if node.Kind != ast.NodeKindOperationDefinition {
+			continue
+		}
+		def := operation.OperationDefinitions[node.Ref]
+		if !def.HasSelections {
+			continue
+		}
+
+		var rootTypeName ast.ByteSlice
+		switch def.OperationType {
+		case ast.OperationTypeQuery:
+			rootTypeName = c.definition.Index.QueryTypeName
+		case ast.OperationTypeMutation:
+			rootTypeName = c.definition.Index.MutationTypeName
+		case ast.OperationTypeSubscription:
+			rootTypeName = c.definition.Index.SubscriptionTypeName
+		}
+		rootNode, ok := c.definition.Index.FirstNodeByNameBytes(rootTypeName)
+		if !ok {
+			continue
+		}
+
+		w := &costWalker{calculator: c, operation: operation, variables: variables}
+		cost = addCost(cost, w.selectionSetCost(def.SelectionSet, rootNode, nil))
+	}
+
+	return cost
+}
+
+type costWalker struct {
+	calculator *Calculator
+	operation  *ast.Document
+	variables  VariableResolver
+}
+
+// selectionSetCost returns the cost of the selection set. The sized fields are fields of a connection-like type, their
+// size is the size of the field returning the connection.
+func (w *costWalker) selectionSetCost(set int, enclosingNode ast.Node, sizedFields map[string]int) int {
+	cost := 0
+
+	for _, selection := range w.operation.SelectionSets[set].SelectionRefs {
+		ref := w.operation.Selections[selection].Ref
+
+		switch w.operation.Selections[selection].Kind {
+		case ast.SelectionKindField:
+			size, sized := sizedFields[w.operation.FieldNameString(ref)]
+			if !sized {
+				size = -1
+			}
+			cost = addCost(cost, w.fieldCost(ref, enclosingNode, size))
+		case ast.SelectionKindInlineFragment:
+			fragment := w.operation.InlineFragments[ref]
+			if !fragment.HasSelections {
+				continue
+			}
+			node := enclosingNode
+			if w.operation.InlineFragmentHasTypeCondition(ref) {
+				typeConditionNode, ok := w.calculator.definition.Index.FirstNodeByNameStr(w.operation.InlineFragmentTypeConditionNameString(ref))
+				if !ok {
+					continue
+				}
+				node = typeConditionNode
+			}
+			cost = addCost(cost, w.selectionSetCost(fragment.SelectionSet, node, sizedFields))
+		}
+	}
+
+	return cost
+}
+
+// fieldCost returns the cost of the field including its selections. A sized field uses the given size as list size,
+// the size is negative for all other fields.
+func (w *costWalker) fieldCost(field int, enclosingNode ast.Node, sizedFieldSize int) int {
+	c := w.calculator
+	d := c.definition
+
+	fieldName := w.operation.FieldNameString(field)
+	// __typename and introspection fields are resolved by the router
+	if strings.HasPrefix(fieldName, "__") {
+		return 0
+	}
+
+	fieldDefinition, ok := d.NodeFieldDefinitionByName(enclosingNode, w.operation.FieldNameBytes(field))
+	if !ok {
+		return 0
+	}
+
+	coordinate := d.NodeNameString(enclosingNode) + "." + fieldName
+	fieldType := d.FieldDefinitionType(fieldDefinition)
+	typeName := d.ResolveTypeNameString(fieldType)
+
+	typeNode, typeExists := d.Index.FirstNodeByNameStr(typeName)
+
+	weight, ok := c.fieldWeights[coordinate]
+	if !ok {
+		weight, ok = c.typeWeights[typeName]
+	}
+	if !ok {
+		weight = leafTypeWeight
+		if typeExists && isCompositeType(typeNode.Kind) {
+			weight = compositeTypeWeight
+		}
+	}
+
+	argumentsCost := 0
+	for _, argument := range w.operation.FieldArguments(field) {
+		argumentsCost = addCost(argumentsCost, c.argumentWeights[coordinate+"("+w.operation.ArgumentNameString(argument)+")"])
+	}
+
+	ls := c.listSizes[coordinate]
+	isList := d.TypeIsList(fieldType)
+
+	size := 1
+	switch {
+	case sizedFieldSize >= 0:
+		size = sizedFieldSize
+	case isList || len(ls.sizedFields) > 0:
+		size = w.listSize(field, ls)
+	}
+
+	childCost := 0
+	if w.operation.FieldHasSelections(field) && typeExists {
+		var sizedFields map[string]int
+		if len(ls.sizedFields) > 0 {
+			sizedFields = make(map[string]int, len(ls.sizedFields))
+			for _, name := range ls.sizedFields {
+				sizedFields[name] = size
+			}
+		}
+		childCost = w.selectionSetCost(w.operation.Fields[field].SelectionSet, typeNode, sizedFields)
+	}
+
+	// The size of connection-like fields applies to the sized child fields instead of the field itself
+	if len(ls.sizedFields) > 0 {
+		return addCost(argumentsCost, addCost(weight, childCost))
+	}
+	// A sized field that isn't a list, like a page of items, is still counted once per item
+	if !isList && sizedFieldSize < 0 {
+		return addCost(argumentsCost, addCost(weight, childCost))
+	}
+
+	return addCost(argumentsCost, multiplyCost(size, addCost(weight, childCost)))
+}
+
+// listSize returns the largest value of the slicing arguments of the field, capped at the max list size. Negative
+// values are invalid and ignored. Without slicing arguments it falls back to the assumed size of the field and the
+// default list size.
+func (w *costWalker) listSize(field int, ls listSize) int {
+	size, found := 0, false
+
+	for _, name := range ls.slicingArguments {
+		argument, ok := w.operation.FieldArgument(field, []byte(name))
+		if !ok {
+			continue
+		}
+		value, ok := w.argumentInt(w.operation.ArgumentValue(argument))
+		if !ok || value < 0 {
+			continue
+		}
+		size, found = max(size, min(value, w.calculator.maxListSize)), true
+	}
+
+	if found {
+		return size
+	}
+	if ls.hasAssumedSize {
+		return ls.assumedSize
+	}
+
+	return w.calculator.defaultListSize
+}
+
+func (w *costWalker) argumentInt(value ast.Value) (int, bool) {
+	switch value.Kind {
+	case ast.ValueKindInteger:
+		return int(w.operation.IntValueAsInt(value.Ref)), true
+	case ast.ValueKindVariable:
+		if w.variables == nil {
+			return 0, false
+		}
+		return w.variables(w.operation.VariableValueNameString(value.Ref))
+	default:
+		return 0, false
+	}
+}
+
+func isCompositeType(kind ast.NodeKind) bool {
+	switch kind {
+	case ast.NodeKindObjectTypeDefinition, ast.NodeKindInterfaceTypeDefinition, ast.NodeKindUnionTypeDefinition:
+		return true
+	default:
+		return false
+	}
+}
+
+// addCost adds two costs, saturating at math.MaxInt and math.MinInt instead of overflowing
+func addCost(a, b int) int {
+	if b > 0 && a > math.MaxInt-b {
+		return math.MaxInt
+	}
+	if b < 0 && a < math.MinInt-b {
+		return math.MinInt
+	}
+	return a + b
+}
+
+// multiplyCost multiplies two costs, saturating at math.MaxInt and math.MinInt instead of overflowing
+func multiplyCost(a, b int) int {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	result := a * b
+	if result/b != a || (a == -1 && b == math.MinInt) || (b == -1 && a == math.MinInt) {
+		if (a > 0) == (b > 0) {
+			return math.MaxInt
+		}
+		return math.MinInt
+	}
+	return result
+}
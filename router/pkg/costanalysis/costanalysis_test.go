@@ -0,0 +1,219 @@
+package costanalysis
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/ast"
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/astparser"
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/asttransform"
+)
+
+const schema = `
+directive @cost(weight: Int!) on ARGUMENT_DEFINITION | ENUM | FIELD_DEFINITION | INPUT_FIELD_DEFINITION | OBJECT | SCALAR
+directive @listSize(assumedSize: Int, slicingArguments: [String!], sizedFields: [String!], requireOneSlicingArgument: Boolean = true) on FIELD_DEFINITION
+
+type Query {
+  employee(id: Int!): Employee
+  employees(first: Int, last: Int): [Employee!]! @listSize(slicingArguments: ["first", "last"])
+  products: [Product!]!
+  search(query: String! @cost(weight: 20)): SearchConnection! @listSize(assumedSize: 5, sizedFields: ["edges"])
+  report: String @cost(weight: "12.5")
+}
+
+type Employee {
+  id: Int!
+  name: String
+  details: Details
+  salary: Int @cost(weight: 3)
+  products: [Product!]! @listSize(assumedSize: 2)
+}
+
+type Details {
+  forename: String
+}
+
+type Product @cost(weight: 4) {
+  upc: String!
+}
+
+type SearchConnection {
+  edges: [SearchEdge!]!
+}
+
+type SearchEdge {
+  node: Employee
+}
+`
+
+func calculator(t *testing.T, cfg Config) *Calculator {
+	t.Helper()
+
+	definition, report := astparser.ParseGraphqlDocumentString(schema)
+	require.False(t, report.HasErrors(), report.Error())
+	require.NoError(t, asttransform.MergeDefinitionWithBaseSchema(&definition))
+
+	return NewCalculator(&definition, cfg)
+}
+
+func cost(t *testing.T, c *Calculator, operation string, variables map[string]int) int {
+	t.Helper()
+
+	doc, report := astparser.ParseGraphqlDocumentString(operation)
+	require.False(t, report.HasErrors(), report.Error())
+
+	return c.Cost(&doc, func(name string) (int, bool) {
+		value, ok := variables[name]
+		return value, ok
+	})
+}
+
+func TestCost(t *testing.T) {
+	t.Parallel()
+
+	c := calculator(t, Config{})
+
+	testCases := []struct {
+		name      string
+		operation string
+		variables map[string]int
+		cost      int
+	}{
+		{
+			name:      "scalar fields are free",
+			operation: `{ report }`,
+			cost:      12,
+		},
+		{
+			name:      "object field",
+			operation: `{ employee(id: 1) { id name details { forename } } }`,
+			// employee + details
+			cost: 2,
+		},
+		{
+			name:      "field weight",
+			operation: `{ employee(id: 1) { salary } }`,
+			cost:      4,
+		},
+		{
+			name:      "slicing argument literal",
+			operation: `{ employees(first: 5) { id details { forename } } }`,
+			// 5 * (employee + details)
+			cost: 10,
+		},
+		{
+			name:      "largest slicing argument from variables",
+			operation: `query ($first: Int, $last: Int) { employees(first: $first, last: $last) { id } }`,
+			variables: map[string]int{"first": 3, "last": 7},
+			cost:      7,
+		},
+		{
+			name:      "default list size without slicing argument",
+			operation: `{ employees { id } }`,
+			cost:      10,
+		},
+		{
+			name:      "type weight and assumed size",
+			operation: `{ employee(id: 1) { products { upc } } }`,
+			// employee + 2 * product
+			cost: 9,
+		},
+		{
+			name:      "nested lists",
+			operation: `{ employees(first: 2) { products { upc } } }`,
+			// 2 * (employee + 2 * product)
+			cost: 18,
+		},
+		{
+			name:      "sized fields and argument weight",
+			operation: `{ search(query: "a") { edges { node { id } } } }`,
+			// argument + connection + 5 * (edges + 1 * node)
+			cost: 20 + 1 + 5*(1+1),
+		},
+		{
+			name:      "typename and introspection",
+			operation: `{ __typename __schema { types { name } } }`,
+			cost:      0,
+		},
+		{
+			name:      "inline fragments",
+			operation: `{ employee(id: 1) { ... on Employee { details { forename } } } }`,
+			cost:      2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.cost, cost(t, c, tc.operation, tc.variables))
+		})
+	}
+}
+
+func TestCostConfigOverrides(t *testing.T) {
+	t.Parallel()
+
+	c := calculator(t, Config{
+		DefaultListSize: 100,
+		FieldWeights:    map[string]int{"Employee.details": 10},
+		TypeWeights:     map[string]int{"Product": 1},
+		ListSizes:       map[string]int{"Employee.products": 3, "Query.employees": 4},
+	})
+
+	require.Equal(t, 11, cost(t, c, `{ employee(id: 1) { details { forename } } }`, nil))
+	require.Equal(t, 4, cost(t, c, `{ employee(id: 1) { products { upc } } }`, nil))
+	// The list size mapping replaces the default list size
+	require.Equal(t, 4, cost(t, c, `{ employees { id } }`, nil))
+	// Slicing arguments take precedence
+	require.Equal(t, 2, cost(t, c, `{ employees(first: 2) { id } }`, nil))
+	require.Equal(t, 100, cost(t, c, `{ products { upc } }`, nil))
+}
+
+func TestCostWithoutRootType(t *testing.T) {
+	t.Parallel()
+
+	c := NewCalculator(&ast.Document{}, Config{})
+	require.Equal(t, 0, cost(t, c, `mutation { update }`, nil))
+}
+
+func TestCostSlicingArgumentBounds(t *testing.T) {
+	t.Parallel()
+
+	c := calculator(t, Config{MaxListSize: 100})
+
+	operation := `query ($n: Int) { employees(first: $n) { details { forename } products { upc } } }`
+
+	// Huge values are capped at the max list size: 100 * (employee + details + 2 * product)
+	require.Equal(t, 100*(1+1+2*4), cost(t, c, operation, map[string]int{"n": 1 << 62}))
+	require.Equal(t, 100*(1+1+2*4), cost(t, c, `{ employees(first: 4611686018427387904) { details { forename } products { upc } } }`, nil))
+
+	// Negative values are ignored in favor of the default list size
+	require.Equal(t, 10*(1+1+2*4), cost(t, c, operation, map[string]int{"n": -5}))
+	require.Equal(t, 10*(1+1+2*4), cost(t, c, operation, map[string]int{"n": math.MinInt}))
+}
+
+func TestCostSaturates(t *testing.T) {
+	t.Parallel()
+
+	c := calculator(t, Config{
+		MaxListSize: math.MaxInt,
+		ListSizes:   map[string]int{"Employee.products": math.MaxInt},
+	})
+
+	require.Equal(t, math.MaxInt, cost(t, c, `query ($n: Int) { employees(first: $n) { products { upc } } }`, map[string]int{"n": 1 << 62}))
+	require.Equal(t, math.MaxInt, cost(t, c, `{ a: employee(id: 1) { products { upc } } b: employee(id: 2) { products { upc } } }`, nil))
+}
+
+func TestMultiplyCost(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 0, multiplyCost(0, math.MaxInt))
+	require.Equal(t, 6, multiplyCost(2, 3))
+	require.Equal(t, -6, multiplyCost(-2, 3))
+	require.Equal(t, math.MaxInt, multiplyCost(1<<62, 4))
+	require.Equal(t, math.MinInt, multiplyCost(-(1<<62), 4))
+	require.Equal(t, math.MaxInt, multiplyCost(-1, math.MinInt))
+	require.Equal(t, math.MaxInt, addCost(math.MaxInt, 1))
+	require.Equal(t, math.MinInt, addCost(math.MinInt, -1))
+}
@@ -0,0 +1,48 @@
+package execution_config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+
+	nodev1 "github.com/wundergraph/cosmo/router/gen/proto/wg/cosmo/node/v1"
+	"github.com/wundergraph/cosmo/router/pkg/routerconfig"
+)
+
+// SignatureFileSuffix is appended to the path of the execution config to locate its signature file
+const SignatureFileSuffix = ".sig"
+
+var ErrMissingSignatureFile = errors.New("signature file of the execution config not found")
+
+// FromSignedFile creates a new router config from the file at the given path. If a sign key is set,
+// the file <path>.sig must contain the base64 encoded HMAC-SHA256 signature of the config.
+func FromSignedFile(path string, signKey string) (*nodev1.RouterConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if signKey != "" {
+		if err := verifyFileSignature(path, data, signKey); err != nil {
+			return nil, err
+		}
+	}
+
+	return UnmarshalConfig(data)
+}
+
+func verifyFileSignature(path string, data []byte, signKey string) error {
+	signaturePath := path + SignatureFileSuffix
+
+	signature, err := os.ReadFile(signaturePath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("%w: %s", ErrMissingSignatureFile, signaturePath)
+		}
+		return fmt.Errorf("could not read signature file: %w", err)
+	}
+
+	return routerconfig.VerifySignature(routerconfig.NewSignatureHash(signKey), data, strings.TrimSpace(string(signature)))
+}
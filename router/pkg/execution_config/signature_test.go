@@ -0,0 +1,62 @@
+package execution_config
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wundergraph/cosmo/router/pkg/routerconfig"
+)
+
+const signedTestConfig = `{"version":"v1","engineConfig":{"graphqlSchema":"type Query { hello: String }"}}`
+
+func writeSignedConfig(t *testing.T, signKey string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.json")
+	require.NoError(t, os.WriteFile(path, []byte(signedTestConfig), 0600))
+
+	h := hmac.New(sha256.New, []byte(signKey))
+	h.Write([]byte(signedTestConfig))
+	signature := base64.StdEncoding.EncodeToString(h.Sum(nil)) + "\n"
+	require.NoError(t, os.WriteFile(path+SignatureFileSuffix, []byte(signature), 0600))
+
+	return path
+}
+
+func TestFromSignedFile(t *testing.T) {
+	t.Run("valid signature", func(t *testing.T) {
+		path := writeSignedConfig(t, "secret")
+
+		cfg, err := FromSignedFile(path, "secret")
+		require.NoError(t, err)
+		require.Equal(t, "v1", cfg.GetVersion())
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		path := writeSignedConfig(t, "other")
+
+		_, err := FromSignedFile(path, "secret")
+		require.ErrorIs(t, err, routerconfig.ErrInvalidSignature)
+	})
+
+	t.Run("missing signature file", func(t *testing.T) {
+		path := writeSignedConfig(t, "secret")
+		require.NoError(t, os.Remove(path+SignatureFileSuffix))
+
+		_, err := FromSignedFile(path, "secret")
+		require.ErrorIs(t, err, ErrMissingSignatureFile)
+	})
+
+	t.Run("signature is not verified without sign key", func(t *testing.T) {
+		path := writeSignedConfig(t, "other")
+
+		cfg, err := FromSignedFile(path, "")
+		require.NoError(t, err)
+		require.Equal(t, "v1", cfg.GetVersion())
+	})
+}
@@ -0,0 +1,127 @@
+package metric
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/wundergraph/cosmo/router/pkg/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric"
+)
+
+const (
+	cosmoRouterBulkheadMeterName    = "cosmo.router.bulkhead"
+	cosmoRouterBulkheadMeterVersion = "0.0.1"
+
+	bulkheadInFlightMetric         = "router.http.client.bulkhead.in_flight"
+	bulkheadQueuedMetric           = "router.http.client.bulkhead.queued"
+	bulkheadRejectedRequestsMetric = "router.http.client.bulkhead.rejected_requests"
+)
+
+// BulkheadStats is the state of the bulkhead of a subgraph
+type BulkheadStats struct {
+	InFlight int64
+	Queued   int64
+	Rejected int64
+}
+
+// BulkheadStatsFunc returns the stats of the bulkhead of every subgraph by subgraph name
+type BulkheadStatsFunc func() map[string]BulkheadStats
+
+type bulkheadInstruments struct {
+	meter    otelmetric.Meter
+	inFlight otelmetric.Int64ObservableGauge
+	queued   otelmetric.Int64ObservableGauge
+	rejected otelmetric.Int64ObservableCounter
+}
+
+// BulkheadMetrics exports the in flight and queued requests of the subgraph bulkheads and the requests they rejected.
+type BulkheadMetrics struct {
+	instruments             []bulkheadInstruments
+	baseAttributes          []attribute.KeyValue
+	instrumentRegistrations []otelmetric.Registration
+}
+
+// NewBulkheadMetrics creates the bulkhead instruments for every given provider.
+func NewBulkheadMetrics(baseAttributes []attribute.KeyValue, providers ...*metric.MeterProvider) (*BulkheadMetrics, error) {
+	m := &BulkheadMetrics{
+		baseAttributes: baseAttributes,
+	}
+
+	for _, provider := range providers {
+		if provider == nil {
+			continue
+		}
+
+		meter := provider.Meter(cosmoRouterBulkheadMeterName, otelmetric.WithInstrumentationVersion(cosmoRouterBulkheadMeterVersion))
+
+		inFlight, err := meter.Int64ObservableGauge(
+			bulkheadInFlightMetric,
+			otelmetric.WithDescription("Number of requests to the subgraph that hold a slot of the bulkhead"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create bulkhead in flight gauge: %w", err)
+		}
+
+		queued, err := meter.Int64ObservableGauge(
+			bulkheadQueuedMetric,
+			otelmetric.WithDescription("Number of requests to the subgraph that wait in the queue of the bulkhead"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create bulkhead queued gauge: %w", err)
+		}
+
+		rejected, err := meter.Int64ObservableCounter(
+			bulkheadRejectedRequestsMetric,
+			otelmetric.WithDescription("Number of requests to the subgraph rejected by the bulkhead because the queue was full or timed out"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create bulkhead rejected requests counter: %w", err)
+		}
+
+		m.instruments = append(m.instruments, bulkheadInstruments{
+			meter:    meter,
+			inFlight: inFlight,
+			queued:   queued,
+			rejected: rejected,
+		})
+	}
+
+	return m, nil
+}
+
+// RegisterObserver observes the stats of the bulkheads when the metrics are collected.
+func (m *BulkheadMetrics) RegisterObserver(stats BulkheadStatsFunc) error {
+	for _, instruments := range m.instruments {
+		reg, err := instruments.meter.RegisterCallback(func(_ context.Context, o otelmetric.Observer) error {
+			for subgraph, s := range stats() {
+				opt := otelmetric.WithAttributes(append([]attribute.KeyValue{otel.WgSubgraphName.String(subgraph)}, m.baseAttributes...)...)
+				o.ObserveInt64(instruments.inFlight, s.InFlight, opt)
+				o.ObserveInt64(instruments.queued, s.Queued, opt)
+				o.ObserveInt64(instruments.rejected, s.Rejected, opt)
+			}
+			return nil
+		}, instruments.inFlight, instruments.queued, instruments.rejected)
+		if err != nil {
+			return err
+		}
+
+		m.instrumentRegistrations = append(m.instrumentRegistrations, reg)
+	}
+
+	return nil
+}
+
+func (m *BulkheadMetrics) Shutdown() error {
+	var err error
+
+	for _, reg := range m.instrumentRegistrations {
+		if regErr := reg.Unregister(); regErr != nil {
+			err = errors.Join(err, regErr)
+		}
+	}
+
+	return err
+}
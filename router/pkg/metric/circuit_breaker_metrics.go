@@ -0,0 +1,117 @@
+package metric
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/wundergraph/cosmo/router/pkg/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric"
+)
+
+const (
+	cosmoRouterCircuitBreakerMeterName    = "cosmo.router.circuit_breaker"
+	cosmoRouterCircuitBreakerMeterVersion = "0.0.1"
+
+	circuitBreakerStateMetric            = "router.http.client.circuit_breaker.state"
+	circuitBreakerRejectedRequestsMetric = "router.http.client.circuit_breaker.rejected_requests"
+)
+
+// CircuitBreakerStates returns the state of the circuit breaker of every subgraph by subgraph name.
+// 0 is closed, 1 is half open and 2 is open.
+type CircuitBreakerStates func() map[string]int64
+
+type circuitBreakerInstruments struct {
+	meter    otelmetric.Meter
+	state    otelmetric.Int64ObservableGauge
+	rejected otelmetric.Int64Counter
+}
+
+// CircuitBreakerMetrics exports the state of the subgraph circuit breakers and counts the requests they rejected.
+type CircuitBreakerMetrics struct {
+	instruments             []circuitBreakerInstruments
+	baseAttributes          []attribute.KeyValue
+	instrumentRegistrations []otelmetric.Registration
+}
+
+// NewCircuitBreakerMetrics creates the circuit breaker instruments for every given provider.
+func NewCircuitBreakerMetrics(baseAttributes []attribute.KeyValue, providers ...*metric.MeterProvider) (*CircuitBreakerMetrics, error) {
+	m := &CircuitBreakerMetrics{
+		baseAttributes: baseAttributes,
+	}
+
+	for _, provider := range providers {
+		if provider == nil {
+			continue
+		}
+
+		meter := provider.Meter(cosmoRouterCircuitBreakerMeterName, otelmetric.WithInstrumentationVersion(cosmoRouterCircuitBreakerMeterVersion))
+
+		state, err := meter.Int64ObservableGauge(
+			circuitBreakerStateMetric,
+			otelmetric.WithDescription("State of the subgraph circuit breaker. 0 is closed, 1 is half open and 2 is open"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create circuit breaker state gauge: %w", err)
+		}
+
+		rejected, err := meter.Int64Counter(
+			circuitBreakerRejectedRequestsMetric,
+			otelmetric.WithDescription("Number of subgraph requests rejected by an open circuit breaker"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create circuit breaker rejected requests counter: %w", err)
+		}
+
+		m.instruments = append(m.instruments, circuitBreakerInstruments{
+			meter:    meter,
+			state:    state,
+			rejected: rejected,
+		})
+	}
+
+	return m, nil
+}
+
+// RegisterObserver observes the states of the circuit breakers when the metrics are collected.
+func (m *CircuitBreakerMetrics) RegisterObserver(states CircuitBreakerStates) error {
+	for _, instruments := range m.instruments {
+		state := instruments.state
+		reg, err := instruments.meter.RegisterCallback(func(_ context.Context, o otelmetric.Observer) error {
+			for subgraph, value := range states() {
+				o.ObserveInt64(state, value, otelmetric.WithAttributes(append([]attribute.KeyValue{otel.WgSubgraphName.String(subgraph)}, m.baseAttributes...)...))
+			}
+			return nil
+		}, state)
+		if err != nil {
+			return err
+		}
+
+		m.instrumentRegistrations = append(m.instrumentRegistrations, reg)
+	}
+
+	return nil
+}
+
+// MeasureRejectedRequest counts a request to the subgraph that was rejected by its circuit breaker.
+func (m *CircuitBreakerMetrics) MeasureRejectedRequest(ctx context.Context, subgraph string) {
+	opt := otelmetric.WithAttributeSet(attribute.NewSet(append([]attribute.KeyValue{otel.WgSubgraphName.String(subgraph)}, m.baseAttributes...)...))
+
+	for _, instruments := range m.instruments {
+		instruments.rejected.Add(ctx, 1, opt)
+	}
+}
+
+func (m *CircuitBreakerMetrics) Shutdown() error {
+	var err error
+
+	for _, reg := range m.instrumentRegistrations {
+		if regErr := reg.Unregister(); regErr != nil {
+			err = errors.Join(err, regErr)
+		}
+	}
+
+	return err
+}
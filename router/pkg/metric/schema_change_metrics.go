@@ -0,0 +1,59 @@
+package metric
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric"
+)
+
+const (
+	cosmoRouterSchemaMeterName    = "cosmo.router.schema"
+	cosmoRouterSchemaMeterVersion = "0.0.1"
+
+	schemaChangesMetric = "router.schema.changes"
+)
+
+// SchemaChangeMetrics counts the schema changes between the execution configs that are loaded by the router.
+type SchemaChangeMetrics struct {
+	counters       []otelmetric.Int64Counter
+	baseAttributes []attribute.KeyValue
+}
+
+// NewSchemaChangeMetrics creates the schema change counter for every given provider.
+func NewSchemaChangeMetrics(baseAttributes []attribute.KeyValue, providers ...*metric.MeterProvider) (*SchemaChangeMetrics, error) {
+	m := &SchemaChangeMetrics{
+		baseAttributes: baseAttributes,
+	}
+
+	for _, provider := range providers {
+		if provider == nil {
+			continue
+		}
+
+		meter := provider.Meter(cosmoRouterSchemaMeterName, otelmetric.WithInstrumentationVersion(cosmoRouterSchemaMeterVersion))
+
+		counter, err := meter.Int64Counter(
+			schemaChangesMetric,
+			otelmetric.WithDescription("Number of schema changes between the previous and the new execution config. Tracks added, removed and changed types, fields and arguments by criticality"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create schema changes counter: %w", err)
+		}
+
+		m.counters = append(m.counters, counter)
+	}
+
+	return m, nil
+}
+
+// MeasureSchemaChanges adds the number of changes with the given attributes e.g. the change type and criticality.
+func (m *SchemaChangeMetrics) MeasureSchemaChanges(ctx context.Context, count int64, attrs ...attribute.KeyValue) {
+	opt := otelmetric.WithAttributeSet(attribute.NewSet(append(attrs, m.baseAttributes...)...))
+
+	for _, counter := range m.counters {
+		counter.Add(ctx, count, opt)
+	}
+}
@@ -40,9 +40,16 @@ const (
 	WgQueryRootFields                  = attribute.Key("wg.operation.complexity.root_fields")
 	WgQueryRootFieldAliases            = attribute.Key("wg.operation.complexity.root_fields_aliases")
 	WgQueryDepthCacheHit               = attribute.Key("wg.operation.complexity.cache_hit")
+	WgQueryCost                        = attribute.Key("wg.operation.complexity.cost")
 	WgResponseCacheControlReasons      = attribute.Key("wg.operation.cache_control_reasons")
 	WgResponseCacheControlWarnings     = attribute.Key("wg.operation.cache_control_warnings")
 	WgResponseCacheControlExpiration   = attribute.Key("wg.operation.cache_control_expiration")
+	WgSchemaChangeType                 = attribute.Key("wg.schema.change.type")
+	WgSchemaChangeCriticality          = attribute.Key("wg.schema.change.criticality")
+	WgRouterPreviousConfigVersion      = attribute.Key("wg.router.previous_config.version")
+	WgSubgraphCircuitBreakerState      = attribute.Key("wg.subgraph.circuit_breaker.state")
+	WgSubgraphRetryAttempt             = attribute.Key("wg.subgraph.retry.attempt")
+	WgSubgraphRetryError               = attribute.Key("wg.subgraph.retry.error")
 	// HTTPRequestUploadFileCount is the number of files uploaded in a request (Not specified in the OpenTelemetry specification)
 	HTTPRequestUploadFileCount = attribute.Key("http.request.upload.file_count")
 )
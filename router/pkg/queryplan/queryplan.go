@@ -0,0 +1,249 @@
+// Package queryplan renders the fetch tree of a query plan in formats suited for reviews and visualization.
+package queryplan
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/engine/resolve"
+)
+
+type Format string
+
+const (
+	// FormatText is the pretty printed plan of the engine
+	FormatText Format = "text"
+	// FormatJSON is the fetch tree as structured JSON
+	FormatJSON Format = "json"
+	// FormatDOT is a Graphviz digraph of the fetch tree
+	FormatDOT Format = "dot"
+	// FormatMermaid is a Mermaid flowchart of the fetch tree
+	FormatMermaid Format = "mermaid"
+)
+
+var Formats = []Format{FormatText, FormatJSON, FormatDOT, FormatMermaid}
+
+func ParseFormat(s string) (Format, error) {
+	format := Format(strings.ToLower(strings.TrimSpace(s)))
+	for _, f := range Formats {
+		if f == format {
+			return format, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported query plan format '%s', supported formats are text, json, dot and mermaid", s)
+}
+
+// ContentType returns the media type of the rendered plan
+func (f Format) ContentType() string {
+	switch f {
+	case FormatJSON:
+		return "application/json"
+	case FormatDOT:
+		return "text/vnd.graphviz"
+	default:
+		return "text/plain"
+	}
+}
+
+// Render renders the plan in the given format
+func Render(plan *resolve.FetchTreeQueryPlanNode, format Format) (string, error) {
+	switch format {
+	case FormatText:
+		if plan == nil {
+			return "", nil
+		}
+		return plan.PrettyPrint(), nil
+	case FormatJSON:
+		content, err := json.MarshalIndent(plan, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(content), nil
+	case FormatDOT:
+		return RenderDOT(plan), nil
+	case FormatMermaid:
+		return RenderMermaid(plan), nil
+	default:
+		return "", fmt.Errorf("unsupported query plan format '%s'", format)
+	}
+}
+
+// graphNode is a node of the rendered graph. Sequences and parallel nodes become nodes of their own, so the graph
+// shows which fetches wait for each other.
+type graphNode struct {
+	id    string
+	label string
+	shape nodeShape
+}
+
+type graphEdge struct {
+	from, to string
+	// label is the position of the child in a sequence, empty for parallel children
+	label string
+}
+
+type nodeShape int
+
+const (
+	shapeFetch nodeShape = iota
+	shapeSequence
+	shapeParallel
+	shapeTrigger
+)
+
+type graph struct {
+	nodes []graphNode
+	edges []graphEdge
+}
+
+func buildGraph(plan *resolve.FetchTreeQueryPlanNode) *graph {
+	g := &graph{}
+	if plan == nil {
+		return g
+	}
+
+	root := g.addNode(plan)
+
+	if plan.Trigger != nil {
+		trigger := g.newNodeID()
+		g.nodes = append(g.nodes, graphNode{id: trigger, label: fetchLabel(plan.Trigger), shape: shapeTrigger})
+		if root != "" {
+			g.edges = append(g.edges, graphEdge{from: trigger, to: root})
+		}
+	}
+
+	return g
+}
+
+func (g *graph) newNodeID() string {
+	return fmt.Sprintf("n%d", len(g.nodes))
+}
+
+// addNode adds the node and its children and returns the id of the node. Nodes without fetches or children are
+// skipped and return an empty id.
+func (g *graph) addNode(node *resolve.FetchTreeQueryPlanNode) string {
+	if node == nil {
+		return ""
+	}
+
+	id := g.newNodeID()
+
+	switch node.Kind {
+	case resolve.FetchTreeNodeKindSequence, resolve.FetchTreeNodeKindParallel:
+		shape, label := shapeSequence, "Sequence"
+		if node.Kind == resolve.FetchTreeNodeKindParallel {
+			shape, label = shapeParallel, "Parallel"
+		}
+		g.nodes = append(g.nodes, graphNode{id: id, label: label, shape: shape})
+
+		position := 0
+		for _, child := range node.Children {
+			childID := g.addNode(child)
+			if childID == "" {
+				continue
+			}
+			position++
+			edge := graphEdge{from: id, to: childID}
+			if node.Kind == resolve.FetchTreeNodeKindSequence {
+				edge.label = fmt.Sprint(position)
+			}
+			g.edges = append(g.edges, edge)
+		}
+	default:
+		if node.Fetch == nil {
+			return ""
+		}
+		g.nodes = append(g.nodes, graphNode{id: id, label: fetchLabel(node.Fetch), shape: shapeFetch})
+	}
+
+	return id
+}
+
+func fetchLabel(fetch *resolve.FetchTreeQueryPlan) string {
+	label := fmt.Sprintf("%s\n%s", fetch.SubgraphName, fetch.Kind)
+	if fetch.Path != "" {
+		label += "\n" + fetch.Path
+	}
+	return label
+}
+
+// RenderDOT renders the plan as a Graphviz digraph
+func RenderDOT(plan *resolve.FetchTreeQueryPlanNode) string {
+	g := buildGraph(plan)
+
+	var b strings.Builder
+	b.WriteString("digraph QueryPlan {\n")
+	b.WriteString("  node [fontname=\"Helvetica\"];\n")
+
+	for _, node := range g.nodes {
+		var attrs string
+		switch node.shape {
+		case shapeSequence:
+			attrs = "shape=cds"
+		case shapeParallel:
+			attrs = "shape=diamond"
+		case shapeTrigger:
+			attrs = "shape=box, style=\"rounded,dashed\""
+		default:
+			attrs = "shape=box, style=rounded"
+		}
+		fmt.Fprintf(&b, "  %s [label=%s, %s];\n", node.id, dotString(node.label), attrs)
+	}
+
+	for _, edge := range g.edges {
+		if edge.label != "" {
+			fmt.Fprintf(&b, "  %s -> %s [label=%s];\n", edge.from, edge.to, dotString(edge.label))
+			continue
+		}
+		fmt.Fprintf(&b, "  %s -> %s;\n", edge.from, edge.to)
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func dotString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+// RenderMermaid renders the plan as a Mermaid flowchart
+func RenderMermaid(plan *resolve.FetchTreeQueryPlanNode) string {
+	g := buildGraph(plan)
+
+	var b strings.Builder
+	b.WriteString("flowchart TD\n")
+
+	for _, node := range g.nodes {
+		label := mermaidString(node.label)
+		switch node.shape {
+		case shapeSequence:
+			fmt.Fprintf(&b, "  %s[[%s]]\n", node.id, label)
+		case shapeParallel:
+			fmt.Fprintf(&b, "  %s{{%s}}\n", node.id, label)
+		case shapeTrigger:
+			fmt.Fprintf(&b, "  %s([%s])\n", node.id, label)
+		default:
+			fmt.Fprintf(&b, "  %s[%s]\n", node.id, label)
+		}
+	}
+
+	for _, edge := range g.edges {
+		if edge.label != "" {
+			fmt.Fprintf(&b, "  %s -->|%s| %s\n", edge.from, edge.label, edge.to)
+			continue
+		}
+		fmt.Fprintf(&b, "  %s --> %s\n", edge.from, edge.to)
+	}
+
+	return b.String()
+}
+
+func mermaidString(s string) string {
+	s = strings.ReplaceAll(s, `"`, "#quot;")
+	s = strings.ReplaceAll(s, "\n", "<br/>")
+	return `"` + s + `"`
+}
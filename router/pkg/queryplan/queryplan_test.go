@@ -0,0 +1,106 @@
+package queryplan
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/engine/resolve"
+)
+
+func testPlan() *resolve.FetchTreeQueryPlanNode {
+	return &resolve.FetchTreeQueryPlanNode{
+		Version: "1",
+		Kind:    resolve.FetchTreeNodeKindSequence,
+		Children: []*resolve.FetchTreeQueryPlanNode{
+			{
+				Kind:  resolve.FetchTreeNodeKindSingle,
+				Fetch: &resolve.FetchTreeQueryPlan{Kind: "Single", SubgraphName: "employees", Query: "{employees {id}}"},
+			},
+			{
+				Kind: resolve.FetchTreeNodeKindParallel,
+				Children: []*resolve.FetchTreeQueryPlanNode{
+					{
+						Kind:  resolve.FetchTreeNodeKindSingle,
+						Fetch: &resolve.FetchTreeQueryPlan{Kind: "BatchEntity", SubgraphName: "products", Path: "query.employees"},
+					},
+					{
+						Kind:  resolve.FetchTreeNodeKindSingle,
+						Fetch: &resolve.FetchTreeQueryPlan{Kind: "BatchEntity", SubgraphName: "mood \"v2\"", Path: "query.employees"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	t.Parallel()
+
+	format, err := ParseFormat(" Mermaid ")
+	require.NoError(t, err)
+	require.Equal(t, FormatMermaid, format)
+
+	_, err = ParseFormat("svg")
+	require.ErrorContains(t, err, "unsupported query plan format 'svg'")
+}
+
+func TestRenderDOT(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, `digraph QueryPlan {
+  node [fontname="Helvetica"];
+  n0 [label="Sequence", shape=cds];
+  n1 [label="employees\nSingle", shape=box, style=rounded];
+  n2 [label="Parallel", shape=diamond];
+  n3 [label="products\nBatchEntity\nquery.employees", shape=box, style=rounded];
+  n4 [label="mood \"v2\"\nBatchEntity\nquery.employees", shape=box, style=rounded];
+  n0 -> n1 [label="1"];
+  n2 -> n3;
+  n2 -> n4;
+  n0 -> n2 [label="2"];
+}
+`, RenderDOT(testPlan()))
+}
+
+func TestRenderMermaid(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, `flowchart TD
+  n0[["Sequence"]]
+  n1["employees<br/>Single"]
+  n2{{"Parallel"}}
+  n3["products<br/>BatchEntity<br/>query.employees"]
+  n4["mood #quot;v2#quot;<br/>BatchEntity<br/>query.employees"]
+  n0 -->|1| n1
+  n2 --> n3
+  n2 --> n4
+  n0 -->|2| n2
+`, RenderMermaid(testPlan()))
+}
+
+func TestRenderSubscription(t *testing.T) {
+	t.Parallel()
+
+	plan := &resolve.FetchTreeQueryPlanNode{
+		Kind:    resolve.FetchTreeNodeKindSequence,
+		Trigger: &resolve.FetchTreeQueryPlan{Kind: "Trigger", SubgraphName: "employees"},
+	}
+
+	require.Equal(t, `flowchart TD
+  n0[["Sequence"]]
+  n1(["employees<br/>Trigger"])
+  n1 --> n0
+`, RenderMermaid(plan))
+}
+
+func TestRenderJSON(t *testing.T) {
+	t.Parallel()
+
+	content, err := Render(testPlan(), FormatJSON)
+	require.NoError(t, err)
+
+	var plan resolve.FetchTreeQueryPlanNode
+	require.NoError(t, json.Unmarshal([]byte(content), &plan))
+	require.Equal(t, testPlan(), &plan)
+}
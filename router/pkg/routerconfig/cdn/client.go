@@ -4,10 +4,6 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
-	"crypto/hmac"
-	"crypto/sha256"
-	"crypto/subtle"
-	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -25,13 +21,9 @@ import (
 	"go.uber.org/zap"
 )
 
-const (
-	sigResponseHeaderName = "X-Signature-SHA256"
-)
-
 var (
 	ErrMissingSignatureHeader       = errors.New("signature header not found in CDN response")
-	ErrInvalidSignature             = errors.New("invalid config signature, potential tampering detected")
+	ErrInvalidSignature             = routerconfig.ErrInvalidSignature
 	ErrConfigNotFound         error = &routerConfigNotFoundError{}
 )
 
@@ -106,7 +98,7 @@ func NewClient(endpoint string, token string, opts *Options) (routerconfig.Clien
 	}
 
 	if opts.SignatureKey != "" {
-		c.hash = hmac.New(sha256.New, []byte(opts.SignatureKey))
+		c.hash = routerconfig.NewSignatureHash(opts.SignatureKey)
 	}
 
 	return c, nil
@@ -184,7 +176,7 @@ func (cdn *Client) getRouterConfig(ctx context.Context, version string, _ time.T
 	 */
 
 	if cdn.hash != nil {
-		configSignature := resp.Header.Get(sigResponseHeaderName)
+		configSignature := resp.Header.Get(routerconfig.SignatureHeaderName)
 		if configSignature == "" {
 			cdn.logger.Error(
 				"Signature header not found in CDN response. Ensure that your Admission Controller was able to sign the config. Open the compositions page in the Studio to check the status of the last deployment",
@@ -193,25 +185,14 @@ func (cdn *Client) getRouterConfig(ctx context.Context, version string, _ time.T
 			return nil, ErrMissingSignatureHeader
 		}
 
-		// create a signature of the received config body
-		if _, err := cdn.hash.Write(body); err != nil {
-			return nil, fmt.Errorf("could not write config body to hmac: %w", err)
-		}
-		dataHmac := cdn.hash.Sum(nil)
-		cdn.hash.Reset()
-
-		// compare received signature with the one we calculated with the private signature key
-		rawSignature, err := base64.StdEncoding.DecodeString(configSignature)
-		if err != nil {
-			return nil, fmt.Errorf("could not hex decode signature key: %w", err)
-		}
-
-		if subtle.ConstantTimeCompare(rawSignature, dataHmac) != 1 {
-			cdn.logger.Error(
-				"Invalid config signature, potential tampering detected. Ensure that your Admission Controller has signed the config correctly. Open the compositions page in the Studio to check the status of the last deployment",
-				zap.Error(ErrInvalidSignature),
-			)
-			return nil, ErrInvalidSignature
+		if err := routerconfig.VerifySignature(cdn.hash, body, configSignature); err != nil {
+			if errors.Is(err, ErrInvalidSignature) {
+				cdn.logger.Error(
+					"Invalid config signature, potential tampering detected. Ensure that your Admission Controller has signed the config correctly. Open the compositions page in the Studio to check the status of the last deployment",
+					zap.Error(err),
+				)
+			}
+			return nil, err
 		}
 
 		cdn.logger.Info("Config signature validation successful",
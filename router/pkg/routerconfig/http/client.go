@@ -0,0 +1,183 @@
+package http
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/wundergraph/cosmo/router/internal/httpclient"
+	"github.com/wundergraph/cosmo/router/pkg/controlplane/configpoller"
+	"github.com/wundergraph/cosmo/router/pkg/execution_config"
+	"github.com/wundergraph/cosmo/router/pkg/routerconfig"
+	"go.uber.org/zap"
+)
+
+var ErrMissingSignatureHeader = errors.New("signature header not found in response")
+
+type Options struct {
+	Logger *zap.Logger
+	// ObjectPath is resolved against the endpoint URL e.g. the object path of the execution config storage
+	ObjectPath string
+	// Headers are added to every request e.g. to authenticate against the server
+	Headers map[string]string
+	// SignatureKey is used to verify the HMAC-SHA256 signature of the config in the X-Signature-SHA256 response header
+	SignatureKey string
+}
+
+// Client fetches the execution config from any HTTP server. It uses conditional requests with the ETag and
+// Last-Modified headers of the last response, so the server can answer with 304 Not Modified when the config hasn't changed.
+type Client struct {
+	configURL  string
+	headers    map[string]string
+	httpClient *http.Client
+	logger     *zap.Logger
+	hash       hash.Hash
+
+	// mu guards the validators of the last response and the hash
+	mu sync.Mutex
+	// version, etag and lastModified belong to the last config that was returned by the client
+	version      string
+	etag         string
+	lastModified string
+}
+
+// NewClient creates a new HTTP client. Endpoint is the URL of the execution config.
+func NewClient(endpoint string, opts *Options) (routerconfig.Client, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid execution config URL %q: %w", endpoint, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid execution config URL %q: scheme must be http or https", endpoint)
+	}
+
+	if opts.ObjectPath != "" {
+		objectURL, err := url.Parse(opts.ObjectPath)
+		if err != nil {
+			return nil, fmt.Errorf("invalid object path %q: %w", opts.ObjectPath, err)
+		}
+		u = u.ResolveReference(objectURL)
+	}
+
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+
+	logger := opts.Logger.With(zap.String("component", "router_config_client"))
+
+	c := &Client{
+		configURL:  u.String(),
+		headers:    opts.Headers,
+		httpClient: httpclient.NewRetryableHTTPClient(logger),
+		logger:     logger,
+	}
+
+	if opts.SignatureKey != "" {
+		c.hash = routerconfig.NewSignatureHash(opts.SignatureKey)
+	}
+
+	return c, nil
+}
+
+func (c *Client) RouterConfig(ctx context.Context, prevVersion string, _ time.Time) (*routerconfig.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.configURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, value := range c.headers {
+		req.Header.Set(name, value)
+	}
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	// Conditional requests are only sent when the router runs with the last config of this client.
+	// Otherwise, a config that could not be applied or a config of another storage would never be replaced.
+	if prevVersion != "" && prevVersion == c.version {
+		if c.etag != "" {
+			req.Header.Set("If-None-Match", c.etag)
+		}
+		if c.lastModified != "" {
+			req.Header.Set("If-Modified-Since", c.lastModified)
+		}
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotModified:
+		return nil, configpoller.ErrConfigNotModified
+	case http.StatusNotFound:
+		return &routerconfig.Response{Config: routerconfig.GetDefaultConfig()}, nil
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return nil, fmt.Errorf("could not authenticate against execution config server, statusCode: %d", resp.StatusCode)
+	default:
+		return nil, fmt.Errorf("unexpected status code when loading router config, statusCode: %d", resp.StatusCode)
+	}
+
+	var reader io.Reader = resp.Body
+
+	if resp.Header.Get("Content-Encoding") == "gzip" {
+		r, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("could not create gzip reader: %w", err)
+		}
+		defer r.Close()
+		reader = r
+	}
+
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the response body: %w", err)
+	}
+
+	if len(body) == 0 {
+		return nil, errors.New("empty response body")
+	}
+
+	if c.hash != nil {
+		signature := resp.Header.Get(routerconfig.SignatureHeaderName)
+		if signature == "" {
+			c.logger.Error("Signature header not found in execution config response. Ensure that your server returns the signature of the config",
+				zap.String("url", c.configURL),
+				zap.Error(ErrMissingSignatureHeader),
+			)
+			return nil, ErrMissingSignatureHeader
+		}
+
+		if err := routerconfig.VerifySignature(c.hash, body, signature); err != nil {
+			if errors.Is(err, routerconfig.ErrInvalidSignature) {
+				c.logger.Error("Invalid config signature, potential tampering detected",
+					zap.String("url", c.configURL),
+					zap.Error(err),
+				)
+			}
+			return nil, err
+		}
+	}
+
+	config, err := execution_config.UnmarshalConfig(body)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal router config from %s: %w", c.configURL, err)
+	}
+
+	c.version = config.GetVersion()
+	c.etag = resp.Header.Get("ETag")
+	c.lastModified = resp.Header.Get("Last-Modified")
+
+	return &routerconfig.Response{Config: config}, nil
+}
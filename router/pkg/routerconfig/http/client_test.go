@@ -0,0 +1,103 @@
+package http
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wundergraph/cosmo/router/pkg/controlplane/configpoller"
+	"github.com/wundergraph/cosmo/router/pkg/routerconfig"
+)
+
+const testConfig = `{"version":"v1","engineConfig":{"graphqlSchema":"type Query { hello: String }"}}`
+
+func sign(key, body string) string {
+	h := hmac.New(sha256.New, []byte(key))
+	h.Write([]byte(body))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+func TestClientConditionalRequests(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		require.Equal(t, "/configs/router.json", r.URL.Path)
+		require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(testConfig))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL+"/configs/", &Options{
+		ObjectPath: "router.json",
+		Headers:    map[string]string{"Authorization": "Bearer token"},
+	})
+	require.NoError(t, err)
+
+	res, err := client.RouterConfig(context.Background(), "", time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, "v1", res.Config.GetVersion())
+
+	_, err = client.RouterConfig(context.Background(), "v1", time.Now())
+	require.ErrorIs(t, err, configpoller.ErrConfigNotModified)
+
+	// The router runs with another config e.g. from the fallback storage, so the config has to be fetched again
+	res, err = client.RouterConfig(context.Background(), "v0", time.Now())
+	require.NoError(t, err)
+	require.Equal(t, "v1", res.Config.GetVersion())
+
+	require.Equal(t, int32(3), requests.Load())
+}
+
+func TestClientSignature(t *testing.T) {
+	signature := sign("secret", testConfig)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(routerconfig.SignatureHeaderName, signature)
+		_, _ = w.Write([]byte(testConfig))
+	}))
+	defer server.Close()
+
+	t.Run("valid signature", func(t *testing.T) {
+		client, err := NewClient(server.URL, &Options{SignatureKey: "secret"})
+		require.NoError(t, err)
+
+		res, err := client.RouterConfig(context.Background(), "", time.Time{})
+		require.NoError(t, err)
+		require.Equal(t, "v1", res.Config.GetVersion())
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		client, err := NewClient(server.URL, &Options{SignatureKey: "other"})
+		require.NoError(t, err)
+
+		_, err = client.RouterConfig(context.Background(), "", time.Time{})
+		require.ErrorIs(t, err, routerconfig.ErrInvalidSignature)
+	})
+
+	t.Run("missing signature", func(t *testing.T) {
+		unsigned := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(testConfig))
+		}))
+		defer unsigned.Close()
+
+		client, err := NewClient(unsigned.URL, &Options{SignatureKey: "secret"})
+		require.NoError(t, err)
+
+		_, err = client.RouterConfig(context.Background(), "", time.Time{})
+		require.ErrorIs(t, err, ErrMissingSignatureHeader)
+	})
+}
@@ -3,8 +3,10 @@ package s3
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/minio/minio-go/v7"
@@ -12,13 +14,25 @@ import (
 	"github.com/wundergraph/cosmo/router/pkg/controlplane/configpoller"
 	"github.com/wundergraph/cosmo/router/pkg/execution_config"
 	"github.com/wundergraph/cosmo/router/pkg/routerconfig"
+	"go.uber.org/zap"
 )
 
+const (
+	// SignatureMetadataKey is the user metadata key of the base64 encoded HMAC-SHA256 signature of the config.
+	// It is set with the x-amz-meta-signature-sha256 header when the object is uploaded.
+	SignatureMetadataKey = "Signature-Sha256"
+	// SignatureObjectSuffix is appended to the object path to locate the signature when the object has no signature metadata
+	SignatureObjectSuffix = ".sig"
+)
+
+var ErrMissingSignature = errors.New("signature not found in object metadata or signature object")
+
 type Option func(*Client)
 
 type Client struct {
 	client  *minio.Client
 	options *ClientOptions
+	logger  *zap.Logger
 }
 
 type ClientOptions struct {
@@ -28,11 +42,19 @@ type ClientOptions struct {
 	Secure          bool
 	BucketName      string
 	ObjectPath      string
+	Logger          *zap.Logger
+	// SignatureKey is used to verify the HMAC-SHA256 signature of the config. If empty, the signature is not verified.
+	SignatureKey string
 }
 
 func NewClient(endpoint string, options *ClientOptions) (routerconfig.Client, error) {
+	if options.Logger == nil {
+		options.Logger = zap.NewNop()
+	}
+
 	client := &Client{
 		options: options,
+		logger:  options.Logger.With(zap.String("component", "router_config_client")),
 	}
 
 	// The providers credential chain is used to allow multiple authentication methods.
@@ -66,7 +88,7 @@ func NewClient(endpoint string, options *ClientOptions) (routerconfig.Client, er
 	return client, nil
 }
 
-func (c Client) getConfigFile(ctx context.Context, version string, modifiedSince time.Time) ([]byte, error) {
+func (c Client) getConfigFile(ctx context.Context, version string, modifiedSince time.Time) ([]byte, map[string]string, error) {
 	options := minio.GetObjectOptions{}
 
 	if !modifiedSince.IsZero() {
@@ -78,23 +100,59 @@ func (c Client) getConfigFile(ctx context.Context, version string, modifiedSince
 		// in order to safe bandwidth. On the controlplane, we don't deploy the config when the subgraph hasn't changed.
 		// Even in the worst case, the server will not swap the config unless the router config version has changed.
 		if err := options.SetModified(modifiedSince); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
 	reader, err := c.client.GetObject(ctx, c.options.BucketName, c.options.ObjectPath, options)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer reader.Close()
 
-	return io.ReadAll(reader)
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info, err := reader.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body, info.UserMetadata, nil
+}
+
+// getSignature returns the signature of the config from the object metadata or the detached signature object
+func (c Client) getSignature(ctx context.Context, metadata map[string]string) (string, error) {
+	for key, value := range metadata {
+		if strings.EqualFold(key, SignatureMetadataKey) && value != "" {
+			return value, nil
+		}
+	}
+
+	reader, err := c.client.GetObject(ctx, c.options.BucketName, c.options.ObjectPath+SignatureObjectSuffix, minio.GetObjectOptions{})
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+
+	signature, err := io.ReadAll(reader)
+	if err != nil {
+		var minioErr minio.ErrorResponse
+		if errors.As(err, &minioErr) && minioErr.Code == "NoSuchKey" {
+			return "", ErrMissingSignature
+		}
+		return "", fmt.Errorf("could not read signature object: %w", err)
+	}
+
+	return strings.TrimSpace(string(signature)), nil
 }
 
 func (c Client) RouterConfig(ctx context.Context, version string, modifiedSince time.Time) (*routerconfig.Response, error) {
 	res := &routerconfig.Response{}
 
-	body, err := c.getConfigFile(ctx, version, modifiedSince)
+	body, metadata, err := c.getConfigFile(ctx, version, modifiedSince)
 	if err != nil {
 		var minioErr minio.ErrorResponse
 		if errors.As(err, &minioErr) {
@@ -109,6 +167,36 @@ func (c Client) RouterConfig(ctx context.Context, version string, modifiedSince
 		return nil, err
 	}
 
+	if c.options.SignatureKey != "" {
+		signature, err := c.getSignature(ctx, metadata)
+		if err != nil {
+			if errors.Is(err, ErrMissingSignature) {
+				c.logger.Error(
+					"Signature not found in S3 object metadata or signature object. Ensure that the config was uploaded with its signature",
+					zap.String("object_path", c.options.ObjectPath),
+					zap.Error(err),
+				)
+			}
+			return nil, err
+		}
+
+		if err := routerconfig.VerifySignature(routerconfig.NewSignatureHash(c.options.SignatureKey), body, signature); err != nil {
+			if errors.Is(err, routerconfig.ErrInvalidSignature) {
+				c.logger.Error(
+					"Invalid config signature, potential tampering detected. Ensure that the config in the S3 storage has been signed correctly",
+					zap.String("object_path", c.options.ObjectPath),
+					zap.Error(err),
+				)
+			}
+			return nil, err
+		}
+
+		c.logger.Info("Config signature validation successful",
+			zap.String("object_path", c.options.ObjectPath),
+			zap.String("signature", signature),
+		)
+	}
+
 	res.Config, err = execution_config.UnmarshalConfig(body)
 	return res, err
 }
@@ -0,0 +1,129 @@
+package s3
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wundergraph/cosmo/router/pkg/routerconfig"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+const testConfig = `{"version":"v1","engineConfig":{"graphqlSchema":"type Query { hello: String }"}}`
+
+const noSuchKeyResponse = `<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`
+
+func sign(key, body string) string {
+	h := hmac.New(sha256.New, []byte(key))
+	h.Write([]byte(body))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+// newS3Server serves the config and the signature object like an S3 bucket. The signature is returned as object
+// metadata if metadataSignature is set. A missing signature object is answered with NoSuchKey.
+func newS3Server(t *testing.T, metadataSignature, signatureObject string) *httptest.Server {
+	t.Helper()
+
+	writeObject := func(w http.ResponseWriter, body string) {
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+		w.Header().Set("ETag", `"etag"`)
+		_, _ = w.Write([]byte(body))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/cosmo/router.json":
+			if metadataSignature != "" {
+				w.Header().Set("X-Amz-Meta-Signature-Sha256", metadataSignature)
+			}
+			writeObject(w, testConfig)
+		case "/cosmo/router.json" + SignatureObjectSuffix:
+			if signatureObject == "" {
+				w.Header().Set("Content-Type", "application/xml")
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(noSuchKeyResponse))
+				return
+			}
+			writeObject(w, signatureObject+"\n")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newTestClient(t *testing.T, server *httptest.Server, signatureKey string, logger *zap.Logger) routerconfig.Client {
+	t.Helper()
+
+	client, err := NewClient(strings.TrimPrefix(server.URL, "http://"), &ClientOptions{
+		AccessKeyID:     "access-key",
+		SecretAccessKey: "secret-key",
+		Region:          "us-east-1",
+		BucketName:      "cosmo",
+		ObjectPath:      "router.json",
+		SignatureKey:    signatureKey,
+		Logger:          logger,
+	})
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestClientSignature(t *testing.T) {
+	signature := sign("secret", testConfig)
+
+	t.Run("valid signature in object metadata", func(t *testing.T) {
+		client := newTestClient(t, newS3Server(t, signature, ""), "secret", nil)
+
+		res, err := client.RouterConfig(context.Background(), "", time.Time{})
+		require.NoError(t, err)
+		require.Equal(t, "v1", res.Config.GetVersion())
+	})
+
+	t.Run("valid signature in signature object", func(t *testing.T) {
+		client := newTestClient(t, newS3Server(t, "", signature), "secret", nil)
+
+		res, err := client.RouterConfig(context.Background(), "", time.Time{})
+		require.NoError(t, err)
+		require.Equal(t, "v1", res.Config.GetVersion())
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		core, logs := observer.New(zapcore.ErrorLevel)
+		client := newTestClient(t, newS3Server(t, sign("other", testConfig), ""), "secret", zap.New(core))
+
+		_, err := client.RouterConfig(context.Background(), "", time.Time{})
+		require.ErrorIs(t, err, routerconfig.ErrInvalidSignature)
+		require.Equal(t, 1, logs.FilterMessageSnippet("Invalid config signature").Len())
+	})
+
+	t.Run("missing signature", func(t *testing.T) {
+		core, logs := observer.New(zapcore.ErrorLevel)
+		client := newTestClient(t, newS3Server(t, "", ""), "secret", zap.New(core))
+
+		_, err := client.RouterConfig(context.Background(), "", time.Time{})
+		require.ErrorIs(t, err, ErrMissingSignature)
+		require.Equal(t, 1, logs.FilterMessageSnippet("Signature not found").Len())
+	})
+
+	t.Run("signature is not verified without a key", func(t *testing.T) {
+		client := newTestClient(t, newS3Server(t, "", ""), "", nil)
+
+		res, err := client.RouterConfig(context.Background(), "", time.Time{})
+		require.NoError(t, err)
+		require.Equal(t, "v1", res.Config.GetVersion())
+	})
+}
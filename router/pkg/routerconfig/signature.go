@@ -0,0 +1,45 @@
+package routerconfig
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+)
+
+// SignatureHeaderName is the response header that carries the base64 encoded HMAC-SHA256 signature of the config
+const SignatureHeaderName = "X-Signature-SHA256"
+
+var ErrInvalidSignature = errors.New("invalid config signature, potential tampering detected")
+
+// NewSignatureHash returns the HMAC-SHA256 hash that is used to sign router configs with the given key
+func NewSignatureHash(key string) hash.Hash {
+	return hmac.New(sha256.New, []byte(key))
+}
+
+// VerifySignature compares the base64 encoded signature with the HMAC of the body.
+// The hash is reset afterward, so it can be reused for the next config. It is not safe for concurrent use.
+func VerifySignature(h hash.Hash, body []byte, signature string) error {
+	defer h.Reset()
+
+	// create a signature of the received config body
+	if _, err := h.Write(body); err != nil {
+		return fmt.Errorf("could not write config body to hmac: %w", err)
+	}
+	dataHmac := h.Sum(nil)
+
+	// compare received signature with the one we calculated with the private signature key
+	rawSignature, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("could not decode signature: %w", err)
+	}
+
+	if subtle.ConstantTimeCompare(rawSignature, dataHmac) != 1 {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
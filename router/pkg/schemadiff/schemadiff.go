@@ -0,0 +1,540 @@
+package schemadiff
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/ast"
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/astparser"
+)
+
+// Criticality describes the impact of a change on existing clients.
+type Criticality string
+
+const (
+	// CriticalitySafe changes don't affect existing clients
+	CriticalitySafe Criticality = "SAFE"
+	// CriticalityDangerous changes don't break existing operations but can change the behavior
+	// of clients, e.g. a new enum value that a client doesn't handle
+	CriticalityDangerous Criticality = "DANGEROUS"
+	// CriticalityBreaking changes break existing operations
+	CriticalityBreaking Criticality = "BREAKING"
+)
+
+// ChangeType identifies the kind of change.
+type ChangeType string
+
+const (
+	TypeAdded                ChangeType = "TYPE_ADDED"
+	TypeRemoved              ChangeType = "TYPE_REMOVED"
+	TypeKindChanged          ChangeType = "TYPE_KIND_CHANGED"
+	FieldAdded               ChangeType = "FIELD_ADDED"
+	FieldRemoved             ChangeType = "FIELD_REMOVED"
+	FieldTypeChanged         ChangeType = "FIELD_TYPE_CHANGED"
+	ArgumentAdded            ChangeType = "ARGUMENT_ADDED"
+	ArgumentRemoved          ChangeType = "ARGUMENT_REMOVED"
+	ArgumentTypeChanged      ChangeType = "ARGUMENT_TYPE_CHANGED"
+	ArgumentDefaultChanged   ChangeType = "ARGUMENT_DEFAULT_CHANGED"
+	InputFieldAdded          ChangeType = "INPUT_FIELD_ADDED"
+	InputFieldRemoved        ChangeType = "INPUT_FIELD_REMOVED"
+	InputFieldTypeChanged    ChangeType = "INPUT_FIELD_TYPE_CHANGED"
+	InputFieldDefaultChanged ChangeType = "INPUT_FIELD_DEFAULT_CHANGED"
+	EnumValueAdded           ChangeType = "ENUM_VALUE_ADDED"
+	EnumValueRemoved         ChangeType = "ENUM_VALUE_REMOVED"
+	UnionMemberAdded         ChangeType = "UNION_MEMBER_ADDED"
+	UnionMemberRemoved       ChangeType = "UNION_MEMBER_REMOVED"
+	InterfaceAdded           ChangeType = "INTERFACE_ADDED"
+	InterfaceRemoved         ChangeType = "INTERFACE_REMOVED"
+	DirectiveAdded           ChangeType = "DIRECTIVE_ADDED"
+	DirectiveRemoved         ChangeType = "DIRECTIVE_REMOVED"
+	DirectiveLocationAdded   ChangeType = "DIRECTIVE_LOCATION_ADDED"
+	DirectiveLocationRemoved ChangeType = "DIRECTIVE_LOCATION_REMOVED"
+	DirectiveRepeatableAdded ChangeType = "DIRECTIVE_REPEATABLE_ADDED"
+	// DirectiveRepeatableRemoved means that a directive can't be repeated at the same location anymore
+	DirectiveRepeatableRemoved ChangeType = "DIRECTIVE_REPEATABLE_REMOVED"
+)
+
+// Change is a single difference between two schemas.
+type Change struct {
+	Type        ChangeType  `json:"type"`
+	Criticality Criticality `json:"criticality"`
+	// Path is the coordinate of the changed element, e.g. Query.employee, Query.employee(id) or @tag(name)
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// Diff is the list of changes between two schemas ordered by path and type. Directive definitions are compared, the
+// directives applied to types and fields are not.
+type Diff struct {
+	Changes []Change `json:"changes"`
+}
+
+// HasChanges returns true if the schemas differ.
+func (d *Diff) HasChanges() bool {
+	return len(d.Changes) > 0
+}
+
+// Count returns the number of changes with the given criticality.
+func (d *Diff) Count(criticality Criticality) int {
+	count := 0
+	for _, change := range d.Changes {
+		if change.Criticality == criticality {
+			count++
+		}
+	}
+	return count
+}
+
+// Filter returns the changes with the given criticality.
+func (d *Diff) Filter(criticality Criticality) []Change {
+	var changes []Change
+	for _, change := range d.Changes {
+		if change.Criticality == criticality {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+const (
+	kindObject      = "object"
+	kindInterface   = "interface"
+	kindUnion       = "union"
+	kindEnum        = "enum"
+	kindInputObject = "input object"
+	kindScalar      = "scalar"
+)
+
+type inputValue struct {
+	typ          string
+	defaultValue string
+	hasDefault   bool
+}
+
+type field struct {
+	typ  string
+	args map[string]inputValue
+}
+
+type typeDefinition struct {
+	kind        string
+	fields      map[string]field
+	inputFields map[string]inputValue
+	// values holds the enum values, union members or implemented interfaces depending on the kind
+	values map[string]struct{}
+}
+
+type directiveDefinition struct {
+	args       map[string]inputValue
+	locations  map[string]struct{}
+	repeatable bool
+}
+
+type schema struct {
+	types      map[string]*typeDefinition
+	directives map[string]*directiveDefinition
+}
+
+// Compare parses both schemas and returns the changes from the old to the new schema.
+func Compare(oldSchema, newSchema string) (*Diff, error) {
+	oldTypes, err := parseSchema(oldSchema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse old schema: %w", err)
+	}
+
+	newTypes, err := parseSchema(newSchema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse new schema: %w", err)
+	}
+
+	d := &differ{}
+	d.compare(oldTypes, newTypes)
+
+	// The changes are collected from maps, so every change must have a distinct position in the order
+	sort.Slice(d.changes, func(i, j int) bool {
+		a, b := d.changes[i], d.changes[j]
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		return a.Message < b.Message
+	})
+
+	return &Diff{Changes: d.changes}, nil
+}
+
+func parseSchema(input string) (schema, error) {
+	doc, report := astparser.ParseGraphqlDocumentString(input)
+	if report.HasErrors() {
+		return schema{}, report
+	}
+
+	s := schema{
+		types:      map[string]*typeDefinition{},
+		directives: map[string]*directiveDefinition{},
+	}
+
+	for _, node := range doc.RootNodes {
+		var kind string
+
+		switch node.Kind {
+		case ast.NodeKindDirectiveDefinition:
+			s.directives[doc.DirectiveDefinitionNameString(node.Ref)] = newDirectiveDefinition(&doc, node.Ref)
+			continue
+		case ast.NodeKindObjectTypeDefinition, ast.NodeKindObjectTypeExtension:
+			kind = kindObject
+		case ast.NodeKindInterfaceTypeDefinition, ast.NodeKindInterfaceTypeExtension:
+			kind = kindInterface
+		case ast.NodeKindUnionTypeDefinition, ast.NodeKindUnionTypeExtension:
+			kind = kindUnion
+		case ast.NodeKindEnumTypeDefinition, ast.NodeKindEnumTypeExtension:
+			kind = kindEnum
+		case ast.NodeKindInputObjectTypeDefinition, ast.NodeKindInputObjectTypeExtension:
+			kind = kindInputObject
+		case ast.NodeKindScalarTypeDefinition, ast.NodeKindScalarTypeExtension:
+			kind = kindScalar
+		default:
+			continue
+		}
+
+		name := doc.NodeNameString(node)
+
+		// Extensions are merged into the definition of the type
+		t, ok := s.types[name]
+		if !ok {
+			t = &typeDefinition{
+				kind:        kind,
+				fields:      map[string]field{},
+				inputFields: map[string]inputValue{},
+				values:      map[string]struct{}{},
+			}
+			s.types[name] = t
+		}
+
+		for _, ref := range doc.NodeFieldDefinitions(node) {
+			f := field{
+				typ:  printType(&doc, doc.FieldDefinitionType(ref)),
+				args: map[string]inputValue{},
+			}
+			for _, argRef := range doc.FieldDefinitionArgumentsDefinitions(ref) {
+				f.args[doc.InputValueDefinitionNameString(argRef)] = newInputValue(&doc, argRef)
+			}
+			t.fields[doc.FieldDefinitionNameString(ref)] = f
+		}
+
+		switch node.Kind {
+		case ast.NodeKindInputObjectTypeDefinition, ast.NodeKindInputObjectTypeExtension:
+			for _, ref := range doc.NodeInputValueDefinitions(node) {
+				t.inputFields[doc.InputValueDefinitionNameString(ref)] = newInputValue(&doc, ref)
+			}
+		case ast.NodeKindEnumTypeDefinition:
+			for _, ref := range doc.EnumTypeDefinitions[node.Ref].EnumValuesDefinition.Refs {
+				t.values[doc.EnumValueDefinitionNameString(ref)] = struct{}{}
+			}
+		case ast.NodeKindEnumTypeExtension:
+			for _, ref := range doc.EnumTypeExtensions[node.Ref].EnumValuesDefinition.Refs {
+				t.values[doc.EnumValueDefinitionNameString(ref)] = struct{}{}
+			}
+		case ast.NodeKindUnionTypeDefinition, ast.NodeKindUnionTypeExtension:
+			for _, ref := range doc.NodeUnionMemberRefs(node) {
+				t.values[doc.TypeNameString(ref)] = struct{}{}
+			}
+		case ast.NodeKindObjectTypeDefinition, ast.NodeKindObjectTypeExtension:
+			for _, ref := range doc.NodeInterfaceRefs(node) {
+				t.values[doc.TypeNameString(ref)] = struct{}{}
+			}
+		case ast.NodeKindInterfaceTypeDefinition:
+			for _, ref := range doc.InterfaceTypeDefinitions[node.Ref].ImplementsInterfaces.Refs {
+				t.values[doc.TypeNameString(ref)] = struct{}{}
+			}
+		case ast.NodeKindInterfaceTypeExtension:
+			for _, ref := range doc.InterfaceTypeExtensions[node.Ref].ImplementsInterfaces.Refs {
+				t.values[doc.TypeNameString(ref)] = struct{}{}
+			}
+		}
+	}
+
+	return s, nil
+}
+
+func newDirectiveDefinition(doc *ast.Document, ref int) *directiveDefinition {
+	definition := doc.DirectiveDefinitions[ref]
+	d := &directiveDefinition{
+		args:       map[string]inputValue{},
+		locations:  map[string]struct{}{},
+		repeatable: definition.Repeatable.IsRepeatable,
+	}
+	for _, argRef := range definition.ArgumentsDefinition.Refs {
+		d.args[doc.InputValueDefinitionNameString(argRef)] = newInputValue(doc, argRef)
+	}
+	// DirectiveLocations.Iterable skips the first location, so all locations are checked
+	for location := ast.ExecutableDirectiveLocationQuery; location <= ast.TypeSystemDirectiveLocationInputFieldDefinition; location++ {
+		if definition.DirectiveLocations.Get(location) {
+			d.locations[location.LiteralString()] = struct{}{}
+		}
+	}
+	return d
+}
+
+func newInputValue(doc *ast.Document, ref int) inputValue {
+	v := inputValue{
+		typ:        printType(doc, doc.InputValueDefinitions[ref].Type),
+		hasDefault: doc.InputValueDefinitionHasDefaultValue(ref),
+	}
+	if v.hasDefault {
+		value, err := doc.PrintValueBytes(doc.InputValueDefinitionDefaultValue(ref), nil)
+		if err == nil {
+			v.defaultValue = string(value)
+		}
+	}
+	return v
+}
+
+func printType(doc *ast.Document, ref int) string {
+	typ, err := doc.PrintTypeBytes(ref, nil)
+	if err != nil {
+		return ""
+	}
+	return string(typ)
+}
+
+type differ struct {
+	changes []Change
+}
+
+func (d *differ) add(changeType ChangeType, criticality Criticality, path, message string) {
+	d.changes = append(d.changes, Change{
+		Type:        changeType,
+		Criticality: criticality,
+		Path:        path,
+		Message:     message,
+	})
+}
+
+func (d *differ) compare(oldSchema, newSchema schema) {
+	for name, oldType := range oldSchema.types {
+		newType, ok := newSchema.types[name]
+		if !ok {
+			d.add(TypeRemoved, CriticalityBreaking, name, fmt.Sprintf("Type '%s' was removed", name))
+			continue
+		}
+		if oldType.kind != newType.kind {
+			d.add(TypeKindChanged, CriticalityBreaking, name, fmt.Sprintf("Type '%s' changed from %s to %s", name, oldType.kind, newType.kind))
+			continue
+		}
+		d.compareType(name, oldType, newType)
+	}
+
+	// Fields and arguments of new types are not reported separately
+	for name := range newSchema.types {
+		if _, ok := oldSchema.types[name]; !ok {
+			d.add(TypeAdded, CriticalitySafe, name, fmt.Sprintf("Type '%s' was added", name))
+		}
+	}
+
+	d.compareDirectives(oldSchema.directives, newSchema.directives)
+}
+
+func (d *differ) compareType(typeName string, oldType, newType *typeDefinition) {
+	for fieldName, oldField := range oldType.fields {
+		path := typeName + "." + fieldName
+
+		newField, ok := newType.fields[fieldName]
+		if !ok {
+			d.add(FieldRemoved, CriticalityBreaking, path, fmt.Sprintf("Field '%s' was removed", path))
+			continue
+		}
+
+		if oldField.typ != newField.typ {
+			criticality := CriticalityBreaking
+			if isSafeOutputTypeChange(oldField.typ, newField.typ) {
+				criticality = CriticalitySafe
+			}
+			d.add(FieldTypeChanged, criticality, path, fmt.Sprintf("Field '%s' changed type from '%s' to '%s'", path, oldField.typ, newField.typ))
+		}
+
+		d.compareInputValues(path, "Argument", oldField.args, newField.args, argumentChanges)
+	}
+
+	for fieldName := range newType.fields {
+		if _, ok := oldType.fields[fieldName]; !ok {
+			path := typeName + "." + fieldName
+			d.add(FieldAdded, CriticalitySafe, path, fmt.Sprintf("Field '%s' was added", path))
+		}
+	}
+
+	d.compareInputValues(typeName, "Input field", oldType.inputFields, newType.inputFields, inputFieldChanges)
+
+	var added, removed ChangeType
+	var addedCriticality Criticality
+	var noun string
+
+	switch oldType.kind {
+	case kindEnum:
+		added, removed, addedCriticality, noun = EnumValueAdded, EnumValueRemoved, CriticalityDangerous, "Enum value"
+	case kindUnion:
+		added, removed, addedCriticality, noun = UnionMemberAdded, UnionMemberRemoved, CriticalityDangerous, "Member"
+	case kindObject, kindInterface:
+		added, removed, addedCriticality, noun = InterfaceAdded, InterfaceRemoved, CriticalityDangerous, "Interface"
+	default:
+		return
+	}
+
+	for value := range oldType.values {
+		if _, ok := newType.values[value]; !ok {
+			d.add(removed, CriticalityBreaking, typeName+"."+value, fmt.Sprintf("%s '%s' was removed from '%s'", noun, value, typeName))
+		}
+	}
+	for value := range newType.values {
+		if _, ok := oldType.values[value]; !ok {
+			d.add(added, addedCriticality, typeName+"."+value, fmt.Sprintf("%s '%s' was added to '%s'", noun, value, typeName))
+		}
+	}
+}
+
+func (d *differ) compareDirectives(oldDirectives, newDirectives map[string]*directiveDefinition) {
+	for name, oldDirective := range oldDirectives {
+		path := "@" + name
+
+		newDirective, ok := newDirectives[name]
+		if !ok {
+			d.add(DirectiveRemoved, CriticalityBreaking, path, fmt.Sprintf("Directive '%s' was removed", path))
+			continue
+		}
+
+		for location := range oldDirective.locations {
+			if _, ok := newDirective.locations[location]; !ok {
+				d.add(DirectiveLocationRemoved, CriticalityBreaking, path, fmt.Sprintf("Location '%s' was removed from directive '%s'", location, path))
+			}
+		}
+		for location := range newDirective.locations {
+			if _, ok := oldDirective.locations[location]; !ok {
+				d.add(DirectiveLocationAdded, CriticalitySafe, path, fmt.Sprintf("Location '%s' was added to directive '%s'", location, path))
+			}
+		}
+
+		switch {
+		case oldDirective.repeatable && !newDirective.repeatable:
+			d.add(DirectiveRepeatableRemoved, CriticalityBreaking, path, fmt.Sprintf("Directive '%s' is no longer repeatable", path))
+		case !oldDirective.repeatable && newDirective.repeatable:
+			d.add(DirectiveRepeatableAdded, CriticalitySafe, path, fmt.Sprintf("Directive '%s' is now repeatable", path))
+		}
+
+		d.compareInputValues(path, "Argument", oldDirective.args, newDirective.args, argumentChanges)
+	}
+
+	for name := range newDirectives {
+		if _, ok := oldDirectives[name]; !ok {
+			path := "@" + name
+			d.add(DirectiveAdded, CriticalitySafe, path, fmt.Sprintf("Directive '%s' was added", path))
+		}
+	}
+}
+
+type inputValueChanges struct {
+	added, removed, typeChanged, defaultChanged ChangeType
+	// path returns the coordinate of the input value
+	path func(parent, name string) string
+}
+
+var argumentChanges = inputValueChanges{
+	added:          ArgumentAdded,
+	removed:        ArgumentRemoved,
+	typeChanged:    ArgumentTypeChanged,
+	defaultChanged: ArgumentDefaultChanged,
+	path: func(parent, name string) string {
+		return parent + "(" + name + ")"
+	},
+}
+
+var inputFieldChanges = inputValueChanges{
+	added:          InputFieldAdded,
+	removed:        InputFieldRemoved,
+	typeChanged:    InputFieldTypeChanged,
+	defaultChanged: InputFieldDefaultChanged,
+	path: func(parent, name string) string {
+		return parent + "." + name
+	},
+}
+
+func (d *differ) compareInputValues(parent, noun string, oldValues, newValues map[string]inputValue, changes inputValueChanges) {
+	for name, oldValue := range oldValues {
+		path := changes.path(parent, name)
+
+		newValue, ok := newValues[name]
+		if !ok {
+			d.add(changes.removed, CriticalityBreaking, path, fmt.Sprintf("%s '%s' was removed", noun, path))
+			continue
+		}
+
+		if oldValue.typ != newValue.typ {
+			criticality := CriticalityBreaking
+			if isSafeInputTypeChange(oldValue.typ, newValue.typ) {
+				criticality = CriticalitySafe
+			}
+			d.add(changes.typeChanged, criticality, path, fmt.Sprintf("%s '%s' changed type from '%s' to '%s'", noun, path, oldValue.typ, newValue.typ))
+		}
+
+		if oldValue.hasDefault != newValue.hasDefault || oldValue.defaultValue != newValue.defaultValue {
+			d.add(changes.defaultChanged, CriticalityDangerous, path, fmt.Sprintf("%s '%s' changed default value from '%s' to '%s'", noun, path, oldValue.defaultValue, newValue.defaultValue))
+		}
+	}
+
+	for name, newValue := range newValues {
+		if _, ok := oldValues[name]; ok {
+			continue
+		}
+		path := changes.path(parent, name)
+		// A new required input value breaks operations that don't set it
+		if isNonNull(newValue.typ) && !newValue.hasDefault {
+			d.add(changes.added, CriticalityBreaking, path, fmt.Sprintf("Required %s '%s' was added", strings.ToLower(noun), path))
+			continue
+		}
+		d.add(changes.added, CriticalityDangerous, path, fmt.Sprintf("%s '%s' was added", noun, path))
+	}
+}
+
+func isNonNull(typ string) bool {
+	return len(typ) > 0 && typ[len(typ)-1] == '!'
+}
+
+func isList(typ string) bool {
+	return len(typ) > 1 && typ[0] == '[' && typ[len(typ)-1] == ']'
+}
+
+// isSafeOutputTypeChange returns true if the new type of a field only narrows the old type, e.g. String to String!
+func isSafeOutputTypeChange(oldType, newType string) bool {
+	if oldType == newType {
+		return true
+	}
+	if isNonNull(newType) {
+		if isNonNull(oldType) {
+			return isSafeOutputTypeChange(oldType[:len(oldType)-1], newType[:len(newType)-1])
+		}
+		return isSafeOutputTypeChange(oldType, newType[:len(newType)-1])
+	}
+	if isList(oldType) && isList(newType) {
+		return isSafeOutputTypeChange(oldType[1:len(oldType)-1], newType[1:len(newType)-1])
+	}
+	return false
+}
+
+// isSafeInputTypeChange returns true if the new type of an input value only widens the old type, e.g. String! to String
+func isSafeInputTypeChange(oldType, newType string) bool {
+	if oldType == newType {
+		return true
+	}
+	if isNonNull(oldType) {
+		if isNonNull(newType) {
+			return isSafeInputTypeChange(oldType[:len(oldType)-1], newType[:len(newType)-1])
+		}
+		return isSafeInputTypeChange(oldType[:len(oldType)-1], newType)
+	}
+	if isList(oldType) && isList(newType) {
+		return isSafeInputTypeChange(oldType[1:len(oldType)-1], newType[1:len(newType)-1])
+	}
+	return false
+}
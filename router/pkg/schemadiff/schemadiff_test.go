@@ -0,0 +1,192 @@
+package schemadiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const oldSchema = `
+type Query {
+  employee(id: Int!): Employee
+  employees(limit: Int = 10): [Employee!]!
+  team: Team
+}
+
+interface Node {
+  id: ID!
+}
+
+type Employee implements Node {
+  id: ID!
+  name: String
+  role: Role!
+}
+
+type Team {
+  id: ID!
+}
+
+enum Role {
+  ENGINEER
+  MANAGER
+}
+
+union SearchResult = Employee | Team
+
+input EmployeeFilter {
+  name: String
+  role: Role!
+}
+`
+
+const newSchema = `
+type Query {
+  employee(id: Int!, active: Boolean): Employee
+  employees(limit: Int = 20, offset: Int!): [Employee!]!
+  search(filter: EmployeeFilter): [SearchResult!]!
+}
+
+interface Node {
+  id: ID!
+}
+
+type Employee {
+  id: ID!
+  name: String!
+  role: String!
+}
+
+type Department {
+  id: ID!
+}
+
+enum Role {
+  ENGINEER
+  OPERATIONS
+}
+
+union SearchResult = Employee | Department
+
+input EmployeeFilter {
+  name: String
+  role: Role
+  team: ID!
+}
+`
+
+func TestCompare(t *testing.T) {
+	diff, err := Compare(oldSchema, newSchema)
+	require.NoError(t, err)
+
+	changes := make(map[string]Change, len(diff.Changes))
+	for _, change := range diff.Changes {
+		changes[string(change.Type)+" "+change.Path] = change
+	}
+
+	expected := map[string]Criticality{
+		"TYPE_ADDED Department":                           CriticalitySafe,
+		"TYPE_REMOVED Team":                               CriticalityBreaking,
+		"FIELD_ADDED Query.search":                        CriticalitySafe,
+		"FIELD_REMOVED Query.team":                        CriticalityBreaking,
+		"FIELD_TYPE_CHANGED Employee.name":                CriticalitySafe,
+		"FIELD_TYPE_CHANGED Employee.role":                CriticalityBreaking,
+		"ARGUMENT_ADDED Query.employee(active)":           CriticalityDangerous,
+		"ARGUMENT_ADDED Query.employees(offset)":          CriticalityBreaking,
+		"ARGUMENT_DEFAULT_CHANGED Query.employees(limit)": CriticalityDangerous,
+		"INPUT_FIELD_ADDED EmployeeFilter.team":           CriticalityBreaking,
+		"INPUT_FIELD_TYPE_CHANGED EmployeeFilter.role":    CriticalitySafe,
+		"ENUM_VALUE_ADDED Role.OPERATIONS":                CriticalityDangerous,
+		"ENUM_VALUE_REMOVED Role.MANAGER":                 CriticalityBreaking,
+		"UNION_MEMBER_ADDED SearchResult.Department":      CriticalityDangerous,
+		"UNION_MEMBER_REMOVED SearchResult.Team":          CriticalityBreaking,
+		"INTERFACE_REMOVED Employee.Node":                 CriticalityBreaking,
+	}
+
+	require.Len(t, changes, len(expected))
+	for key, criticality := range expected {
+		change, ok := changes[key]
+		require.True(t, ok, "missing change %s", key)
+		require.Equal(t, criticality, change.Criticality, key)
+	}
+
+	require.Equal(t, 8, diff.Count(CriticalityBreaking))
+	require.Len(t, diff.Filter(CriticalityDangerous), 4)
+
+	// Changes are ordered by path
+	for i := 1; i < len(diff.Changes); i++ {
+		require.LessOrEqual(t, diff.Changes[i-1].Path, diff.Changes[i].Path)
+	}
+}
+
+func TestCompareDirectives(t *testing.T) {
+	diff, err := Compare(`
+type Query { a: String }
+directive @tag(name: String!) repeatable on FIELD_DEFINITION | OBJECT
+directive @key(fields: String!) on OBJECT
+directive @cache(maxAge: Int) on QUERY
+`, `
+type Query { a: String }
+directive @tag(name: String!, scope: String!) on FIELD_DEFINITION | INTERFACE
+directive @cache(maxAge: Int) repeatable on QUERY | FIELD
+directive @auth on OBJECT
+`)
+	require.NoError(t, err)
+
+	expected := []Change{
+		{Type: DirectiveAdded, Criticality: CriticalitySafe, Path: "@auth", Message: "Directive '@auth' was added"},
+		{Type: DirectiveLocationAdded, Criticality: CriticalitySafe, Path: "@cache", Message: "Location 'FIELD' was added to directive '@cache'"},
+		{Type: DirectiveRepeatableAdded, Criticality: CriticalitySafe, Path: "@cache", Message: "Directive '@cache' is now repeatable"},
+		{Type: DirectiveRemoved, Criticality: CriticalityBreaking, Path: "@key", Message: "Directive '@key' was removed"},
+		{Type: DirectiveLocationAdded, Criticality: CriticalitySafe, Path: "@tag", Message: "Location 'INTERFACE' was added to directive '@tag'"},
+		{Type: DirectiveLocationRemoved, Criticality: CriticalityBreaking, Path: "@tag", Message: "Location 'OBJECT' was removed from directive '@tag'"},
+		{Type: DirectiveRepeatableRemoved, Criticality: CriticalityBreaking, Path: "@tag", Message: "Directive '@tag' is no longer repeatable"},
+	}
+
+	require.Len(t, diff.Changes, len(expected)+1)
+	argument := diff.Changes[len(diff.Changes)-1]
+	require.Equal(t, "@tag(scope)", argument.Path)
+	require.Equal(t, CriticalityBreaking, argument.Criticality)
+	require.Equal(t, expected, diff.Changes[:len(expected)])
+}
+
+func TestCompareOrderIsDeterministic(t *testing.T) {
+	first, err := Compare(oldSchema, newSchema)
+	require.NoError(t, err)
+
+	for i := 0; i < 20; i++ {
+		diff, err := Compare(oldSchema, newSchema)
+		require.NoError(t, err)
+		require.Equal(t, first.Changes, diff.Changes)
+	}
+}
+
+func TestCompareEqualSchemas(t *testing.T) {
+	diff, err := Compare(oldSchema, oldSchema)
+	require.NoError(t, err)
+	require.False(t, diff.HasChanges())
+}
+
+func TestCompareMergesExtensions(t *testing.T) {
+	diff, err := Compare(
+		`type Query { a: String } extend type Query { b: String }`,
+		`type Query { a: String b: String }`,
+	)
+	require.NoError(t, err)
+	require.False(t, diff.HasChanges())
+}
+
+func TestCompareInvalidSchema(t *testing.T) {
+	_, err := Compare(`type Query {`, oldSchema)
+	require.Error(t, err)
+}
+
+func TestTypeChanges(t *testing.T) {
+	require.True(t, isSafeOutputTypeChange("[String]", "[String!]!"))
+	require.False(t, isSafeOutputTypeChange("String!", "String"))
+	require.False(t, isSafeOutputTypeChange("[String]", "String"))
+
+	require.True(t, isSafeInputTypeChange("[String!]!", "[String]"))
+	require.False(t, isSafeInputTypeChange("String", "String!"))
+	require.False(t, isSafeInputTypeChange("Int", "String"))
+}